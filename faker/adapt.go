@@ -0,0 +1,108 @@
+package faker
+
+import (
+	"github.com/grafana/sobek"
+)
+
+// maxEntities is the maximum number of stored entities per kind,
+// the oldest entities are overwritten when the limit is reached.
+const maxEntities = 10000
+
+// entities contains the stored entities of a kind.
+type entities struct {
+	values []sobek.Value
+	next   int
+}
+
+// add stores the value, overwriting the oldest one if the limit is reached.
+func (e *entities) add(value sobek.Value) {
+	if len(e.values) < maxEntities {
+		e.values = append(e.values, value)
+
+		return
+	}
+
+	e.values[e.next] = value
+	e.next = (e.next + 1) % maxEntities
+}
+
+// adapt returns a function which calls fn with its arguments (e.g. a k6 HTTP response)
+// and stores the entities returned by fn in the entity store of the instance.
+//
+// The fn function returns an object, the property names are the entity kinds,
+// the property values are the entities (e.g. server-assigned IDs) or arrays of entities.
+// The stored entities can be referenced by the ref method or by ref field specifications in schemas.
+func (f *faker) adapt(fn sobek.Value) sobek.Value {
+	callable, ok := sobek.AssertFunction(fn)
+	if !ok {
+		f.throw(&ArgumentError{Function: "adapt", Parameter: "fn", Expected: "function", Reason: "invalid value " + fn.String()})
+	}
+
+	return f.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
+		val, err := callable(call.This, call.Arguments...)
+		if err != nil {
+			panic(err)
+		}
+
+		f.remember(val)
+
+		return val
+	})
+}
+
+// remember stores the entities of the object in the entity store.
+func (f *faker) remember(val sobek.Value) {
+	if val == nil || sobek.IsUndefined(val) || sobek.IsNull(val) {
+		return
+	}
+
+	obj, ok := val.(*sobek.Object)
+	if !ok || obj.ClassName() != "Object" {
+		f.throw(&ArgumentError{Function: "adapt", Parameter: "fn", Expected: "function returning object", Reason: "invalid result " + val.String()})
+	}
+
+	for _, kind := range obj.Keys() {
+		store, found := f.entities[kind]
+		if !found {
+			store = new(entities)
+			f.entities[kind] = store
+		}
+
+		value := obj.Get(kind)
+
+		if arr, ok := value.(*sobek.Object); ok && arr.ClassName() == "Array" {
+			var items []sobek.Value
+
+			_ = f.runtime.ExportTo(arr, &items)
+
+			for _, item := range items {
+				store.add(item)
+			}
+
+			continue
+		}
+
+		if !sobek.IsUndefined(value) && !sobek.IsNull(value) {
+			store.add(value)
+		}
+	}
+}
+
+// pickEntity returns a random stored entity of the kind.
+func (f *faker) pickEntity(kind string) (sobek.Value, bool) {
+	store, found := f.entities[kind]
+	if !found || len(store.values) == 0 {
+		return nil, false
+	}
+
+	return store.values[f.rand.Intn(len(store.values))], true
+}
+
+// ref returns a random stored entity of the kind, undefined if there is no such entity.
+func (f *faker) ref(kind string) sobek.Value {
+	if val, found := f.pickEntity(kind); found {
+		return val
+	}
+
+	return sobek.Undefined()
+}
@@ -0,0 +1,88 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errUnknownDistribution = errors.New("unknown distribution")
+	errInvalidArrivalRate  = errors.New("rate must be a positive number")
+)
+
+// Inter-arrival time distributions.
+const (
+	// arrivalPoisson is the distribution of a Poisson arrival process (exponentially distributed times).
+	arrivalPoisson = "poisson"
+	// arrivalPareto is a heavy tailed distribution of bursty arrivals.
+	arrivalPareto = "pareto"
+)
+
+// paretoShape is the shape of the Pareto distribution, between 1 and 2 the variance is infinite (bursty traffic).
+const paretoShape = 1.5
+
+func init() {
+	gofakeit.AddFuncLookup("interarrival", gofakeit.Info{
+		Display:     "Interarrival",
+		Category:    "time",
+		Description: "Time until the next arrival in seconds, successive values follow the arrival process of the distribution",
+		Example:     "0.734",
+		Output:      "float64",
+		Params: []gofakeit.Param{
+			{
+				Field:       "distribution",
+				Display:     "Distribution",
+				Type:        "string",
+				Default:     arrivalPoisson,
+				Options:     []string{arrivalPoisson, arrivalPareto},
+				Description: "Distribution of the inter-arrival times, poisson for random and pareto for bursty arrivals",
+			},
+			{
+				Field:       "rate",
+				Display:     "Rate",
+				Type:        "float",
+				Default:     "1",
+				Description: "Average number of arrivals per second",
+			},
+		},
+		Generate: interarrival,
+	})
+}
+
+// interarrivalTime returns a random inter-arrival time in seconds, the mean of the times is 1/rate.
+func interarrivalTime(r *rand.Rand, distribution string, rate float64) (float64, error) {
+	if !(rate > 0) || math.IsInf(rate, 0) {
+		return 0, fmt.Errorf("%w: %g", errInvalidArrivalRate, rate)
+	}
+
+	switch strings.ToLower(distribution) {
+	case arrivalPoisson:
+		return r.ExpFloat64() / rate, nil
+	case arrivalPareto:
+		// the scale is chosen so that the mean (shape * scale / (shape - 1)) is 1/rate
+		scale := (paretoShape - 1) / (paretoShape * rate)
+
+		return scale / math.Pow(1-r.Float64(), 1/paretoShape), nil
+	default:
+		return 0, fmt.Errorf("%w: %s", errUnknownDistribution, distribution)
+	}
+}
+
+func interarrival(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	distribution, err := info.GetString(m, "distribution")
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := info.GetFloat64(m, "rate")
+	if err != nil {
+		return nil, err
+	}
+
+	return interarrivalTime(r, distribution, rate)
+}
@@ -0,0 +1,53 @@
+package faker_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_interarrival(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("interarrival")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	const count = 20000
+
+	for _, distribution := range []string{"poisson", "Pareto"} {
+		params := gofakeit.NewMapParams()
+		params.Add("distribution", distribution)
+		params.Add("rate", "4")
+
+		var sum float64
+
+		for range count {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Greater(t, val, 0.0)
+
+			sum += val.(float64) //nolint:forcetypeassert
+		}
+
+		require.InDelta(t, 0.25, sum/count, 0.05, distribution)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("distribution", "uniform")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown distribution")
+
+	params = gofakeit.NewMapParams()
+	params.Add("rate", "0")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "rate must be a positive number")
+}
@@ -0,0 +1,396 @@
+package faker
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// arrowMagic is the magic string at the beginning (padded to 8 bytes) and at the end of Arrow IPC files.
+const arrowMagic = "ARROW1"
+
+// Arrow metadata version, message header types, type union types and floating point precision.
+const (
+	arrowVersion       = 4 // V5
+	arrowSchema        = 1
+	arrowRecordBatch   = 3
+	arrowInt           = 2
+	arrowFloatingPoint = 3
+	arrowUtf8          = 5
+	arrowBool          = 6
+	arrowDouble        = 2
+)
+
+// arrowContinuation is the marker preceding the encapsulated messages.
+const arrowContinuation = 0xffffffff
+
+// arrowBlock is the position of a record batch message in the Arrow file.
+type arrowBlock struct {
+	offset   int
+	metadata int
+	body     int
+}
+
+// encodeArrow encodes the columns in Arrow IPC file format: the schema message and the record batches
+// of at most columnarBatchRows rows, followed by the footer.
+// Every column is a nullable column of a primitive type (Bool, Int64, Float64 or Utf8), stored uncompressed.
+func encodeArrow(columns []*column, rows int) ([]byte, error) {
+	buff := append([]byte(arrowMagic), 0, 0)
+	schema := arrowSchemaTable(columns)
+
+	buff, _ = appendArrowMessage(buff, arrowSchema, schema, nil)
+
+	var blocks []arrowBlock
+
+	for from := 0; from < rows; from += columnarBatchRows {
+		batch, body, err := arrowRecordBatchTable(columns, from, min(from+columnarBatchRows, rows))
+		if err != nil {
+			return nil, err
+		}
+
+		var block arrowBlock
+
+		buff, block = appendArrowMessage(buff, arrowRecordBatch, batch, body)
+		blocks = append(blocks, block)
+	}
+
+	// end-of-stream marker
+	buff = binary.LittleEndian.AppendUint32(buff, arrowContinuation)
+	buff = binary.LittleEndian.AppendUint32(buff, 0)
+
+	const blockSize = 24
+
+	records := make([]byte, 0, blockSize*len(blocks))
+
+	for _, block := range blocks {
+		records = binary.LittleEndian.AppendUint64(records, uint64(block.offset))   //nolint:gosec
+		records = binary.LittleEndian.AppendUint32(records, uint32(block.metadata)) //nolint:gosec
+		records = binary.LittleEndian.AppendUint32(records, 0)
+		records = binary.LittleEndian.AppendUint64(records, uint64(block.body)) //nolint:gosec
+	}
+
+	footer := encodeFlatbuffer(fbTable{
+		fbInt16(arrowVersion),
+		fbRef(schema),
+		fbRef(fbStructs{align: 8}),
+		fbRef(fbStructs{data: records, count: len(blocks), align: 8}),
+	})
+
+	buff = append(buff, footer...)
+	buff = binary.LittleEndian.AppendUint32(buff, uint32(len(footer))) //nolint:gosec
+
+	return append(buff, arrowMagic...), nil
+}
+
+// appendArrowMessage appends the encapsulated message to the buffer: the continuation marker,
+// the size of the metadata, the Message flatbuffer and the body.
+func appendArrowMessage(buff []byte, headerType byte, header fbTable, body []byte) ([]byte, arrowBlock) {
+	meta := encodeFlatbuffer(fbTable{
+		fbInt16(arrowVersion),
+		fbUint8(headerType),
+		fbRef(header),
+		fbInt64(int64(len(body))),
+	})
+
+	block := arrowBlock{offset: len(buff), metadata: 8 + len(meta), body: len(body)}
+
+	buff = binary.LittleEndian.AppendUint32(buff, arrowContinuation)
+	buff = binary.LittleEndian.AppendUint32(buff, uint32(len(meta))) //nolint:gosec
+	buff = append(buff, meta...)
+
+	return append(buff, body...), block
+}
+
+// arrowSchemaTable returns the Schema table of the columns.
+func arrowSchemaTable(columns []*column) fbTable {
+	fields := make(fbVector, len(columns))
+
+	for idx, col := range columns {
+		var (
+			typeType byte
+			typ      fbTable
+		)
+
+		switch col.kind {
+		case columnBoolean:
+			typeType, typ = arrowBool, fbTable{}
+		case columnInt64:
+			typeType, typ = arrowInt, fbTable{fbInt32(64), fbBool(true)}
+		case columnDouble:
+			typeType, typ = arrowFloatingPoint, fbTable{fbInt16(arrowDouble)}
+		case columnString:
+			typeType, typ = arrowUtf8, fbTable{}
+		}
+
+		fields[idx] = fbTable{
+			fbRef(fbString(col.name)),
+			fbBool(true),
+			fbUint8(typeType),
+			fbRef(typ),
+			{},
+			fbRef(fbVector{}),
+		}
+	}
+
+	return fbTable{{}, fbRef(fields)}
+}
+
+// arrowRecordBatchTable returns the RecordBatch table and the body of the rows from and to (exclusive).
+// The body contains the validity bitmap and the value buffers of every column, each padded to 8 bytes.
+func arrowRecordBatchTable(columns []*column, from, to int) (fbTable, []byte, error) {
+	const structSize = 16
+
+	var (
+		body    []byte
+		nodes   []byte
+		buffers []byte
+	)
+
+	addBuffer := func(data []byte) {
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(body))) //nolint:gosec
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(data))) //nolint:gosec
+
+		body = append(body, data...)
+
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+
+	for _, col := range columns {
+		nulls := col.nulls(from, to)
+
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(to-from)) //nolint:gosec
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(nulls))   //nolint:gosec
+
+		if nulls == 0 {
+			addBuffer(nil)
+		} else {
+			addBuffer(appendBitmap(nil, col.valid[from:to]))
+		}
+
+		switch col.kind {
+		case columnBoolean:
+			addBuffer(appendBitmap(nil, col.bools[from:to]))
+		case columnInt64:
+			data := make([]byte, 0, 8*(to-from))
+
+			for _, val := range col.ints[from:to] {
+				data = binary.LittleEndian.AppendUint64(data, uint64(val)) //nolint:gosec
+			}
+
+			addBuffer(data)
+		case columnDouble:
+			data := make([]byte, 0, 8*(to-from))
+
+			for _, val := range col.floats[from:to] {
+				data = binary.LittleEndian.AppendUint64(data, math.Float64bits(val))
+			}
+
+			addBuffer(data)
+		case columnString:
+			offsets := binary.LittleEndian.AppendUint32(make([]byte, 0, 4*(to-from+1)), 0)
+
+			var data []byte
+
+			for _, val := range col.strings[from:to] {
+				data = append(data, val...)
+
+				if len(data) > math.MaxInt32 {
+					return nil, nil, errColumnTooLarge
+				}
+
+				offsets = binary.LittleEndian.AppendUint32(offsets, uint32(len(data))) //nolint:gosec
+			}
+
+			addBuffer(offsets)
+			addBuffer(data)
+		}
+	}
+
+	batch := fbTable{
+		fbInt64(int64(to - from)),
+		fbRef(fbStructs{data: nodes, count: len(nodes) / structSize, align: 8}),
+		fbRef(fbStructs{data: buffers, count: len(buffers) / structSize, align: 8}),
+	}
+
+	return batch, body, nil
+}
+
+// fbField is a field of a flatbuffers table: a scalar stored inline or an object referenced by offset.
+// The zero value is an absent field.
+type fbField struct {
+	inline []byte
+	object fbObject
+}
+
+// fbObject is a flatbuffers object referenced by offset: a table, a vector or a string.
+type fbObject interface {
+	// write writes the object at the end of the buffer and returns its position.
+	write(b *fbBuilder) int
+}
+
+// fbTable is a flatbuffers table, the fields are indexed by their field ID.
+type fbTable []fbField
+
+// fbVector is a flatbuffers vector of objects (e.g. tables).
+type fbVector []fbObject
+
+// fbString is a flatbuffers string.
+type fbString string
+
+// fbStructs is a flatbuffers vector of structs stored inline.
+type fbStructs struct {
+	data  []byte
+	count int
+	align int
+}
+
+// fbBuilder builds flatbuffers front to back: the objects referenced by offset
+// are written after the referencing table or vector, so the offsets are always positive.
+type fbBuilder struct {
+	buff []byte
+}
+
+func fbUint8(val byte) fbField {
+	return fbField{inline: []byte{val}}
+}
+
+func fbBool(val bool) fbField {
+	if val {
+		return fbUint8(1)
+	}
+
+	return fbUint8(0)
+}
+
+func fbInt16(val int16) fbField {
+	return fbField{inline: binary.LittleEndian.AppendUint16(nil, uint16(val))} //nolint:gosec
+}
+
+func fbInt32(val int32) fbField {
+	return fbField{inline: binary.LittleEndian.AppendUint32(nil, uint32(val))} //nolint:gosec
+}
+
+func fbInt64(val int64) fbField {
+	return fbField{inline: binary.LittleEndian.AppendUint64(nil, uint64(val))} //nolint:gosec
+}
+
+func fbRef(obj fbObject) fbField {
+	return fbField{object: obj}
+}
+
+// encodeFlatbuffer returns the flatbuffer of the root table, padded to 8 bytes.
+func encodeFlatbuffer(root fbTable) []byte {
+	b := &fbBuilder{buff: make([]byte, 4)}
+
+	b.patch(0, root.write(b))
+	b.pad(8, 0)
+
+	return b.buff
+}
+
+// pad appends zero bytes until the buffer length is offset modulo align.
+func (b *fbBuilder) pad(align int, offset int) {
+	for len(b.buff)%align != offset {
+		b.buff = append(b.buff, 0)
+	}
+}
+
+// patch sets the offset at the position to point to the target position.
+func (b *fbBuilder) patch(pos int, target int) {
+	binary.LittleEndian.PutUint32(b.buff[pos:], uint32(target-pos)) //nolint:gosec
+}
+
+// write writes the vtable followed by the table. The table is aligned to 8 bytes
+// and the inline fields are aligned to their size, the referenced objects are written after the table.
+func (t fbTable) write(b *fbBuilder) int {
+	offsets := make([]int, len(t))
+	size := 4 // offset of the vtable
+
+	for id, field := range t {
+		width := len(field.inline)
+
+		if field.object != nil {
+			width = 4
+		}
+
+		if width == 0 {
+			continue
+		}
+
+		for size%width != 0 {
+			size++
+		}
+
+		offsets[id] = size
+		size += width
+	}
+
+	b.pad(2, 0)
+
+	vtable := len(b.buff)
+
+	b.buff = binary.LittleEndian.AppendUint16(b.buff, uint16(4+2*len(t))) //nolint:gosec
+	b.buff = binary.LittleEndian.AppendUint16(b.buff, uint16(size))       //nolint:gosec
+
+	for _, offset := range offsets {
+		b.buff = binary.LittleEndian.AppendUint16(b.buff, uint16(offset)) //nolint:gosec
+	}
+
+	b.pad(8, 0)
+
+	table := len(b.buff)
+
+	b.buff = binary.LittleEndian.AppendUint32(b.buff, uint32(table-vtable)) //nolint:gosec
+	b.buff = append(b.buff, make([]byte, size-4)...)
+
+	for id, field := range t {
+		copy(b.buff[table+offsets[id]:], field.inline)
+	}
+
+	for id, field := range t {
+		if field.object != nil {
+			b.patch(table+offsets[id], field.object.write(b))
+		}
+	}
+
+	return table
+}
+
+func (v fbVector) write(b *fbBuilder) int {
+	b.pad(4, 0)
+
+	pos := len(b.buff)
+
+	b.buff = binary.LittleEndian.AppendUint32(b.buff, uint32(len(v))) //nolint:gosec
+	b.buff = append(b.buff, make([]byte, 4*len(v))...)
+
+	for idx, obj := range v {
+		b.patch(pos+4+4*idx, obj.write(b))
+	}
+
+	return pos
+}
+
+func (s fbString) write(b *fbBuilder) int {
+	b.pad(4, 0)
+
+	pos := len(b.buff)
+
+	b.buff = binary.LittleEndian.AppendUint32(b.buff, uint32(len(s))) //nolint:gosec
+	b.buff = append(append(b.buff, s...), 0)
+
+	return pos
+}
+
+// write writes the length of the vector, so that the structs following it are aligned.
+func (s fbStructs) write(b *fbBuilder) int {
+	b.pad(s.align, s.align-4)
+
+	pos := len(b.buff)
+
+	b.buff = binary.LittleEndian.AppendUint32(b.buff, uint32(s.count)) //nolint:gosec
+	b.buff = append(b.buff, s.data...)
+
+	return pos
+}
@@ -0,0 +1,577 @@
+package faker
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errInvalidAvroSchema = errors.New("invalid Avro schema")
+
+// avroPrimitives contains the names of the Avro primitive types.
+var avroPrimitives = map[string]struct{}{ //nolint:gochecknoglobals
+	"null": {}, "boolean": {}, "int": {}, "long": {}, "float": {}, "double": {}, "bytes": {}, "string": {},
+}
+
+// avroSchema is a parsed Avro schema.
+type avroSchema struct {
+	// typ is the name of a primitive type or one of record, enum, array, map, union and fixed.
+	typ string
+	// name is the full name of named types (record, enum, fixed).
+	name    string
+	logical string
+	fields  []*avroField
+	symbols []string
+	// items is the schema of array items or map values.
+	items    *avroSchema
+	branches []*avroSchema
+	size     int
+}
+
+// avroField is a field of an Avro record schema.
+type avroField struct {
+	name       string
+	schema     *avroSchema
+	def        any
+	hasDefault bool
+}
+
+// parseAvroSchema parses the Avro schema in JSON format.
+func parseAvroSchema(src string) (*avroSchema, error) {
+	var def any
+
+	if err := json.Unmarshal([]byte(src), &def); err != nil {
+		// a primitive type name is valid schema without quotes too
+		def = src
+	}
+
+	return newAvroParser().parse(def, "")
+}
+
+type avroParser struct {
+	named map[string]*avroSchema
+}
+
+func newAvroParser() *avroParser {
+	return &avroParser{named: make(map[string]*avroSchema)}
+}
+
+func (p *avroParser) parse(def any, namespace string) (*avroSchema, error) {
+	switch def := def.(type) {
+	case string:
+		return p.reference(def, namespace)
+	case []any:
+		union := &avroSchema{typ: "union", branches: make([]*avroSchema, len(def))}
+
+		for idx, branch := range def {
+			schema, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+
+			union.branches[idx] = schema
+		}
+
+		return union, nil
+	case map[string]any:
+		return p.parseComplex(def, namespace)
+	default:
+		return nil, fmt.Errorf("%w: unexpected %v", errInvalidAvroSchema, def)
+	}
+}
+
+// reference returns the schema of a primitive type or a previously defined named type.
+func (p *avroParser) reference(name string, namespace string) (*avroSchema, error) {
+	if _, primitive := avroPrimitives[name]; primitive {
+		return &avroSchema{typ: name}, nil
+	}
+
+	if schema, found := p.named[avroFullName(name, namespace)]; found {
+		return schema, nil
+	}
+
+	if schema, found := p.named[name]; found {
+		return schema, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown type %s", errInvalidAvroSchema, name)
+}
+
+func (p *avroParser) parseComplex(def map[string]any, namespace string) (*avroSchema, error) {
+	typ, isName := def["type"].(string)
+	if !isName {
+		return p.parse(def["type"], namespace)
+	}
+
+	schema := &avroSchema{typ: typ}
+	schema.logical, _ = def["logicalType"].(string)
+
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		name, _ := def["name"].(string)
+		if len(name) == 0 {
+			return nil, fmt.Errorf("%w: missing name of %s", errInvalidAvroSchema, typ)
+		}
+
+		if ns, found := def["namespace"].(string); found && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+
+		schema.name = avroFullName(name, namespace)
+		if idx := strings.LastIndexByte(schema.name, '.'); idx >= 0 {
+			namespace = schema.name[:idx]
+		}
+
+		// registered before the fields are parsed, so recursive types can reference it
+		p.named[schema.name] = schema
+	}
+
+	switch typ {
+	case "record", "error":
+		schema.typ = "record"
+
+		return schema, p.parseFields(schema, def["fields"], namespace)
+	case "enum":
+		symbols, _ := def["symbols"].([]any)
+		for _, symbol := range symbols {
+			schema.symbols = append(schema.symbols, fmt.Sprint(symbol))
+		}
+
+		if len(schema.symbols) == 0 {
+			return nil, fmt.Errorf("%w: enum %s without symbols", errInvalidAvroSchema, schema.name)
+		}
+	case "fixed":
+		size, _ := def["size"].(float64)
+		if schema.size = int(size); schema.size <= 0 {
+			return nil, fmt.Errorf("%w: fixed %s without size", errInvalidAvroSchema, schema.name)
+		}
+	case "array", "map":
+		key := map[string]string{"array": "items", "map": "values"}[typ]
+
+		items, err := p.parse(def[key], namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		schema.items = items
+	default:
+		if _, primitive := avroPrimitives[typ]; !primitive {
+			return p.reference(typ, namespace)
+		}
+	}
+
+	return schema, nil
+}
+
+func (p *avroParser) parseFields(schema *avroSchema, def any, namespace string) error {
+	fields, isArray := def.([]any)
+	if !isArray {
+		return fmt.Errorf("%w: record %s without fields", errInvalidAvroSchema, schema.name)
+	}
+
+	for _, item := range fields {
+		field, _ := item.(map[string]any)
+
+		name, _ := field["name"].(string)
+		if len(name) == 0 {
+			return fmt.Errorf("%w: field of record %s without name", errInvalidAvroSchema, schema.name)
+		}
+
+		fieldSchema, err := p.parse(field["type"], namespace)
+		if err != nil {
+			return err
+		}
+
+		def, hasDefault := field["default"]
+
+		schema.fields = append(schema.fields, &avroField{
+			name:       name,
+			schema:     fieldSchema,
+			def:        def,
+			hasDefault: hasDefault,
+		})
+	}
+
+	return nil
+}
+
+// avroFullName returns the full name of the named type in the namespace.
+func avroFullName(name string, namespace string) string {
+	if strings.Contains(name, ".") || len(namespace) == 0 {
+		return name
+	}
+
+	return namespace + "." + name
+}
+
+// encode appends the value in Avro binary encoding to buff.
+func (s *avroSchema) encode(buff []byte, val any) ([]byte, error) { //nolint:cyclop,funlen
+	invalid := func() ([]byte, error) {
+		return nil, fmt.Errorf("%w %v (expected %s)", errInvalidValue, val, s.describe())
+	}
+
+	switch s.typ {
+	case "null":
+		if val != nil {
+			return invalid()
+		}
+
+		return buff, nil
+	case "boolean":
+		flag, ok := val.(bool)
+		if !ok {
+			return invalid()
+		}
+
+		if flag {
+			return append(buff, 1), nil
+		}
+
+		return append(buff, 0), nil
+	case "int", "long":
+		num, ok := s.integer(val)
+		if !ok || (s.typ == "int" && (num < math.MinInt32 || num > math.MaxInt32)) {
+			return invalid()
+		}
+
+		return binary.AppendVarint(buff, num), nil
+	case "float":
+		num, ok := floatOf(val)
+		if !ok {
+			return invalid()
+		}
+
+		return binary.LittleEndian.AppendUint32(buff, math.Float32bits(float32(num))), nil
+	case "double":
+		num, ok := floatOf(val)
+		if !ok {
+			return invalid()
+		}
+
+		return binary.LittleEndian.AppendUint64(buff, math.Float64bits(num)), nil
+	case "bytes", "string":
+		data, ok := bytesOf(val)
+		if !ok {
+			return invalid()
+		}
+
+		return append(binary.AppendVarint(buff, int64(len(data))), data...), nil
+	case "fixed":
+		data, ok := bytesOf(val)
+		if !ok || len(data) != s.size {
+			return invalid()
+		}
+
+		return append(buff, data...), nil
+	case "enum":
+		for idx, symbol := range s.symbols {
+			if symbol == val {
+				return binary.AppendVarint(buff, int64(idx)), nil
+			}
+		}
+
+		return invalid()
+	case "record":
+		return s.encodeRecord(buff, val)
+	case "array":
+		items, ok := val.([]any)
+		if !ok {
+			return invalid()
+		}
+
+		return s.encodeBlock(buff, len(items), func(buff []byte) ([]byte, error) {
+			for idx, item := range items {
+				var err error
+
+				if buff, err = s.items.encode(buff, item); err != nil {
+					return nil, fmt.Errorf("[%d]: %w", idx, err)
+				}
+			}
+
+			return buff, nil
+		})
+	case "map":
+		entries, ok := val.(map[string]any)
+		if !ok {
+			return invalid()
+		}
+
+		return s.encodeBlock(buff, len(entries), func(buff []byte) ([]byte, error) {
+			for _, key := range slices.Sorted(maps.Keys(entries)) {
+				var err error
+
+				buff = append(binary.AppendVarint(buff, int64(len(key))), key...)
+
+				if buff, err = s.items.encode(buff, entries[key]); err != nil {
+					return nil, fmt.Errorf("%s: %w", key, err)
+				}
+			}
+
+			return buff, nil
+		})
+	case "union":
+		idx, branchVal := s.branch(val)
+		if idx < 0 {
+			return invalid()
+		}
+
+		return s.branches[idx].encode(binary.AppendVarint(buff, int64(idx)), branchVal)
+	}
+
+	return invalid()
+}
+
+func (s *avroSchema) encodeRecord(buff []byte, val any) ([]byte, error) {
+	record, ok := val.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w %v (expected %s)", errInvalidValue, val, s.describe())
+	}
+
+	for _, field := range s.fields {
+		value, found := record[field.name]
+		if !found {
+			if !field.hasDefault {
+				return nil, fmt.Errorf("%s: %w: missing field", field.name, errInvalidValue)
+			}
+
+			value = field.def
+		}
+
+		var err error
+
+		if buff, err = field.schema.encode(buff, value); err != nil {
+			return nil, fmt.Errorf("%s: %w", field.name, err)
+		}
+	}
+
+	return buff, nil
+}
+
+// encodeBlock appends an array or map block of count items followed by the terminating empty block.
+func (s *avroSchema) encodeBlock(buff []byte, count int, items func([]byte) ([]byte, error)) ([]byte, error) {
+	if count == 0 {
+		return append(buff, 0), nil
+	}
+
+	buff, err := items(binary.AppendVarint(buff, int64(count)))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buff, 0), nil
+}
+
+// integer returns the value as integer, dates are converted according to the logical type.
+func (s *avroSchema) integer(val any) (int64, bool) {
+	const day = 24 * time.Hour
+
+	tm, isTime := val.(time.Time)
+	if !isTime {
+		return integerOf(val)
+	}
+
+	switch s.logical {
+	case "date":
+		return tm.Unix() / int64(day/time.Second), true
+	case "timestamp-millis", "local-timestamp-millis":
+		return tm.UnixMilli(), true
+	case "timestamp-micros", "local-timestamp-micros":
+		return tm.UnixMicro(), true
+	default:
+		return 0, false
+	}
+}
+
+// branch returns the index of the union branch matching the value and the value to encode.
+// Besides plain values, the JSON encoding of unions ({"type name": value}) is accepted too.
+func (s *avroSchema) branch(val any) (int, any) {
+	if wrapped, ok := val.(map[string]any); ok && len(wrapped) == 1 {
+		for idx, branch := range s.branches {
+			if inner, found := wrapped[branch.typeName()]; found {
+				return idx, inner
+			}
+		}
+	}
+
+	for idx, branch := range s.branches {
+		if branch.matches(val) {
+			return idx, val
+		}
+	}
+
+	return -1, nil
+}
+
+// matches reports whether the value can be encoded with the schema.
+func (s *avroSchema) matches(val any) bool {
+	switch s.typ {
+	case "null":
+		return val == nil
+	case "boolean":
+		_, ok := val.(bool)
+
+		return ok
+	case "int", "long":
+		_, ok := s.integer(val)
+
+		return ok
+	case "float", "double":
+		_, ok := floatOf(val)
+
+		return ok
+	case "string", "enum":
+		_, ok := val.(string)
+
+		return ok
+	case "bytes", "fixed":
+		data, ok := bytesOf(val)
+
+		return ok && (s.typ == "bytes" || len(data) == s.size)
+	case "array":
+		_, ok := val.([]any)
+
+		return ok
+	case "map", "record":
+		_, ok := val.(map[string]any)
+
+		return ok
+	default:
+		return false
+	}
+}
+
+// typeName returns the name of the type used in the JSON encoding of unions.
+func (s *avroSchema) typeName() string {
+	if len(s.name) != 0 {
+		return s.name
+	}
+
+	return s.typ
+}
+
+// describe returns the description of the type used in error messages.
+func (s *avroSchema) describe() string {
+	if len(s.logical) != 0 {
+		return s.typ + " (" + s.logical + ")"
+	}
+
+	return s.typeName()
+}
+
+// generate returns a random value of the schema. String fields are generated by the
+// generator function named after the field if there is such (the safe replacement in safe mode),
+// so the record contains realistic data.
+func (s *avroSchema) generate(r *rand.Rand, safe bool, field string, depth int) any { //nolint:cyclop,funlen
+	const (
+		maxDepth = 4
+		maxItems = 3
+		maxInt   = 1000
+		cents    = 100
+		maxAge   = 365 * 24 * time.Hour
+		// bytesLength is the length of generated bytes values.
+		bytesLength = 16
+	)
+
+	fake := &gofakeit.Faker{Rand: r}
+	past := time.Now().Add(-time.Duration(r.Int63n(int64(maxAge))))
+
+	switch s.typ {
+	case "boolean":
+		return fake.Bool()
+	case "int", "long":
+		switch s.logical {
+		case "date", "timestamp-millis", "local-timestamp-millis", "timestamp-micros", "local-timestamp-micros":
+			return past
+		default:
+			return int64(r.Intn(maxInt))
+		}
+	case "float", "double":
+		return math.Round(r.Float64()*maxInt*cents) / cents
+	case "string":
+		return avroString(r, s.logical, field, safe)
+	case "bytes":
+		return randomBytes(r, bytesLength)
+	case "fixed":
+		return randomBytes(r, s.size)
+	case "enum":
+		return s.symbols[r.Intn(len(s.symbols))]
+	case "array":
+		count := 1 + r.Intn(maxItems)
+		if depth >= maxDepth {
+			count = 0
+		}
+
+		items := make([]any, count)
+		for idx := range items {
+			items[idx] = s.items.generate(r, safe, field, depth+1)
+		}
+
+		return items
+	case "map":
+		count := 1 + r.Intn(maxItems)
+		if depth >= maxDepth {
+			count = 0
+		}
+
+		entries := make(map[string]any, count)
+		for range count {
+			entries[fake.Word()] = s.items.generate(r, safe, field, depth+1)
+		}
+
+		return entries
+	case "record":
+		record := make(map[string]any, len(s.fields))
+		for _, field := range s.fields {
+			record[field.name] = field.schema.generate(r, safe, field.name, depth+1)
+		}
+
+		return record
+	case "union":
+		branch := s.branches[r.Intn(len(s.branches))]
+
+		if depth >= maxDepth {
+			// the null branch ends recursive types
+			for _, candidate := range s.branches {
+				if candidate.typ == "null" {
+					branch = candidate
+				}
+			}
+		}
+
+		return branch.generate(r, safe, field, depth)
+	default:
+		return nil
+	}
+}
+
+// avroString returns a random string value of the field, generated by the safe replacement in safe mode.
+func avroString(r *rand.Rand, logical string, field string, safe bool) string {
+	fake := &gofakeit.Faker{Rand: r}
+
+	if logical == "uuid" {
+		return fake.UUID()
+	}
+
+	if info, found := lookupFunc(field); found {
+		if val, err := generatorOf(safe, field, info)(r, nil, info); err == nil {
+			if str, ok := val.(string); ok {
+				return str
+			}
+		}
+	}
+
+	if strings.HasSuffix(strings.ToLower(field), "id") {
+		return fake.UUID()
+	}
+
+	return fake.Word()
+}
@@ -0,0 +1,502 @@
+package faker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+var (
+	errUnknownAI        = errors.New("unknown GS1 application identifier")
+	errInvalidBarcode   = errors.New("invalid barcode")
+	errUnknownSymbology = errors.New("unknown symbology")
+)
+
+// code128FNC1 marks the FNC1 function character in the data of a Code 128 barcode.
+const code128FNC1 = -1
+
+// Code 128 symbol values of the function characters.
+const (
+	code128CodeC  = 99
+	code128CodeB  = 100
+	code128Value1 = 102
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+	code128Modulo = 103
+)
+
+// code128Widths contains the bar and space widths of the Code 128 symbols by value.
+//
+//nolint:gochecknoglobals
+var code128Widths = []string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// eanLeftOdd contains the left hand odd parity (L) patterns of the EAN digits,
+// the even parity (G) and the right hand (R) patterns are derived from them.
+//
+//nolint:gochecknoglobals
+var eanLeftOdd = []string{
+	"0001101", "0011001", "0010011", "0111101", "0100011", "0110001", "0101111", "0111011", "0110111", "0001011",
+}
+
+// eanParities contains the parity patterns of the left hand digits of EAN-13 by the first digit (G is even).
+//
+//nolint:gochecknoglobals
+var eanParities = []string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG", "LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+// gs1FixedPrefixes contains the first two digits of the GS1 application identifiers of predefined length,
+// the data of the other identifiers is terminated by FNC1.
+//
+//nolint:gochecknoglobals
+var gs1FixedPrefixes = []string{
+	"00", "01", "02", "03", "04", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20",
+	"31", "32", "33", "34", "35", "36", "41",
+}
+
+// Barcode symbologies.
+const (
+	symbologyAuto    = "auto"
+	symbologyEAN13   = "ean13"
+	symbologyUPCA    = "upca"
+	symbologyCode128 = "code128"
+	symbologyGS1128  = "gs1128"
+)
+
+// gs1Elements contains the generators of the data of the supported GS1 application identifiers.
+//
+//nolint:gochecknoglobals,mnd
+var gs1Elements = map[string]func(r *rand.Rand) string{
+	"00":   func(r *rand.Rand) string { return withCheckDigit(digits(r, 17)) },
+	"01":   func(r *rand.Rand) string { return withCheckDigit(digits(r, 13)) },
+	"02":   func(r *rand.Rand) string { return withCheckDigit(digits(r, 13)) },
+	"10":   func(r *rand.Rand) string { return skuOf(r, "??####") },
+	"11":   gs1Date,
+	"13":   gs1Date,
+	"15":   gs1Date,
+	"17":   gs1Date,
+	"21":   func(r *rand.Rand) string { return digits(r, 8+r.Intn(5)) },
+	"30":   func(r *rand.Rand) string { return strconv.Itoa(1 + r.Intn(999)) },
+	"3103": func(r *rand.Rand) string { return digits(r, 6) },
+	"37":   func(r *rand.Rand) string { return strconv.Itoa(1 + r.Intn(99)) },
+	"400":  func(r *rand.Rand) string { return skuOf(r, "PO-######") },
+	"414":  func(r *rand.Rand) string { return withCheckDigit(digits(r, 12)) },
+}
+
+func init() {
+	gofakeit.AddFuncLookup("ean13", gofakeit.Info{
+		Display:     "EAN-13",
+		Category:    "product",
+		Description: "European Article Number of a product, 13 digits with a valid check digit",
+		Example:     "5901234123457",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			const gs1Prefixes = 10 // the first digits of the GS1 country prefixes excluding the 0 of UPC-A
+
+			return withCheckDigit(strconv.Itoa(1+r.Intn(gs1Prefixes-1)) + digits(r, 11)), nil //nolint:mnd
+		},
+	})
+
+	gofakeit.AddFuncLookup("upca", gofakeit.Info{
+		Display:     "UPC-A",
+		Category:    "product",
+		Description: "Universal Product Code of a product, 12 digits with a valid check digit",
+		Example:     "036000291452",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return withCheckDigit(digits(r, 11)), nil //nolint:mnd
+		},
+	})
+
+	gofakeit.AddFuncLookup("gs1128", gofakeit.Info{
+		Display:     "GS1-128",
+		Category:    "product",
+		Description: "GS1-128 element string of a logistic label in human readable form, the identifiers have valid check digits",
+		Example:     "(01)09501101530003(17)250131(10)AB1234",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "ai",
+				Display:     "Application Identifiers",
+				Type:        "[]string",
+				Optional:    true,
+				Options:     slices.Sorted(maps.Keys(gs1Elements)),
+				Description: "GS1 application identifiers of the elements, GTIN, use by date and batch if empty",
+			},
+		},
+		Generate: gs1128,
+	})
+}
+
+// withCheckDigit returns the digits followed by their GS1 mod 10 check digit (EAN, UPC, GTIN, SSCC, GLN).
+func withCheckDigit(body string) string {
+	const (
+		base   = 10
+		weight = 3
+	)
+
+	var sum int
+
+	// from the rightmost digit, the weights are 3 and 1 alternately
+	for idx := len(body) - 1; idx >= 0; idx-- {
+		digit := int(body[idx] - '0')
+
+		if (len(body)-1-idx)%2 == 0 {
+			digit *= weight
+		}
+
+		sum += digit
+	}
+
+	return body + string(rune('0'+(base-sum%base)%base))
+}
+
+// gs1Date returns a random date within a year in YYMMDD format.
+func gs1Date(r *rand.Rand) string {
+	const days = 365
+
+	return time.Now().AddDate(0, 0, r.Intn(2*days+1)-days).Format("060102")
+}
+
+func gs1128(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	ais, err := info.GetStringArray(m, "ai")
+	if err != nil || len(ais) == 0 {
+		ais = []string{"01", "17", "10"}
+	}
+
+	var buff strings.Builder
+
+	for _, ai := range ais {
+		generate, found := gs1Elements[ai]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errUnknownAI, ai)
+		}
+
+		buff.WriteString("(" + ai + ")" + generate(r))
+	}
+
+	return buff.String(), nil
+}
+
+// barcodeModules returns the modules (true for bar) of the barcode of the code in the symbology.
+func barcodeModules(code string, symbology string) ([]bool, error) {
+	const (
+		eanLength = 13
+		upcLength = 12
+	)
+
+	symbology = strings.ToLower(symbology)
+
+	if symbology == symbologyAuto {
+		switch {
+		case strings.HasPrefix(code, "("):
+			symbology = symbologyGS1128
+		case len(code) == eanLength && isDigits(code):
+			symbology = symbologyEAN13
+		case len(code) == upcLength && isDigits(code):
+			symbology = symbologyUPCA
+		default:
+			symbology = symbologyCode128
+		}
+	}
+
+	switch symbology {
+	case symbologyEAN13, symbologyUPCA:
+		if symbology == symbologyUPCA && len(code) == upcLength {
+			// UPC-A is the EAN-13 with leading zero
+			code = "0" + code
+		}
+
+		if len(code) != eanLength || !isDigits(code) {
+			return nil, fmt.Errorf("%w: %s %s (expected %d digits)", errInvalidBarcode, symbology, code, eanLength)
+		}
+
+		return ean13Modules(code), nil
+	case symbologyCode128:
+		data := make([]int, len(code))
+
+		for idx := range code {
+			data[idx] = int(code[idx])
+		}
+
+		return code128Modules(data)
+	case symbologyGS1128:
+		data, err := gs1Data(code)
+		if err != nil {
+			return nil, err
+		}
+
+		return code128Modules(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownSymbology, symbology)
+	}
+}
+
+func isDigits(str string) bool {
+	return len(str) != 0 && strings.Trim(str, "0123456789") == ""
+}
+
+// ean13Modules returns the 95 modules of the EAN-13 barcode.
+func ean13Modules(code string) []bool {
+	var buff strings.Builder
+
+	parity := eanParities[code[0]-'0']
+
+	buff.WriteString("101")
+
+	for idx, chr := range code[1:] {
+		pattern := eanLeftOdd[chr-'0']
+
+		switch {
+		case idx >= len(parity):
+			// right hand digits are the complement of the odd parity patterns
+			pattern = strings.Map(func(r rune) rune { return '0' + '1' - r }, pattern)
+		case parity[idx] == 'G':
+			// even parity patterns are the reversed right hand patterns
+			pattern = strings.Map(func(r rune) rune { return '0' + '1' - r }, pattern)
+
+			reversed := []byte(pattern)
+			slices.Reverse(reversed)
+			pattern = string(reversed)
+		}
+
+		if idx == len(parity) {
+			buff.WriteString("01010")
+		}
+
+		buff.WriteString(pattern)
+	}
+
+	buff.WriteString("101")
+
+	modules := make([]bool, buff.Len())
+
+	for idx, chr := range buff.String() {
+		modules[idx] = chr == '1'
+	}
+
+	return modules
+}
+
+// gs1Data returns the Code 128 data of the GS1 element string in human readable form, e.g. (01)09501101530003(10)AB12.
+func gs1Data(code string) ([]int, error) {
+	data := []int{code128FNC1}
+	rest := code
+
+	for len(rest) != 0 {
+		ai, after, found := strings.Cut(strings.TrimPrefix(rest, "("), ")")
+		if !found || !strings.HasPrefix(rest, "(") || !isDigits(ai) || len(ai) < 2 {
+			return nil, fmt.Errorf("%w: GS1 element string %s", errInvalidBarcode, code)
+		}
+
+		value, next, _ := strings.Cut(after, "(")
+		if len(value) == 0 {
+			return nil, fmt.Errorf("%w: GS1 element string %s", errInvalidBarcode, code)
+		}
+
+		for _, chr := range []byte(ai + value) {
+			data = append(data, int(chr))
+		}
+
+		rest = strings.TrimPrefix(after, value)
+
+		if len(next) != 0 && !slices.Contains(gs1FixedPrefixes, ai[:2]) {
+			data = append(data, code128FNC1)
+		}
+	}
+
+	return data, nil
+}
+
+// code128Values returns the symbol values of the data including the start, the check and the stop symbols.
+// Runs of digits are encoded pairwise in code set C, the other characters in code set B.
+func code128Values(data []int) ([]int, error) {
+	const (
+		minRunC = 4
+		first   = ' '
+		last    = '~'
+	)
+
+	digitRun := func(idx int) int {
+		count := 0
+
+		for idx+count < len(data) && data[idx+count] >= '0' && data[idx+count] <= '9' {
+			count++
+		}
+
+		return count
+	}
+
+	start := 0
+
+	for start < len(data) && data[start] == code128FNC1 {
+		start++
+	}
+
+	values := []int{code128StartB}
+	codeC := digitRun(start) >= minRunC || (digitRun(start) == len(data)-start && digitRun(start) == 2)
+
+	if codeC {
+		values[0] = code128StartC
+	}
+
+	for idx := 0; idx < len(data); {
+		switch {
+		case data[idx] == code128FNC1:
+			values = append(values, code128Value1)
+			idx++
+		case codeC && digitRun(idx) >= 2:
+			values = append(values, int(data[idx]-'0')*10+int(data[idx+1]-'0')) //nolint:mnd
+			idx += 2
+		case codeC:
+			values = append(values, code128CodeB)
+			codeC = false
+		case digitRun(idx) >= minRunC && digitRun(idx)%2 == 0:
+			values = append(values, code128CodeC)
+			codeC = true
+		case data[idx] < first || data[idx] > last:
+			return nil, fmt.Errorf("%w: character %q is not supported by Code 128", errInvalidBarcode, rune(data[idx]))
+		default:
+			values = append(values, data[idx]-first)
+			idx++
+		}
+	}
+
+	check := values[0]
+
+	for idx, value := range values[1:] {
+		check += (idx + 1) * value
+	}
+
+	return append(values, check%code128Modulo, code128Stop), nil
+}
+
+// code128Modules returns the modules of the Code 128 barcode of the data.
+func code128Modules(data []int) ([]bool, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty code", errInvalidBarcode)
+	}
+
+	values, err := code128Values(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []bool
+
+	for _, value := range values {
+		for idx, width := range code128Widths[value] {
+			for range width - '0' {
+				// the widths are the bar and space widths alternately
+				modules = append(modules, idx%2 == 0)
+			}
+		}
+	}
+
+	return modules, nil
+}
+
+// barcodePNG returns the PNG image of the modules with quiet zone on both sides.
+func barcodePNG(modules []bool, moduleWidth int, height int) ([]byte, error) {
+	const quietZone = 10
+
+	width := (len(modules) + 2*quietZone) * moduleWidth
+	img := image.NewGray(image.Rect(0, 0, width, height))
+
+	for idx := range img.Pix {
+		img.Pix[idx] = math.MaxUint8
+	}
+
+	for idx, bar := range modules {
+		if !bar {
+			continue
+		}
+
+		for x := (quietZone + idx) * moduleWidth; x < (quietZone+idx+1)*moduleWidth; x++ {
+			for y := range height {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			}
+		}
+	}
+
+	var buff bytes.Buffer
+
+	if err := png.Encode(&buff, img); err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// barcodePng returns the PNG image of the barcode of the code. The symbology is detected from the code
+// unless the symbology option is set: GS1-128 for element strings in human readable form, EAN-13 for 13 digits,
+// UPC-A for 12 digits and Code 128 for the others.
+func (f *faker) barcodePng(code string, options sobek.Value) sobek.ArrayBuffer {
+	const (
+		method    = "product.barcodePng"
+		maxWidth  = 10
+		maxHeight = 1000
+	)
+
+	symbology, moduleWidth, height := symbologyAuto, int64(2), int64(80) //nolint:mnd
+
+	if options != nil && !sobek.IsUndefined(options) && !sobek.IsNull(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("symbology"); val != nil && !sobek.IsUndefined(val) {
+			symbology = val.String()
+		}
+
+		if val := opts.Get("moduleWidth"); val != nil && !sobek.IsUndefined(val) {
+			moduleWidth = val.ToInteger()
+		}
+
+		if val := opts.Get("height"); val != nil && !sobek.IsUndefined(val) {
+			height = val.ToInteger()
+		}
+	}
+
+	if moduleWidth < 1 || moduleWidth > maxWidth || height < 1 || height > maxHeight {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "options", Expected: "moduleWidth 1-10 and height 1-1000", Reason: "invalid value",
+		})
+	}
+
+	modules, err := barcodeModules(code, symbology)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "code", Reason: err.Error()})
+	}
+
+	data, err := barcodePNG(modules, int(moduleWidth), int(height))
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "code", Reason: err.Error()})
+	}
+
+	return f.runtime.NewArrayBuffer(data)
+}
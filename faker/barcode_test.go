@@ -0,0 +1,83 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+// gs1Valid reports whether the last digit of the code is the valid GS1 mod 10 check digit.
+func gs1Valid(code string) bool {
+	var sum int
+
+	for idx := range code {
+		digit := int(code[idx] - '0')
+
+		if (len(code)-1-idx)%2 == 1 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
+
+func Test_ean13_upca(t *testing.T) {
+	t.Parallel()
+
+	r := testRand(t)
+
+	for name, length := range map[string]int{"ean13": 13, "upca": 12} {
+		info := gofakeit.GetFuncLookup(name)
+
+		require.NotNil(t, info)
+
+		for range 100 {
+			val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+			require.NoError(t, err)
+			require.Regexp(t, regexp.MustCompile(`^\d+$`), val)
+			require.Len(t, val, length)
+			require.True(t, gs1Valid(val.(string)), val) //nolint:forcetypeassert
+		}
+	}
+
+	require.True(t, gs1Valid("5901234123457"))
+	require.True(t, gs1Valid("036000291452"))
+}
+
+func Test_gs1128(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("gs1128")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^\(01\)\d{14}\(17\)\d{6}\(10\)[A-Z]{2}\d{4}$`, val)
+	require.True(t, gs1Valid(val.(string)[4:18])) //nolint:forcetypeassert
+
+	params := gofakeit.NewMapParams()
+	params.Add("ai", "00")
+	params.Add("ai", "3103")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^\(00\)\d{18}\(3103\)\d{6}$`, val)
+	require.True(t, gs1Valid(val.(string)[4:22])) //nolint:forcetypeassert
+
+	params = gofakeit.NewMapParams()
+	params.Add("ai", "99")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown GS1 application identifier")
+}
@@ -0,0 +1,68 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errInvalidAgeRange = errors.New("age range must satisfy 0 <= minAge <= maxAge")
+
+// adultAge is the age of majority, persons younger than it are minors.
+const adultAge = 18
+
+func init() {
+	gofakeit.AddFuncLookup("birthdate", gofakeit.Info{
+		Display:     "Birthdate",
+		Category:    "person",
+		Description: "Date of birth of a person whose age in completed years is between minAge and maxAge today",
+		Example:     "1987-06-23",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{Field: "minAge", Display: "Min Age", Type: "int", Default: "18", Description: "Minimum age in years"},
+			{Field: "maxAge", Display: "Max Age", Type: "int", Default: "80", Description: "Maximum age in years"},
+		},
+		Generate: birthdate,
+	})
+}
+
+// birthdateOf returns a random date of birth of a person who is exactly age years old at now.
+func birthdateOf(r *rand.Rand, now time.Time, age int) time.Time {
+	now = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// the earliest birth date is the day after the (age+1)th birthday would be today
+	earliest := now.AddDate(-age-1, 0, 1)
+	latest := now.AddDate(-age, 0, 0)
+
+	// on 29 February the birthday of a non-leap year is normalized to 1 March, which is still ahead
+	if latest.Day() != now.Day() {
+		latest = latest.AddDate(0, 0, -1)
+	}
+
+	days := int(latest.Sub(earliest)/day) + 1
+
+	return earliest.AddDate(0, 0, r.Intn(days))
+}
+
+func birthdate(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	youngest, err := info.GetInt(m, "minAge")
+	if err != nil {
+		return nil, err
+	}
+
+	oldest, err := info.GetInt(m, "maxAge")
+	if err != nil {
+		return nil, err
+	}
+
+	if youngest < 0 || youngest > oldest {
+		return nil, fmt.Errorf("%w: %d-%d", errInvalidAgeRange, youngest, oldest)
+	}
+
+	age := youngest + r.Intn(oldest-youngest+1)
+
+	return birthdateOf(r, time.Now(), age).Format(time.DateOnly), nil
+}
@@ -0,0 +1,63 @@
+package faker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+// ageAt returns the age in completed years at now of a person born on the birth date.
+func ageAt(born time.Time, now time.Time) int {
+	age := now.Year() - born.Year()
+
+	if now.Month() < born.Month() || (now.Month() == born.Month() && now.Day() < born.Day()) {
+		age--
+	}
+
+	return age
+}
+
+func Test_birthdate(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("birthdate")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("minAge", "16")
+	params.Add("maxAge", "17")
+
+	ages := make(map[int]bool)
+
+	for range 200 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		born, err := time.Parse(time.DateOnly, val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+
+		age := ageAt(born, time.Now())
+
+		require.GreaterOrEqual(t, age, 16)
+		require.LessOrEqual(t, age, 17)
+
+		ages[age] = true
+	}
+
+	require.Len(t, ages, 2)
+
+	params = gofakeit.NewMapParams()
+	params.Add("minAge", "30")
+	params.Add("maxAge", "20")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "age range must satisfy")
+}
@@ -0,0 +1,147 @@
+package faker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/grafana/sobek"
+)
+
+// estimateSample is the maximum number of values generated to estimate the size of the output.
+const estimateSample = 100
+
+// budgetSample is the number of values of a batch generated before its total size is projected.
+const budgetSample = 10
+
+// estimateBytes returns the approximate size in bytes of count values of the source serialized as a JSON array,
+// e.g. for checking the memory need of a data set before generating it.
+//
+// The source is a schema (like the fromSchema method) or a generator function name. The estimate is extrapolated
+// from a sample of values generated by a Faker derived from the seed of the instance,
+// so the random sequence of the instance is not affected.
+func (f *faker) estimateBytes(source sobek.Value, count sobek.Value) int64 {
+	const method = "estimateBytes"
+
+	if count == nil || sobek.IsUndefined(count) || count.ToInteger() < 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "count", Expected: "non-negative number", Reason: "invalid value"})
+	}
+
+	if sobek.IsUndefined(source) {
+		f.throw(&ArgumentError{Function: method, Parameter: "schema", Expected: "object or string", Reason: "missing parameter"})
+	}
+
+	total := count.ToInteger()
+	if total == 0 {
+		return int64(len("[]"))
+	}
+
+	gen := newFakerWithOptions(f.deriveSeed(method), f.opts, f.runtime)
+	next := gen.iterSource(method, source, sobek.FunctionCall{This: sobek.Undefined()})
+	sample := min(total, estimateSample)
+
+	var size int64
+
+	for idx := range sample {
+		size += valueBytes(next(int(idx)))
+	}
+
+	// the values are separated by commas and enclosed in brackets
+	return size*total/sample + total - 1 + int64(len("[]"))
+}
+
+// byteBudget enforces the maxTotalBytes option on a bulk generation call. The size of the generated values
+// is measured by their JSON representation. The call is aborted as soon as the values exceed the budget,
+// or when the projected size of the batch exceeds it after generating the first values.
+type byteBudget struct {
+	limit int64
+	total int64
+	// expected and generated are the number of values of the current batch, batchTotal is their size so far.
+	expected   int
+	generated  int
+	batchTotal int64
+	fail       func(reason string)
+}
+
+// budget returns the byte budget of a bulk generation call, nil if the maxTotalBytes option is not set.
+// The fail function throws the error of the call.
+func (f *faker) budget(fail func(reason string)) *byteBudget {
+	if f.opts.maxTotalBytes <= 0 {
+		return nil
+	}
+
+	return &byteBudget{limit: f.opts.maxTotalBytes, fail: fail}
+}
+
+// batch starts a batch of count values of similar size.
+// Since every value takes at least one byte, too large counts fail immediately.
+func (b *byteBudget) batch(count int) {
+	if b == nil {
+		return
+	}
+
+	if b.total+int64(count) > b.limit {
+		b.fail(fmt.Sprintf("%d values exceed maxTotalBytes %d", count, b.limit))
+	}
+
+	b.expected, b.generated, b.batchTotal = count, 0, 0
+}
+
+// add adds the size of a generated value to the budget.
+func (b *byteBudget) add(val any) {
+	if b == nil {
+		return
+	}
+
+	// the values are separated by commas
+	size := valueBytes(val) + 1
+
+	b.total += size
+	b.batchTotal += size
+	b.generated++
+
+	if b.total > b.limit {
+		b.fail("output exceeds maxTotalBytes " + strconv.FormatInt(b.limit, 10))
+	}
+
+	if b.generated != budgetSample || b.expected <= b.generated {
+		return
+	}
+
+	projected := b.total - b.batchTotal + b.batchTotal*int64(b.expected)/int64(b.generated)
+	if projected > b.limit {
+		b.fail(fmt.Sprintf("estimated output of %d bytes exceeds maxTotalBytes %d", projected, b.limit))
+	}
+}
+
+// valueBytes returns the size of the JSON representation of the value.
+// The value is encoded into a byteCounter, so the representation is measured without being buffered.
+func valueBytes(val any) int64 {
+	if value, isValue := val.(sobek.Value); isValue {
+		if value == nil || sobek.IsUndefined(value) {
+			return int64(len("null"))
+		}
+
+		val = value.Export()
+	}
+
+	var counter byteCounter
+
+	if err := json.NewEncoder(&counter).Encode(val); err != nil {
+		return int64(len(fmt.Sprint(val)))
+	}
+
+	// the encoder terminates the value with a newline
+	return counter.count - 1
+}
+
+// byteCounter is an io.Writer which counts the written bytes and discards them.
+type byteCounter struct {
+	count int64
+}
+
+func (c *byteCounter) Write(data []byte) (int, error) {
+	c.count += int64(len(data))
+
+	return len(data), nil
+}
@@ -0,0 +1,356 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // business hours of any IANA time zone, even without system time zone database
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errUnknownHolidayCalendar = errors.New("unknown holiday calendar")
+	errUnknownTimezone        = errors.New("unknown time zone")
+	errInvalidWindow          = errors.New("window must be a positive duration (e.g. 15m, 24h or 7d)")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("businessday", gofakeit.Info{
+		Display:     "Business Day",
+		Category:    "time",
+		Description: "Date of a business day within the next year, which is neither a weekend nor a public holiday",
+		Example:     "2024-03-15",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "holidayCalendar",
+				Display:     "Holiday Calendar",
+				Type:        "string",
+				Default:     "US",
+				Options:     cloudTypes(holidayCalendars)[1:],
+				Description: "Country code of the public holidays (US, GB, DE, FR), none for weekends only",
+			},
+		},
+		Generate: businessday,
+	})
+
+	gofakeit.AddFuncLookup("withinbusinesshours", gofakeit.Info{
+		Display:     "Within Business Hours",
+		Category:    "time",
+		Description: "Timestamp between 9:00 and 17:00 local time on a weekday within the next two weeks",
+		Example:     "2024-03-15T10:20:00-04:00",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "tz",
+				Display:     "Time Zone",
+				Type:        "string",
+				Default:     "UTC",
+				Description: "IANA time zone name (e.g. America/New_York)",
+			},
+		},
+		Generate: withinbusinesshours,
+	})
+
+	gofakeit.AddFuncLookup("cron", gofakeit.Info{
+		Display:     "Cron",
+		Category:    "time",
+		Description: "Cron expression of a typical job schedule (e.g. nightly, every few minutes or on weekdays)",
+		Example:     "30 2 * * 1-5",
+		Output:      "string",
+		Params:      nil,
+		Generate:    cron,
+	})
+
+	window := gofakeit.Param{
+		Field:       "window",
+		Display:     "Window",
+		Type:        "string",
+		Default:     "24h",
+		Description: "Length of the time window (e.g. 15m, 24h or 7d)",
+	}
+
+	gofakeit.AddFuncLookup("recenttimestamp", gofakeit.Info{
+		Display:     "Recent Timestamp",
+		Category:    "time",
+		Description: "RFC 3339 timestamp within the window before now",
+		Example:     "2024-03-15T10:20:00Z",
+		Output:      "string",
+		Params:      []gofakeit.Param{window},
+		Generate:    recenttimestamp,
+	})
+
+	gofakeit.AddFuncLookup("soontimestamp", gofakeit.Info{
+		Display:     "Soon Timestamp",
+		Category:    "time",
+		Description: "RFC 3339 timestamp within the window after now",
+		Example:     "2024-03-15T10:20:00Z",
+		Output:      "string",
+		Params:      []gofakeit.Param{window},
+		Generate:    soontimestamp,
+	})
+}
+
+const day = 24 * time.Hour
+
+// holiday returns the date of the holiday in the year.
+type holiday func(year int) time.Time
+
+// holidayCalendars contains the public holidays of the countries.
+//
+//nolint:gochecknoglobals,mnd
+var holidayCalendars = map[string][]holiday{
+	"US": {
+		fixedHoliday(time.January, 1),
+		weekdayHoliday(time.January, time.Monday, 3),
+		weekdayHoliday(time.February, time.Monday, 3),
+		weekdayHoliday(time.May, time.Monday, -1),
+		fixedHoliday(time.June, 19),
+		fixedHoliday(time.July, 4),
+		weekdayHoliday(time.September, time.Monday, 1),
+		weekdayHoliday(time.October, time.Monday, 2),
+		fixedHoliday(time.November, 11),
+		weekdayHoliday(time.November, time.Thursday, 4),
+		fixedHoliday(time.December, 25),
+	},
+	"GB": {
+		fixedHoliday(time.January, 1),
+		easterHoliday(-2),
+		easterHoliday(1),
+		weekdayHoliday(time.May, time.Monday, 1),
+		weekdayHoliday(time.May, time.Monday, -1),
+		weekdayHoliday(time.August, time.Monday, -1),
+		fixedHoliday(time.December, 25),
+		fixedHoliday(time.December, 26),
+	},
+	"DE": {
+		fixedHoliday(time.January, 1),
+		easterHoliday(-2),
+		easterHoliday(1),
+		fixedHoliday(time.May, 1),
+		easterHoliday(39),
+		easterHoliday(50),
+		fixedHoliday(time.October, 3),
+		fixedHoliday(time.December, 25),
+		fixedHoliday(time.December, 26),
+	},
+	"FR": {
+		fixedHoliday(time.January, 1),
+		easterHoliday(1),
+		fixedHoliday(time.May, 1),
+		fixedHoliday(time.May, 8),
+		easterHoliday(39),
+		easterHoliday(50),
+		fixedHoliday(time.July, 14),
+		fixedHoliday(time.August, 15),
+		fixedHoliday(time.November, 1),
+		fixedHoliday(time.November, 11),
+		fixedHoliday(time.December, 25),
+	},
+	"none": nil,
+}
+
+// fixedHoliday returns a holiday on the same day every year.
+func fixedHoliday(month time.Month, dom int) holiday {
+	return func(year int) time.Time {
+		return time.Date(year, month, dom, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// weekdayHoliday returns a holiday on the nth weekday of the month, the last one if nth is -1.
+func weekdayHoliday(month time.Month, weekday time.Weekday, nth int) holiday {
+	const week = 7
+
+	return func(year int) time.Time {
+		if nth < 0 {
+			last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+
+			return last.AddDate(0, 0, -(int(last.Weekday()-weekday)+week)%week)
+		}
+
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+		return first.AddDate(0, 0, (int(weekday-first.Weekday())+week)%week+(nth-1)*week)
+	}
+}
+
+// easterHoliday returns a holiday relative to Easter Sunday.
+func easterHoliday(offset int) holiday {
+	return func(year int) time.Time {
+		return easter(year).AddDate(0, 0, offset)
+	}
+}
+
+// easter returns the date of Easter Sunday in the Gregorian calendar (anonymous Gregorian algorithm).
+//
+//nolint:mnd
+func easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	dom := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), dom, 0, 0, 0, 0, time.UTC)
+}
+
+// isWeekend reports whether the date is on Saturday or Sunday.
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+// isBusinessDay reports whether the date is neither a weekend nor a holiday.
+func isBusinessDay(date time.Time, holidays []holiday) bool {
+	if isWeekend(date) {
+		return false
+	}
+
+	for _, fun := range holidays {
+		if fun(date.Year()).Equal(date) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parsePeriod parses a positive duration, which can also be given in days (e.g. 7d).
+func parsePeriod(str string) (time.Duration, bool) {
+	if days, found := strings.CutSuffix(str, "d"); found {
+		if num, err := strconv.Atoi(days); err == nil && num > 0 {
+			return time.Duration(num) * day, true
+		}
+	}
+
+	if dur, err := time.ParseDuration(str); err == nil && dur > 0 {
+		return dur, true
+	}
+
+	return 0, false
+}
+
+func businessday(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const daysPerYear = 365
+
+	name, err := info.GetString(m, "holidayCalendar")
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, found := holidayCalendars[name]
+	if !found {
+		holidays, found = holidayCalendars[strings.ToUpper(name)]
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownHolidayCalendar, name)
+	}
+
+	date := time.Now().UTC().Truncate(day).AddDate(0, 0, 1+r.Intn(daysPerYear))
+
+	// the next business day, there are no long runs of holidays
+	for !isBusinessDay(date, holidays) {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return date.Format(time.DateOnly), nil
+}
+
+func withinbusinesshours(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		days      = 14
+		openHour  = 9
+		openHours = 8
+	)
+
+	tz, err := info.GetString(m, "tz")
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errUnknownTimezone, tz)
+	}
+
+	now := time.Now().In(loc)
+	date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1+r.Intn(days))
+
+	for isWeekend(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	open := time.Date(date.Year(), date.Month(), date.Day(), openHour, 0, 0, 0, loc)
+
+	return open.Add(time.Duration(r.Int63n(int64(openHours * time.Hour)))).Truncate(time.Second).Format(time.RFC3339), nil
+}
+
+// cronSchedules contains typical job schedules, # is replaced by a random minute, @ by a random hour.
+var cronSchedules = []string{ //nolint:gochecknoglobals
+	"*/5 * * * *",
+	"*/15 * * * *",
+	"# * * * *",
+	"# */6 * * *",
+	"# @ * * *",
+	"# @ * * 1-5",
+	"# @ * * 0",
+	"0 @ 1 * *",
+	"0 9 * * 1-5",
+	"0 0 1 1 *",
+}
+
+func cron(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		minutes = 60
+		hours   = 24
+	)
+
+	schedule := cronSchedules[r.Intn(len(cronSchedules))]
+	schedule = strings.Replace(schedule, "#", strconv.Itoa(r.Intn(minutes)), 1)
+	schedule = strings.Replace(schedule, "@", strconv.Itoa(r.Intn(hours)), 1)
+
+	return schedule, nil
+}
+
+// windowOf returns a random offset within the window parameter.
+func windowOf(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (time.Duration, error) {
+	str, err := info.GetString(m, "window")
+	if err != nil {
+		return 0, err
+	}
+
+	window, ok := parsePeriod(str)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errInvalidWindow, str)
+	}
+
+	return time.Duration(r.Int63n(int64(window))), nil
+}
+
+func recenttimestamp(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	offset, err := windowOf(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.Now().UTC().Add(-offset).Format(time.RFC3339), nil
+}
+
+func soontimestamp(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	offset, err := windowOf(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.Now().UTC().Add(offset).Format(time.RFC3339), nil
+}
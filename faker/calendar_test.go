@@ -0,0 +1,155 @@
+package faker_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_businessday(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("businessday")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, calendar := range []string{"US", "gb", "DE", "FR", "none"} {
+		params := gofakeit.NewMapParams()
+		params.Add("holidayCalendar", calendar)
+
+		for range 50 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+
+			date, err := time.Parse(time.DateOnly, val.(string)) //nolint:forcetypeassert
+
+			require.NoError(t, err)
+			require.NotEqual(t, time.Saturday, date.Weekday())
+			require.NotEqual(t, time.Sunday, date.Weekday())
+
+			if calendar != "none" {
+				require.False(t, date.Month() == time.December && date.Day() == 25, val)
+				require.False(t, date.Month() == time.January && date.Day() == 1, val)
+			}
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("holidayCalendar", "XX")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown holiday calendar")
+}
+
+func Test_withinbusinesshours(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("withinbusinesshours")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("tz", "America/New_York")
+
+	loc, err := time.LoadLocation("America/New_York")
+
+	require.NoError(t, err)
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		ts, err := time.Parse(time.RFC3339, val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+
+		ts = ts.In(loc)
+
+		require.GreaterOrEqual(t, ts.Hour(), 9)
+		require.Less(t, ts.Hour(), 17)
+		require.NotEqual(t, time.Saturday, ts.Weekday())
+		require.NotEqual(t, time.Sunday, ts.Weekday())
+		require.True(t, ts.After(time.Now()))
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("tz", "Mars/Olympus_Mons")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown time zone")
+}
+
+func Test_cron(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("cron")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(val.(string)), 5) //nolint:forcetypeassert
+		require.NotContains(t, val, "#")
+		require.NotContains(t, val, "@")
+	}
+}
+
+func Test_recenttimestamp(t *testing.T) {
+	t.Parallel()
+
+	recent := gofakeit.GetFuncLookup("recenttimestamp")
+	soon := gofakeit.GetFuncLookup("soontimestamp")
+
+	require.NotNil(t, recent)
+	require.NotNil(t, soon)
+
+	params := gofakeit.NewMapParams()
+	params.Add("window", "2d")
+
+	r := testRand(t)
+	now := time.Now().Truncate(time.Second)
+
+	for range 100 {
+		val, err := recent.Generate(r, params, recent)
+
+		require.NoError(t, err)
+
+		ts, err := time.Parse(time.RFC3339, val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.False(t, ts.After(time.Now()))
+		require.False(t, ts.Before(now.Add(-48*time.Hour)))
+
+		val, err = soon.Generate(r, params, soon)
+
+		require.NoError(t, err)
+
+		ts, err = time.Parse(time.RFC3339, val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.False(t, ts.Before(now))
+		require.False(t, ts.After(time.Now().Add(48*time.Hour)))
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("window", "-5m")
+
+	_, err := recent.Generate(r, params, recent)
+
+	require.ErrorContains(t, err, "window must be a positive duration")
+}
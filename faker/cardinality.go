@@ -0,0 +1,150 @@
+package faker
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/grafana/sobek"
+)
+
+// Modes of picking values by the cardinality generator.
+const (
+	cardinalityRoundRobin = "round-robin"
+	cardinalityRandom     = "random"
+)
+
+// maxCardinalityAttempts is the number of generated values per distinct value after which
+// the cardinality generator gives up (e.g. for a boolean generator and count greater than 2).
+const maxCardinalityAttempts = 100
+
+// cardinality pre-generates count distinct values and returns a generator picking among them.
+//
+// The values are generated by the generator function (function name or JavaScript function)
+// with the args option as parameters. The next() method of the returned object picks the values
+// in round-robin order (default) or randomly, depending on the mode option.
+// The values property contains a copy of the pre-generated values.
+func (f *faker) cardinality(function sobek.Value, count sobek.Value, options sobek.Value) *sobek.Object {
+	const method = "cardinality"
+
+	size := f.countArgument(method, "", "count", count, 1)
+
+	mode := cardinalityRoundRobin
+
+	var args []sobek.Value
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("mode"); val != nil && !sobek.IsUndefined(val) {
+			if mode = val.String(); mode != cardinalityRoundRobin && mode != cardinalityRandom {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "mode", Expected: "round-robin or random", Reason: "invalid value " + mode,
+				})
+			}
+		}
+
+		if val := opts.Get("args"); val != nil && !sobek.IsUndefined(val) {
+			args = f.arrayArgument(method, "args", val)
+		}
+	}
+
+	budget := f.budget(func(reason string) {
+		f.throw(&ArgumentError{Function: method, Parameter: "count", Reason: reason})
+	})
+
+	budget.batch(size)
+
+	generate := f.cardinalityFunc(method, function, args)
+	values := f.distinctValues(method, generate, size, budget)
+
+	items := make([]any, len(values))
+
+	for idx, val := range values {
+		items[idx] = val
+	}
+
+	var counter int
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("length", len(values))
+	_ = obj.Set("values", f.runtime.NewArray(items...))
+	_ = obj.Set("next", func() sobek.Value {
+		if mode == cardinalityRandom {
+			f.rescope()
+
+			return values[f.rand.Intn(len(values))]
+		}
+
+		val := values[counter%len(values)]
+		counter++
+
+		return val
+	})
+
+	return obj
+}
+
+// cardinalityFunc returns the function generating the values, the function parameter is either
+// the name of a generator function or a JavaScript function.
+func (f *faker) cardinalityFunc(method string, function sobek.Value, args []sobek.Value) func() sobek.Value {
+	if callable, isFunction := sobek.AssertFunction(function); isFunction {
+		return func() sobek.Value {
+			val, err := callable(sobek.Undefined(), args...)
+			if err != nil {
+				panic(err)
+			}
+
+			return val
+		}
+	}
+
+	name, info := f.lookup(method, function)
+
+	return func() sobek.Value {
+		return f.invoke(name, info, sobek.FunctionCall{This: sobek.Undefined(), Arguments: args})
+	}
+}
+
+// distinctValues generates count distinct values, the values are compared by their JSON representation.
+// The distinct values are added to the byte budget (which can be nil).
+func (f *faker) distinctValues(
+	method string, generate func() sobek.Value, count int, budget *byteBudget,
+) []sobek.Value {
+	values := make([]sobek.Value, 0, count)
+	seen := make(map[string]struct{}, count)
+
+	for attempts := 0; len(values) < count; attempts++ {
+		if attempts == count*maxCardinalityAttempts {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: "count", Expected: "positive number",
+				Reason: "only " + strconv.Itoa(len(values)) + " distinct values generated",
+			})
+		}
+
+		val := generate()
+
+		key := distinctKey(val)
+
+		if _, found := seen[key]; found {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		values = append(values, val)
+
+		budget.add(val)
+	}
+
+	return values
+}
+
+// distinctKey returns the JSON representation of the value, its string representation if it can't be serialized.
+func distinctKey(val sobek.Value) string {
+	data, err := json.Marshal(val.Export())
+	if err != nil {
+		return val.String()
+	}
+
+	return string(data)
+}
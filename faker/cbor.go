@@ -0,0 +1,174 @@
+package faker
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/grafana/sobek"
+)
+
+// CBOR major types (RFC 8949).
+const (
+	cborUnsigned = 0 << 5
+	cborNegative = 1 << 5
+	cborBytes    = 2 << 5
+	cborText     = 3 << 5
+	cborArray    = 4 << 5
+	cborMap      = 5 << 5
+	cborTag      = 6 << 5
+	cborSimple   = 7 << 5
+)
+
+// CBOR simple values and tags.
+const (
+	cborFalse     = cborSimple | 20
+	cborTrue      = cborSimple | 21
+	cborNull      = cborSimple | 22
+	cborFloat32   = cborSimple | 26
+	cborFloat64   = cborSimple | 27
+	cborPosBignum = 2
+	cborNegBignum = 3
+)
+
+// appendCBORHead appends the head of a data item: the major type and the argument in the shortest form.
+func appendCBORHead(buff []byte, major byte, arg uint64) []byte {
+	const (
+		oneByte   = 24
+		twoBytes  = 25
+		fourBytes = 26
+		eightByte = 27
+	)
+
+	switch {
+	case arg < oneByte:
+		return append(buff, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(buff, major|oneByte, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(buff, major|twoBytes), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(buff, major|fourBytes), uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64(append(buff, major|eightByte), arg)
+	}
+}
+
+// appendCBORInt appends an integer in the shortest form.
+func appendCBORInt(buff []byte, num int64) []byte {
+	if num < 0 {
+		return appendCBORHead(buff, cborNegative, uint64(-(num + 1)))
+	}
+
+	return appendCBORHead(buff, cborUnsigned, uint64(num))
+}
+
+// appendCBORFloat appends a floating point number, integral values are encoded as integers
+// and values exactly representable in single precision as single precision numbers.
+func appendCBORFloat(buff []byte, num float64) []byte {
+	if num == math.Trunc(num) && num >= math.MinInt64 && num < math.MaxInt64 {
+		return appendCBORInt(buff, int64(num))
+	}
+
+	if single := float32(num); float64(single) == num {
+		return binary.BigEndian.AppendUint32(append(buff, cborFloat32), math.Float32bits(single))
+	}
+
+	return binary.BigEndian.AppendUint64(append(buff, cborFloat64), math.Float64bits(num))
+}
+
+// appendCBOR appends the value exported from JavaScript in CBOR format (RFC 8949).
+// The map keys are sorted in the core deterministic order, so equal values are encoded to equal bytes.
+func appendCBOR(buff []byte, val any) ([]byte, error) { //nolint:cyclop
+	switch data := val.(type) {
+	case nil:
+		return append(buff, cborNull), nil
+	case bool:
+		if data {
+			return append(buff, cborTrue), nil
+		}
+
+		return append(buff, cborFalse), nil
+	case int64:
+		return appendCBORInt(buff, data), nil
+	case int:
+		return appendCBORInt(buff, int64(data)), nil
+	case float64:
+		return appendCBORFloat(buff, data), nil
+	case *big.Int:
+		return appendCBORBigInt(buff, data), nil
+	case string:
+		return append(appendCBORHead(buff, cborText, uint64(len(data))), data...), nil
+	case []byte:
+		return append(appendCBORHead(buff, cborBytes, uint64(len(data))), data...), nil
+	case sobek.ArrayBuffer:
+		return appendCBOR(buff, data.Bytes())
+	case []any:
+		buff = appendCBORHead(buff, cborArray, uint64(len(data)))
+
+		for idx, item := range data {
+			var err error
+
+			if buff, err = appendCBOR(buff, item); err != nil {
+				return nil, fmt.Errorf("[%d]: %w", idx, err)
+			}
+		}
+
+		return buff, nil
+	case map[string]any:
+		return appendCBORMap(buff, data)
+	default:
+		return nil, fmt.Errorf("%w %v (unsupported type %T)", errInvalidValue, val, val)
+	}
+}
+
+// appendCBORBigInt appends a big integer, as an integer if it fits in 64 bits, otherwise as a bignum.
+func appendCBORBigInt(buff []byte, num *big.Int) []byte {
+	if num.Sign() >= 0 {
+		if num.IsUint64() {
+			return appendCBORHead(buff, cborUnsigned, num.Uint64())
+		}
+
+		data := num.Bytes()
+
+		return append(appendCBORHead(appendCBORHead(buff, cborTag, cborPosBignum), cborBytes, uint64(len(data))), data...)
+	}
+
+	// negative integers are encoded as -1 - n
+	abs := new(big.Int).Sub(new(big.Int).Neg(num), big.NewInt(1))
+
+	if abs.IsUint64() {
+		return appendCBORHead(buff, cborNegative, abs.Uint64())
+	}
+
+	data := abs.Bytes()
+
+	return append(appendCBORHead(appendCBORHead(buff, cborTag, cborNegBignum), cborBytes, uint64(len(data))), data...)
+}
+
+// appendCBORMap appends a map, the entries are sorted by the bytewise order of the encoded keys,
+// which is the shorter key first, then the lexical order for text keys.
+func appendCBORMap(buff []byte, data map[string]any) ([]byte, error) {
+	keys := slices.SortedFunc(maps.Keys(data), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+	})
+
+	buff = appendCBORHead(buff, cborMap, uint64(len(data)))
+
+	for _, key := range keys {
+		var err error
+
+		buff = append(appendCBORHead(buff, cborText, uint64(len(key))), key...)
+
+		if buff, err = appendCBOR(buff, data[key]); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return buff, nil
+}
@@ -0,0 +1,80 @@
+package faker_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_selfsignedcert(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("selfsignedcert")
+
+	require.NotNil(t, info)
+
+	for _, keyType := range []string{"ecdsa", "rsa", "ed25519"} {
+		params := gofakeit.NewMapParams()
+		params.Add("cn", "api.example.com")
+		params.Add("sans", "api.example.com")
+		params.Add("sans", "10.0.0.1")
+		params.Add("notAfter", "90d")
+		params.Add("keyType", keyType)
+
+		val, err := info.Generate(testRand(t), params, info)
+
+		require.NoError(t, err)
+
+		result := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+		pair, err := tls.X509KeyPair([]byte(result["cert"].(string)), []byte(result["key"].(string))) //nolint:forcetypeassert
+
+		require.NoError(t, err, keyType)
+
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+
+		require.NoError(t, err)
+		require.Equal(t, "api.example.com", cert.Subject.CommonName)
+		require.Equal(t, []string{"api.example.com"}, cert.DNSNames)
+		require.Len(t, cert.IPAddresses, 1)
+		require.Equal(t, "10.0.0.1", cert.IPAddresses[0].String())
+		require.NoError(t, cert.VerifyHostname("api.example.com"))
+		require.WithinDuration(t, time.Now().Add(90*24*time.Hour), cert.NotAfter, time.Minute)
+		require.Equal(t, cert.SerialNumber.Text(16), result["serialNumber"])
+
+		again, err := info.Generate(testRand(t), params, info)
+
+		require.NoError(t, err)
+		require.Equal(t, result["key"], faker.PlainMaps(again).(map[string]any)["key"], keyType)          //nolint:forcetypeassert
+		require.Equal(t, result["serialNumber"], faker.PlainMaps(again).(map[string]any)["serialNumber"]) //nolint:forcetypeassert
+	}
+
+	val, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+
+	result := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Contains(t, result["cert"], "-----BEGIN CERTIFICATE-----")
+
+	for _, notAfter := range []string{"2000-01-01", "soon", "-1d"} {
+		params := gofakeit.NewMapParams()
+		params.Add("notAfter", notAfter)
+
+		_, err = info.Generate(testRand(t), params, info)
+
+		require.ErrorContains(t, err, "invalid notAfter", notAfter)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("keyType", "dsa")
+
+	_, err = info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "unknown key type")
+}
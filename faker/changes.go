@@ -0,0 +1,377 @@
+package faker
+
+import (
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+// Operations of the mutation events of the changes method.
+const (
+	changeInsert = "insert"
+	changeUpdate = "update"
+	changeDelete = "delete"
+)
+
+// changeSet is the state of the changes method: the current records and the remaining operations.
+type changeSet struct {
+	key       string
+	schema    *schema
+	records   []*sobek.Object
+	original  []*sobek.Object
+	remaining map[string]int
+	sequence  int
+}
+
+// changes returns an iterator of CRUD mutation events against the dataset (an array of records), e.g. for
+// load testing sync and CDC endpoints. The updates, inserts and deletes options are the number of events
+// of each operation (0 by default), in random order. The events are applied to a copy of the dataset,
+// so their before and after states are consistent: updates and deletes refer to the current records
+// and the state method returns the records after the events so far.
+//
+// The records are identified by the key option ("id" by default). The inserted records and the updated fields
+// are generated from the schema option, without schema they are taken from the values of the original records
+// and the key of the inserted records is the next number or a new UUID, unless the schema generates it.
+func (f *faker) changes(dataset sobek.Value, options sobek.Value) *sobek.Object {
+	const method = "changes"
+
+	set := &changeSet{key: "id", remaining: make(map[string]int)}
+
+	records, isArray := dataset.(*sobek.Object)
+	if !isArray || records.ClassName() != "Array" {
+		f.throw(&ArgumentError{Function: method, Parameter: "dataset", Expected: "array", Reason: "invalid value " + dataset.String()})
+	}
+
+	var items []sobek.Value
+
+	_ = f.runtime.ExportTo(records, &items)
+
+	for _, item := range items {
+		if !isOptions(item) {
+			f.throw(&ArgumentError{Function: method, Parameter: "dataset", Expected: "array of objects", Reason: "invalid record " + item.String()})
+		}
+
+		set.records = append(set.records, f.copyPayload(item).(*sobek.Object)) //nolint:forcetypeassert
+	}
+
+	set.original = slices.Clone(set.records)
+
+	if options != nil && !sobek.IsUndefined(options) {
+		f.changeOptions(method, set, f.objectArgument(method, "options", options))
+	}
+
+	if set.remaining[changeDelete]+min(set.remaining[changeUpdate], 1) > len(set.records)+set.remaining[changeInsert] {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "deletes", Expected: "at most the number of records",
+			Reason: "invalid value " + strconv.Itoa(set.remaining[changeDelete]),
+		})
+	}
+
+	obj := f.runtime.NewObject()
+
+	result := func(val sobek.Value, done bool) *sobek.Object {
+		res := f.runtime.NewObject()
+
+		_ = res.Set("value", val)
+		_ = res.Set("done", done)
+
+		return res
+	}
+
+	_ = obj.Set("length", set.remaining[changeInsert]+set.remaining[changeUpdate]+set.remaining[changeDelete])
+	_ = obj.Set("next", func() *sobek.Object {
+		event := f.nextChange(set)
+		if event == nil {
+			return result(sobek.Undefined(), true)
+		}
+
+		return result(event, false)
+	})
+	_ = obj.Set("state", func() sobek.Value {
+		state := make([]any, len(set.records))
+
+		for idx, record := range set.records {
+			state[idx] = f.copyPayload(record)
+		}
+
+		return f.runtime.NewArray(state...)
+	})
+	_ = obj.SetSymbol(sobek.SymIterator, func(call sobek.FunctionCall) sobek.Value { return call.This })
+
+	return obj
+}
+
+// changeOptions parses the options of the changes method.
+func (f *faker) changeOptions(method string, set *changeSet, opts *sobek.Object) {
+	for _, param := range []struct{ name, op string }{
+		{"inserts", changeInsert}, {"updates", changeUpdate}, {"deletes", changeDelete},
+	} {
+		val := opts.Get(param.name)
+		if val == nil || sobek.IsUndefined(val) {
+			continue
+		}
+
+		count := val.ToFloat()
+		if count < 0 || count != math.Trunc(count) || math.IsInf(count, 0) {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: param.name, Expected: "non-negative integer",
+				Reason: "invalid value " + val.String(),
+			})
+		}
+
+		set.remaining[param.op] = int(count)
+	}
+
+	if val := opts.Get("key"); val != nil && !sobek.IsUndefined(val) {
+		set.key = val.String()
+	}
+
+	if val := opts.Get("schema"); val != nil && !sobek.IsUndefined(val) {
+		set.schema = f.compileSchema(method, val)
+	}
+}
+
+// nextChange applies a random remaining operation to the records and returns its event, nil if there is no more.
+// The updates and deletes are only picked if there is a record to change, and a delete doesn't remove
+// the last record while updates remain without inserts.
+func (f *faker) nextChange(set *changeSet) *sobek.Object {
+	f.rescope()
+
+	ops := []string{changeInsert, changeUpdate, changeDelete}
+	weights := make([]float64, len(ops))
+
+	var total float64
+
+	for idx, op := range ops {
+		allowed := set.remaining[op] > 0
+
+		switch op {
+		case changeUpdate:
+			allowed = allowed && len(set.records) > 0
+		case changeDelete:
+			allowed = allowed && len(set.records) > 0 &&
+				(len(set.records) > 1 || set.remaining[changeUpdate] == 0 || set.remaining[changeInsert] > 0)
+		}
+
+		if allowed {
+			weights[idx] = float64(set.remaining[op])
+			total += weights[idx]
+		}
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	op := ops[weightedIndex(f.rand, weights)]
+
+	set.remaining[op]--
+	set.sequence++
+
+	event := f.runtime.NewObject()
+
+	_ = event.Set("sequence", set.sequence)
+	_ = event.Set("op", op)
+
+	var before, after *sobek.Object
+
+	switch op {
+	case changeInsert:
+		after = f.insertedRecord(set)
+		set.records = append(set.records, after)
+	case changeUpdate:
+		const attempts = 10
+
+		idx := f.rand.Intn(len(set.records))
+		before = set.records[idx]
+
+		// the new values may happen to be the same as the old ones
+		var changed []string
+
+		for range attempts {
+			after = f.updatedRecord(set, before)
+
+			if changed = changedFields(before, after); len(changed) != 0 {
+				break
+			}
+		}
+
+		set.records[idx] = after
+
+		_ = event.Set("changed", changed)
+	case changeDelete:
+		idx := f.rand.Intn(len(set.records))
+		before = set.records[idx]
+		set.records = slices.Delete(set.records, idx, idx+1)
+	}
+
+	key := sobek.Undefined()
+
+	for _, record := range []*sobek.Object{after, before} {
+		if record != nil {
+			key = record.Get(set.key)
+		}
+	}
+
+	_ = event.Set("key", key)
+	_ = event.Set("before", f.changeState(before))
+	_ = event.Set("after", f.changeState(after))
+
+	return event
+}
+
+// changeState returns a copy of the record for the event, null if there is no record.
+func (f *faker) changeState(record *sobek.Object) sobek.Value {
+	if record == nil {
+		return sobek.Null()
+	}
+
+	return f.copyPayload(record)
+}
+
+// insertedRecord returns a new record, generated from the schema or combined from the fields of random records
+// of the original dataset.
+func (f *faker) insertedRecord(set *changeSet) *sobek.Object {
+	var record *sobek.Object
+
+	if set.schema != nil {
+		record = f.generate(set.schema)
+	} else {
+		record = f.runtime.NewObject()
+
+		if len(set.original) != 0 {
+			for _, field := range set.original[0].Keys() {
+				_ = record.Set(field, f.copyPayload(set.original[f.rand.Intn(len(set.original))].Get(field)))
+			}
+		}
+	}
+
+	// the key generated by the schema is kept
+	if key := record.Get(set.key); key == nil || set.schema == nil {
+		_ = record.Set(set.key, f.nextKey(set))
+	}
+
+	return record
+}
+
+// nextKey returns the key of an inserted record: the next number for numeric keys, a new UUID otherwise.
+func (f *faker) nextKey(set *changeSet) any {
+	if len(set.records) == 0 {
+		return (&gofakeit.Faker{Rand: f.rand}).UUID()
+	}
+
+	var next int64
+
+	for _, record := range set.records {
+		key, isNumber := record.Get(set.key).Export().(int64)
+		if !isNumber {
+			return (&gofakeit.Faker{Rand: f.rand}).UUID()
+		}
+
+		next = max(next, key+1)
+	}
+
+	return next
+}
+
+// updatedRecord returns a copy of the record with one or more non-key fields changed,
+// generated from the schema or taken from the original dataset.
+func (f *faker) updatedRecord(set *changeSet, record *sobek.Object) *sobek.Object {
+	updated := f.copyPayload(record).(*sobek.Object) //nolint:forcetypeassert
+
+	var fresh *sobek.Object
+
+	if set.schema != nil {
+		fresh = f.generate(set.schema)
+	}
+
+	fields := slices.DeleteFunc(record.Keys(), func(field string) bool { return field == set.key })
+	if len(fields) == 0 {
+		return updated
+	}
+
+	f.rand.Shuffle(len(fields), func(i, j int) { fields[i], fields[j] = fields[j], fields[i] })
+
+	for _, field := range fields[:1+f.rand.Intn(len(fields))] {
+		_ = updated.Set(field, f.copyPayload(f.changedValue(set, fresh, field, record.Get(field))))
+	}
+
+	return updated
+}
+
+// changedValue returns a new value of the field: the value of the generated record if it has the field,
+// otherwise a different value of the field in the original dataset. If all values of the field are the same,
+// booleans are negated and numbers are incremented.
+func (f *faker) changedValue(set *changeSet, fresh *sobek.Object, field string, current sobek.Value) sobek.Value {
+	if fresh != nil {
+		if val := fresh.Get(field); val != nil {
+			return val
+		}
+	}
+
+	var candidates []sobek.Value
+
+	for _, record := range set.original {
+		if val := record.Get(field); val != nil && !sameValue(val, current) {
+			candidates = append(candidates, val)
+		}
+	}
+
+	if len(candidates) != 0 {
+		return candidates[f.rand.Intn(len(candidates))]
+	}
+
+	switch val := current.Export().(type) {
+	case bool:
+		return f.runtime.ToValue(!val)
+	case int64:
+		return f.runtime.ToValue(val + 1)
+	case float64:
+		return f.runtime.ToValue(val + 1)
+	default:
+		return current
+	}
+}
+
+// changedFields returns the names of the fields with different values in the records.
+func changedFields(before *sobek.Object, after *sobek.Object) []string {
+	changed := make([]string, 0, len(after.Keys()))
+
+	for _, field := range after.Keys() {
+		if !sameValue(before.Get(field), after.Get(field)) {
+			changed = append(changed, field)
+		}
+	}
+
+	return changed
+}
+
+// sameValue reports whether the values are equal, comparing the objects and arrays by content.
+func sameValue(left sobek.Value, right sobek.Value) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+
+	lobj, lok := left.(*sobek.Object)
+	robj, rok := right.(*sobek.Object)
+
+	if !lok || !rok {
+		return left.StrictEquals(right)
+	}
+
+	lkeys, rkeys := lobj.Keys(), robj.Keys()
+	if !slices.Equal(lkeys, rkeys) {
+		return false
+	}
+
+	for _, key := range lkeys {
+		if !sameValue(lobj.Get(key), robj.Get(key)) {
+			return false
+		}
+	}
+
+	return true
+}
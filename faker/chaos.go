@@ -0,0 +1,138 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errInvalidRate = errors.New("rate must be between 0 and 1")
+
+func init() {
+	rate := gofakeit.Param{
+		Field:       "rate",
+		Display:     "Rate",
+		Type:        "float",
+		Default:     "0.1",
+		Description: "Probability of generating an edge case value instead of a normal one (between 0 and 1)",
+	}
+
+	gofakeit.AddFuncLookup("chaosquerystring", gofakeit.Info{
+		Display:     "Query String",
+		Category:    "chaos",
+		Description: "URL encoded query string, which is an edge case (long, reserved or multilingual values) at the given rate",
+		Example:     "q=pizza&page=2",
+		Output:      "string",
+		Params:      []gofakeit.Param{rate},
+		Generate:    chaosquerystring,
+	})
+
+	gofakeit.AddFuncLookup("chaospathsegment", gofakeit.Info{
+		Display:     "Path Segment",
+		Category:    "chaos",
+		Description: "URL encoded path segment, which is an edge case (long, reserved or multilingual values) at the given rate",
+		Example:     "summer-sale",
+		Output:      "string",
+		Params:      []gofakeit.Param{rate},
+		Generate:    chaospathsegment,
+	})
+}
+
+// edgeValues contains edge case values, the values are encoded by the caller.
+var edgeValues = []string{ //nolint:gochecknoglobals
+	// empty and whitespace only values
+	"", " ", "\t", "  \n ", "\u00a0", "\u3000",
+	// already percent encoded values (double encoding)
+	"%20", "%2F", "%25", "%00", "%E2%82%AC", "%%",
+	// mixed scripts, combining characters and emoji
+	"Ünïcödé", "日本語テキスト", "Ελληνικά", "e\u0301\u0302\u0303", "👩\u200d👩\u200d👧", "🇭🇺🚀", "ＦＵＬＬＷＩＤＴＨ",
+	// right-to-left text and invisible characters
+	"עברית", "العربية", "\u202eevil", "zero\u200bwidth", "\ufeffbom",
+	// control characters
+	"line\r\nbreak", "nul\x00byte", "\x1b[31mred", "\x7f",
+	// numeric edge cases
+	"-1", "0", "-0", "9223372036854775808", "1e309", "1.0e-400", "NaN", "0x1F",
+}
+
+// reserved contains the characters which have special meaning in URLs or are not allowed unencoded.
+const reserved = "!#$&'()*+,/:;=?@[]% \"<>\\^`{|}~"
+
+// edgeCase returns a random edge case value: a very long value, a value containing
+// a reserved character or one of the edge case values.
+func edgeCase(r *rand.Rand) string {
+	const (
+		kinds     = 4
+		minLength = 1024
+		maxLength = 8192
+	)
+
+	switch r.Intn(kinds) {
+	case 0:
+		return strings.Repeat("a", minLength+r.Intn(maxLength-minLength))
+	case 1:
+		return skuOf(r, "???") + string(reserved[r.Intn(len(reserved))]) + skuOf(r, "###")
+	default:
+		return edgeValues[r.Intn(len(edgeValues))]
+	}
+}
+
+func chaosRate(m *gofakeit.MapParams, info *gofakeit.Info) (float64, error) {
+	rate, err := info.GetFloat64(m, "rate")
+	if err != nil {
+		return 0, err
+	}
+
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("%w: %g", errInvalidRate, rate)
+	}
+
+	return rate, nil
+}
+
+// chaosValue returns an edge case value with the probability of rate, otherwise the normal value.
+func chaosValue(r *rand.Rand, rate float64, normal func() string) string {
+	if r.Float64() < rate {
+		return edgeCase(r)
+	}
+
+	return normal()
+}
+
+func chaosquerystring(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const maxParams = 3
+
+	rate, err := chaosRate(m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+	count := 1 + r.Intn(maxParams)
+	pairs := make([]string, count)
+
+	for idx := range pairs {
+		key := chaosValue(r, rate, fake.Noun)
+		value := chaosValue(r, rate, fake.Word)
+
+		pairs[idx] = url.QueryEscape(key) + "=" + url.QueryEscape(value)
+	}
+
+	return strings.Join(pairs, "&"), nil
+}
+
+func chaospathsegment(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	rate, err := chaosRate(m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	return url.PathEscape(chaosValue(r, rate, func() string {
+		return strings.ReplaceAll(strings.ToLower(fake.Adjective()+" "+fake.Noun()), " ", "-")
+	})), nil
+}
@@ -0,0 +1,75 @@
+package faker_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_chaosquerystring(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("chaosquerystring")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("rate", "1")
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		_, err = url.ParseQuery(val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("rate", "2")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "rate must be between 0 and 1")
+}
+
+func Test_chaospathsegment(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("chaospathsegment")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("rate", "1")
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		segment, ok := val.(string)
+
+		require.True(t, ok)
+		require.NotContains(t, segment, "/")
+
+		_, err = url.PathUnescape(segment)
+
+		require.NoError(t, err)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("rate", "0")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^[a-z]+(-[a-z]+)+$`, val)
+}
@@ -0,0 +1,286 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownResourceType = errors.New("unknown resource type")
+
+const (
+	awsAccountLength    = 12
+	ec2InstanceIDLength = 17
+	// uniqueSuffixLength is the length of the numeric suffix of globally unique names (e.g. buckets).
+	uniqueSuffixLength = 6
+)
+
+func init() {
+	gofakeit.AddFuncLookup("awsarn", gofakeit.Info{
+		Display:     "AWS ARN",
+		Category:    "cloud",
+		Description: "Amazon Resource Name of an AWS resource",
+		Example:     "arn:aws:lambda:eu-west-1:493817266012:function:checkout-api-prod",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "service",
+				Display:     "Service",
+				Type:        "string",
+				Default:     "any",
+				Options:     cloudTypes(awsARNs),
+				Description: "AWS service of the resource",
+			},
+		},
+		Generate: awsarn,
+	})
+
+	gofakeit.AddFuncLookup("gcpresourcename", gofakeit.Info{
+		Display:     "GCP Resource Name",
+		Category:    "cloud",
+		Description: "Full resource name of a Google Cloud resource",
+		Example:     "//compute.googleapis.com/projects/quiet-river-482913/zones/europe-west1-b/instances/search-dev",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "type",
+				Display:     "Type",
+				Type:        "string",
+				Default:     "any",
+				Options:     cloudTypes(gcpNames),
+				Description: "Type of the resource",
+			},
+		},
+		Generate: gcpresourcename,
+	})
+
+	gofakeit.AddFuncLookup("azureresourceid", gofakeit.Info{
+		Display:     "Azure Resource ID",
+		Category:    "cloud",
+		Description: "Azure Resource Manager identifier of an Azure resource",
+		Example: "/subscriptions/590c1440-9888-45b0-bd51-a817ee07c3f2/resourceGroups/rg-payments-prod" +
+			"/providers/Microsoft.Compute/virtualMachines/payments-prod",
+		Output: "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "type",
+				Display:     "Type",
+				Type:        "string",
+				Default:     "any",
+				Options:     cloudTypes(azureIDs),
+				Description: "Type of the resource",
+			},
+		},
+		Generate: azureresourceid,
+	})
+}
+
+// awsRegions and gcpRegions contain regions of the cloud providers.
+var (
+	awsRegions = []string{ //nolint:gochecknoglobals
+		"us-east-1", "us-east-2", "us-west-2", "eu-west-1", "eu-central-1", "ap-southeast-1", "ap-northeast-1", "sa-east-1",
+	}
+	gcpRegions = []string{ //nolint:gochecknoglobals
+		"us-central1", "us-east1", "us-west1", "europe-west1", "europe-west4", "asia-east1", "asia-northeast1",
+	}
+)
+
+// awsARNs contains the ARN generators of the AWS services.
+var awsARNs = map[string]func(r *rand.Rand) string{ //nolint:gochecknoglobals
+	"s3": func(r *rand.Rand) string {
+		return "arn:aws:s3:::" + cloudName(r) + "-" + digits(r, uniqueSuffixLength)
+	},
+	"ec2": func(r *rand.Rand) string {
+		return awsPrefix(r, "ec2") + "instance/i-" + hexOf(r, ec2InstanceIDLength)
+	},
+	"lambda": func(r *rand.Rand) string {
+		return awsPrefix(r, "lambda") + "function:" + cloudName(r)
+	},
+	"iam": func(r *rand.Rand) string {
+		return "arn:aws:iam::" + digits(r, awsAccountLength) + ":" + pickOf("role", "user", "policy")(r) + "/" + cloudName(r)
+	},
+	"dynamodb": func(r *rand.Rand) string {
+		return awsPrefix(r, "dynamodb") + "table/" + cloudName(r)
+	},
+	"sqs": func(r *rand.Rand) string {
+		return awsPrefix(r, "sqs") + cloudName(r)
+	},
+	"sns": func(r *rand.Rand) string {
+		return awsPrefix(r, "sns") + cloudName(r)
+	},
+	"rds": func(r *rand.Rand) string {
+		return awsPrefix(r, "rds") + "db:" + cloudName(r)
+	},
+	"kms": func(r *rand.Rand) string {
+		return awsPrefix(r, "kms") + "key/" + (&gofakeit.Faker{Rand: r}).UUID()
+	},
+}
+
+// gcpNames contains the resource name generators of the Google Cloud resource types.
+var gcpNames = map[string]func(r *rand.Rand) string{ //nolint:gochecknoglobals
+	"instance": func(r *rand.Rand) string {
+		region := gcpRegions[r.Intn(len(gcpRegions))]
+
+		return "//compute.googleapis.com/projects/" + gcpProject(r) +
+			"/zones/" + region + "-" + pickOf("a", "b", "c")(r) + "/instances/" + cloudName(r)
+	},
+	"bucket": func(r *rand.Rand) string {
+		return "//storage.googleapis.com/projects/_/buckets/" + cloudName(r) + "-" + digits(r, uniqueSuffixLength)
+	},
+	"topic": func(r *rand.Rand) string {
+		return "//pubsub.googleapis.com/projects/" + gcpProject(r) + "/topics/" + cloudName(r)
+	},
+	"subscription": func(r *rand.Rand) string {
+		return "//pubsub.googleapis.com/projects/" + gcpProject(r) + "/subscriptions/" + cloudName(r)
+	},
+	"serviceAccount": func(r *rand.Rand) string {
+		project := gcpProject(r)
+
+		return "//iam.googleapis.com/projects/" + project + "/serviceAccounts/" +
+			cloudName(r) + "@" + project + ".iam.gserviceaccount.com"
+	},
+	"cluster": func(r *rand.Rand) string {
+		return "//container.googleapis.com/projects/" + gcpProject(r) +
+			"/locations/" + gcpRegions[r.Intn(len(gcpRegions))] + "/clusters/" + cloudName(r)
+	},
+	"dataset": func(r *rand.Rand) string {
+		return "//bigquery.googleapis.com/projects/" + gcpProject(r) +
+			"/datasets/" + strings.ReplaceAll(cloudName(r), "-", "_")
+	},
+}
+
+// azureIDs contains the resource provider namespaces and types of the Azure resource types.
+var azureIDs = map[string]string{ //nolint:gochecknoglobals
+	"virtualMachine": "Microsoft.Compute/virtualMachines",
+	"storageAccount": "Microsoft.Storage/storageAccounts",
+	"virtualNetwork": "Microsoft.Network/virtualNetworks",
+	"webApp":         "Microsoft.Web/sites",
+	"keyVault":       "Microsoft.KeyVault/vaults",
+	"sqlServer":      "Microsoft.Sql/servers",
+	"aksCluster":     "Microsoft.ContainerService/managedClusters",
+}
+
+// cloudTypes returns the sorted resource types of the generators prefixed with "any".
+func cloudTypes[T any](types map[string]T) []string {
+	names := make([]string, 0, len(types))
+
+	for name := range types {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return append([]string{"any"}, names...)
+}
+
+// cloudType returns the resource type of the parameter, a random one if it is "any".
+func cloudType[T any](
+	r *rand.Rand,
+	m *gofakeit.MapParams,
+	info *gofakeit.Info,
+	field string,
+	types map[string]T,
+) (T, error) {
+	var zero T
+
+	name, err := info.GetString(m, field)
+	if err != nil {
+		return zero, err
+	}
+
+	if strings.EqualFold(name, "any") {
+		names := cloudTypes(types)[1:]
+		name = names[r.Intn(len(names))]
+	}
+
+	for key, value := range types {
+		if strings.EqualFold(key, name) {
+			return value, nil
+		}
+	}
+
+	return zero, fmt.Errorf("%w: %s", errUnknownResourceType, name)
+}
+
+// cloudName returns a resource name of an application and an environment (e.g. checkout-api-prod).
+func cloudName(r *rand.Rand) string {
+	return k8sApps[r.Intn(len(k8sApps))] + "-" + k8sEnvs[r.Intn(len(k8sEnvs))]
+}
+
+// awsPrefix returns the ARN prefix of a regional resource of the service, including the account ID.
+func awsPrefix(r *rand.Rand, service string) string {
+	return "arn:aws:" + service + ":" + awsRegions[r.Intn(len(awsRegions))] + ":" + digits(r, awsAccountLength) + ":"
+}
+
+// gcpProject returns a Google Cloud project ID like the generated ones (e.g. quiet-river-482913),
+// project IDs are at most 30 characters long.
+func gcpProject(r *rand.Rand) string {
+	const maxLength = 30
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	words := dns1123Label(fake.AdjectiveDescriptive() + "-" + fake.NounCommon())
+	words = strings.TrimRight(words[:min(len(words), maxLength-uniqueSuffixLength-1)], "-")
+
+	return words + "-" + digits(r, uniqueSuffixLength)
+}
+
+// hexOf returns a random string of n lower case hexadecimal digits.
+func hexOf(r *rand.Rand, n int) string {
+	const hex = "0123456789abcdef"
+
+	buff := make([]byte, n)
+
+	for idx := range buff {
+		buff[idx] = hex[r.Intn(len(hex))]
+	}
+
+	return string(buff)
+}
+
+func awsarn(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	generate, err := cloudType(r, m, info, "service", awsARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	return generate(r), nil
+}
+
+func gcpresourcename(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	generate, err := cloudType(r, m, info, "type", gcpNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return generate(r), nil
+}
+
+func azureresourceid(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		maxStorageName = 24
+		storageSuffix  = 4
+	)
+
+	provider, err := cloudType(r, m, info, "type", azureIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	team, env := k8sTeams[r.Intn(len(k8sTeams))], k8sEnvs[r.Intn(len(k8sEnvs))]
+
+	name := team + "-" + env
+	if strings.HasPrefix(provider, "Microsoft.Storage/") {
+		// storage account names are 3-24 lower case letters and digits
+		name = strings.ReplaceAll(name, "-", "") + digits(r, storageSuffix)
+		name = name[:min(len(name), maxStorageName)]
+	}
+
+	return "/subscriptions/" + (&gofakeit.Faker{Rand: r}).UUID() +
+		"/resourceGroups/rg-" + team + "-" + env +
+		"/providers/" + provider + "/" + name, nil
+}
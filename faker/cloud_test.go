@@ -0,0 +1,76 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_awsarn(t *testing.T) {
+	t.Parallel()
+
+	testCloudFormats(t, "awsarn", "service", map[string]*regexp.Regexp{
+		"s3":     regexp.MustCompile(`^arn:aws:s3:::[a-z0-9-]{3,63}$`),
+		"ec2":    regexp.MustCompile(`^arn:aws:ec2:[a-z0-9-]+:[0-9]{12}:instance/i-[0-9a-f]{17}$`),
+		"Lambda": regexp.MustCompile(`^arn:aws:lambda:[a-z0-9-]+:[0-9]{12}:function:[a-z0-9-]+$`),
+		"iam":    regexp.MustCompile(`^arn:aws:iam::[0-9]{12}:(role|user|policy)/[a-z0-9-]+$`),
+		"any":    regexp.MustCompile(`^arn:aws:[a-z0-9]+:([a-z0-9-]+)?:([0-9]{12})?:.+$`),
+	})
+}
+
+func Test_gcpresourcename(t *testing.T) {
+	t.Parallel()
+
+	testCloudFormats(t, "gcpresourcename", "type", map[string]*regexp.Regexp{
+		"instance": regexp.MustCompile(
+			`^//compute\.googleapis\.com/projects/[a-z][-a-z0-9]{4,28}[a-z0-9]/zones/[a-z0-9-]+-[abc]/instances/[a-z0-9-]+$`,
+		),
+		"bucket":         regexp.MustCompile(`^//storage\.googleapis\.com/projects/_/buckets/[a-z0-9-]+$`),
+		"serviceaccount": regexp.MustCompile(`^//iam\.googleapis\.com/projects/([-a-z0-9]+)/serviceAccounts/[a-z0-9-]+@([-a-z0-9]+)\.iam\.gserviceaccount\.com$`),
+		"dataset":        regexp.MustCompile(`^//bigquery\.googleapis\.com/projects/[-a-z0-9]+/datasets/[a-z0-9_]+$`),
+		"any":            regexp.MustCompile(`^//[a-z]+\.googleapis\.com/projects/.+$`),
+	})
+}
+
+func Test_azureresourceid(t *testing.T) {
+	t.Parallel()
+
+	testCloudFormats(t, "azureresourceid", "type", map[string]*regexp.Regexp{
+		"virtualMachine": regexp.MustCompile(
+			`^/subscriptions/[0-9a-f-]{36}/resourceGroups/rg-[a-z-]+/providers/Microsoft\.Compute/virtualMachines/[a-z-]+$`,
+		),
+		"storageAccount": regexp.MustCompile(`/providers/Microsoft\.Storage/storageAccounts/[a-z0-9]{3,24}$`),
+		"any":            regexp.MustCompile(`/providers/Microsoft\.[A-Za-z]+/[A-Za-z]+/[a-z0-9-]+$`),
+	})
+}
+
+func testCloudFormats(t *testing.T, name string, field string, formats map[string]*regexp.Regexp) {
+	t.Helper()
+
+	info := gofakeit.GetFuncLookup(name)
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for typ, format := range formats {
+		params := gofakeit.NewMapParams()
+		params.Add(field, typ)
+
+		for range 20 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Regexp(t, format, val, typ)
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add(field, "mainframe")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown resource type: mainframe")
+}
@@ -0,0 +1,213 @@
+package faker
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/grafana/sobek"
+)
+
+// defaultCollectionSize is the number of records of the collections without count property.
+const defaultCollectionSize = 10
+
+// collection is a compiled collection specification of the collections method.
+type collection struct {
+	name   string
+	count  int
+	schema *schema
+	// parents are the names of the other collections referenced by the fields.
+	parents []string
+}
+
+// collections generates multiple related collections of records, e.g. for seeding a database.
+//
+// The property names of the specification are the collection names, the values are schemas
+// (see fromSchema) or objects with schema and count (10 by default) properties.
+// The ref fields in "collection.field" format (e.g. { ref: "users.id" }) reference a random generated record
+// of the collection, so the foreign keys point to actually generated parent records. The field path can be nested
+// (e.g. "users.address.city"), the collection name alone references the whole record. The collections are generated
+// in the order of their dependencies, the references to the same collection pick one of the previous records.
+// The other ref fields reference the stored entities as usual.
+func (f *faker) collections(spec sobek.Value) *sobek.Object {
+	const method = "collections"
+
+	obj := f.objectArgument(method, "spec", spec)
+	specs := make(map[string]*collection, len(obj.Keys()))
+
+	for _, name := range obj.Keys() {
+		specs[name] = f.compileCollection(method, name, obj.Get(name), obj.Keys())
+	}
+
+	order := f.collectionOrder(method, obj.Keys(), specs)
+
+	f.related = make(map[string][]sobek.Value, len(specs))
+	defer func() { f.related = nil }()
+
+	budget := f.budget(func(reason string) {
+		f.throw(&ArgumentError{Function: method, Parameter: "spec", Reason: reason})
+	})
+
+	for _, coll := range order {
+		budget.batch(coll.count)
+
+		for range coll.count {
+			record := f.generate(coll.schema)
+
+			budget.add(record)
+
+			f.related[coll.name] = append(f.related[coll.name], record)
+		}
+	}
+
+	result := f.runtime.NewObject()
+
+	for _, name := range obj.Keys() {
+		_ = result.Set(name, f.runtime.NewArray(anySlice(f.related[name])...))
+	}
+
+	return result
+}
+
+// compileCollection compiles the collection specification, a schema or an object with schema and count properties.
+func (f *faker) compileCollection(method string, name string, spec sobek.Value, names []string) *collection {
+	coll := &collection{name: name, count: defaultCollectionSize}
+	obj := f.objectArgument(method, name, spec)
+
+	if val := obj.Get("schema"); isOptions(val) {
+		if count := obj.Get("count"); count != nil && !sobek.IsUndefined(count) {
+			if coll.count = int(count.ToInteger()); coll.count < 0 {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: name + ".count", Expected: "non-negative integer",
+					Reason: "invalid value " + count.String(),
+				})
+			}
+		}
+
+		spec = val
+	}
+
+	coll.schema = f.compileSchema(method, spec)
+
+	for _, ref := range schemaRefs(coll.schema, nil) {
+		parent, _, _ := strings.Cut(ref, ".")
+
+		if parent != name && slices.Contains(names, parent) && !slices.Contains(coll.parents, parent) {
+			coll.parents = append(coll.parents, parent)
+		}
+	}
+
+	return coll
+}
+
+// schemaRefs returns the ref values of the fields of the schema, including the nested fields and the oneOf variants.
+func schemaRefs(s *schema, refs []string) []string {
+	var walk func(field *schemaField)
+
+	walk = func(field *schemaField) {
+		if len(field.ref) != 0 {
+			refs = append(refs, field.ref)
+		}
+
+		if field.nested != nil {
+			refs = schemaRefs(field.nested, refs)
+		}
+
+		for _, variant := range field.variants {
+			walk(variant)
+		}
+	}
+
+	for _, field := range s.fields {
+		walk(field)
+	}
+
+	return refs
+}
+
+// collectionOrder returns the collections in dependency order, the parents before the children.
+// The independent collections keep the order of the specification.
+func (f *faker) collectionOrder(method string, names []string, specs map[string]*collection) []*collection {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(names))
+	order := make([]*collection, 0, len(names))
+
+	var visit func(name string, path []string)
+
+	visit = func(name string, path []string) {
+		switch state[name] {
+		case visited:
+			return
+		case visiting:
+			f.throw(&ArgumentError{
+				Function: method, Parameter: name, Expected: "acyclic references",
+				Reason: "circular reference " + strings.Join(append(path, name), " -> "),
+			})
+		}
+
+		state[name] = visiting
+
+		for _, parent := range specs[name].parents {
+			visit(parent, append(path, name))
+		}
+
+		state[name] = visited
+		order = append(order, specs[name])
+	}
+
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name, nil)
+		}
+	}
+
+	return order
+}
+
+// pickRelated returns the referenced value of a random record generated by the collections method,
+// false if the ref doesn't reference a collection or the collection has no record yet.
+func (f *faker) pickRelated(ref string) (sobek.Value, bool) {
+	name, path, _ := strings.Cut(ref, ".")
+
+	records, found := f.related[name]
+	if !found || len(records) == 0 {
+		return nil, false
+	}
+
+	f.rescope()
+
+	val := records[f.rand.Intn(len(records))]
+
+	if len(path) == 0 {
+		return val, true
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := val.(*sobek.Object)
+		if !ok {
+			return sobek.Undefined(), true
+		}
+
+		val = obj.Get(key)
+		if val == nil {
+			return sobek.Undefined(), true
+		}
+	}
+
+	return val, true
+}
+
+// anySlice returns the values as a slice of any, for creating JavaScript arrays.
+func anySlice(values []sobek.Value) []any {
+	items := make([]any, len(values))
+
+	for idx, val := range values {
+		items[idx] = val
+	}
+
+	return items
+}
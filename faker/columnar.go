@@ -0,0 +1,241 @@
+package faker
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/grafana/sobek"
+)
+
+// columnarBatchRows is the maximum number of rows of a Parquet data page and of an Arrow record batch.
+const columnarBatchRows = 1 << 16
+
+var errColumnTooLarge = errors.New("column data too large")
+
+// columnKind is the type of a column of the columnar formats.
+type columnKind int
+
+const (
+	columnString columnKind = iota
+	columnBoolean
+	columnInt64
+	columnDouble
+)
+
+// column contains the values of a dataset property in columnar form.
+// The value slice of the kind contains a value for every row, the zero value for the missing values.
+type column struct {
+	name    string
+	kind    columnKind
+	valid   []bool
+	bools   []bool
+	ints    []int64
+	floats  []float64
+	strings []string
+	// raw contains the exported values until the kind of the column is resolved.
+	raw []any
+}
+
+// writeParquet writes the dataset (array of objects, e.g. generated by many or compile) into a Parquet file.
+func (f *faker) writeParquet(filePath string, dataset sobek.Value) {
+	f.writeColumnar("writeParquet", filePath, dataset, encodeParquet)
+}
+
+// writeArrow writes the dataset (array of objects, e.g. generated by many or compile)
+// into an Arrow IPC file (Feather V2).
+func (f *faker) writeArrow(filePath string, dataset sobek.Value) {
+	f.writeColumnar("writeArrow", filePath, dataset, encodeArrow)
+}
+
+// writeColumnar writes the dataset into the file in the columnar format of the encode function.
+// The properties of the objects are the columns, in the order of their first occurrence.
+// The boolean, integer and floating point properties are stored as such, the strings as UTF-8 strings,
+// the other values (e.g. nested objects) as JSON strings, the missing and null values as nulls.
+// The path is relative to the working directory of k6, the file is created or truncated.
+func (f *faker) writeColumnar(
+	method string, filePath string, dataset sobek.Value, encode func(columns []*column, rows int) ([]byte, error),
+) {
+	if len(filePath) == 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "path", Expected: "string", Reason: "missing parameter"})
+	}
+
+	array, isObject := dataset.(*sobek.Object)
+	if !isObject || array.ClassName() != "Array" {
+		f.throw(&ArgumentError{Function: method, Parameter: "dataset", Expected: "array of objects", Reason: "invalid value"})
+	}
+
+	var rows []sobek.Value
+
+	_ = f.runtime.ExportTo(array, &rows)
+
+	if len(rows) == 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "dataset", Expected: "array of objects", Reason: "empty array"})
+	}
+
+	columns := f.columns(method, rows)
+
+	data, err := encode(columns, len(rows))
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "dataset", Reason: err.Error()})
+	}
+
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		f.throw(err)
+	}
+}
+
+// columns returns the columns of the rows.
+func (f *faker) columns(method string, rows []sobek.Value) []*column {
+	var columns []*column
+
+	index := make(map[string]*column)
+
+	for idx, row := range rows {
+		if !isOptions(row) {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: "dataset", Expected: "array of objects", Reason: "invalid item " + row.String(),
+			})
+		}
+
+		obj := row.ToObject(f.runtime)
+
+		for _, key := range obj.Keys() {
+			col, found := index[key]
+			if !found {
+				col = &column{name: key, raw: make([]any, len(rows))}
+				index[key] = col
+				columns = append(columns, col)
+			}
+
+			if val := obj.Get(key); val != nil {
+				col.raw[idx] = val.Export()
+			}
+		}
+	}
+
+	for _, col := range columns {
+		col.resolve()
+	}
+
+	return columns
+}
+
+// resolve sets the kind and the values of the column from the exported values.
+// The integer and floating point values are stored as floating point numbers if both occur,
+// mixed values are stored as strings.
+func (c *column) resolve() {
+	var bools, ints, floats, others int
+
+	for _, val := range c.raw {
+		switch val.(type) {
+		case nil:
+		case bool:
+			bools++
+		case int64:
+			ints++
+		case float64:
+			floats++
+		default:
+			others++
+		}
+	}
+
+	switch {
+	case others == 0 && bools != 0 && ints+floats == 0:
+		c.kind = columnBoolean
+	case others == 0 && bools == 0 && floats == 0 && ints != 0:
+		c.kind = columnInt64
+	case others == 0 && bools == 0 && floats != 0:
+		c.kind = columnDouble
+	default:
+		c.kind = columnString
+	}
+
+	c.valid = make([]bool, len(c.raw))
+
+	switch c.kind {
+	case columnBoolean:
+		c.bools = make([]bool, len(c.raw))
+	case columnInt64:
+		c.ints = make([]int64, len(c.raw))
+	case columnDouble:
+		c.floats = make([]float64, len(c.raw))
+	case columnString:
+		c.strings = make([]string, len(c.raw))
+	}
+
+	for idx, val := range c.raw {
+		if val == nil {
+			continue
+		}
+
+		c.valid[idx] = true
+
+		switch c.kind {
+		case columnBoolean:
+			c.bools[idx], _ = val.(bool)
+		case columnInt64:
+			c.ints[idx], _ = val.(int64)
+		case columnDouble:
+			if num, isInt := val.(int64); isInt {
+				c.floats[idx] = float64(num)
+			} else {
+				c.floats[idx], _ = val.(float64)
+			}
+		case columnString:
+			c.strings[idx] = columnText(val)
+		}
+	}
+
+	c.raw = nil
+}
+
+// nulls returns the number of missing values between the rows from and to (exclusive).
+func (c *column) nulls(from, to int) int {
+	var count int
+
+	for _, valid := range c.valid[from:to] {
+		if !valid {
+			count++
+		}
+	}
+
+	return count
+}
+
+// columnText returns the string stored in a string column: the value itself if it is a string,
+// the RFC 3339 representation if it is a date, the JSON representation otherwise.
+func columnText(val any) string {
+	switch val := val.(type) {
+	case string:
+		return val
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	}
+
+	data, err := json.Marshal(val)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// appendBitmap appends the bitmap of the values (least significant bit first) to the buffer.
+func appendBitmap(buff []byte, values []bool) []byte {
+	for idx := 0; idx < len(values); idx += 8 {
+		var octet byte
+
+		for bit, val := range values[idx:min(idx+8, len(values))] {
+			if val {
+				octet |= 1 << bit
+			}
+		}
+
+		buff = append(buff, octet)
+	}
+
+	return buff
+}
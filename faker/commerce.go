@@ -0,0 +1,421 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errUnknownCarrier = errors.New("unknown carrier")
+	errNegativeCount  = errors.New("negative count")
+	errTooManyItems   = errors.New("too many items")
+)
+
+// maxItems is the maximum number of items (line items, records, points, words, ...) of one generated value.
+// The value is built in memory at once, so larger counts are rejected instead of exhausting the memory (see maxCount).
+const maxItems = 100_000
+
+func init() {
+	gofakeit.AddFuncLookup("order", gofakeit.Info{
+		Display:     "Order",
+		Category:    "commerce",
+		Description: "E-commerce order with customer, line items, totals and shipment tracking",
+		Example: `{
+	"id": "ORD-48213377",
+	"status": "shipped",
+	"createdAt": "2024-03-02T11:25:41Z",
+	"customer": {"name": "Linda Garcia", "email": "lindagarcia@example.com"},
+	"items": [{"sku": "QXT-27182", "name": "Ergonomic Steel Chair", "quantity": 2, "unitPrice": 59.99, "total": 119.98}],
+	"currency": "USD",
+	"subtotal": 119.98,
+	"discountCode": "SPRING15",
+	"discount": 18,
+	"shipping": 4.99,
+	"total": 106.97,
+	"carrier": "UPS",
+	"trackingNumber": "1Z999AA10123456784"
+}`,
+		Output:   "map[string]any",
+		Params:   nil,
+		Generate: unsafeOf(order),
+	})
+
+	gofakeit.AddFuncLookup("cartitems", gofakeit.Info{
+		Display:     "Cart Items",
+		Category:    "commerce",
+		Description: "Shopping cart line items with quantity, unit price and total",
+		Example:     `[{"sku": "QXT-27182", "name": "Ergonomic Steel Chair", "quantity": 2, "unitPrice": 59.99, "total": 119.98}]`,
+		Output:      "[]map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "3", Description: "Number of line items"},
+		},
+		Generate: cartitems,
+	})
+
+	gofakeit.AddFuncLookup("sku", gofakeit.Info{
+		Display:     "SKU",
+		Category:    "commerce",
+		Description: "Stock keeping unit identifier of a product",
+		Example:     "QXT-27182",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "pattern",
+				Display:     "Pattern",
+				Type:        "string",
+				Default:     "???-#####",
+				Description: "Pattern of the SKU, # is replaced by a digit, ? by an upper case letter",
+			},
+		},
+		Generate: sku,
+	})
+
+	gofakeit.AddFuncLookup("discountcode", gofakeit.Info{
+		Display:     "Discount Code",
+		Category:    "commerce",
+		Description: "Promotional code giving a discount on the order",
+		Example:     "SPRING15",
+		Output:      "string",
+		Params:      nil,
+		Generate:    discountcode,
+	})
+
+	gofakeit.AddFuncLookup("trackingnumber", gofakeit.Info{
+		Display:     "Tracking Number",
+		Category:    "commerce",
+		Description: "Shipment tracking number in the format of the carrier, with valid check digit",
+		Example:     "1Z999AA10123456784",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "carrier",
+				Display:     "Carrier",
+				Type:        "string",
+				Default:     "any",
+				Options:     []string{"any", "ups", "fedex", "usps", "dhl"},
+				Description: "Shipping carrier",
+			},
+		},
+		Generate: trackingnumber,
+	})
+}
+
+// carriers contains the display names of the supported carriers.
+var carriers = []string{"UPS", "FedEx", "USPS", "DHL"} //nolint:gochecknoglobals
+
+// orderStatuses contains the states of the order lifecycle.
+var orderStatuses = []string{"pending", "paid", "shipped", "delivered", "cancelled", "refunded"} //nolint:gochecknoglobals
+
+// promotions contains the words of the discount codes.
+var promotions = []string{ //nolint:gochecknoglobals
+	"SAVE", "SPRING", "SUMMER", "FALL", "WINTER", "WELCOME", "FREESHIP", "VIP", "FLASH", "HOLIDAY", "BLACKFRIDAY",
+}
+
+// percents contains the discount percents of the discount codes.
+var percents = []int{5, 10, 15, 20, 25, 30, 50} //nolint:gochecknoglobals
+
+func order(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info, safe bool) (any, error) {
+	const (
+		idLength    = 8
+		maxItems    = 5
+		maxOrderAge = 30 * 24 * time.Hour
+		shipping    = 4.99
+		freeLimit   = 100
+		percent     = 100
+	)
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	items := newCartItems(r, 1+r.Intn(maxItems))
+
+	var subtotal float64
+
+	for _, item := range items {
+		subtotal += item.get("total").(float64) //nolint:forcetypeassert
+	}
+
+	subtotal = roundCents(subtotal)
+
+	var (
+		code     string
+		discount float64
+	)
+
+	if r.Intn(2) == 0 {
+		var pct int
+
+		code, pct = newDiscountCode(r)
+		discount = roundCents(subtotal * float64(pct) / percent)
+	}
+
+	fee := shipping
+	if subtotal >= freeLimit {
+		fee = 0
+	}
+
+	carrier := carriers[r.Intn(len(carriers))]
+	tracking, _ := newTrackingNumber(r, carrier)
+	first, last := fake.FirstName(), fake.LastName()
+
+	return object{
+		{"id", "ORD-" + digits(r, idLength)},
+		{"status", orderStatuses[r.Intn(len(orderStatuses))]},
+		{"createdAt", time.Now().Add(-time.Duration(r.Int63n(int64(maxOrderAge)))).UTC().Format(time.RFC3339)},
+		{"customer", object{
+			{"name", first + " " + last},
+			{"email", strings.ToLower(first+last) + "@" + mailDomain(r, safe)},
+		}},
+		{"items", items},
+		{"currency", "USD"},
+		{"subtotal", subtotal},
+		{"discountCode", code},
+		{"discount", discount},
+		{"shipping", fee},
+		{"total", roundCents(subtotal - discount + fee)},
+		{"carrier", carrier},
+		{"trackingNumber", tracking},
+	}, nil
+}
+
+func cartitems(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	count, err := info.GetInt(m, "count")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCount(count); err != nil {
+		return nil, err
+	}
+
+	return newCartItems(r, count), nil
+}
+
+// checkCount returns an error if the count of items is negative or greater than maxItems.
+func checkCount(count int) error {
+	if count < 0 {
+		return fmt.Errorf("%w: %d", errNegativeCount, count)
+	}
+
+	if count > maxItems {
+		return fmt.Errorf("%w: %d (expected at most %d)", errTooManyItems, count, maxItems)
+	}
+
+	return nil
+}
+
+func newCartItems(r *rand.Rand, count int) []object {
+	const (
+		minPrice    = 1
+		maxPrice    = 500
+		maxQuantity = 5
+	)
+
+	fake := &gofakeit.Faker{Rand: r}
+	items := make([]object, count)
+
+	for idx := range items {
+		quantity := 1 + r.Intn(maxQuantity)
+		price := roundCents(fake.Price(minPrice, maxPrice))
+
+		items[idx] = object{
+			{"sku", skuOf(r, "???-#####")},
+			{"name", fake.ProductName()},
+			{"quantity", quantity},
+			{"unitPrice", price},
+			{"total", roundCents(price * float64(quantity))},
+		}
+	}
+
+	return items
+}
+
+// roundCents rounds the amount to cents.
+func roundCents(amount float64) float64 {
+	const cents = 100
+
+	return math.Round(amount*cents) / cents
+}
+
+func sku(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	pattern, err := info.GetString(m, "pattern")
+	if err != nil {
+		return nil, err
+	}
+
+	return skuOf(r, pattern), nil
+}
+
+// skuOf replaces # characters of the pattern by random digits and ? characters by random upper case letters.
+func skuOf(r *rand.Rand, pattern string) string {
+	const (
+		letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		base    = 10
+	)
+
+	buff := []byte(pattern)
+
+	for idx, chr := range buff {
+		switch chr {
+		case '#':
+			buff[idx] = byte('0' + r.Intn(base))
+		case '?':
+			buff[idx] = letters[r.Intn(len(letters))]
+		}
+	}
+
+	return string(buff)
+}
+
+func discountcode(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	code, _ := newDiscountCode(r)
+
+	return code, nil
+}
+
+// newDiscountCode returns a random discount code and its discount percent.
+func newDiscountCode(r *rand.Rand) (string, int) {
+	pct := percents[r.Intn(len(percents))]
+	code := promotions[r.Intn(len(promotions))] + strconv.Itoa(pct)
+
+	if r.Intn(2) == 0 {
+		code += "-" + skuOf(r, "?#??")
+	}
+
+	return code, pct
+}
+
+func trackingnumber(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	carrier, err := info.GetString(m, "carrier")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(carrier, "any") {
+		carrier = carriers[r.Intn(len(carriers))]
+	}
+
+	return newTrackingNumber(r, carrier)
+}
+
+// newTrackingNumber returns a random tracking number in the format of the carrier.
+func newTrackingNumber(r *rand.Rand, carrier string) (string, error) {
+	switch strings.ToLower(carrier) {
+	case "ups":
+		return upsTrackingNumber(r), nil
+	case "fedex":
+		return fedexTrackingNumber(r), nil
+	case "usps":
+		return uspsTrackingNumber(r), nil
+	case "dhl":
+		return dhlTrackingNumber(r), nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnknownCarrier, carrier)
+	}
+}
+
+// upsTrackingNumber returns a "1Z" tracking number: 6 character shipper number,
+// 2 digit service code, 7 digit package number and a check digit.
+func upsTrackingNumber(r *rand.Rand) string {
+	const alnum = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	var shipper [6]byte
+
+	for idx := range shipper {
+		shipper[idx] = alnum[r.Intn(len(alnum))]
+	}
+
+	body := string(shipper[:]) + skuOf(r, "#########")
+
+	return "1Z" + body + strconv.Itoa(upsCheckDigit(body))
+}
+
+// upsCheckDigit returns the check digit of the UPS tracking number (without the "1Z" prefix).
+// Letters count as (position in alphabet + 2) mod 10, values on even positions are doubled.
+func upsCheckDigit(body string) int {
+	const base = 10
+
+	var sum int
+
+	for idx := range len(body) {
+		chr := body[idx]
+
+		value := int(chr - '0')
+		if chr >= 'A' {
+			value = int(chr-'A'+2) % base
+		}
+
+		if idx%2 == 1 {
+			value *= 2
+		}
+
+		sum += value
+	}
+
+	return (base - sum%base) % base
+}
+
+// fedexTrackingNumber returns a 12 digit FedEx Express tracking number,
+// the check digit is the weighted sum (1, 3, 7 from the right) modulo 11.
+func fedexTrackingNumber(r *rand.Rand) string {
+	const (
+		length  = 11
+		modulus = 11
+		base    = 10
+	)
+
+	weights := [3]int{1, 3, 7}
+	body := digits(r, length)
+
+	var sum int
+
+	for idx := range length {
+		sum += int(body[length-1-idx]-'0') * weights[idx%len(weights)]
+	}
+
+	return body + strconv.Itoa(sum%modulus%base)
+}
+
+// uspsTrackingNumber returns a 22 digit USPS Intelligent Mail package barcode number,
+// the check digit is the mod 10 checksum with weights 3 and 1 from the right.
+func uspsTrackingNumber(r *rand.Rand) string {
+	const (
+		length = 16
+		base   = 10
+	)
+
+	body := "94001" + digits(r, length)
+
+	var sum int
+
+	for idx := range len(body) {
+		digit := int(body[len(body)-1-idx] - '0')
+		if idx%2 == 0 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return body + strconv.Itoa((base-sum%base)%base)
+}
+
+// dhlTrackingNumber returns a 10 digit DHL Express waybill number,
+// the check digit is the first 9 digits modulo 7.
+func dhlTrackingNumber(r *rand.Rand) string {
+	const (
+		length  = 9
+		modulus = 7
+	)
+
+	body := digits(r, length)
+	num, _ := strconv.Atoi(body)
+
+	return body + strconv.Itoa(num%modulus)
+}
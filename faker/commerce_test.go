@@ -0,0 +1,136 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_trackingnumber(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("trackingnumber")
+
+	require.NotNil(t, info)
+
+	formats := map[string]*regexp.Regexp{
+		"ups":   regexp.MustCompile(`^1Z[0-9A-Z]{6}[0-9]{10}$`),
+		"fedex": regexp.MustCompile(`^[0-9]{12}$`),
+		"usps":  regexp.MustCompile(`^94001[0-9]{17}$`),
+		"dhl":   regexp.MustCompile(`^[0-9]{10}$`),
+	}
+
+	r := testRand(t)
+
+	for carrier, format := range formats {
+		params := gofakeit.NewMapParams()
+		params.Add("carrier", carrier)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, format, val, carrier)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("carrier", "pigeon")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown carrier: pigeon")
+}
+
+func Test_order(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("order")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 10 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+
+		order, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		items, ok := order["items"].([]map[string]any)
+
+		require.True(t, ok)
+		require.NotEmpty(t, items)
+
+		var subtotal float64
+
+		for _, item := range items {
+			subtotal += item["total"].(float64) //nolint:forcetypeassert
+		}
+
+		require.InDelta(t, subtotal, order["subtotal"], 0.001)
+		require.InDelta(t,
+			order["subtotal"].(float64)-order["discount"].(float64)+order["shipping"].(float64), //nolint:forcetypeassert
+			order["total"], 0.001,
+		)
+	}
+}
+
+func Test_sku(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("sku")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("pattern", "SHOE-??-###")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^SHOE-[A-Z]{2}-[0-9]{3}$`), val)
+}
+
+func Test_cartitems(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("cartitems")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+	params := gofakeit.NewMapParams()
+	params.Add("count", "4")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Len(t, faker.PlainMaps(val), 4)
+
+	for count, reason := range map[string]string{
+		"-1":     "negative count: -1",
+		"100001": "too many items: 100001 (expected at most 100000)",
+	} {
+		params = gofakeit.NewMapParams()
+		params.Add("count", count)
+
+		_, err = info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, reason)
+	}
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	_, err = vm.RunString(`new Faker(11).commerce.cartItems(1e9)`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+	require.ErrorContains(t, err, "too many items")
+}
@@ -0,0 +1,147 @@
+package faker
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"unicode"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func init() {
+	gofakeit.AddFuncLookup("organization", gofakeit.Info{
+		Display:     "Organization",
+		Category:    "company",
+		Description: "Organization record with coherent name, identifiers, address and contact information",
+		Example: `{
+	"name": "Intermap Technologies",
+	"suffix": "Inc",
+	"ein": "27-5413589",
+	"duns": "804327651",
+	"address": {
+		"street": "369 North Cornerbury",
+		"city": "Miami",
+		"state": "North Dakota",
+		"zip": "24259",
+		"country": "United States of America"
+	},
+	"domain": "intermaptechnologies.biz",
+	"email": "info@intermaptechnologies.biz",
+	"phone": "3023202027",
+	"industry": "Information"
+}`,
+		Output:   "map[string]any",
+		Params:   nil,
+		Generate: unsafeOf(organization),
+	})
+}
+
+// einPrefixes contains the valid prefixes of the US Employer Identification Number.
+var einPrefixes = []string{ //nolint:gochecknoglobals
+	"01", "02", "03", "04", "05", "06", "10", "11", "12", "13", "14", "15", "16", "20", "21", "22", "23",
+	"24", "25", "26", "27", "30", "31", "32", "33", "34", "35", "36", "37", "38", "39", "40", "41", "42",
+	"43", "44", "45", "46", "47", "48", "50", "51", "52", "53", "54", "55", "56", "57", "58", "59", "60",
+	"61", "62", "63", "64", "65", "66", "67", "68", "71", "72", "73", "74", "75", "76", "77", "80", "81",
+	"82", "83", "84", "85", "86", "87", "88", "90", "91", "92", "93", "94", "95", "98", "99",
+}
+
+// unassignedEINPrefixes contains the prefixes of the US Employer Identification Number never assigned by the IRS.
+var unassignedEINPrefixes = []string{ //nolint:gochecknoglobals
+	"00", "07", "08", "09", "17", "18", "19", "28", "29", "49", "69", "70", "78", "79", "89", "96", "97",
+}
+
+// industries contains the sectors of the North American Industry Classification System.
+var industries = []string{ //nolint:gochecknoglobals
+	"Agriculture, Forestry, Fishing and Hunting",
+	"Mining, Quarrying, and Oil and Gas Extraction",
+	"Utilities",
+	"Construction",
+	"Manufacturing",
+	"Wholesale Trade",
+	"Retail Trade",
+	"Transportation and Warehousing",
+	"Information",
+	"Finance and Insurance",
+	"Real Estate and Rental and Leasing",
+	"Professional, Scientific, and Technical Services",
+	"Management of Companies and Enterprises",
+	"Administrative and Support Services",
+	"Educational Services",
+	"Health Care and Social Assistance",
+	"Arts, Entertainment, and Recreation",
+	"Accommodation and Food Services",
+	"Public Administration",
+}
+
+// organizationCountry is the country of the organization addresses, the identifiers (EIN, phone number)
+// and the states of the addresses are all from the United States.
+const organizationCountry = "United States of America"
+
+// mailboxes contains the local parts of the organization email addresses.
+var mailboxes = []string{"info", "contact", "sales", "office", "hello", "support"} //nolint:gochecknoglobals
+
+func organization(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info, safe bool) (any, error) {
+	const dunsLength = 9
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	name := fake.Company()
+	domain := domainOf(name) + "." + domainSuffix(r, safe)
+	addr := fake.Address()
+
+	return object{
+		{"name", name},
+		{"suffix", fake.CompanySuffix()},
+		{"ein", newEIN(r, safe)},
+		{"duns", digits(r, dunsLength)},
+		{"address", object{
+			{"street", addr.Street},
+			{"city", addr.City},
+			{"state", addr.State},
+			{"zip", addr.Zip},
+			{"country", organizationCountry},
+		}},
+		{"domain", domain},
+		{"email", mailboxes[r.Intn(len(mailboxes))] + "@" + domain},
+		{"phone", phoneNumber(r, safe)},
+		{"industry", industries[r.Intn(len(industries))]},
+	}, nil
+}
+
+// newEIN returns a random US Employer Identification Number with valid prefix,
+// one with never assigned prefix in safe mode.
+func newEIN(r *rand.Rand, safe bool) string {
+	const serialLength = 7
+
+	prefixes := einPrefixes
+	if safe {
+		prefixes = unassignedEINPrefixes
+	}
+
+	return fmt.Sprintf("%s-%s", prefixes[r.Intn(len(prefixes))], digits(r, serialLength))
+}
+
+// digits returns a random string of n decimal digits.
+func digits(r *rand.Rand, n int) string {
+	const base = 10
+
+	buff := make([]byte, n)
+
+	for idx := range buff {
+		buff[idx] = byte('0' + r.Intn(base))
+	}
+
+	return string(buff)
+}
+
+// domainOf returns the domain name label derived from the name.
+func domainOf(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r)) {
+			return -1
+		}
+
+		return unicode.ToLower(r)
+	}, name)
+}
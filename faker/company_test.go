@@ -0,0 +1,47 @@
+package faker_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/brianvoe/gofakeit/v6/data"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_organization(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("organization")
+
+	require.NotNil(t, info)
+
+	val, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+
+	org, ok := faker.PlainMaps(val).(map[string]any)
+
+	require.True(t, ok)
+
+	name, ok := org["name"].(string)
+
+	require.True(t, ok)
+
+	domain, ok := org["domain"].(string)
+
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(domain, strings.ToLower(strings.ReplaceAll(name, " ", ""))))
+	require.True(t, strings.HasSuffix(org["email"].(string), "@"+domain)) //nolint:forcetypeassert
+	require.Regexp(t, regexp.MustCompile(`^[0-9]{2}-[0-9]{7}$`), org["ein"])
+	require.Regexp(t, regexp.MustCompile(`^[0-9]{9}$`), org["duns"])
+	require.IsType(t, map[string]any{}, org["address"])
+
+	addr := org["address"].(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "United States of America", addr["country"])
+	require.Contains(t, data.Address["state"], addr["state"])
+	require.NotEmpty(t, org["industry"])
+}
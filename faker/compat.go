@@ -0,0 +1,706 @@
+package faker
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/js/modules"
+	"lukechampine.com/frand"
+)
+
+// NewCompatForVU returns an object exposing the faker-js (v8) API bound to the given k6 VU (which can be nil).
+// The faker-js methods are mapped onto the generator functions of a Faker instance created with the seed.
+func NewCompatForVU(seed int64, runtime *sobek.Runtime, vu modules.VU) *sobek.Object {
+	return runtime.NewDynamicObject(&compat{faker: newFakerForVU(seed, runtime, vu)})
+}
+
+// compatMethod maps a faker-js method onto a generator function.
+type compatMethod struct {
+	// function is the name of the generator function.
+	function string
+	// positional is the parameter receiving a non-object argument (e.g. lorem.sentence(5)).
+	positional string
+	// options maps the faker-js option names to the parameters of the generator function,
+	// other faker-js options are ignored.
+	options map[string]string
+	// defaults contains the parameters whose faker-js defaults differ from the generator function defaults.
+	defaults map[string]any
+	// custom implements the method if it cannot be mapped onto a generator function.
+	custom func(f *faker, opts *sobek.Object) sobek.Value
+}
+
+// alias returns the method mapped onto the generator function without parameters.
+func alias(function string) *compatMethod {
+	return &compatMethod{function: function}
+}
+
+// custom returns the method implemented by the function, a non-object argument is passed as the positional option.
+func custom(positional string, fn func(f *faker, opts *sobek.Object) sobek.Value) *compatMethod {
+	return &compatMethod{positional: positional, custom: fn}
+}
+
+// compatModules contains the supported faker-js modules and methods.
+//
+//nolint:gochecknoglobals
+var compatModules = map[string]map[string]*compatMethod{
+	"animal": {
+		"bird": alias("bird"),
+		"cat":  alias("cat"),
+		"dog":  alias("dog"),
+		"type": alias("animalType"),
+	},
+	"color": {
+		"human": alias("color"),
+		"rgb":   alias("hexColor"),
+	},
+	"commerce": {
+		"department":         alias("productCategory"),
+		"price":              custom("", compatPrice),
+		"productAdjective":   alias("adjective"),
+		"productDescription": alias("productDescription"),
+		"productMaterial":    alias("productMaterial"),
+		"productName":        alias("productName"),
+	},
+	"company": {
+		"buzzNoun":    alias("buzzword"),
+		"buzzPhrase":  alias("bs"),
+		"catchPhrase": alias("slogan"),
+		"name":        alias("company"),
+	},
+	"datatype": {
+		"boolean": custom("probability", compatBoolean),
+	},
+	"date": {
+		"between": custom("", compatDateBetween),
+		"future":  custom("", compatDate("years", 1, compatYear, 1)),
+		"month":   alias("monthString"),
+		"past":    custom("", compatDate("years", 1, compatYear, -1)),
+		"recent":  custom("", compatDate("days", 1, day, -1)),
+		"soon":    custom("", compatDate("days", 1, day, 1)),
+		"weekday": alias("weekday"),
+	},
+	"finance": {
+		"accountNumber":    alias("achAccountNumber"),
+		"amount":           custom("", compatAmount),
+		"bitcoinAddress":   alias("bitcoinAddress"),
+		"creditCardCVV":    alias("creditCardCVV"),
+		"creditCardNumber": alias("creditCardNumber"),
+		"currencyCode":     alias("currencyShort"),
+		"currencyName":     alias("currencyLong"),
+		"routingNumber":    alias("achRoutingNumber"),
+	},
+	"hacker": {
+		"abbreviation": alias("hackerAbbreviation"),
+		"adjective":    alias("hackerAdjective"),
+		"ingverb":      alias("hackeringVerb"),
+		"noun":         alias("hackerNoun"),
+		"phrase":       alias("hackerPhrase"),
+		"verb":         alias("hackerVerb"),
+	},
+	"image": {
+		"url": {function: "imageUrl", options: map[string]string{"width": "width", "height": "height"}},
+	},
+	"internet": {
+		"displayName":    alias("username"),
+		"domainName":     alias("domainName"),
+		"domainSuffix":   alias("domainSuffix"),
+		"email":          custom("", compatEmail(freeEmailProviders)),
+		"emoji":          alias("emoji"),
+		"exampleEmail":   custom("", compatEmail(exampleEmailProviders)),
+		"httpMethod":     alias("httpMethod"),
+		"httpStatusCode": alias("httpStatusCode"),
+		"ip":             alias("ipv4Address"),
+		"ipv4":           alias("ipv4Address"),
+		"ipv6":           alias("ipv6Address"),
+		"mac":            alias("macAddress"),
+		"password": {
+			function:   "password",
+			positional: "length",
+			options:    map[string]string{"length": "length"},
+			defaults:   map[string]any{"length": 15},
+		},
+		"port":      {function: "number", defaults: map[string]any{"min": 0, "max": math.MaxUint16}},
+		"url":       alias("url"),
+		"userAgent": alias("userAgent"),
+		"userName":  alias("username"),
+		"username":  alias("username"),
+	},
+	"location": {
+		"buildingNumber": alias("streetNumber"),
+		"city":           alias("city"),
+		"country":        alias("country"),
+		"countryCode":    alias("countryAbbreviation"),
+		"latitude": {
+			function: "latitudeRange",
+			options:  map[string]string{"min": "min", "max": "max"},
+			defaults: map[string]any{"min": -90, "max": 90},
+		},
+		"longitude": {
+			function: "longitudeRange",
+			options:  map[string]string{"min": "min", "max": "max"},
+			defaults: map[string]any{"min": -180, "max": 180},
+		},
+		"state":         alias("state"),
+		"street":        alias("streetName"),
+		"streetAddress": alias("street"),
+		"timeZone":      alias("timezoneRegion"),
+		"zipCode":       alias("zip"),
+	},
+	"lorem": {
+		"paragraph": {
+			function:   "loremIpsumParagraph",
+			positional: "sentencecount",
+			defaults:   map[string]any{"paragraphcount": 1, "sentencecount": 3},
+		},
+		"paragraphs": {
+			function:   "loremIpsumParagraph",
+			positional: "paragraphcount",
+			defaults:   map[string]any{"paragraphcount": 3, "sentencecount": 3, "paragraphseparator": "\n"},
+		},
+		"sentence": custom("wordCount", compatSentence),
+		"text":     {function: "loremIpsumParagraph", defaults: map[string]any{"paragraphcount": 1}},
+		"word":     alias("loremIpsumWord"),
+		"words":    custom("count", compatWords("loremIpsumWord")),
+	},
+	"number": {
+		"float": custom("max", compatNumberFloat),
+		"int": {
+			function:   "number",
+			positional: "max",
+			options:    map[string]string{"min": "min", "max": "max"},
+			defaults:   map[string]any{"min": 0, "max": maxSafeInteger},
+		},
+	},
+	"person": {
+		"firstName":     custom("sex", compatFirstName),
+		"fullName":      alias("name"),
+		"gender":        alias("gender"),
+		"jobDescriptor": alias("jobDescriptor"),
+		"jobTitle":      alias("jobTitle"),
+		"jobType":       alias("jobLevel"),
+		"lastName":      custom("sex", compatLastName),
+		"middleName":    alias("middleName"),
+		"prefix":        alias("namePrefix"),
+		"sex":           alias("gender"),
+		"suffix":        alias("nameSuffix"),
+	},
+	"phone": {
+		"number": alias("phoneFormatted"),
+	},
+	"string": {
+		"alpha": custom("length", compatAlpha),
+		"alphanumeric": {
+			function:   "identifier",
+			positional: "length",
+			options:    map[string]string{"length": "length"},
+			defaults:   map[string]any{"length": 1},
+		},
+		"hexadecimal": custom("length", compatHexadecimal),
+		"nanoid":      {function: "nanoid", positional: "length", options: map[string]string{"length": "length"}},
+		"numeric": {
+			function:   "digitN",
+			positional: "count",
+			options:    map[string]string{"length": "count"},
+			defaults:   map[string]any{"count": 1},
+		},
+		"uuid": alias("uuid"),
+	},
+	"system": {
+		"fileExt":  alias("fileExtension"),
+		"mimeType": alias("fileMimeType"),
+	},
+	"vehicle": {
+		"fuel":         alias("carFuelType"),
+		"manufacturer": alias("carMaker"),
+		"model":        alias("carModel"),
+		"type":         alias("carType"),
+		"vin":          alias("vin"),
+		"vrm":          alias("licensePlate"),
+	},
+	"word": {
+		"adjective":    alias("adjective"),
+		"adverb":       alias("adverb"),
+		"conjunction":  alias("connective"),
+		"interjection": alias("interjection"),
+		"noun":         alias("noun"),
+		"preposition":  alias("preposition"),
+		"sample":       alias("word"),
+		"verb":         alias("verb"),
+		"words":        custom("count", compatWords("word")),
+	},
+}
+
+const (
+	// maxSafeInteger is the largest integer which can be represented exactly in JavaScript.
+	maxSafeInteger = 1<<53 - 1
+	// compatYear is the length of a year in the date methods.
+	compatYear = 365 * day
+)
+
+//nolint:gochecknoglobals
+var (
+	freeEmailProviders    = []string{"gmail.com", "yahoo.com", "hotmail.com"}
+	exampleEmailProviders = []string{"example.com", "example.net", "example.org"}
+)
+
+// compat is the faker-js compatible object.
+type compat struct {
+	faker *faker
+}
+
+// Delete implements sobek.DynamicObject.
+func (c *compat) Delete(_ string) bool {
+	return false
+}
+
+// Get implements sobek.DynamicObject.
+func (c *compat) Get(key string) sobek.Value {
+	f := c.faker
+
+	if val, found := f.members["compat."+key]; found {
+		return val
+	}
+
+	var val sobek.Value
+
+	switch key {
+	case "helpers":
+		val = f.helpers()
+	case "seed":
+		val = f.runtime.ToValue(c.seed)
+	default:
+		methods, found := compatModules[key]
+		if !found {
+			return sobek.Undefined()
+		}
+
+		val = f.runtime.NewDynamicObject(&compatModule{faker: f, name: key, methods: methods})
+	}
+
+	f.members["compat."+key] = val
+
+	return val
+}
+
+// seed sets the seed of the random source (like faker.seed() of faker-js) and returns it.
+// If the seed is missing, a new seed derived from system entropy is used.
+func (c *compat) seed(seed sobek.Value) int64 {
+	val := int64(frand.Uint64n(math.MaxInt64)) + 1 //nolint:gosec
+
+	if seed != nil && !sobek.IsUndefined(seed) {
+		val = seed.ToInteger()
+	}
+
+	c.faker.seed = val
+	c.faker.rand.Seed(val)
+
+	return val
+}
+
+// Has implements sobek.DynamicObject.
+func (c *compat) Has(key string) bool {
+	return !sobek.IsUndefined(c.Get(key))
+}
+
+// Keys implements sobek.DynamicObject.
+// The modules (including helpers) are enumerated in alphabetical order, the seed method is omitted.
+func (c *compat) Keys() []string {
+	keys := append(slices.Collect(maps.Keys(compatModules)), "helpers")
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Set implements sobek.DynamicObject.
+func (c *compat) Set(_ string, _ sobek.Value) bool {
+	return false
+}
+
+// compatModule is a faker-js module (e.g. faker.person).
+type compatModule struct {
+	faker   *faker
+	name    string
+	methods map[string]*compatMethod
+}
+
+// Delete implements sobek.DynamicObject.
+func (m *compatModule) Delete(_ string) bool {
+	return false
+}
+
+// Get implements sobek.DynamicObject.
+func (m *compatModule) Get(key string) sobek.Value {
+	method, found := m.methods[key]
+	if !found {
+		return sobek.Undefined()
+	}
+
+	cacheKey := "compat." + m.name + "." + key
+
+	if val, found := m.faker.values[cacheKey]; found {
+		return val
+	}
+
+	val := m.faker.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
+		return m.faker.invokeCompat(m.name+"."+key, method, call)
+	})
+
+	m.faker.values[cacheKey] = val
+
+	return val
+}
+
+// Has implements sobek.DynamicObject.
+func (m *compatModule) Has(key string) bool {
+	_, found := m.methods[key]
+
+	return found
+}
+
+// Keys implements sobek.DynamicObject.
+// The methods are enumerated in alphabetical order.
+func (m *compatModule) Keys() []string {
+	return slices.Sorted(maps.Keys(m.methods))
+}
+
+// Set implements sobek.DynamicObject.
+func (m *compatModule) Set(_ string, _ sobek.Value) bool {
+	return false
+}
+
+// invokeCompat calls the faker-js method: the faker-js options (or the positional argument)
+// are converted to the options object of the generator function.
+func (f *faker) invokeCompat(name string, method *compatMethod, call sobek.FunctionCall) sobek.Value {
+	opts := f.runtime.NewObject()
+
+	for param, val := range method.defaults {
+		_ = opts.Set(param, val)
+	}
+
+	arg := call.Argument(0)
+
+	switch {
+	case isOptions(arg):
+		obj := arg.ToObject(f.runtime)
+
+		for _, key := range obj.Keys() {
+			if method.custom != nil {
+				_ = opts.Set(key, obj.Get(key))
+			} else if param, found := method.options[key]; found {
+				_ = opts.Set(param, obj.Get(key))
+			}
+		}
+	case !sobek.IsUndefined(arg) && len(method.positional) != 0:
+		_ = opts.Set(method.positional, arg)
+	}
+
+	if method.custom != nil {
+		f.rescope()
+
+		return method.custom(f, opts)
+	}
+
+	return f.compatInvoke(name, method.function, opts)
+}
+
+// compatInvoke calls the generator function with the parameters of the options object.
+func (f *faker) compatInvoke(method string, function string, opts *sobek.Object) sobek.Value {
+	fname, info := f.lookup(method, f.runtime.ToValue(function))
+
+	var args []sobek.Value
+
+	if len(opts.Keys()) != 0 {
+		args = []sobek.Value{opts}
+	}
+
+	return f.invoke(fname, info, sobek.FunctionCall{This: sobek.Undefined(), Arguments: args})
+}
+
+// compatOption returns the option, nil if it is missing.
+func compatOption(opts *sobek.Object, name string) sobek.Value {
+	val := opts.Get(name)
+	if val == nil || sobek.IsUndefined(val) || sobek.IsNull(val) {
+		return nil
+	}
+
+	return val
+}
+
+// compatFloat returns the numeric option or the default value.
+func compatFloat(opts *sobek.Object, name string, def float64) float64 {
+	if val := compatOption(opts, name); val != nil {
+		return val.ToFloat()
+	}
+
+	return def
+}
+
+// compatCount returns the non-negative integer option or the default value.
+func (f *faker) compatCount(method string, opts *sobek.Object, name string, def int) int {
+	val := compatOption(opts, name)
+	if val == nil {
+		return def
+	}
+
+	count := val.ToFloat()
+	if !(count >= 0 && count <= math.MaxInt32) {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: name, Expected: "non-negative number", Reason: "invalid value " + val.String(),
+		})
+	}
+
+	return int(count)
+}
+
+// compatString returns the string option or the default value.
+func compatString(opts *sobek.Object, name string, def string) string {
+	if val := compatOption(opts, name); val != nil {
+		return val.String()
+	}
+
+	return def
+}
+
+// compatTime returns the time option (Date, timestamp in milliseconds or date string) or now.
+func (f *faker) compatTime(method string, opts *sobek.Object, name string) time.Time {
+	val := compatOption(opts, name)
+	if val == nil {
+		return time.Now()
+	}
+
+	switch exported := val.Export().(type) {
+	case time.Time:
+		return exported
+	case int64:
+		return time.UnixMilli(exported)
+	case float64:
+		return time.UnixMilli(int64(exported))
+	case string:
+		if t, err := parseInstant(exported, time.Now()); err == nil {
+			return t
+		}
+	}
+
+	f.throw(&ArgumentError{Function: method, Parameter: name, Expected: "Date", Reason: "invalid value " + val.String()})
+
+	return time.Time{}
+}
+
+// jsDate returns the time as JavaScript Date object.
+func (f *faker) jsDate(t time.Time) sobek.Value {
+	date, err := f.runtime.New(f.runtime.Get("Date"), f.runtime.ToValue(t.UnixMilli()))
+	if err != nil {
+		panic(err)
+	}
+
+	return date
+}
+
+// compatDate returns the implementation of date.past, date.future, date.recent and date.soon:
+// a random Date within the period (the option multiplied by the unit) before (-1) or after (1) refDate.
+func compatDate(
+	option string,
+	def float64,
+	unit time.Duration,
+	direction int64,
+) func(*faker, *sobek.Object) sobek.Value {
+	return func(f *faker, opts *sobek.Object) sobek.Value {
+		ref := f.compatTime("date", opts, "refDate")
+
+		period := int64(compatFloat(opts, option, def) * float64(unit))
+		if period <= 0 {
+			f.throw(&ArgumentError{Function: "date", Parameter: option, Expected: "positive number", Reason: "invalid value"})
+		}
+
+		return f.jsDate(ref.Add(time.Duration(direction * (1 + f.rand.Int63n(period)))))
+	}
+}
+
+func compatDateBetween(f *faker, opts *sobek.Object) sobek.Value {
+	from := f.compatTime("between", opts, "from")
+	to := f.compatTime("between", opts, "to")
+
+	if to.Before(from) {
+		f.throw(&ArgumentError{Function: "between", Parameter: "to", Expected: "Date", Reason: "to is before from"})
+	}
+
+	return f.jsDate(from.Add(time.Duration(f.rand.Int63n(int64(to.Sub(from)) + 1))))
+}
+
+func compatBoolean(f *faker, opts *sobek.Object) sobek.Value {
+	const defaultProbability = 0.5
+
+	return f.runtime.ToValue(f.rand.Float64() < compatFloat(opts, "probability", defaultProbability))
+}
+
+// compatAmountOf returns a random amount between min and max formatted with dec decimals and the symbol.
+func compatAmountOf(f *faker, opts *sobek.Object, low float64, high float64) sobek.Value {
+	const defaultDecimals = 2
+
+	low, high = compatFloat(opts, "min", low), compatFloat(opts, "max", high)
+	dec := int(compatFloat(opts, "dec", defaultDecimals))
+
+	if low > high || dec < 0 {
+		f.throw(&ArgumentError{Function: "amount", Reason: "invalid range or decimals"})
+	}
+
+	amount := low + f.rand.Float64()*(high-low)
+
+	return f.runtime.ToValue(compatString(opts, "symbol", "") + strconv.FormatFloat(amount, 'f', dec, 64))
+}
+
+func compatAmount(f *faker, opts *sobek.Object) sobek.Value {
+	const defaultMax = 1000
+
+	return compatAmountOf(f, opts, 0, defaultMax)
+}
+
+func compatPrice(f *faker, opts *sobek.Object) sobek.Value {
+	const defaultMax = 1000
+
+	return compatAmountOf(f, opts, 1, defaultMax)
+}
+
+// compatEmail returns the implementation of internet.email and internet.exampleEmail,
+// the domain is the provider option or one of the providers.
+func compatEmail(providers []string) func(*faker, *sobek.Object) sobek.Value {
+	return func(f *faker, opts *sobek.Object) sobek.Value {
+		fake := &gofakeit.Faker{Rand: f.rand}
+
+		first := emailPart(compatString(opts, "firstName", fake.FirstName()))
+		last := emailPart(compatString(opts, "lastName", fake.LastName()))
+		provider := compatString(opts, "provider", providers[f.rand.Intn(len(providers))])
+
+		return f.runtime.ToValue(localPartOf(f.rand, first, last) + "@" + provider)
+	}
+}
+
+// emailPart returns the lowercase name without characters not allowed in email addresses (e.g. O'Connor).
+func emailPart(name string) string {
+	return strings.Map(func(chr rune) rune {
+		if strings.ContainsRune(alphanum, chr) {
+			return chr
+		}
+
+		return -1
+	}, strings.ToLower(name))
+}
+
+// compatFirstName passes the faker-js sex option (female, male or generic) as the gender parameter of firstName.
+func compatFirstName(f *faker, opts *sobek.Object) sobek.Value {
+	params := f.runtime.NewObject()
+
+	if sex := compatString(opts, "sex", "generic"); sex != "generic" {
+		_ = params.Set("gender", sex)
+	}
+
+	return f.compatInvoke("firstName", "firstName", params)
+}
+
+// compatLastName accepts the faker-js sex option, but the last names don't depend on the gender.
+func compatLastName(f *faker, _ *sobek.Object) sobek.Value {
+	return f.compatInvoke("lastName", "lastName", f.runtime.NewObject())
+}
+
+// compatNumberFloat returns a random number between min and max. The number is a multiple of
+// the multipleOf (or the legacy precision) option or has at most fractionDigits decimals, if they are set.
+func compatNumberFloat(f *faker, opts *sobek.Object) sobek.Value {
+	low, high := compatFloat(opts, "min", 0), compatFloat(opts, "max", 1)
+	step := compatFloat(opts, "multipleOf", compatFloat(opts, "precision", 0))
+	digits := f.compatCount("float", opts, "fractionDigits", -1)
+
+	if digits >= 0 {
+		step = math.Pow10(-digits)
+	}
+
+	if !(low <= high) || !(step >= 0) {
+		f.throw(&ArgumentError{Function: "float", Reason: "invalid range or precision"})
+	}
+
+	if step == 0 {
+		return f.runtime.ToValue(low + f.rand.Float64()*(high-low))
+	}
+
+	first, last := math.Ceil(low/step), math.Floor(high/step)
+	if first > last {
+		f.throw(&ArgumentError{Function: "float", Reason: "no multiple of the precision between min and max"})
+	}
+
+	val := (first + math.Floor(f.rand.Float64()*(last-first+1))) * step
+
+	if digits >= 0 {
+		// removes the floating point error of the multiplication (e.g. 0.30000000000000004)
+		val, _ = strconv.ParseFloat(strconv.FormatFloat(val, 'f', digits, 64), 64)
+	}
+
+	return f.runtime.ToValue(min(val, high))
+}
+
+// compatSentence returns a sentence of wordCount words, or of a random number of words
+// between the min and max options.
+func compatSentence(f *faker, opts *sobek.Object) sobek.Value {
+	params := f.runtime.NewObject()
+
+	if val := compatOption(opts, "wordCount"); val != nil {
+		_ = params.Set("wordcount", val)
+	} else if compatOption(opts, "min") != nil || compatOption(opts, "max") != nil {
+		low := f.compatCount("sentence", opts, "min", 1)
+		high := f.compatCount("sentence", opts, "max", low)
+
+		if low > high {
+			f.throw(&ArgumentError{Function: "sentence", Parameter: "max", Reason: "max is less than min"})
+		}
+
+		_ = params.Set("wordcount", low+f.rand.Intn(high-low+1))
+	}
+
+	return f.compatInvoke("sentence", "loremIpsumSentence", params)
+}
+
+// compatWords returns the implementation of words methods joining count words of the generator function.
+func compatWords(function string) func(*faker, *sobek.Object) sobek.Value {
+	return func(f *faker, opts *sobek.Object) sobek.Value {
+		const defaultCount = 3
+
+		count := f.compatCount("words", opts, "count", defaultCount)
+		info, _ := lookupFunc(function)
+		words := make([]string, 0, count)
+
+		for range count {
+			val, _ := info.Generate(f.rand, nil, info)
+
+			words = append(words, val.(string)) //nolint:forcetypeassert
+		}
+
+		return f.runtime.ToValue(strings.Join(words, " "))
+	}
+}
+
+// compatCasing returns the random string of the alphabet with the length and casing (lower, upper or mixed) options.
+func (f *faker) compatCasing(method string, opts *sobek.Object, alphabet string) string {
+	str := stringOf(f.rand, alphabet, f.compatCount(method, opts, "length", 1))
+
+	switch compatString(opts, "casing", "mixed") {
+	case "lower":
+		return strings.ToLower(str)
+	case "upper":
+		return strings.ToUpper(str)
+	default:
+		return str
+	}
+}
+
+func compatAlpha(f *faker, opts *sobek.Object) sobek.Value {
+	return f.runtime.ToValue(f.compatCasing("alpha", opts, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"))
+}
+
+func compatHexadecimal(f *faker, opts *sobek.Object) sobek.Value {
+	return f.runtime.ToValue(compatString(opts, "prefix", "0x") + f.compatCasing("hexadecimal", opts, "0123456789abcdef"))
+}
@@ -0,0 +1,96 @@
+package faker_test
+
+import (
+	"testing"
+
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func newCompatRuntime(t *testing.T) *sobek.Runtime {
+	t.Helper()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("faker", faker.NewCompatForVU(11, vm, nil)))
+
+	return vm
+}
+
+func Test_Compat(t *testing.T) {
+	t.Parallel()
+
+	vm := newCompatRuntime(t)
+
+	val, err := vm.RunString(`
+	const checks = {
+	  firstName: typeof faker.person.firstName() === "string",
+	  email: /^[a-z0-9._]+@acme\.io$/.test(faker.internet.email({ provider: "acme.io" })),
+	  namedEmail: faker.internet.email({ firstName: "Jane", lastName: "O'Doe", provider: "x.org" }).startsWith("jane"),
+	  int: faker.number.int({ min: 5, max: 5 }) === 5,
+	  intMax: faker.number.int(3) <= 3,
+	  float: faker.number.float() < 1,
+	  precision: Array.from({ length: 20 }, () => faker.number.float({ max: 10, precision: 0.5 }) * 2).every(Number.isInteger),
+	  fractionDigits: Array.from({ length: 20 }, () => faker.number.float({ max: 100, fractionDigits: 2 })).every((num) => /^[0-9]+(\.[0-9]{1,2})?$/.test(String(num))),
+	  multipleOf: faker.number.float({ min: 0.1, max: 0.3, multipleOf: 0.2 }) === 0.2,
+	  femaleName: typeof faker.person.firstName("female") === "string" && typeof faker.person.firstName({ sex: "male" }) === "string",
+	  lastName: typeof faker.person.lastName("female") === "string",
+	  sentence: Array.from({ length: 20 }, () => faker.lorem.sentence({ min: 3, max: 4 }).split(" ").length).every((count) => count >= 3 && count <= 4),
+	  sentenceCount: faker.lorem.sentence(6).split(" ").length === 6,
+	  alpha: /^[A-Z]{8}$/.test(faker.string.alpha({ length: 8, casing: "upper" })),
+	  numeric: /^[0-9]{6}$/.test(faker.string.numeric(6)),
+	  alphanumeric: /^[A-Za-z0-9]{10}$/.test(faker.string.alphanumeric(10)),
+	  hexadecimal: /^0x[0-9a-f]{4}$/.test(faker.string.hexadecimal({ length: 4, casing: "lower" })),
+	  uuid: faker.string.uuid().length === 36,
+	  words: faker.lorem.words(4).split(" ").length === 4,
+	  amount: /^\$[0-9]+\.[0-9]{3}$/.test(faker.finance.amount({ min: 1, max: 9, dec: 3, symbol: "$" })),
+	  past: faker.date.past() instanceof Date && faker.date.past() < new Date(),
+	  future: faker.date.future({ years: 2 }) > new Date(),
+	  between: faker.date.between({ from: "2024-01-01", to: "2024-01-02" }).getUTCFullYear() === 2024,
+	  boolean: faker.datatype.boolean(1) === true,
+	  element: faker.helpers.arrayElement(["a"]) === "a",
+	  maybe: faker.helpers.maybe(() => 1, { probability: 0 }) === undefined,
+	  multiple: faker.helpers.multiple((_, idx) => idx).join() === "0,1,2" && faker.helpers.multiple(() => 1, { count: 5 }).length === 5,
+	  multipleRange: [2, 3].includes(faker.helpers.multiple(() => 1, { count: { min: 2, max: 3 } }).length),
+	  keys: Object.keys(faker).includes("helpers") && Object.keys(faker.person).includes("firstName"),
+	  has: "person" in faker && "seed" in faker && "firstName" in faker.person && !("music" in faker),
+	  unknown: faker.person.zodiacSign === undefined && faker.music === undefined,
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+
+	var failed []string
+
+	require.NoError(t, vm.ExportTo(val, &failed))
+	require.Empty(t, failed)
+}
+
+func Test_Compat_seed(t *testing.T) {
+	t.Parallel()
+
+	vm := newCompatRuntime(t)
+
+	val, err := vm.RunString(`
+	faker.seed(42)
+	const first = [faker.person.fullName(), faker.internet.email(), faker.date.past().getTime()]
+	faker.seed(42)
+	const second = [faker.person.fullName(), faker.internet.email(), faker.date.past().getTime()]
+
+	first[0] === second[0] && first[1] === second[1]
+	`)
+
+	require.NoError(t, err)
+	require.True(t, val.ToBoolean())
+
+	_, err = vm.RunString(`faker.string.alpha(-1)`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+
+	_, err = vm.RunString(`faker.person.firstName("unknown")`)
+
+	require.ErrorContains(t, err, "unknown gender")
+}
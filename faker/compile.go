@@ -0,0 +1,72 @@
+package faker
+
+import (
+	"github.com/grafana/sobek"
+)
+
+// compile compiles the schema (like fromSchema) once and returns an object generating objects of it,
+// so the scripts generating many objects don't look up the generator functions and convert
+// their arguments on every call. The one() method generates an object (like fromSchema),
+// the many(count) method generates an array of objects.
+//
+// The arguments of the generator functions are validated at compile time.
+func (f *faker) compile(schema sobek.Value) *sobek.Object {
+	const method = "compile"
+
+	compiled := f.compileSchema(method, schema)
+
+	f.prepare(compiled)
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("one", func() *sobek.Object {
+		return f.generate(compiled)
+	})
+
+	_ = obj.Set("many", func(count sobek.Value) sobek.Value {
+		size := f.countArgument(method, "", "count", count, 0)
+
+		budget := f.budget(func(reason string) {
+			f.throw(&ArgumentError{Function: method, Parameter: "count", Reason: reason})
+		})
+
+		budget.batch(size)
+
+		values := make([]any, size)
+
+		for idx := range values {
+			values[idx] = f.generate(compiled)
+
+			budget.add(values[idx])
+		}
+
+		return f.runtime.NewArray(values...)
+	})
+
+	return obj
+}
+
+// prepare converts the arguments of the generator functions of the schema fields in advance.
+func (f *faker) prepare(s *schema) {
+	for _, field := range s.fields {
+		f.prepareField(field)
+	}
+}
+
+func (f *faker) prepareField(field *schemaField) {
+	for _, variant := range field.variants {
+		f.prepareField(variant)
+	}
+
+	if field.nested != nil {
+		f.prepare(field.nested)
+	}
+
+	if field.info == nil {
+		return
+	}
+
+	call := sobek.FunctionCall{This: sobek.Undefined(), Arguments: field.args}
+
+	field.params, field.prepared = f.toMapParams(field.function, field.info, call), true
+}
@@ -0,0 +1,260 @@
+package faker
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"math/big"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/grafana/sobek"
+	"github.com/iancoleman/strcase"
+)
+
+//nolint:gochecknoglobals
+var (
+	timeType   = reflect.TypeFor[time.Time]()
+	bigIntType = reflect.TypeFor[big.Int]()
+)
+
+// property is a named value of an object.
+type property struct {
+	name  string
+	value any
+}
+
+// object is a plain object with ordered properties. Unlike the keys of maps, which are iterated in random order,
+// the properties are converted to JavaScript and JSON in the order they are listed,
+// so the generated objects are reproducible including the order of their keys.
+type object []property
+
+// get returns the value of the property, nil if the object has no such property.
+func (o object) get(name string) any {
+	for _, prop := range o {
+		if prop.name == name {
+			return prop.value
+		}
+	}
+
+	return nil
+}
+
+// set replaces the value of the property, if the object has the property.
+func (o object) set(name string, value any) {
+	for idx := range o {
+		if o[idx].name == name {
+			o[idx].value = value
+
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, the properties are encoded in order.
+// The names and values are encoded into the same buffer, so no intermediate slices are allocated per property.
+func (o object) MarshalJSON() ([]byte, error) {
+	var buff bytes.Buffer
+
+	enc := json.NewEncoder(&buff)
+
+	buff.WriteByte('{')
+
+	for idx, prop := range o {
+		if idx > 0 {
+			buff.WriteByte(',')
+		}
+
+		if err := enc.Encode(prop.name); err != nil {
+			return nil, err
+		}
+
+		// the encoder terminates the values with a newline
+		buff.Truncate(buff.Len() - 1)
+		buff.WriteByte(':')
+
+		if err := enc.Encode(prop.value); err != nil {
+			return nil, err
+		}
+
+		buff.Truncate(buff.Len() - 1)
+	}
+
+	buff.WriteByte('}')
+
+	return buff.Bytes(), nil
+}
+
+// toValue converts the plain value to a JavaScript value. Objects become plain JavaScript objects
+// with the properties in order, maps with the keys in sorted order (e.g. the values of replayed records)
+// and []any slices arrays of the converted items, the other values are converted by the runtime.
+func (f *faker) toValue(val any) sobek.Value {
+	switch val := val.(type) {
+	case object:
+		obj := f.runtime.NewObject()
+
+		for _, prop := range val {
+			_ = obj.Set(prop.name, f.toValue(prop.value))
+		}
+
+		return obj
+	case map[string]any:
+		obj := f.runtime.NewObject()
+
+		for _, key := range slices.Sorted(maps.Keys(val)) {
+			_ = obj.Set(key, f.toValue(val[key]))
+		}
+
+		return obj
+	case []any:
+		items := make([]any, len(val))
+
+		for idx, item := range val {
+			items[idx] = f.toValue(item)
+		}
+
+		return f.runtime.NewArray(items...)
+	default:
+		return f.runtime.ToValue(val)
+	}
+}
+
+// plainValue converts the struct values (e.g. *gofakeit.PersonInfo) returned by generator functions
+// to objects with camelCase keys in field order, so they become plain JavaScript objects instead of wrapped Go values.
+// Nested structs, pointers and slices of structs are converted recursively, as well as the values of objects
+// and maps. The maps with string keys (e.g. labels) are converted to map[string]any, so their keys are sorted
+// by toValue. Time values and big integers (BigInt in JavaScript) are kept as is.
+func plainValue(val any) any {
+	switch val := val.(type) {
+	case nil:
+		return nil
+	case object:
+		plain := make(object, len(val))
+
+		for idx, prop := range val {
+			plain[idx] = property{name: prop.name, value: plainValue(prop.value)}
+		}
+
+		return plain
+	case map[string]any:
+		plain := make(map[string]any, len(val))
+
+		for key, item := range val {
+			plain[key] = plainValue(item)
+		}
+
+		return plain
+	case []any:
+		plain := make([]any, len(val))
+
+		for idx, item := range val {
+			plain[idx] = plainValue(item)
+		}
+
+		return plain
+	case []object:
+		plain := make([]any, len(val))
+
+		for idx, item := range val {
+			plain[idx] = plainValue(item)
+		}
+
+		return plain
+	}
+
+	rval := reflect.ValueOf(val)
+
+	switch structKind(rval) {
+	case reflect.Struct:
+		return structObject(reflect.Indirect(rval))
+	case reflect.Slice:
+		if elem := rval.Type().Elem().Kind(); elem != reflect.Struct && elem != reflect.Pointer && elem != reflect.Map {
+			return val
+		}
+
+		items := make([]any, rval.Len())
+
+		for idx := range items {
+			items[idx] = plainValue(rval.Index(idx).Interface())
+		}
+
+		return items
+	case reflect.Map:
+		if rval.Type().Key().Kind() != reflect.String {
+			return val
+		}
+
+		entries := make(map[string]any, rval.Len())
+
+		for iter := rval.MapRange(); iter.Next(); {
+			entries[iter.Key().String()] = plainValue(iter.Value().Interface())
+		}
+
+		return entries
+	default:
+		return val
+	}
+}
+
+// structKind returns the kind of the value, pointers to structs (other than time and big integers)
+// are reported as structs.
+func structKind(rval reflect.Value) reflect.Kind {
+	if rval.Kind() == reflect.Pointer && !rval.IsNil() {
+		rval = rval.Elem()
+	}
+
+	if rval.Type() == timeType || rval.Type() == bigIntType {
+		return reflect.Invalid
+	}
+
+	return rval.Kind()
+}
+
+// structField is an exported field of a struct type with its camelCase name.
+type structField struct {
+	index int
+	name  string
+}
+
+// structFieldsCache contains the exported fields of the converted struct types by type,
+// so the field names of the bulk generated values are converted to camelCase only once.
+var structFieldsCache sync.Map //nolint:gochecknoglobals
+
+// structFields returns the exported fields of the struct type.
+func structFields(typ reflect.Type) []structField {
+	if cached, found := structFieldsCache.Load(typ); found {
+		return cached.([]structField) //nolint:forcetypeassert
+	}
+
+	fields := make([]structField, 0, typ.NumField())
+
+	for idx := range typ.NumField() {
+		if field := typ.Field(idx); field.IsExported() {
+			fields = append(fields, structField{index: idx, name: strcase.ToLowerCamel(field.Name)})
+		}
+	}
+
+	structFieldsCache.Store(typ, fields)
+
+	return fields
+}
+
+// structObject returns the exported fields of the struct value as properties with camelCase names
+// (e.g. firstName, ssn) in field order, nil pointers are omitted.
+func structObject(rval reflect.Value) object {
+	fields := structFields(rval.Type())
+	values := make(object, 0, len(fields))
+
+	for _, field := range fields {
+		fval := rval.Field(field.index)
+		if fval.Kind() == reflect.Pointer && fval.IsNil() {
+			continue
+		}
+
+		values = append(values, property{name: field.name, value: plainValue(fval.Interface())})
+	}
+
+	return values
+}
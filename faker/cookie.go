@@ -0,0 +1,277 @@
+package faker
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownCookieFlag = errors.New(
+	"unknown cookie flag, expected secure, httponly, partitioned or samesite=strict|lax|none",
+)
+
+func init() {
+	gofakeit.AddFuncLookup("cookie", gofakeit.Info{
+		Display:     "Cookie",
+		Category:    "internet",
+		Description: "Set-Cookie header value of a session, consent, analytics or CSRF cookie",
+		Example: "_ga=GA1.2.1283749561.1710498000; Domain=centralmarkets.io; Path=/;" +
+			" Expires=Mon, 16 Mar 2026 10:20:00 GMT; SameSite=Lax",
+		Output: "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "name",
+				Display:     "Name",
+				Type:        "string",
+				Default:     "any",
+				Description: "Name of the cookie (e.g. JSESSIONID, _ga), any for a random well-known cookie",
+			},
+			{
+				Field:       "domain",
+				Display:     "Domain",
+				Type:        "string",
+				Default:     "any",
+				Description: "Domain attribute of the cookie, any for a random domain name",
+			},
+			{
+				Field:       "flags",
+				Display:     "Flags",
+				Type:        "[]string",
+				Optional:    true,
+				Options:     []string{"secure", "httponly", "partitioned", "samesite=strict", "samesite=lax", "samesite=none"},
+				Description: "Attributes (secure, httponly, partitioned, samesite=strict|lax|none), typical ones if empty",
+			},
+		},
+		Generate: unsafeOf(cookie),
+	})
+
+	gofakeit.AddFuncLookup("cookieheader", gofakeit.Info{
+		Display:     "Cookie Header",
+		Category:    "internet",
+		Description: "Cookie request header value of a returning visitor with distinct well-known cookies",
+		Example:     "_ga=GA1.2.1283749561.1710498000; cookieconsent_status=allow; PHPSESSID=k2v9d1q8x7m3n0b5c4z6l1p2r8",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "3", Description: "Number of cookies"},
+		},
+		Generate: cookieheader,
+	})
+}
+
+// cookieKind describes a well-known cookie: its value generator, lifetime (0 for session cookies) and typical flags.
+type cookieKind struct {
+	value    func(r *rand.Rand) string
+	lifetime time.Duration
+	flags    []string
+}
+
+const (
+	cookieDay  = 24 * time.Hour
+	cookieYear = 365 * cookieDay
+	// tokenLength is the length of random session IDs and tokens.
+	tokenLength     = 32
+	phpSessionIDLen = 26
+	aspSessionIDLen = 24
+	mixedAlphanum   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ" + alphanum
+	// clientIDMin and clientIDSpan define the range of the 10 digits analytics client IDs.
+	clientIDMin  = 1_000_000_000
+	clientIDSpan = 9_000_000_000
+)
+
+// cookieKinds contains well-known session, consent, analytics and CSRF cookies.
+var cookieKinds = map[string]cookieKind{ //nolint:gochecknoglobals
+	"JSESSIONID": {
+		value: func(r *rand.Rand) string { return strings.ToUpper(hexOf(r, tokenLength)) },
+		flags: []string{"secure", "httponly"},
+	},
+	"PHPSESSID": {
+		value: func(r *rand.Rand) string { return stringOf(r, alphanum, phpSessionIDLen) },
+		flags: []string{"httponly", "samesite=lax"},
+	},
+	"ASP.NET_SessionId": {
+		value: func(r *rand.Rand) string { return stringOf(r, alphanum, aspSessionIDLen) },
+		flags: []string{"httponly", "samesite=lax"},
+	},
+	"connect.sid": {
+		value: func(r *rand.Rand) string {
+			return "s%3A" + stringOf(r, alphanum, tokenLength) + "." +
+				base64.RawStdEncoding.EncodeToString(randomBytes(r, tokenLength))
+		},
+		flags: []string{"secure", "httponly", "samesite=strict"},
+	},
+	"sessionid": {
+		value:    func(r *rand.Rand) string { return stringOf(r, alphanum, tokenLength) },
+		lifetime: 2 * 7 * cookieDay,
+		flags:    []string{"secure", "httponly", "samesite=lax"},
+	},
+	"cookieconsent_status": {
+		value:    pickOf("allow", "allow", "dismiss", "deny"),
+		lifetime: cookieYear,
+		flags:    []string{"samesite=lax"},
+	},
+	"_ga": {
+		value:    googleAnalyticsID(cookieYear),
+		lifetime: 2 * cookieYear,
+		flags:    []string{"samesite=lax"},
+	},
+	"_gid": {
+		value:    googleAnalyticsID(cookieDay),
+		lifetime: cookieDay,
+		flags:    []string{"samesite=lax"},
+	},
+	"_fbp": {
+		value: func(r *rand.Rand) string {
+			return "fb.1." + strconv.FormatInt(pastTime(r, cookieYear).UnixMilli(), 10) + "." + clientID(r)
+		},
+		lifetime: cookieYear,
+		flags:    []string{"secure", "samesite=lax"},
+	},
+	"ajs_anonymous_id": {
+		value:    func(r *rand.Rand) string { return (&gofakeit.Faker{Rand: r}).UUID() },
+		lifetime: cookieYear,
+		flags:    []string{"samesite=lax"},
+	},
+	"csrftoken": {
+		value:    func(r *rand.Rand) string { return stringOf(r, mixedAlphanum, tokenLength) },
+		lifetime: cookieYear,
+		flags:    []string{"samesite=lax"},
+	},
+	"XSRF-TOKEN": {
+		value:    func(r *rand.Rand) string { return base64.RawURLEncoding.EncodeToString(randomBytes(r, tokenLength)) },
+		lifetime: 2 * time.Hour,
+		flags:    []string{"secure", "samesite=strict"},
+	},
+}
+
+// cookieNames contains the sorted names of the well-known cookies.
+var cookieNames = cloudTypes(cookieKinds)[1:] //nolint:gochecknoglobals
+
+// stringOf returns a random string of n characters of the alphabet.
+func stringOf(r *rand.Rand, alphabet string, n int) string {
+	buff := make([]byte, n)
+
+	for idx := range buff {
+		buff[idx] = alphabet[r.Intn(len(alphabet))]
+	}
+
+	return string(buff)
+}
+
+// clientID returns a random 10 digits analytics client ID.
+func clientID(r *rand.Rand) string {
+	return strconv.FormatInt(clientIDMin+r.Int63n(clientIDSpan), 10)
+}
+
+// googleAnalyticsID returns generator of Google Analytics cookie values, first visit within the period.
+func googleAnalyticsID(period time.Duration) func(r *rand.Rand) string {
+	return func(r *rand.Rand) string {
+		return "GA1.2." + clientID(r) + "." + strconv.FormatInt(pastTime(r, period).Unix(), 10)
+	}
+}
+
+// pastTime returns a random time within the period before now.
+func pastTime(r *rand.Rand, period time.Duration) time.Time {
+	return time.Now().Add(-time.Duration(r.Int63n(int64(period))))
+}
+
+func cookie(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	name, err := info.GetString(m, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := info.GetString(m, "domain")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(name, "any") {
+		name = cookieNames[r.Intn(len(cookieNames))]
+	}
+
+	if strings.EqualFold(domain, "any") {
+		domain = strings.ToLower(domainName(r, safe))
+	}
+
+	kind, known := cookieKinds[name]
+	if !known {
+		kind = cookieKind{
+			value:    func(r *rand.Rand) string { return hexOf(r, tokenLength) },
+			lifetime: cookieYear,
+			flags:    []string{"secure", "httponly", "samesite=lax"},
+		}
+	}
+
+	flags, err := info.GetStringArray(m, "flags")
+	if err != nil || len(flags) == 0 {
+		flags = kind.flags
+	}
+
+	var buff strings.Builder
+
+	buff.WriteString(name + "=" + kind.value(r) + "; Domain=" + domain + "; Path=/")
+
+	if kind.lifetime != 0 {
+		buff.WriteString("; Expires=" + time.Now().UTC().Add(kind.lifetime).Format(http.TimeFormat))
+	}
+
+	for _, flag := range flags {
+		attr, err := cookieFlag(flag)
+		if err != nil {
+			return nil, err
+		}
+
+		buff.WriteString("; " + attr)
+	}
+
+	return buff.String(), nil
+}
+
+// cookieFlag returns the cookie attribute of the flag.
+func cookieFlag(flag string) (string, error) {
+	switch strings.ToLower(flag) {
+	case "secure":
+		return "Secure", nil
+	case "httponly":
+		return "HttpOnly", nil
+	case "partitioned":
+		return "Partitioned", nil
+	case "samesite=strict":
+		return "SameSite=Strict", nil
+	case "samesite=lax":
+		return "SameSite=Lax", nil
+	case "samesite=none":
+		return "SameSite=None", nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnknownCookieFlag, flag)
+	}
+}
+
+func cookieheader(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	count, err := info.GetInt(m, "count")
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeCount, count)
+	}
+
+	count = min(count, len(cookieNames))
+	pairs := make([]string, 0, count)
+
+	for _, idx := range r.Perm(len(cookieNames))[:count] {
+		name := cookieNames[idx]
+
+		pairs = append(pairs, name+"="+cookieKinds[name].value(r))
+	}
+
+	return strings.Join(pairs, "; "), nil
+}
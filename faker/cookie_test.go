@@ -0,0 +1,91 @@
+package faker_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cookie(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("cookie")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 20 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+
+		parsed, err := http.ParseSetCookie(val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.NotEmpty(t, parsed.Name)
+		require.NotEmpty(t, parsed.Value)
+		require.NotEmpty(t, parsed.Domain)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("name", "JSESSIONID")
+	params.Add("domain", "example.com")
+	params.Add("flags", "Secure")
+	params.Add("flags", "SameSite=None")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+
+	parsed, err := http.ParseSetCookie(val.(string)) //nolint:forcetypeassert
+
+	require.NoError(t, err)
+	require.Equal(t, "JSESSIONID", parsed.Name)
+	require.Len(t, parsed.Value, 32)
+	require.Equal(t, "example.com", parsed.Domain)
+	require.True(t, parsed.Secure)
+	require.False(t, parsed.HttpOnly)
+	require.Equal(t, http.SameSiteNoneMode, parsed.SameSite)
+
+	params = gofakeit.NewMapParams()
+	params.Add("flags", "encrypted")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown cookie flag")
+}
+
+func Test_cookieheader(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("cookieheader")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("count", "5")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+
+	cookies, err := http.ParseCookie(val.(string)) //nolint:forcetypeassert
+
+	require.NoError(t, err)
+	require.Len(t, cookies, 5)
+
+	names := make(map[string]struct{})
+
+	for _, cookie := range cookies {
+		require.NotEmpty(t, cookie.Value)
+		require.False(t, strings.ContainsAny(cookie.Value, "; "))
+
+		names[cookie.Name] = struct{}{}
+	}
+
+	require.Len(t, names, 5)
+}
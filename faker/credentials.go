@@ -0,0 +1,365 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidMinLength  = errors.New("minLength must be positive")
+	errInvalidMaxLength  = errors.New("maxLength must be at least minLength")
+	errEmptyCharset      = errors.New("empty character set")
+	errInvalidCharRange  = errors.New("invalid character range")
+	errUnsatisfiedPolicy = errors.New("policy can not be satisfied")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("passwordpolicy", gofakeit.Info{
+		Display:     "Password Policy",
+		Category:    "internet",
+		Description: "Password always satisfying the password policy of registration endpoints",
+		Example:     "q7R#vmT2x!Kd",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{Field: "minLength", Display: "Min Length", Type: "int", Default: "12", Description: "Minimum length"},
+			{
+				Field:       "maxLength",
+				Display:     "Max Length",
+				Type:        "int",
+				Default:     "0",
+				Description: "Maximum length, 0 for exactly the minimum length",
+			},
+			{Field: "lower", Display: "Lower", Type: "int", Default: "1", Description: "Minimum number of lowercase letters"},
+			{Field: "upper", Display: "Upper", Type: "int", Default: "1", Description: "Minimum number of uppercase letters"},
+			{Field: "digits", Display: "Digits", Type: "int", Default: "1", Description: "Minimum number of digits"},
+			{Field: "symbols", Display: "Symbols", Type: "int", Default: "1", Description: "Minimum number of symbols"},
+			{
+				Field:       "symbolChars",
+				Display:     "Symbol Chars",
+				Type:        "string",
+				Default:     passwordSymbols,
+				Description: "Allowed symbols",
+			},
+			{
+				Field:       "forbidSequential",
+				Display:     "Forbid Sequential",
+				Type:        "bool",
+				Default:     "true",
+				Description: "Forbid three sequential (abc, 321) or identical (aaa) characters in a row",
+			},
+		},
+		Generate: passwordpolicy,
+	})
+
+	gofakeit.AddFuncLookup("usernamepolicy", gofakeit.Info{
+		Display:     "Username Policy",
+		Category:    "internet",
+		Description: "Realistic username always satisfying the username policy of registration endpoints",
+		Example:     "linda.garcia84",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "allowedChars",
+				Display:     "Allowed Chars",
+				Type:        "string",
+				Default:     "a-z0-9._",
+				Description: "Allowed characters, ranges are written as a-z",
+			},
+			{Field: "minLength", Display: "Min Length", Type: "int", Default: "3", Description: "Minimum length"},
+			{Field: "maxLength", Display: "Max Length", Type: "int", Default: "16", Description: "Maximum length"},
+		},
+		Generate: usernamepolicy,
+	})
+}
+
+// Character classes of the passwords.
+const (
+	passwordLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits  = "0123456789"
+	passwordSymbols = "!@#$%^&*-_=+?"
+)
+
+func passwordpolicy(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	var counts [4]int
+
+	minLength, err := info.GetInt(m, "minLength")
+	if err != nil {
+		return nil, err
+	}
+
+	maxLength, err := info.GetInt(m, "maxLength")
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, field := range []string{"lower", "upper", "digits", "symbols"} {
+		if counts[idx], err = info.GetInt(m, field); err != nil {
+			return nil, err
+		}
+
+		if err := checkCount(counts[idx]); err != nil {
+			return nil, err
+		}
+	}
+
+	symbols, err := info.GetString(m, "symbolChars")
+	if err != nil {
+		return nil, err
+	}
+
+	forbidSequential, err := info.GetBool(m, "forbidSequential")
+	if err != nil {
+		return nil, err
+	}
+
+	if minLength < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeLength, minLength)
+	}
+
+	if maxLength != 0 && maxLength < minLength {
+		return nil, fmt.Errorf("%w: %d", errInvalidMaxLength, maxLength)
+	}
+
+	if err := checkLengths(minLength, maxLength); err != nil {
+		return nil, err
+	}
+
+	charsets := [4]string{passwordLower, passwordUpper, passwordDigits, symbols}
+
+	if counts[3] != 0 && len(symbols) == 0 {
+		return nil, fmt.Errorf("%w: symbolChars", errEmptyCharset)
+	}
+
+	required := counts[0] + counts[1] + counts[2] + counts[3]
+	if maxLength != 0 && required > maxLength {
+		return nil, fmt.Errorf("%w: %d required characters, maxLength %d", errUnsatisfiedPolicy, required, maxLength)
+	}
+
+	if err := checkCount(required); err != nil {
+		return nil, err
+	}
+
+	length := max(minLength, required)
+	if maxLength > length {
+		length += r.Intn(maxLength - length + 1)
+	}
+
+	return passwordOf(r, charsets, counts, length, forbidSequential), nil
+}
+
+// passwordOf returns a password of the length containing at least the given number of characters
+// of the character sets. The rest of the characters are picked from the required character sets
+// (or letters and digits if none of them is required).
+func passwordOf(r *rand.Rand, charsets [4]string, counts [4]int, length int, forbidSequential bool) string {
+	classes := make([]int, 0, length)
+	fill := make([]int, 0, len(charsets))
+
+	for idx, count := range counts {
+		for range count {
+			classes = append(classes, idx)
+		}
+
+		if count != 0 {
+			fill = append(fill, idx)
+		}
+	}
+
+	if len(fill) == 0 {
+		fill = []int{0, 1, 2}
+	}
+
+	for len(classes) < length {
+		classes = append(classes, fill[r.Intn(len(fill))])
+	}
+
+	r.Shuffle(len(classes), func(i, j int) { classes[i], classes[j] = classes[j], classes[i] })
+
+	password := make([]rune, 0, length)
+
+	for idx := range classes {
+		candidates := sequentialSafe(password, []rune(charsets[classes[idx]]), forbidSequential)
+
+		// swap with a later position of a different class if all characters of the class are forbidden
+		for swap := idx + 1; len(candidates) == 0 && swap < len(classes); swap++ {
+			if classes[swap] != classes[idx] {
+				classes[idx], classes[swap] = classes[swap], classes[idx]
+				candidates = sequentialSafe(password, []rune(charsets[classes[idx]]), forbidSequential)
+			}
+		}
+
+		if len(candidates) == 0 {
+			candidates = []rune(charsets[classes[idx]])
+		}
+
+		password = append(password, candidates[r.Intn(len(candidates))])
+	}
+
+	return string(password)
+}
+
+// sequentialSafe returns the characters which don't form three sequential or identical characters
+// with the last two characters of the password.
+func sequentialSafe(password []rune, chars []rune, forbidSequential bool) []rune {
+	if !forbidSequential || len(password) < 2 {
+		return chars
+	}
+
+	prev2, prev1 := password[len(password)-2], password[len(password)-1]
+	step := prev1 - prev2
+
+	if step < -1 || step > 1 {
+		return chars
+	}
+
+	return slices.DeleteFunc(slices.Clone(chars), func(c rune) bool { return c-prev1 == step })
+}
+
+func usernamepolicy(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	spec, err := info.GetString(m, "allowedChars")
+	if err != nil {
+		return nil, err
+	}
+
+	minLength, err := info.GetInt(m, "minLength")
+	if err != nil {
+		return nil, err
+	}
+
+	maxLength, err := info.GetInt(m, "maxLength")
+	if err != nil {
+		return nil, err
+	}
+
+	if minLength < 1 {
+		return nil, fmt.Errorf("%w: %d", errInvalidMinLength, minLength)
+	}
+
+	if maxLength < minLength {
+		return nil, fmt.Errorf("%w: %d", errInvalidMaxLength, maxLength)
+	}
+
+	if err := checkLengths(minLength, maxLength); err != nil {
+		return nil, err
+	}
+
+	allowed, err := expandCharset(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return usernameOf(r, allowed, minLength, maxLength), nil
+}
+
+// checkLengths returns an error if the minimum or the maximum length is greater than maxItems.
+func checkLengths(minLength, maxLength int) error {
+	if err := checkCount(minLength); err != nil {
+		return fmt.Errorf("minLength: %w", err)
+	}
+
+	if err := checkCount(maxLength); err != nil {
+		return fmt.Errorf("maxLength: %w", err)
+	}
+
+	return nil
+}
+
+// expandCharset returns the characters of the character set specification, ranges are written as a-z.
+// A hyphen at the start or at the end of the specification is a literal hyphen.
+func expandCharset(spec string) ([]rune, error) {
+	runes := []rune(spec)
+	chars := make([]rune, 0, len(runes))
+
+	for idx := 0; idx < len(runes); idx++ {
+		if idx+2 < len(runes) && runes[idx+1] == '-' {
+			if runes[idx] > runes[idx+2] {
+				return nil, fmt.Errorf("%w: %s", errInvalidCharRange, string(runes[idx:idx+3]))
+			}
+
+			for c := runes[idx]; c <= runes[idx+2]; c++ {
+				chars = append(chars, c)
+			}
+
+			idx += 2
+
+			continue
+		}
+
+		chars = append(chars, runes[idx])
+	}
+
+	slices.Sort(chars)
+	chars = slices.Compact(chars)
+
+	if len(chars) == 0 {
+		return nil, errEmptyCharset
+	}
+
+	return chars, nil
+}
+
+// usernameOf returns a realistic username (based on a name) containing only the allowed characters.
+// Disallowed letters are lowercased or dropped, separators are replaced by an allowed separator.
+// The username starts with a letter if letters are allowed, short usernames are padded with digits
+// (or other allowed characters).
+func usernameOf(r *rand.Rand, allowed []rune, minLength int, maxLength int) string {
+	const maxSuffix = 100
+
+	fake := &gofakeit.Faker{Rand: r}
+	first, last := strings.ToLower(fake.FirstName()), strings.ToLower(fake.LastName())
+
+	var separator string
+
+	separators := slices.DeleteFunc([]rune("._-"), func(c rune) bool { return !slices.Contains(allowed, c) })
+	if len(separators) != 0 {
+		separator = string(separators[r.Intn(len(separators))])
+	}
+
+	patterns := []func() string{
+		func() string { return first + separator + last },
+		func() string { return first + strconv.Itoa(r.Intn(maxSuffix)) },
+		func() string { return first[:1] + last },
+		func() string { return last + separator + first + strconv.Itoa(r.Intn(maxSuffix)) },
+	}
+
+	name := make([]rune, 0, maxLength)
+
+	for _, c := range patterns[r.Intn(len(patterns))]() {
+		switch {
+		case slices.Contains(allowed, c):
+			name = append(name, c)
+		case slices.Contains(allowed, unicode.ToUpper(c)) && unicode.IsLower(c):
+			name = append(name, unicode.ToUpper(c))
+		}
+	}
+
+	letters := slices.DeleteFunc(slices.Clone(allowed), func(c rune) bool { return !unicode.IsLetter(c) })
+
+	if len(letters) != 0 && (len(name) == 0 || !unicode.IsLetter(name[0])) {
+		name = append([]rune{letters[r.Intn(len(letters))]}, name...)
+	}
+
+	name = name[:min(len(name), maxLength)]
+
+	for len(name) > minLength && !unicode.IsLetter(name[len(name)-1]) && !unicode.IsDigit(name[len(name)-1]) {
+		name = name[:len(name)-1]
+	}
+
+	padding := slices.DeleteFunc(slices.Clone(allowed), func(c rune) bool { return !unicode.IsDigit(c) })
+	if len(padding) == 0 {
+		padding = allowed
+	}
+
+	for len(name) < minLength {
+		name = append(name, padding[r.Intn(len(padding))])
+	}
+
+	return string(name)
+}
@@ -0,0 +1,161 @@
+package faker_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func countOf(str string, fn func(rune) bool) int {
+	var count int
+
+	for _, c := range str {
+		if fn(c) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func hasSequential(str string) bool {
+	runes := []rune(str)
+
+	for idx := 2; idx < len(runes); idx++ {
+		step := runes[idx-1] - runes[idx-2]
+
+		if step >= -1 && step <= 1 && runes[idx]-runes[idx-1] == step {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Test_passwordpolicy(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("passwordpolicy")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("minLength", "10")
+	params.Add("maxLength", "14")
+	params.Add("upper", "2")
+	params.Add("digits", "3")
+	params.Add("symbols", "2")
+	params.Add("symbolChars", "#!")
+
+	for range 500 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		password, ok := val.(string)
+
+		require.True(t, ok)
+		require.GreaterOrEqual(t, len(password), 10)
+		require.LessOrEqual(t, len(password), 14)
+		require.GreaterOrEqual(t, countOf(password, unicode.IsLower), 1)
+		require.GreaterOrEqual(t, countOf(password, unicode.IsUpper), 2)
+		require.GreaterOrEqual(t, countOf(password, unicode.IsDigit), 3)
+		require.GreaterOrEqual(t, countOf(password, func(c rune) bool { return c == '#' || c == '!' }), 2)
+		require.Empty(t, strings.Trim(password, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789#!"))
+		require.False(t, hasSequential(password), password)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("lower", "0")
+	params.Add("upper", "0")
+	params.Add("symbols", "0")
+	params.Add("minLength", "6")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^[0-9]{6}$`, val)
+
+	for value, msg := range map[[2]string]string{
+		{"maxLength", "4"}:          "maxLength must be at least minLength: 4",
+		{"digits", "-1"}:            "negative count: -1",
+		{"digits", "100001"}:        "too many items: 100001",
+		{"maxLength", "1000000000"}: "maxLength: too many items: 1000000000",
+		{"symbolChars", ""}:         "empty character set: symbolChars",
+	} {
+		params := gofakeit.NewMapParams()
+		params.Add(value[0], value[1])
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, msg)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("minLength", "2")
+	params.Add("maxLength", "3")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "policy can not be satisfied: 4 required characters, maxLength 3")
+}
+
+func Test_usernamepolicy(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("usernamepolicy")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	policies := map[string]string{
+		"a-z0-9._": `^[a-z][a-z0-9._]{2,15}$`,
+		"A-Z":      `^[A-Z]{3,16}$`,
+		"a-z-":     `^[a-z][a-z-]{2,15}$`,
+		"0-9":      `^[0-9]{3,16}$`,
+	}
+
+	for allowed, format := range policies {
+		params := gofakeit.NewMapParams()
+		params.Add("allowedChars", allowed)
+
+		for range 200 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Regexp(t, format, val, allowed)
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("minLength", "8")
+	params.Add("maxLength", "8")
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Len(t, val, 8)
+	}
+
+	for value, msg := range map[[2]string]string{
+		{"allowedChars", "z-a"}: "invalid character range: z-a",
+		{"allowedChars", ""}:    "empty character set",
+		{"minLength", "0"}:      "minLength must be positive: 0",
+		{"maxLength", "2"}:      "maxLength must be at least minLength: 2",
+		{"maxLength", "100001"}: "maxLength: too many items: 100001",
+	} {
+		params := gofakeit.NewMapParams()
+		params.Add(value[0], value[1])
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, msg)
+	}
+}
@@ -0,0 +1,283 @@
+package faker
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6/data"
+	"github.com/grafana/sobek"
+)
+
+// Modes of loading custom datasets.
+const (
+	datasetReplace = "replace"
+	datasetExtend  = "extend"
+)
+
+// datasetTarget is a value generated from a dataset: the whole output of a function
+// or a property of it (dotted path), optionally only its first or last word.
+type datasetTarget struct {
+	function string
+	path     string
+	// word is 0 for the whole value, 1 for the first word and -1 for the last word.
+	word int
+	// sibling is the property containing the value as a substring (e.g. the full address containing the city),
+	// the value is replaced in it too.
+	sibling string
+}
+
+// dataset is a replaceable embedded dataset of gofakeit.
+type dataset struct {
+	// key and subkey identify the embedded dataset.
+	key, subkey string
+	targets     []datasetTarget
+}
+
+// datasets contains the datasets which can be loaded by the loadData method, by dataset name.
+//
+//nolint:gochecknoglobals
+var datasets = map[string]*dataset{
+	"firstNames": {key: "person", subkey: "first", targets: []datasetTarget{
+		{function: "firstName"}, {function: "name", word: 1}, {function: "person", path: "firstName"},
+	}},
+	"middleNames": {key: "person", subkey: "middle", targets: []datasetTarget{{function: "middleName"}}},
+	"lastNames": {key: "person", subkey: "last", targets: []datasetTarget{
+		{function: "lastName"}, {function: "name", word: -1}, {function: "person", path: "lastName"},
+	}},
+	"hobbies": {key: "person", subkey: "hobby", targets: []datasetTarget{
+		{function: "hobby"}, {function: "person", path: "hobby"},
+	}},
+	"cities": {key: "address", subkey: "city", targets: []datasetTarget{
+		{function: "city"},
+		{function: "address", path: "city", sibling: "address"},
+		{function: "person", path: "address.city", sibling: "address.address"},
+	}},
+	"states": {key: "address", subkey: "state", targets: []datasetTarget{
+		{function: "state"},
+		{function: "address", path: "state", sibling: "address"},
+		{function: "person", path: "address.state", sibling: "address.address"},
+	}},
+	"countries": {key: "address", subkey: "country", targets: []datasetTarget{
+		{function: "country"}, {function: "address", path: "country"}, {function: "person", path: "address.country"},
+	}},
+	"companies": {key: "company", subkey: "name", targets: []datasetTarget{
+		{function: "company"}, {function: "job", path: "company"}, {function: "person", path: "job.company"},
+	}},
+	"jobTitles": {key: "job", subkey: "title", targets: []datasetTarget{
+		{function: "jobTitle"}, {function: "job", path: "title"}, {function: "person", path: "job.title"},
+	}},
+	"products": {key: "product", subkey: "name", targets: []datasetTarget{
+		{function: "productName"}, {function: "product", path: "name"},
+	}},
+	"productCategories": {key: "product", subkey: "category", targets: []datasetTarget{
+		{function: "productCategory"}, {function: "product", path: "categories"},
+	}},
+}
+
+// loadedDataset contains the custom values of a dataset loaded into an instance.
+type loadedDataset struct {
+	values []string
+	// embedded is the number of values of the embedded dataset, 0 if the embedded values are replaced.
+	embedded int
+}
+
+// pick returns a custom value, or false if the generated value is to be kept (extending the embedded dataset).
+func (d *loadedDataset) pick(f *faker) (string, bool) {
+	idx := f.rand.Intn(len(d.values) + d.embedded)
+	if idx >= len(d.values) {
+		return "", false
+	}
+
+	return d.values[idx], true
+}
+
+// loadData loads custom datasets into the instance, the generator functions (including composite ones like
+// person) use the custom values instead of (replace mode, default) or besides (extend mode) the embedded ones.
+// The values of a dataset are an array of strings or a JSON array text (e.g. the content of a file).
+func (f *faker) loadData(values sobek.Value, options sobek.Value) {
+	const method = "loadData"
+
+	mode := datasetReplace
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("mode"); val != nil && !sobek.IsUndefined(val) {
+			if mode = val.String(); mode != datasetReplace && mode != datasetExtend {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "mode", Expected: "replace or extend", Reason: "invalid value " + mode,
+				})
+			}
+		}
+	}
+
+	obj := f.objectArgument(method, "datasets", values)
+
+	if f.datasets == nil {
+		f.datasets = make(map[string]*loadedDataset)
+	}
+
+	for _, name := range obj.Keys() {
+		set, found := datasets[name]
+		if !found {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: name, Expected: "one of " + strings.Join(datasetNames(), ", "),
+				Reason: "unknown dataset",
+			})
+		}
+
+		loaded := &loadedDataset{values: f.datasetValues(method, name, obj.Get(name))}
+
+		if mode == datasetExtend {
+			loaded.embedded = len(data.GetSubData(set.key, set.subkey))
+		}
+
+		f.datasets[name] = loaded
+	}
+}
+
+// datasetNames returns the names of the loadable datasets in alphabetical order.
+func datasetNames() []string {
+	names := make([]string, 0, len(datasets))
+
+	for name := range datasets {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// datasetValues returns the values of the dataset, an array of strings or a JSON array text.
+func (f *faker) datasetValues(method string, name string, val sobek.Value) []string {
+	var items []any
+
+	if str, isString := val.Export().(string); isString {
+		if err := json.Unmarshal([]byte(str), &items); err != nil {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: name, Expected: "array of strings", Reason: "invalid JSON: " + err.Error(),
+			})
+		}
+	} else {
+		for _, item := range f.arrayArgument(method, name, val) {
+			items = append(items, item.Export())
+		}
+	}
+
+	values := make([]string, 0, len(items))
+
+	for _, item := range items {
+		str, isString := item.(string)
+		if !isString || len(str) == 0 {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: name, Expected: "array of strings", Reason: "invalid item",
+			})
+		}
+
+		values = append(values, str)
+	}
+
+	if len(values) == 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: name, Expected: "array of strings", Reason: "empty dataset"})
+	}
+
+	return values
+}
+
+// applyDatasets returns the generated (plain) value of the function with the values of the loaded datasets.
+func (f *faker) applyDatasets(name string, val any) any {
+	if len(f.datasets) == 0 {
+		return val
+	}
+
+	for _, setName := range datasetNames() {
+		loaded, found := f.datasets[setName]
+		if !found {
+			continue
+		}
+
+		for _, target := range datasets[setName].targets {
+			if target.function == name {
+				val = target.apply(f, loaded, val)
+			}
+		}
+	}
+
+	return val
+}
+
+// apply replaces the target value of the generated value with custom values.
+func (t *datasetTarget) apply(f *faker, loaded *loadedDataset, val any) any {
+	if len(t.path) == 0 {
+		if str, isString := val.(string); isString {
+			return t.replace(f, loaded, str)
+		}
+
+		return val
+	}
+
+	parent, key := datasetParent(val, t.path)
+	if parent == nil {
+		return val
+	}
+
+	switch current := parent.get(key).(type) {
+	case string:
+		replaced := t.replace(f, loaded, current)
+		parent.set(key, replaced)
+
+		if sibling, skey := datasetParent(val, t.sibling); sibling != nil && replaced != current {
+			if str, isString := sibling.get(skey).(string); isString {
+				sibling.set(skey, strings.Replace(str, current, replaced, 1))
+			}
+		}
+	case []string:
+		for idx, item := range current {
+			current[idx] = t.replace(f, loaded, item)
+		}
+	}
+
+	return val
+}
+
+// replace returns the string (or its first or last word) replaced by a custom value.
+func (t *datasetTarget) replace(f *faker, loaded *loadedDataset, str string) string {
+	value, picked := loaded.pick(f)
+	if !picked {
+		return str
+	}
+
+	switch t.word {
+	case 1:
+		if _, rest, found := strings.Cut(str, " "); found {
+			return value + " " + rest
+		}
+	case -1:
+		if idx := strings.LastIndexByte(str, ' '); idx >= 0 {
+			return str[:idx+1] + value
+		}
+	}
+
+	return value
+}
+
+// datasetParent returns the object containing the property of the dotted path and the name of the property.
+func datasetParent(val any, path string) (object, string) {
+	if len(path) == 0 {
+		return nil, ""
+	}
+
+	obj, isObject := val.(object)
+	if !isObject {
+		return nil, ""
+	}
+
+	head, rest, nested := strings.Cut(path, ".")
+	if !nested {
+		return obj, head
+	}
+
+	return datasetParent(obj.get(head), rest)
+}
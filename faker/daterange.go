@@ -0,0 +1,227 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidInstant  = errors.New("invalid time, expected now, date or RFC 3339 timestamp")
+	errInvalidDuration = errors.New("invalid duration, expected positive duration (e.g. 90m, 12h or 7d)")
+	errInvalidRange    = errors.New("invalid range")
+	errUnknownUnit     = errors.New("unknown duration unit")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("timerange", gofakeit.Info{
+		Display:     "Time Range",
+		Category:    "time",
+		Description: "Start and end of a time range (e.g. a booking) within the given period, as RFC 3339 timestamps",
+		Example:     `{"start": "2024-03-15T10:20:00Z", "end": "2024-03-18T08:05:00Z"}`,
+		Output:      "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "start",
+				Display:     "Start",
+				Type:        "string",
+				Default:     "now",
+				Description: "Earliest start of the range: now, a date or an RFC 3339 timestamp",
+			},
+			{
+				Field:       "end",
+				Display:     "End",
+				Type:        "string",
+				Default:     "30d",
+				Description: "Latest end of the range: a date, an RFC 3339 timestamp or a duration after the start",
+			},
+			{
+				Field:       "minDuration",
+				Display:     "Min Duration",
+				Type:        "string",
+				Default:     "1h",
+				Description: "Minimum length of the range (e.g. 90m, 12h or 7d)",
+			},
+			{
+				Field:       "maxDuration",
+				Display:     "Max Duration",
+				Type:        "string",
+				Default:     "7d",
+				Description: "Maximum length of the range (e.g. 90m, 12h or 7d)",
+			},
+		},
+		Generate: timerange,
+	})
+
+	gofakeit.AddFuncLookup("duration", gofakeit.Info{
+		Display:     "Duration",
+		Category:    "time",
+		Description: "Random length of time between the minimum and the maximum, as a whole number of the unit",
+		Example:     "2712",
+		Output:      "int",
+		Params: []gofakeit.Param{
+			{
+				Field:       "min",
+				Display:     "Min",
+				Type:        "string",
+				Default:     "1s",
+				Description: "Minimum duration (e.g. 500ms, 90m or 7d)",
+			},
+			{
+				Field:       "max",
+				Display:     "Max",
+				Type:        "string",
+				Default:     "1h",
+				Description: "Maximum duration (e.g. 500ms, 90m or 7d)",
+			},
+			{
+				Field:       "unit",
+				Display:     "Unit",
+				Type:        "string",
+				Default:     "s",
+				Options:     []string{"ns", "us", "ms", "s", "m", "h", "d"},
+				Description: "Unit of the returned number",
+			},
+		},
+		Generate: duration,
+	})
+}
+
+// durationUnits contains the lengths of the duration units.
+var durationUnits = map[string]time.Duration{ //nolint:gochecknoglobals
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  day,
+}
+
+// parseInstant parses now, a date or an RFC 3339 timestamp.
+func parseInstant(str string, now time.Time) (time.Time, error) {
+	if strings.EqualFold(str, "now") {
+		return now, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %s", errInvalidInstant, str)
+}
+
+// durationParam returns the duration parameter, which can also be given in days (e.g. 7d).
+func durationParam(m *gofakeit.MapParams, info *gofakeit.Info, field string) (time.Duration, error) {
+	str, err := info.GetString(m, field)
+	if err != nil {
+		return 0, err
+	}
+
+	dur, ok := parsePeriod(str)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errInvalidDuration, str)
+	}
+
+	return dur, nil
+}
+
+// durationBetween returns a random duration between min and max (inclusive).
+func durationBetween(r *rand.Rand, low time.Duration, high time.Duration) time.Duration {
+	return low + time.Duration(r.Int63n(int64(high-low)+1))
+}
+
+func timerange(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	minDuration, err := durationParam(m, info, "minDuration")
+	if err != nil {
+		return nil, err
+	}
+
+	maxDuration, err := durationParam(m, info, "maxDuration")
+	if err != nil {
+		return nil, err
+	}
+
+	if minDuration > maxDuration {
+		return nil, fmt.Errorf("%w: minDuration %s is greater than maxDuration %s",
+			errInvalidRange, minDuration, maxDuration)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	str, err := info.GetString(m, "start")
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := parseInstant(str, now)
+	if err != nil {
+		return nil, err
+	}
+
+	str, err = info.GetString(m, "end")
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseInstant(str, now)
+	if period, ok := parsePeriod(str); ok {
+		end, err = start.Add(period), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the range is generated in whole seconds
+	span := int64(end.Sub(start) / time.Second)
+	low := int64((minDuration + time.Second - 1) / time.Second)
+	high := min(int64(maxDuration/time.Second), span)
+
+	if low > high {
+		return nil, fmt.Errorf("%w: %s - %s is shorter than minDuration %s", errInvalidRange,
+			start.Format(time.RFC3339), end.Format(time.RFC3339), minDuration)
+	}
+
+	length := low + r.Int63n(high-low+1)
+	from := start.Add(time.Duration(r.Int63n(span-length+1)) * time.Second)
+
+	return object{
+		{"start", from.Format(time.RFC3339)},
+		{"end", from.Add(time.Duration(length) * time.Second).Format(time.RFC3339)},
+	}, nil
+}
+
+func duration(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	low, err := durationParam(m, info, "min")
+	if err != nil {
+		return nil, err
+	}
+
+	high, err := durationParam(m, info, "max")
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := info.GetString(m, "unit")
+	if err != nil {
+		return nil, err
+	}
+
+	unit, found := durationUnits[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownUnit, name)
+	}
+
+	if low > high {
+		return nil, fmt.Errorf("%w: min %s is greater than max %s", errInvalidRange, low, high)
+	}
+
+	return int64(durationBetween(r, low, high) / unit), nil
+}
@@ -0,0 +1,121 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_timerange(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("timerange")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("start", "2024-03-01")
+	params.Add("end", "2024-03-08T12:00:00Z")
+	params.Add("minDuration", "2h")
+	params.Add("maxDuration", "2d")
+
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.March, 8, 12, 0, 0, 0, time.UTC)
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		pair, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		from, err := time.Parse(time.RFC3339, pair["start"].(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+
+		to, err := time.Parse(time.RFC3339, pair["end"].(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.False(t, from.Before(start))
+		require.False(t, to.After(end))
+		require.GreaterOrEqual(t, to.Sub(from), 2*time.Hour)
+		require.LessOrEqual(t, to.Sub(from), 48*time.Hour)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("end", "30m")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "is shorter than minDuration 1h0m0s")
+
+	params = gofakeit.NewMapParams()
+	params.Add("start", "yesterday")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid time")
+}
+
+func Test_daterange(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("daterange")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("startdate", "2020-01-01")
+	params.Add("enddate", "2020-12-31")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^2020-\d{2}-\d{2}$`), val)
+}
+
+func Test_duration(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("duration")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("min", "90m")
+	params.Add("max", "3h")
+	params.Add("unit", "m")
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, val, int64(90))
+		require.LessOrEqual(t, val, int64(180))
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("min", "2h")
+	params.Add("max", "1h")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "min 2h0m0s is greater than max 1h0m0s")
+
+	params = gofakeit.NewMapParams()
+	params.Add("unit", "fortnight")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown duration unit")
+}
@@ -0,0 +1,310 @@
+package faker
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// demographicsJSON contains the embedded demographic dataset.
+// The dataset is versioned, generated values for a given seed only change when the version changes.
+//
+//go:embed data/demographics.json
+var demographicsJSON []byte
+
+// ageBucketWidth is the width of the age pyramid buckets in years.
+const ageBucketWidth = 5
+
+// maxAge is the upper bound (exclusive) of the last, open ended age pyramid bucket.
+const maxAge = 100
+
+var (
+	errUnknownCountry = errors.New("no demographic data for country")
+	errUnknownGender  = errors.New("unknown gender")
+)
+
+type demographics struct {
+	Version   string                        `json:"version"`
+	Countries map[string]*countryDemography `json:"countries"`
+}
+
+type countryDemography struct {
+	Male   weightedStrings `json:"male"`
+	Female weightedStrings `json:"female"`
+	Last   weightedStrings `json:"last"`
+	Ages   []float64       `json:"ages"`
+}
+
+// weightedStrings is a list of strings with relative frequencies.
+// It is decoded from a JSON object, the entries are sorted by value to get stable sampling.
+type weightedStrings struct {
+	values  []string
+	weights []float64
+}
+
+func (w *weightedStrings) UnmarshalJSON(data []byte) error {
+	var freq map[string]float64
+
+	if err := json.Unmarshal(data, &freq); err != nil {
+		return err
+	}
+
+	w.values = make([]string, 0, len(freq))
+	for value := range freq {
+		w.values = append(w.values, value)
+	}
+
+	sort.Strings(w.values)
+
+	w.weights = make([]float64, len(w.values))
+	for idx, value := range w.values {
+		w.weights[idx] = freq[value]
+	}
+
+	return nil
+}
+
+func (w *weightedStrings) sample(r *rand.Rand) string {
+	return w.values[weightedIndex(r, w.weights)]
+}
+
+// weightedIndex returns a random index of weights, the probability of each index is proportional to its weight.
+func weightedIndex(r *rand.Rand, weights []float64) int {
+	var total float64
+
+	for _, weight := range weights {
+		total += weight
+	}
+
+	pick := r.Float64() * total
+
+	for idx, weight := range weights {
+		if pick < weight {
+			return idx
+		}
+
+		pick -= weight
+	}
+
+	return len(weights) - 1
+}
+
+//nolint:gochecknoglobals
+var (
+	demographicsData *demographics
+	demographicsOnce sync.Once
+)
+
+func getDemographics() *demographics {
+	demographicsOnce.Do(func() {
+		demographicsData = new(demographics)
+
+		if err := json.Unmarshal(demographicsJSON, demographicsData); err != nil {
+			panic(err)
+		}
+	})
+
+	return demographicsData
+}
+
+func demographicCountries() []string {
+	countries := make([]string, 0, len(getDemographics().Countries))
+	for country := range getDemographics().Countries {
+		countries = append(countries, country)
+	}
+
+	sort.Strings(countries)
+
+	return countries
+}
+
+func init() {
+	country := gofakeit.Param{
+		Field:       "country",
+		Display:     "Country",
+		Type:        "string",
+		Default:     "US",
+		Options:     demographicCountries(),
+		Description: "ISO 3166-1 alpha-2 code of the country whose demographic data is used",
+	}
+
+	gender := gofakeit.Param{
+		Field:       "gender",
+		Display:     "Gender",
+		Type:        "string",
+		Default:     "any",
+		Options:     []string{"any", "male", "female"},
+		Description: "Gender of the person",
+	}
+
+	gofakeit.AddFuncLookup("demographicfirstname", gofakeit.Info{
+		Display:     "Demographic First Name",
+		Category:    "person",
+		Description: "First name sampled according to the name frequencies of the given country",
+		Example:     "Michael",
+		Output:      "string",
+		Params:      []gofakeit.Param{country, gender},
+		Generate:    demographicFirstName,
+	})
+
+	gofakeit.AddFuncLookup("demographiclastname", gofakeit.Info{
+		Display:     "Demographic Last Name",
+		Category:    "person",
+		Description: "Last name sampled according to the name frequencies of the given country",
+		Example:     "Smith",
+		Output:      "string",
+		Params:      []gofakeit.Param{country},
+		Generate:    demographicLastName,
+	})
+
+	gofakeit.AddFuncLookup("demographicage", gofakeit.Info{
+		Display:     "Demographic Age",
+		Category:    "person",
+		Description: "Age in years sampled according to the age pyramid of the given country",
+		Example:     "42",
+		Output:      "int",
+		Params:      []gofakeit.Param{country},
+		Generate:    demographicAge,
+	})
+
+	gofakeit.AddFuncLookup("demographicperson", gofakeit.Info{
+		Display:     "Demographic Person",
+		Category:    "person",
+		Description: "Person with name, gender, age, birthdate and adult flag sampled according to the demographic data of the given country",
+		Example: `{
+	"firstName": "Michael",
+	"lastName": "Smith",
+	"gender": "male",
+	"age": 42,
+	"birthdate": "1982-05-17",
+	"adult": true,
+	"country": "US"
+}`,
+		Output:   "map[string]any",
+		Params:   []gofakeit.Param{country},
+		Generate: demographicPerson,
+	})
+}
+
+func countryParam(m *gofakeit.MapParams, info *gofakeit.Info) (*countryDemography, error) {
+	country, err := info.GetString(m, "country")
+	if err != nil {
+		return nil, err
+	}
+
+	demography, found := getDemographics().Countries[strings.ToUpper(country)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownCountry, country)
+	}
+
+	return demography, nil
+}
+
+func genderParam(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (string, error) {
+	gender, err := info.GetString(m, "gender")
+	if err != nil {
+		return "", err
+	}
+
+	switch strings.ToLower(gender) {
+	case "male":
+		return "male", nil
+	case "female":
+		return "female", nil
+	case "any":
+		return randomGender(r), nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnknownGender, gender)
+	}
+}
+
+func randomGender(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return "male"
+	}
+
+	return "female"
+}
+
+func (c *countryDemography) firstName(r *rand.Rand, gender string) string {
+	if gender == "male" {
+		return c.Male.sample(r)
+	}
+
+	return c.Female.sample(r)
+}
+
+func (c *countryDemography) age(r *rand.Rand) int {
+	bucket := weightedIndex(r, c.Ages)
+	from := bucket * ageBucketWidth
+
+	if bucket == len(c.Ages)-1 {
+		return from + r.Intn(maxAge-from)
+	}
+
+	return from + r.Intn(ageBucketWidth)
+}
+
+func demographicFirstName(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	demography, err := countryParam(m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	gender, err := genderParam(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return demography.firstName(r, gender), nil
+}
+
+func demographicLastName(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	demography, err := countryParam(m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return demography.Last.sample(r), nil
+}
+
+func demographicAge(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	demography, err := countryParam(m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return demography.age(r), nil
+}
+
+func demographicPerson(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	demography, err := countryParam(m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	country, _ := info.GetString(m, "country")
+	gender := randomGender(r)
+	person := object{
+		{"firstName", demography.firstName(r, gender)},
+		{"lastName", demography.Last.sample(r)},
+		{"gender", gender},
+	}
+
+	age := demography.age(r)
+
+	return append(person,
+		property{"age", age},
+		property{"birthdate", birthdateOf(r, time.Now(), age).Format(time.DateOnly)},
+		property{"adult", age >= adultAge},
+		property{"country", strings.ToUpper(country)},
+	), nil
+}
@@ -0,0 +1,121 @@
+package faker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_demographicfirstname(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("demographicfirstname")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("country", "br")
+	params.Add("gender", "female")
+
+	r := testRand(t)
+	counts := make(map[string]int)
+
+	const samples = 1000
+
+	for range samples {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		counts[val.(string)]++ //nolint:forcetypeassert
+	}
+
+	// Maria is by far the most frequent female first name in Brazil.
+	for name, count := range counts {
+		require.LessOrEqual(t, count, counts["Maria"], name)
+	}
+
+	// the less frequent names are sampled too
+	require.Greater(t, len(counts), 40)
+
+	params = gofakeit.NewMapParams()
+	params.Add("country", "XX")
+	params.Add("gender", "any")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "no demographic data for country: XX")
+}
+
+func Test_demographicage(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("demographicage")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("country", "JP")
+
+	r := testRand(t)
+
+	for range 1000 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, val, 0)
+		require.Less(t, val, 100)
+	}
+}
+
+func Test_demographicperson(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("demographicperson")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("country", "de")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+
+	person, ok := faker.PlainMaps(val).(map[string]any)
+
+	require.True(t, ok)
+	require.Equal(t, "DE", person["country"])
+	require.Contains(t, []string{"male", "female"}, person["gender"])
+	require.NotEmpty(t, person["firstName"])
+	require.NotEmpty(t, person["lastName"])
+}
+
+func Test_demographicperson_age(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("demographicperson")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 200 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+
+		person, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		born, err := time.Parse(time.DateOnly, person["birthdate"].(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.Equal(t, person["age"], ageAt(born, time.Now()))
+		require.Equal(t, person["age"].(int) >= 18, person["adult"]) //nolint:forcetypeassert
+	}
+}
@@ -0,0 +1,50 @@
+package faker
+
+// checkDeprecated emits a one-time warning if the function name is deprecated.
+func (f *faker) checkDeprecated(name string) {
+	deprecation, found := deprecations[name]
+	if !found {
+		return
+	}
+
+	f.warnOnce("deprecated."+name,
+		"faker: %s is deprecated since %s and will be removed in %s, use %s instead",
+		name, deprecation.Since, deprecation.Removal, deprecation.Replacement,
+	)
+}
+
+// checkDeprecatedCategory emits a one-time warning if the category name is deprecated.
+func (f *faker) checkDeprecatedCategory(name string) {
+	deprecation, found := categoryDeprecations[name]
+	if !found {
+		return
+	}
+
+	f.warnOnce("deprecated.category."+name,
+		"faker: the %s category is deprecated since %s and will be removed in %s, use %s instead",
+		name, deprecation.Since, deprecation.Removal, deprecation.Replacement,
+	)
+}
+
+// aliases returns the deprecated function and category names with their replacements,
+// e.g. for finding the renamed functions used by a script before an upgrade.
+func (f *faker) aliases() map[string]any {
+	table := func(deprecations map[string]Deprecation) map[string]any {
+		entries := make(map[string]any, len(deprecations))
+
+		for name, deprecation := range deprecations {
+			entries[name] = map[string]any{
+				"replacement": deprecation.Replacement,
+				"since":       deprecation.Since,
+				"removal":     deprecation.Removal,
+			}
+		}
+
+		return entries
+	}
+
+	return map[string]any{
+		"functions":  table(deprecations),
+		"categories": table(categoryDeprecations),
+	}
+}
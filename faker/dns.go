@@ -0,0 +1,226 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownRecordType = errors.New("unknown DNS record type")
+
+func init() {
+	gofakeit.AddFuncLookup("dnsrecord", gofakeit.Info{
+		Display:     "DNS Record",
+		Category:    "internet",
+		Description: "DNS resource record with fully qualified name, TTL and record data in zone file presentation format",
+		Example:     `{"name": "centralmarkets.io.", "type": "MX", "ttl": 3600, "data": "10 mx1.centralmarkets.io."}`,
+		Output:      "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "type",
+				Display:     "Type",
+				Type:        "string",
+				Default:     "any",
+				Options:     append([]string{"any"}, dnsRecordTypes...),
+				Description: "Type of the record",
+			},
+		},
+		Generate: unsafeOf(dnsrecord),
+	})
+
+	gofakeit.AddFuncLookup("zonefile", gofakeit.Info{
+		Display:     "Zone File",
+		Category:    "internet",
+		Description: "DNS zone file (RFC 1035 master file format) with SOA, NS and random resource records",
+		Example: `$ORIGIN centralmarkets.io.
+$TTL 3600
+@	3600	IN	SOA	ns1.centralmarkets.io. hostmaster.centralmarkets.io. 2024031501 7200 3600 1209600 3600
+@	3600	IN	NS	ns1.centralmarkets.io.
+@	3600	IN	NS	ns2.centralmarkets.io.
+www.centralmarkets.io.	300	IN	A	93.184.216.34`,
+		Output: "string",
+		Params: []gofakeit.Param{
+			{Field: "records", Display: "Records", Type: "int", Default: "10", Description: "Number of resource records"},
+		},
+		Generate: unsafeOf(zonefile),
+	})
+}
+
+// dnsRecordTypes contains the supported DNS record types.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV"} //nolint:gochecknoglobals
+
+// dnsHosts contains typical host names.
+var dnsHosts = []string{ //nolint:gochecknoglobals
+	"www", "api", "app", "cdn", "mail", "vpn", "git", "shop", "blog", "status", "auth", "static", "docs", "portal",
+}
+
+// dnsServices contains SRV service names and their ports.
+var dnsServices = []struct { //nolint:gochecknoglobals
+	name string
+	port int
+}{
+	{"_sip._tcp", 5060},
+	{"_sips._tcp", 5061},
+	{"_xmpp-client._tcp", 5222},
+	{"_xmpp-server._tcp", 5269},
+	{"_ldap._tcp", 389},
+	{"_imaps._tcp", 993},
+	{"_submission._tcp", 587},
+	{"_minecraft._tcp", 25565},
+}
+
+// dnsTTLs contains commonly used TTL values in seconds.
+var dnsTTLs = []int{60, 300, 900, 3600, 86400} //nolint:gochecknoglobals
+
+func dnsrecord(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	typ, err := info.GetString(m, "type")
+	if err != nil {
+		return nil, err
+	}
+
+	typ = strings.ToUpper(typ)
+
+	if typ == "ANY" {
+		typ = dnsRecordTypes[r.Intn(len(dnsRecordTypes))]
+	}
+
+	return newDNSRecord(r, dnsZone(r, safe), typ, safe)
+}
+
+// dnsZone returns a random domain name as fully qualified zone name, a reserved one in safe mode.
+func dnsZone(r *rand.Rand, safe bool) string {
+	return strings.ToLower(domainName(r, safe)) + "."
+}
+
+// newDNSRecord returns a random record of the type in the zone, with documentation addresses in safe mode.
+func newDNSRecord(r *rand.Rand, zone string, typ string, safe bool) (object, error) {
+	const (
+		maxPriority = 50
+		priorityBy  = 10
+		maxWeight   = 100
+	)
+
+	host := dnsHosts[r.Intn(len(dnsHosts))]
+	name := host + "." + zone
+
+	priority := func() int {
+		return priorityBy * (1 + r.Intn(maxPriority/priorityBy))
+	}
+
+	var data string
+
+	switch typ {
+	case "A":
+		data = randomIPv4(r, safe).String()
+	case "AAAA":
+		data = randomIPv6(r, safe).String()
+	case "CNAME":
+		data = pickOf("lb", "edge", "origin", "proxy")(r) + strconv.Itoa(1+r.Intn(priorityBy)) + "." + zone
+	case "MX":
+		data = strconv.Itoa(priority()) + " mx" + strconv.Itoa(1+r.Intn(2)) + "." + zone
+		name = zone
+	case "TXT":
+		name, data = dnsTXT(r, zone)
+	case "SRV":
+		service := dnsServices[r.Intn(len(dnsServices))]
+		data = fmt.Sprintf("%d %d %d %s", priority(), r.Intn(maxWeight+1), service.port, name)
+		name = service.name + "." + zone
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownRecordType, typ)
+	}
+
+	return object{
+		{"name", name},
+		{"type", typ},
+		{"ttl", dnsTTLs[r.Intn(len(dnsTTLs))]},
+		{"data", data},
+	}, nil
+}
+
+// dnsTXT returns the name and the quoted data of a TXT record: SPF, DMARC or site verification.
+func dnsTXT(r *rand.Rand, zone string) (string, string) {
+	const (
+		kinds       = 3
+		tokenLength = 43
+		tokenChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	)
+
+	domain := strings.TrimSuffix(zone, ".")
+
+	switch r.Intn(kinds) {
+	case 0:
+		return zone, `"v=spf1 mx include:_spf.` + domain + ` ~all"`
+	case 1:
+		return "_dmarc." + zone, `"v=DMARC1; p=` + pickOf("none", "quarantine", "reject")(r) + `; rua=mailto:dmarc@` + domain + `"`
+	default:
+		token := make([]byte, tokenLength)
+
+		for idx := range token {
+			token[idx] = tokenChars[r.Intn(len(tokenChars))]
+		}
+
+		return zone, `"site-verification=` + string(token) + `"`
+	}
+}
+
+func zonefile(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	const (
+		ttl     = 3600
+		refresh = 7200
+		retry   = 3600
+		expire  = 1209600
+	)
+
+	count, err := info.GetInt(m, "records")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCount(count); err != nil {
+		return nil, err
+	}
+
+	zone := dnsZone(r, safe)
+	serial := time.Now().UTC().Format("20060102") + "01"
+
+	var buff strings.Builder
+
+	fmt.Fprintf(&buff, "$ORIGIN %s\n$TTL %d\n", zone, ttl)
+	fmt.Fprintf(&buff, "@\t%d\tIN\tSOA\tns1.%s hostmaster.%s %s %d %d %d %d\n", ttl, zone, zone, serial, refresh, retry, expire, ttl)
+	fmt.Fprintf(&buff, "@\t%d\tIN\tNS\tns1.%s\n@\t%d\tIN\tNS\tns2.%s\n", ttl, zone, ttl, zone)
+
+	// a name with CNAME record can not have other records and records of a set must have the same TTL
+	types := make(map[string]string, count)
+	ttls := make(map[string]any, count)
+
+	for written := 0; written < count; {
+		record, err := newDNSRecord(r, zone, dnsRecordTypes[r.Intn(len(dnsRecordTypes))], safe)
+		if err != nil {
+			return nil, err
+		}
+
+		name, typ := record.get("name").(string), record.get("type").(string) //nolint:forcetypeassert
+
+		if prev, found := types[name]; found && (prev == "CNAME" || typ == "CNAME") {
+			continue
+		}
+
+		types[name] = typ
+
+		set := name + " " + typ
+		if _, found := ttls[set]; !found {
+			ttls[set] = record.get("ttl")
+		}
+
+		fmt.Fprintf(&buff, "%s\t%d\tIN\t%s\t%s\n", name, ttls[set], typ, record.get("data"))
+
+		written++
+	}
+
+	return buff.String(), nil
+}
@@ -0,0 +1,112 @@
+package faker_test
+
+import (
+	"net/netip"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_dnsrecord(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("dnsrecord")
+
+	require.NotNil(t, info)
+
+	fqdn := `([_a-z0-9-]+\.)+`
+	formats := map[string]*regexp.Regexp{
+		"CNAME": regexp.MustCompile(`^` + fqdn + `$`),
+		"MX":    regexp.MustCompile(`^[0-9]+ ` + fqdn + `$`),
+		"TXT":   regexp.MustCompile(`^"[^"]+"$`),
+		"SRV":   regexp.MustCompile(`^[0-9]+ [0-9]+ [0-9]+ ` + fqdn + `$`),
+	}
+
+	r := testRand(t)
+
+	for _, typ := range []string{"A", "AAAA", "CNAME", "MX", "TXT", "srv"} {
+		params := gofakeit.NewMapParams()
+		params.Add("type", typ)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		record := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+		typ = strings.ToUpper(typ)
+
+		require.Equal(t, typ, record["type"])
+		require.Regexp(t, regexp.MustCompile(`^`+fqdn+`$`), record["name"])
+		require.Positive(t, record["ttl"])
+
+		data := record["data"].(string) //nolint:forcetypeassert
+
+		switch typ {
+		case "A", "AAAA":
+			addr, err := netip.ParseAddr(data)
+
+			require.NoError(t, err)
+			require.Equal(t, typ == "A", addr.Is4())
+		default:
+			require.Regexp(t, formats[typ], data)
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("type", "HINFO")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown DNS record type: HINFO")
+}
+
+func Test_zonefile(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("zonefile")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("records", "100")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(val.(string), "\n"), "\n") //nolint:forcetypeassert
+
+	require.Len(t, lines, 2+3+100)
+	require.True(t, strings.HasPrefix(lines[0], "$ORIGIN "))
+	require.True(t, strings.HasPrefix(lines[1], "$TTL "))
+	require.Contains(t, lines[2], "\tIN\tSOA\t")
+
+	types := make(map[string][]string)
+
+	for _, line := range lines[2:] {
+		fields := strings.Split(line, "\t")
+
+		require.Len(t, fields, 5, line)
+		require.Equal(t, "IN", fields[2])
+
+		types[fields[0]] = append(types[fields[0]], fields[3])
+	}
+
+	for name, list := range types {
+		if len(list) > 1 {
+			require.NotContains(t, list, "CNAME", name)
+		}
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("records", "100001")
+
+	_, err = info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "too many items: 100001")
+}
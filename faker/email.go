@@ -0,0 +1,139 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidDomain = errors.New("invalid domain name")
+	errInvalidEmail  = errors.New("invalid email address")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("emailwithdomain", gofakeit.Info{
+		Display:     "Email With Domain",
+		Category:    "internet",
+		Description: "Realistic email address in the given (test owned) domain",
+		Example:     "linda.garcia42@test.example.com",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "domain",
+				Display:     "Domain",
+				Type:        "string",
+				Default:     "example.com",
+				Description: "Domain name of the email address",
+			},
+		},
+		Generate: emailwithdomain,
+	})
+
+	gofakeit.AddFuncLookup("emailplustag", gofakeit.Info{
+		Display:     "Email Plus Tag",
+		Category:    "internet",
+		Description: "Plus addressed variant (user+tag@domain) of the email address, delivered to the same mailbox",
+		Example:     "qa+k3n8x2pq@example.com",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "base",
+				Display:     "Base",
+				Type:        "string",
+				Default:     "qa@example.com",
+				Description: "Email address of the mailbox",
+			},
+		},
+		Generate: emailplustag,
+	})
+
+	gofakeit.AddFuncLookup("disposableemail", gofakeit.Info{
+		Display:     "Disposable Email",
+		Category:    "internet",
+		Description: "Email address of a public disposable mailbox provider",
+		Example:     "lindagarcia@mailinator.com",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return randomLocalPart(r) + "@" + disposableDomains[r.Intn(len(disposableDomains))], nil
+		},
+	})
+}
+
+// disposableDomains contains the domains of public disposable mailbox providers.
+var disposableDomains = []string{ //nolint:gochecknoglobals
+	"mailinator.com", "guerrillamail.com", "sharklasers.com", "yopmail.com", "maildrop.cc",
+	"dispostable.com", "mailnesia.com", "10minutemail.com", "temp-mail.org", "trashmail.com",
+}
+
+// domainRegexp matches the (case insensitive) domain names of letters, digits and hyphens.
+var domainRegexp = regexp.MustCompile( //nolint:gochecknoglobals
+	`(?i)^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`,
+)
+
+// Length of the random tag of plus addressed emails.
+const plusTagLength = 8
+
+func emailwithdomain(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	domain, err := info.GetString(m, "domain")
+	if err != nil {
+		return nil, err
+	}
+
+	domain = strings.TrimPrefix(domain, "@")
+
+	if !domainRegexp.MatchString(domain) {
+		return nil, fmt.Errorf("%w: %s", errInvalidDomain, domain)
+	}
+
+	return randomLocalPart(r) + "@" + strings.ToLower(domain), nil
+}
+
+func emailplustag(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	base, err := info.GetString(m, "base")
+	if err != nil {
+		return nil, err
+	}
+
+	local, domain, found := strings.Cut(base, "@")
+	if !found || len(local) == 0 || strings.ContainsAny(local, " \t") || !domainRegexp.MatchString(domain) {
+		return nil, fmt.Errorf("%w: %s", errInvalidEmail, base)
+	}
+
+	tag := make([]byte, plusTagLength)
+
+	for idx := range tag {
+		tag[idx] = alphanum[r.Intn(len(alphanum))]
+	}
+
+	return local + "+" + string(tag) + "@" + domain, nil
+}
+
+// randomLocalPart returns the local part of an email address based on a random name.
+func randomLocalPart(r *rand.Rand) string {
+	fake := &gofakeit.Faker{Rand: r}
+
+	return localPartOf(r, emailPart(fake.FirstName()), emailPart(fake.LastName()))
+}
+
+// localPartOf returns the local part of an email address of the name parts joined by a separator,
+// sometimes followed by a number.
+func localPartOf(r *rand.Rand, first string, last string) string {
+	const (
+		numberRate = 3
+		maxNumber  = 100
+	)
+
+	local := first + pickOf(".", "_", "")(r) + last
+
+	if r.Intn(numberRate) == 0 {
+		local += strconv.Itoa(r.Intn(maxNumber))
+	}
+
+	return local
+}
@@ -0,0 +1,95 @@
+package faker_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_emailwithdomain(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("emailwithdomain")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("domain", "QA.Test-Corp.example")
+
+	for range 50 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, `^[a-z]+[._]?[a-z]+[0-9]{0,2}@qa\.test-corp\.example$`, val)
+	}
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(val.(string), "@example.com")) //nolint:forcetypeassert
+
+	for _, domain := range []string{"", "-bad.example", "bad..example", "bad example.com", "gmail.com@x"} {
+		params := gofakeit.NewMapParams()
+		params.Add("domain", domain)
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, "invalid domain name", domain)
+	}
+}
+
+func Test_emailplustag(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("emailplustag")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("base", "load.test@staging.example.com")
+
+	seen := make(map[string]struct{})
+
+	for range 50 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, `^load\.test\+[a-z0-9]{8}@staging\.example\.com$`, val)
+
+		seen[val.(string)] = struct{}{} //nolint:forcetypeassert
+	}
+
+	require.Len(t, seen, 50)
+
+	for _, base := range []string{"", "qa", "@example.com", "qa@", "q a@example.com", "qa@exa mple.com"} {
+		params := gofakeit.NewMapParams()
+		params.Add("base", base)
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, "invalid email address", base)
+	}
+}
+
+func Test_disposableemail(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("disposableemail")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 50 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, `^[a-z]+[._]?[a-z]+[0-9]{0,2}@[a-z0-9-]+\.[a-z]+$`, val)
+	}
+}
@@ -0,0 +1,324 @@
+package faker
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/grafana/sobek"
+)
+
+var errInvalidValue = errors.New("invalid value")
+
+// encoder returns the object containing the binary encoding methods (faker.encode).
+func (f *faker) encoder() *sobek.Object {
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("avro", f.encodeAvro)
+	_ = obj.Set("protobuf", f.encodeProtobuf)
+	_ = obj.Set("cbor", f.encodeCBOR)
+	_ = obj.Set("senml", f.encodeSenML)
+
+	return obj
+}
+
+// encodeAvro encodes the record in Avro binary format using the schema (JSON string or object).
+// If the record is missing, a random record of the schema is generated.
+// If the schemaId option is set, the data is prefixed with the schema registry wire format header.
+func (f *faker) encodeAvro(schema sobek.Value, record sobek.Value, options sobek.Value) sobek.ArrayBuffer {
+	const method = "avro"
+
+	src := f.sourceArgument(method, "schema", schema)
+
+	compiled, found := f.codecs[method+":"+src].(*avroSchema)
+	if !found {
+		var err error
+
+		if compiled, err = parseAvroSchema(src); err != nil {
+			f.throw(&ArgumentError{Function: method, Parameter: "schema", Expected: "Avro schema", Reason: err.Error()})
+		}
+
+		f.codecs[method+":"+src] = compiled
+	}
+
+	var val any
+
+	if record == nil || sobek.IsUndefined(record) || sobek.IsNull(record) {
+		f.rescope()
+
+		val = compiled.generate(f.rand, f.opts.safe, "", 0)
+	} else {
+		val = record.Export()
+	}
+
+	data, err := compiled.encode(f.wireHeader(method, options), val)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "record", Reason: err.Error()})
+	}
+
+	return f.runtime.NewArrayBuffer(data)
+}
+
+// encodeProtobuf encodes the record in protobuf binary format using the message type of the .proto definition.
+// The message type is the first one in the definition, unless the message option is set.
+// If the schemaId option is set, the data is prefixed with the schema registry wire format header.
+func (f *faker) encodeProtobuf(descriptor sobek.Value, record sobek.Value, options sobek.Value) sobek.ArrayBuffer {
+	const method = "protobuf"
+
+	src := f.sourceArgument(method, "descriptor", descriptor)
+
+	compiled, found := f.codecs[method+":"+src].(*protoFile)
+	if !found {
+		var err error
+
+		if compiled, err = parseProto(src); err != nil {
+			f.throw(&ArgumentError{Function: method, Parameter: "descriptor", Expected: "string", Reason: err.Error()})
+		}
+
+		f.codecs[method+":"+src] = compiled
+	}
+
+	var name string
+
+	if isOptions(options) {
+		if val := options.ToObject(f.runtime).Get("message"); val != nil && !sobek.IsUndefined(val) {
+			name = val.String()
+		}
+	}
+
+	msg, err := compiled.message(name)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "message", Expected: "string", Reason: err.Error()})
+	}
+
+	if record == nil || sobek.IsUndefined(record) || sobek.IsNull(record) {
+		f.throw(&ArgumentError{Function: method, Parameter: "record", Expected: "object", Reason: "missing parameter"})
+	}
+
+	buff := f.wireHeader(method, options)
+
+	if len(buff) != 0 {
+		buff = appendMessageIndexes(buff, msg.index)
+	}
+
+	data, err := msg.encode(buff, record.Export())
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "record", Reason: err.Error()})
+	}
+
+	return f.runtime.NewArrayBuffer(data)
+}
+
+// encodeCBOR encodes the value in CBOR format (RFC 8949).
+func (f *faker) encodeCBOR(value sobek.Value) sobek.ArrayBuffer {
+	const method = "cbor"
+
+	var val any
+
+	if value != nil {
+		val = value.Export()
+	}
+
+	data, err := appendCBOR(nil, val)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "value", Reason: err.Error()})
+	}
+
+	return f.runtime.NewArrayBuffer(data)
+}
+
+// encodeSenML encodes the records (or the single record) as SenML pack, in CBOR format unless the format
+// option is json. The records are SenML records or sensor readings, e.g. of iot.sensorReading.
+func (f *faker) encodeSenML(records sobek.Value, options sobek.Value) sobek.ArrayBuffer {
+	const method = "senml"
+
+	format, baseName := senmlCBOR, ""
+
+	if options != nil && !sobek.IsUndefined(options) && !sobek.IsNull(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("format"); val != nil && !sobek.IsUndefined(val) {
+			format = val.String()
+		}
+
+		if val := opts.Get("baseName"); val != nil && !sobek.IsUndefined(val) {
+			baseName = val.String()
+		}
+	}
+
+	var items []any
+
+	if records != nil {
+		switch val := records.Export().(type) {
+		case []any:
+			items = val
+		case map[string]any:
+			items = []any{val}
+		}
+	}
+
+	if items == nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "records", Expected: "object or array", Reason: "invalid value"})
+	}
+
+	pack := make([]map[string]any, len(items))
+
+	for idx, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: "records", Expected: "object", Reason: fmt.Sprintf("invalid item %d", idx),
+			})
+		}
+
+		var err error
+
+		if pack[idx], err = senmlRecord(obj); err != nil {
+			f.throw(&ArgumentError{Function: method, Parameter: "records", Reason: fmt.Sprintf("[%d]: %s", idx, err)})
+		}
+	}
+
+	data, err := senmlPack(pack, format, baseName)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "format", Expected: "cbor or json", Reason: err.Error()})
+	}
+
+	return f.runtime.NewArrayBuffer(data)
+}
+
+// sourceArgument returns the schema argument as string, objects are converted to JSON.
+func (f *faker) sourceArgument(method string, param string, val sobek.Value) string {
+	if str, isString := val.Export().(string); isString {
+		return str
+	}
+
+	if !isOptions(val) {
+		f.throw(&ArgumentError{Function: method, Parameter: param, Expected: "string or object", Reason: "invalid value"})
+	}
+
+	data, err := json.Marshal(val.Export())
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: param, Expected: "string or object", Reason: err.Error()})
+	}
+
+	return string(data)
+}
+
+// wireHeader returns the header of the schema registry wire format (magic byte and schema ID)
+// if the schemaId option is set, otherwise an empty buffer.
+func (f *faker) wireHeader(method string, options sobek.Value) []byte {
+	if options == nil || sobek.IsUndefined(options) || sobek.IsNull(options) {
+		return nil
+	}
+
+	opts := f.objectArgument(method, "options", options)
+
+	val := opts.Get("schemaId")
+	if val == nil || sobek.IsUndefined(val) {
+		return nil
+	}
+
+	id, ok := integerOf(val.Export())
+	if !ok || id < 0 || id > math.MaxUint32 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "schemaId", Expected: "number", Reason: "invalid value " + val.String(),
+		})
+	}
+
+	return binary.BigEndian.AppendUint32([]byte{0}, uint32(id))
+}
+
+// appendMessageIndexes appends the message indexes of the protobuf schema registry wire format,
+// the indexes of the first message ([0]) are encoded as a single 0 byte.
+func appendMessageIndexes(buff []byte, index []int) []byte {
+	if len(index) == 1 && index[0] == 0 {
+		return append(buff, 0)
+	}
+
+	buff = binary.AppendVarint(buff, int64(len(index)))
+
+	for _, idx := range index {
+		buff = binary.AppendVarint(buff, int64(idx))
+	}
+
+	return buff
+}
+
+// integerOf returns the value as an integer: integral numbers, big integers and numeric strings are accepted.
+func integerOf(val any) (int64, bool) {
+	switch num := val.(type) {
+	case int64:
+		return num, true
+	case int:
+		return int64(num), true
+	case float64:
+		if num != math.Trunc(num) || math.Abs(num) > math.MaxInt64 {
+			return 0, false
+		}
+
+		return int64(num), true
+	case *big.Int:
+		return num.Int64(), num.IsInt64()
+	case string:
+		parsed, err := strconv.ParseInt(num, 10, 64)
+
+		return parsed, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// unsignedOf returns the value as a non-negative integer.
+func unsignedOf(val any) (uint64, bool) {
+	switch num := val.(type) {
+	case *big.Int:
+		return num.Uint64(), num.IsUint64()
+	case string:
+		parsed, err := strconv.ParseUint(num, 10, 64)
+
+		return parsed, err == nil
+	default:
+		signed, ok := integerOf(val)
+
+		return uint64(signed), ok && signed >= 0 //nolint:gosec
+	}
+}
+
+// floatOf returns the value as a floating point number.
+func floatOf(val any) (float64, bool) {
+	switch num := val.(type) {
+	case float64:
+		return num, true
+	case int64:
+		return float64(num), true
+	case int:
+		return float64(num), true
+	default:
+		return 0, false
+	}
+}
+
+// bytesOf returns the content of a string, a byte slice or an ArrayBuffer.
+func bytesOf(val any) ([]byte, bool) {
+	switch data := val.(type) {
+	case string:
+		return []byte(data), true
+	case []byte:
+		return data, true
+	case sobek.ArrayBuffer:
+		return data.Bytes(), true
+	default:
+		return nil, false
+	}
+}
+
+// isString reports whether the value is a string.
+func isString(val any) bool {
+	_, ok := val.(string)
+
+	return ok
+}
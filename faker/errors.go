@@ -0,0 +1,154 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/grafana/sobek"
+	"github.com/iancoleman/strcase"
+)
+
+// docsURL contains the base URL of the API documentation.
+const docsURL = "https://faker.x.k6.io"
+
+// ArgumentError is returned when a generator function is called with invalid arguments.
+// It is thrown as FakerArgumentError on the JavaScript side.
+type ArgumentError struct {
+	// Function is the name of the generator function.
+	Function string
+	// Category is the category of the generator function.
+	Category string
+	// Parameter is the name of the invalid parameter, empty if not parameter specific.
+	Parameter string
+	// Expected is the expected type of the parameter.
+	Expected string
+	// Reason describes the problem.
+	Reason string
+}
+
+// Error implements error interface.
+func (e *ArgumentError) Error() string {
+	var buff strings.Builder
+
+	buff.WriteString(e.Function)
+	buff.WriteString(": ")
+
+	if len(e.Parameter) != 0 {
+		fmt.Fprintf(&buff, "parameter %s: ", e.Parameter)
+	}
+
+	buff.WriteString(e.Reason)
+
+	if len(e.Expected) != 0 {
+		fmt.Fprintf(&buff, " (expected %s)", e.Expected)
+	}
+
+	return buff.String()
+}
+
+// Docs returns the API documentation link of the generator function.
+func (e *ArgumentError) Docs() string {
+	return docsLink(e.Category, e.Function)
+}
+
+// LookupError is returned when a generator function cannot be found by name.
+// It is thrown as FakerLookupError on the JavaScript side.
+type LookupError struct {
+	// Function is the name of the requested generator function.
+	Function string
+}
+
+// Error implements error interface.
+func (e *LookupError) Error() string {
+	return fmt.Sprintf("no such generator function: %s", e.Function)
+}
+
+// Docs returns the API documentation link.
+func (e *LookupError) Docs() string {
+	return docsURL
+}
+
+func docsLink(category, function string) string {
+	if len(category) == 0 {
+		return docsURL
+	}
+
+	return fmt.Sprintf("%s/interfaces/%s.html#%s", docsURL, strcase.ToCamel(category), strings.ToLower(function))
+}
+
+// jsType returns the JavaScript type name of a gofakeit parameter type.
+func jsType(src string) string {
+	var array bool
+	if array = strings.HasPrefix(src, "[]"); array {
+		src = src[2:]
+	}
+
+	switch src {
+	case "bool":
+		src = "boolean"
+	case "float", "float32", "float64",
+		"byte", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		src = "number"
+	case "any":
+		src = "unknown"
+	}
+
+	if array {
+		src += "[]"
+	}
+
+	return src
+}
+
+func isNumberType(src string) bool {
+	return jsType(src) == "number"
+}
+
+// isKnownType reports whether the gofakeit parameter type can be converted from JavaScript value directly.
+func isKnownType(src string) bool {
+	switch jsType(src) {
+	case "string", "number", "boolean", "string[]", "number[]", "boolean[]":
+		return true
+	default:
+		return false
+	}
+}
+
+// throw panics with JavaScript error object created from err.
+func (f *faker) throw(err error) {
+	var (
+		argErr    *ArgumentError
+		lookupErr *LookupError
+	)
+
+	switch {
+	case errors.As(err, &argErr):
+		panic(f.newError("FakerArgumentError", argErr, map[string]string{
+			"function":  argErr.Function,
+			"parameter": argErr.Parameter,
+			"expected":  argErr.Expected,
+			"docs":      argErr.Docs(),
+		}))
+	case errors.As(err, &lookupErr):
+		panic(f.newError("FakerLookupError", lookupErr, map[string]string{
+			"function": lookupErr.Function,
+			"docs":     lookupErr.Docs(),
+		}))
+	default:
+		panic(f.runtime.NewGoError(err))
+	}
+}
+
+// newError creates a TypeError object with the given name and additional properties.
+func (f *faker) newError(name string, err error, props map[string]string) *sobek.Object {
+	obj := f.runtime.NewTypeError(err.Error())
+
+	_ = obj.Set("name", name)
+
+	for key, value := range props {
+		_ = obj.Set(key, value)
+	}
+
+	return obj
+}
@@ -0,0 +1,446 @@
+package faker
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/grafana/sobek"
+)
+
+// constraint reports whether the value satisfies a constraint derived from the arguments of the generator function.
+type constraint func(value any) bool
+
+//nolint:gochecknoglobals
+var (
+	// lengthParams contains the parameters defining the exact length of the output of the generator functions,
+	// the number of characters of strings (after the prefix parameter) or the number of items of arrays and objects.
+	lengthParams = map[string]string{
+		"digitN":               "count",
+		"letterN":              "count",
+		"password":             "length",
+		"nanoid":               "length",
+		"identifier":           "length",
+		"cartItems":            "count",
+		"labelSet":             "count",
+		"telemetryBatch":       "count",
+		"playerTelemetryBatch": "count",
+		"gpsTrace":             "points",
+		"dice":                 "numdice",
+	}
+
+	// rangeDecimals contains the number of decimals the output of the generator functions with min and max
+	// parameters is rounded to, the value may exceed the range by the rounding (see also the precision parameter).
+	rangeDecimals = map[string]int{
+		"price":  2,
+		"amount": 2,
+	}
+
+	// templateParams contains the template parameters of the generator functions
+	// and the patterns of the placeholder characters of the template.
+	templateParams = map[string]struct {
+		param        string
+		placeholders map[rune]string
+	}{
+		"sku":      {param: "pattern", placeholders: map[rune]string{'#': "[0-9]", '?': "[A-Z]"}},
+		"numerify": {param: "str", placeholders: map[rune]string{'#': "[0-9]"}},
+		"lexify":   {param: "str", placeholders: map[rune]string{'?': "[a-zA-Z]"}},
+	}
+
+	// dateFormats contains the time layouts of the named date formats (see the format parameter of date).
+	dateFormats = map[string]string{
+		"ANSIC":       time.ANSIC,
+		"UnixDate":    time.UnixDate,
+		"RubyDate":    time.RubyDate,
+		"RFC822":      time.RFC822,
+		"RFC822Z":     time.RFC822Z,
+		"RFC850":      time.RFC850,
+		"RFC1123":     time.RFC1123,
+		"RFC1123Z":    time.RFC1123Z,
+		"RFC3339":     time.RFC3339,
+		"RFC3339Nano": time.RFC3339Nano,
+	}
+)
+
+// expectations returns a check function validating a response body against the schema.
+// The check function accepts a k6 HTTP response, a JSON string or an object.
+// Besides the type, the fields are checked against the constraints derived from the arguments
+// of the generator functions: the range of min and max, the length and the format.
+func (f *faker) expectations(schemaVal sobek.Value) sobek.Value {
+	compiled := f.compileSchema("expectations", schemaVal)
+
+	f.constrain(compiled)
+
+	return f.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
+		return f.runtime.ToValue(compiled.match(f.exportBody(call.Argument(0))))
+	})
+}
+
+// exportBody exports the body of the value for validation.
+func (f *faker) exportBody(val sobek.Value) any {
+	if obj, ok := val.(*sobek.Object); ok && obj.ClassName() == "Object" {
+		if body := obj.Get("body"); body != nil && !sobek.IsUndefined(body) {
+			val = body
+		}
+	}
+
+	if str, ok := val.Export().(string); ok {
+		var data any
+
+		if json.Unmarshal([]byte(str), &data) != nil {
+			return nil
+		}
+
+		return data
+	}
+
+	return val.Export()
+}
+
+// match reports whether the data conforms to the schema.
+func (s *schema) match(data any) bool {
+	dict, ok := data.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	for _, field := range s.fields {
+		value, found := dict[field.name]
+		if !found && field.missing > 0 {
+			continue
+		}
+
+		if !found || !field.match(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (field *schemaField) match(value any) bool {
+	if value == nil && field.nullable > 0 {
+		return true
+	}
+
+	if len(field.variants) != 0 {
+		return slices.ContainsFunc(field.variants, func(variant *schemaField) bool { return variant.match(value) })
+	}
+
+	if field.nested != nil {
+		return field.nested.match(value)
+	}
+
+	if field.info == nil {
+		return value != nil
+	}
+
+	if !kindMatch(outputKind(field.info.Output), value) {
+		return false
+	}
+
+	for _, check := range field.constraints {
+		if !check(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// constrain sets the constraints of the fields of the schema, including the nested fields and the variants.
+func (f *faker) constrain(s *schema) {
+	for _, field := range s.fields {
+		if field.nested != nil {
+			f.constrain(field.nested)
+		}
+
+		f.constrain(&schema{fields: field.variants})
+
+		field.constraints = f.fieldConstraints(field)
+	}
+}
+
+// fieldConstraints returns the constraints of the field derived from the arguments of the generator function.
+func (f *faker) fieldConstraints(field *schemaField) []constraint {
+	if field.info == nil || len(field.info.Params) == 0 {
+		return nil
+	}
+
+	params := field.params
+	if !field.prepared {
+		params = f.toMapParams(field.function, field.info, sobek.FunctionCall{This: sobek.Undefined(), Arguments: field.args})
+	}
+
+	if params == nil {
+		return nil
+	}
+
+	name := resolveFunc(field.function)
+	param := func(name string) (string, bool) {
+		values, found := (*params)[name]
+
+		return firstOf(values), found
+	}
+
+	var constraints []constraint
+
+	if low, high, found := f.fieldRange(field); found && outputKind(field.info.Output) == "number" {
+		constraints = append(constraints, rangeConstraint(name, field.info.Output, low, high, param))
+	}
+
+	if check := lengthConstraint(name, param); check != nil {
+		constraints = append(constraints, check)
+	}
+
+	if check := formatConstraint(name, param); check != nil {
+		constraints = append(constraints, check)
+	}
+
+	return constraints
+}
+
+// rangeConstraint checks that the number is between low and high, allowing the rounding of the output.
+func rangeConstraint(name string, output string, low, high float64, param func(string) (string, bool)) constraint {
+	decimals, rounded := rangeDecimals[name]
+
+	if precision, found := param("precision"); found {
+		decimals, _ = strconv.Atoi(precision)
+		rounded = decimals >= 0
+	}
+
+	var tolerance float64
+
+	if rounded {
+		tolerance = math.Pow10(-decimals)
+	}
+
+	return func(value any) bool {
+		num, ok := toFloat(value)
+		if !ok {
+			return false
+		}
+
+		if output == "float32" {
+			return float32(num) >= float32(low-tolerance) && float32(num) <= float32(high+tolerance)
+		}
+
+		return num >= low-tolerance && num <= high+tolerance
+	}
+}
+
+// lengthConstraint returns the check of the length of the value, nil if the length is not defined by the parameters.
+// The length is defined by the parameter in lengthParams, by the minLength and maxLength parameters
+// (maxLength 0 means exactly minLength) or in bytes by the bytes (exact) and maxBytes parameters.
+func lengthConstraint(name string, param func(string) (string, bool)) constraint {
+	number := func(name string) (int, bool) {
+		str, found := param(name)
+		if !found {
+			return 0, false
+		}
+
+		num, err := strconv.Atoi(str)
+
+		return num, err == nil
+	}
+
+	if lengthParam, found := lengthParams[name]; found {
+		length, ok := number(lengthParam)
+		if !ok {
+			return nil
+		}
+
+		prefix, _ := param("prefix")
+
+		return func(value any) bool {
+			if str, isString := value.(string); isString {
+				rest, found := strings.CutPrefix(str, prefix)
+
+				return found && utf8.RuneCountInString(rest) == length
+			}
+
+			val := reflect.ValueOf(value)
+
+			//nolint:exhaustive
+			switch val.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				return val.Len() == length
+			default:
+				return false
+			}
+		}
+	}
+
+	if minLength, found := number("minLength"); found {
+		maxLength, _ := number("maxLength")
+		if maxLength == 0 {
+			maxLength = minLength
+		}
+
+		return func(value any) bool {
+			str, ok := value.(string)
+			length := utf8.RuneCountInString(str)
+
+			return ok && length >= minLength && length <= maxLength
+		}
+	}
+
+	if bytes, found := number("bytes"); found {
+		return func(value any) bool {
+			str, ok := value.(string)
+
+			return ok && len(str) == bytes
+		}
+	}
+
+	if maxBytes, found := number("maxBytes"); found && maxBytes > 0 {
+		return func(value any) bool {
+			str, ok := value.(string)
+
+			return ok && len(str) <= maxBytes
+		}
+	}
+
+	return nil
+}
+
+// formatConstraint returns the check of the format of the string value, nil if the format is not defined
+// by the parameters: the template of the functions in templateParams or the date format of date and dateRange.
+func formatConstraint(name string, param func(string) (string, bool)) constraint {
+	if template, found := templateParams[name]; found {
+		str, _ := param(template.param)
+
+		var pattern strings.Builder
+
+		for _, char := range str {
+			if class, placeholder := template.placeholders[char]; placeholder {
+				pattern.WriteString(class)
+			} else {
+				pattern.WriteString(regexp.QuoteMeta(string(char)))
+			}
+		}
+
+		re := regexp.MustCompile("^" + pattern.String() + "$")
+
+		return func(value any) bool {
+			str, ok := value.(string)
+
+			return ok && re.MatchString(str)
+		}
+	}
+
+	if name != "date" && name != "dateRange" {
+		return nil
+	}
+
+	format, _ := param("format")
+
+	layout, named := dateFormats[format]
+
+	switch {
+	case named:
+	case len(format) == 0:
+		layout = time.RFC3339
+	default:
+		layout = javaDateLayout(format)
+	}
+
+	return func(value any) bool {
+		str, ok := value.(string)
+		if !ok {
+			return false
+		}
+
+		_, err := time.Parse(layout, str)
+
+		return err == nil
+	}
+}
+
+// javaDateLayout converts the Java date format to time layout, like the date functions of gofakeit.
+func javaDateLayout(format string) string {
+	for _, pair := range [][2]string{
+		{"ddd", "_2"}, {"dd", "02"}, {"d", "2"}, {"HH", "15"}, {"hh", "03"}, {"h", "3"},
+		{"mm", "04"}, {"m", "4"}, {"ss", "05"}, {"s", "5"}, {"yyyy", "2006"}, {"yy", "06"}, {"y", "06"},
+		{"SSS", "000"}, {"a", "pm"}, {"aa", "PM"}, {"MMMM", "January"}, {"MMM", "Jan"}, {"MM", "01"}, {"M", "1"},
+		{"ZZ", "-0700"},
+	} {
+		format = strings.ReplaceAll(format, pair[0], pair[1])
+	}
+
+	if !strings.Contains(format, "Z07") {
+		format = strings.ReplaceAll(format, "Z", "-07")
+	}
+
+	for _, pair := range [][2]string{
+		{"zz:zz", "Z07:00"}, {"zzzz", "Z0700"}, {"z", "MST"}, {"EEEE", "Monday"}, {"E", "Mon"},
+	} {
+		format = strings.ReplaceAll(format, pair[0], pair[1])
+	}
+
+	return format
+}
+
+// toFloat returns the number value as float64, false if the value is not a number.
+func toFloat(value any) (float64, bool) {
+	val := reflect.ValueOf(value)
+
+	//nolint:exhaustive
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(val.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(val.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return val.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// outputKind returns the JSON kind of the gofakeit output type, empty if any kind is acceptable.
+func outputKind(output string) string {
+	switch typ := jsType(output); {
+	case typ == "string", typ == "number", typ == "boolean":
+		return typ
+	case strings.HasSuffix(typ, "[]"):
+		return "array"
+	case strings.HasPrefix(typ, "map["):
+		return "object"
+	default:
+		return ""
+	}
+}
+
+func kindMatch(kind string, value any) bool {
+	if len(kind) == 0 {
+		return true
+	}
+
+	if value == nil {
+		return false
+	}
+
+	switch reflect.TypeOf(value).Kind() { //nolint:exhaustive
+	case reflect.String:
+		return kind == "string"
+	case reflect.Bool:
+		return kind == "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return kind == "number"
+	case reflect.Slice, reflect.Array:
+		return kind == "array"
+	case reflect.Map, reflect.Struct:
+		return kind == "object"
+	default:
+		return false
+	}
+}
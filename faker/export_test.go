@@ -0,0 +1,39 @@
+package faker
+
+import "testing"
+
+// SetDeprecations replaces the deprecated function and category names until the end of the test.
+// The tests using it must not run in parallel.
+func SetDeprecations(t testing.TB, functions, categories map[string]Deprecation) {
+	t.Helper()
+
+	prevFunctions, prevCategories := deprecations, categoryDeprecations
+	deprecations, categoryDeprecations = functions, categories
+
+	t.Cleanup(func() { deprecations, categoryDeprecations = prevFunctions, prevCategories })
+}
+
+// PlainMaps returns the generated value with the ordered objects converted to maps,
+// so the tests can access the properties by name.
+func PlainMaps(val any) any {
+	switch val := val.(type) {
+	case object:
+		entries := make(map[string]any, len(val))
+
+		for _, prop := range val {
+			entries[prop.name] = PlainMaps(prop.value)
+		}
+
+		return entries
+	case []object:
+		items := make([]map[string]any, len(val))
+
+		for idx, item := range val {
+			items[idx], _ = PlainMaps(item).(map[string]any)
+		}
+
+		return items
+	default:
+		return val
+	}
+}
@@ -2,18 +2,98 @@
 package faker
 
 import (
+	"math"
 	"math/rand"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/js/modules"
 	"lukechampine.com/frand"
 )
 
 // Constructor is a Faker class constructor.
 func Constructor(call sobek.ConstructorCall, runtime *sobek.Runtime) *sobek.Object {
-	seed := call.Argument(0).ToInteger()
+	return construct(call, runtime, nil, nil, nil, nil)
+}
+
+// NewConstructor returns a Faker class constructor bound to the given k6 VU.
+// In the init context the data generation metrics are also registered, so they can be enabled
+// by the metrics constructor option, and the recording is enabled if the XK6_FAKER_RECORD environment variable is set.
+// The instances created by the constructor share the mock servers of the VU (see serve).
+func NewConstructor(vu modules.VU) func(sobek.ConstructorCall, *sobek.Runtime) *sobek.Object {
+	mtr := registerMetrics(vu)
+	rec := openRecorder(vu)
+	srv := newMockServers()
+
+	return func(call sobek.ConstructorCall, runtime *sobek.Runtime) *sobek.Object {
+		return construct(call, runtime, vu, mtr, rec, srv)
+	}
+}
+
+func construct(
+	call sobek.ConstructorCall, runtime *sobek.Runtime,
+	vu modules.VU, mtr *fakerMetrics, rec *recorder, srv *mockServers,
+) *sobek.Object {
+	var (
+		seed int64
+		opts *options
+	)
+
+	if isOptions(call.Argument(0)) {
+		opts = parseOptions(runtime, call.Argument(0))
+		seed = opts.seed
+	} else {
+		seed = call.Argument(0).ToInteger()
+		opts = parseOptions(runtime, call.Argument(1))
+	}
+
+	faker := newFakerWithOptions(seed, opts, runtime)
+	faker.vu = vu
+	faker.recorder = rec
+	faker.servers = srv
+
+	if opts.metrics {
+		faker.enableMetrics(mtr)
+	}
+
+	return runtime.NewDynamicObject(faker)
+}
+
+// NewClass returns the Faker class bound to the given k6 VU (which can be nil).
+// Unlike Constructor, the class also contains the static methods of Faker (fromState and restore).
+// The instances of the class share the mock servers of the VU (see serve).
+func NewClass(runtime *sobek.Runtime, vu modules.VU) *sobek.Object {
+	mtr := registerMetrics(vu)
+	rec := openRecorder(vu)
+	srv := newMockServers()
+	class := runtime.ToValue(func(call sobek.ConstructorCall, runtime *sobek.Runtime) *sobek.Object {
+		return construct(call, runtime, vu, mtr, rec, srv)
+	}).ToObject(runtime)
+
+	restore := func(state string) *sobek.Object {
+		faker, err := restoreFaker(state, runtime)
+		if err != nil {
+			panic(runtime.NewTypeError(err.Error()))
+		}
+
+		faker.vu = vu
+		faker.recorder = rec
+		faker.servers = srv
+
+		if faker.opts.metrics {
+			faker.enableMetrics(mtr)
+		}
 
-	return runtime.NewDynamicObject(newFaker(seed, runtime))
+		return runtime.NewDynamicObject(faker)
+	}
+
+	_ = class.Set("fromState", restore)
+	_ = class.Set("restore", restore)
+
+	return class
 }
 
 // New calls Faker constructor and returns new Faker object.
@@ -27,21 +107,84 @@ func New(seed int64, runtime *sobek.Runtime) *sobek.Object {
 	)
 }
 
+// NewForVU calls Faker constructor bound to the given k6 VU and returns new Faker object.
+func NewForVU(seed int64, vu modules.VU) *sobek.Object {
+	runtime := vu.Runtime()
+
+	return NewConstructor(vu)(
+		sobek.ConstructorCall{
+			This:      runtime.NewObject(),
+			Arguments: []sobek.Value{runtime.ToValue(seed)},
+		},
+		runtime,
+	)
+}
+
 // faker represents JavaScript Faker class.
 type faker struct {
 	rand    *rand.Rand
+	source  rand.Source64
+	seed    int64
+	scope   string
+	opts    *options
 	runtime *sobek.Runtime
+	vu      modules.VU
+	values  map[string]sobek.Value
+	// members contains the cached members of the nested objects (e.g. bound generator functions) by qualified
+	// name (e.g. person.email). They are kept apart from values, so they don't become properties of the instance.
+	members    map[string]sobek.Value
+	recipes    map[string]*schema
+	codecs     map[string]any
+	entities   map[string]*entities
+	metrics    *fakerMetrics
+	corpusKeys map[string]string
+	datasets   map[string]*loadedDataset
+	recorder   *recorder
+	servers    *mockServers
+	replay     *replay
+	sensors    map[string]float64
+	related    map[string][]sobek.Value
+	// compiling contains the schema objects being compiled, from the outermost (see compileSchema).
+	compiling []*sobek.Object
 }
 
 // newFaker creates new Faker instance.
 func newFaker(seed int64, runtime *sobek.Runtime) *faker {
-	src := frand.NewSource()
+	return newFakerWithOptions(seed, new(options), runtime)
+}
+
+// newFakerForVU creates new Faker instance with default options bound to the given k6 VU (which can be nil).
+// It is the engine of the adapters of the other import styles (compat and legacy), so they generate
+// the same values for the same seed as the Faker class.
+func newFakerForVU(seed int64, runtime *sobek.Runtime, vu modules.VU) *faker {
+	faker := newFaker(seed, runtime)
+	faker.vu = vu
+	faker.recorder = openRecorder(vu)
+
+	return faker
+}
 
-	if seed != 0 {
-		src.Seed(seed)
+// newFakerWithOptions creates new Faker instance using the constructor options.
+// If the seed is 0, a seed derived from system entropy is used.
+func newFakerWithOptions(seed int64, opts *options, runtime *sobek.Runtime) *faker {
+	if seed == 0 {
+		seed = int64(frand.Uint64n(math.MaxInt64)) + 1 //nolint:gosec
 	}
 
-	return &faker{rand: rand.New(src), runtime: runtime} //#nosec G404
+	source := newSource(opts.rng, seed)
+
+	return &faker{
+		rand:     rand.New(source), //#nosec G404
+		source:   source,
+		seed:     seed,
+		opts:     opts,
+		runtime:  runtime,
+		values:   make(map[string]sobek.Value),
+		members:  make(map[string]sobek.Value),
+		recipes:  make(map[string]*schema),
+		codecs:   make(map[string]any),
+		entities: make(map[string]*entities),
+	}
 }
 
 // Delete implements sobek.DynamicObject.
@@ -50,9 +193,92 @@ func (f *faker) Delete(_ string) bool {
 }
 
 // Get implements sobek.DynamicObject.
+// The returned values are cached, so methods and categories are allocated only once.
 func (f *faker) Get(key string) sobek.Value {
-	if key == "call" {
+	if val, found := f.values[key]; found {
+		return val
+	}
+
+	val := f.get(key)
+	if !sobek.IsUndefined(val) {
+		f.values[key] = val
+	}
+
+	return val
+}
+
+func (f *faker) get(key string) sobek.Value {
+	switch key {
+	case "call":
 		return f.runtime.ToValue(f.call)
+	case "tag":
+		return f.runtime.ToValue(f.tag)
+	case "correlate":
+		return f.runtime.ToValue(f.correlate)
+	case "many":
+		return f.runtime.ToValue(f.many)
+	case "iter":
+		return f.runtime.ToValue(f.iter)
+	case "fromSchema":
+		return f.runtime.ToValue(f.fromSchema)
+	case "collections":
+		return f.runtime.ToValue(f.collections)
+	case "changes":
+		return f.runtime.ToValue(f.changes)
+	case "lazy":
+		return f.runtime.ToValue(f.lazy)
+	case "compile":
+		return f.runtime.ToValue(f.compile)
+	case "aliases":
+		return f.runtime.ToValue(f.aliases)
+	case "estimateBytes":
+		return f.runtime.ToValue(f.estimateBytes)
+	case "expectations":
+		return f.runtime.ToValue(f.expectations)
+	case "validate":
+		return f.runtime.ToValue(f.validate)
+	case "recipe":
+		return f.runtime.ToValue(f.recipe)
+	case "writeParquet":
+		return f.runtime.ToValue(f.writeParquet)
+	case "writeArrow":
+		return f.runtime.ToValue(f.writeArrow)
+	case "encode":
+		return f.encoder()
+	case "helpers":
+		return f.helpers()
+	case "forms":
+		return f.forms()
+	case "adapt":
+		return f.runtime.ToValue(f.adapt)
+	case "ref":
+		return f.runtime.ToValue(f.ref)
+	case "cardinality":
+		return f.runtime.ToValue(f.cardinality)
+	case "describe":
+		return f.runtime.ToValue(f.describe)
+	case "redactable":
+		return f.runtime.ToValue(f.redactable)
+	case "loadData":
+		return f.runtime.ToValue(f.loadData)
+	case "openapi":
+		return f.runtime.ToValue(f.openapi)
+	case "fromFile":
+		return f.runtime.ToValue(f.fromFile)
+	case "scenarioData":
+		return f.runtime.ToValue(f.scenarioData)
+	case "journey":
+		return f.runtime.ToValue(f.journey)
+	case "sequenceHash":
+		return f.runtime.ToValue(f.sequenceHash)
+	case "serve":
+		return f.runtime.ToValue(f.serve)
+	case "replayFrom":
+		return f.runtime.ToValue(f.replayFrom)
+	case "state":
+		return f.runtime.ToValue(f.state)
+	case "snapshot", "toJSON":
+		return f.runtime.ToValue(f.snapshot)
 	}
 
 	category := newCategory(f, key)
@@ -63,12 +289,36 @@ func (f *faker) Get(key string) sobek.Value {
 	return f.runtime.NewDynamicObject(category)
 }
 
+// bind returns the generator function bound to the faker instance.
+// The bound function values are cached per category and function name.
+func (f *faker) bind(category string, name string, info *gofakeit.Info) sobek.Value {
+	key := category + "." + name
+
+	if val, found := f.members[key]; found {
+		return val
+	}
+
+	fun := f.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
+		return f.invoke(name, info, call)
+	}).ToObject(f.runtime)
+
+	_ = fun.Set("many", func(call sobek.FunctionCall) sobek.Value {
+		return f.invokeMany(name, info, call)
+	})
+
+	f.members[key] = fun
+
+	return fun
+}
+
 // Has implements sobek.DynamicObject.
-func (f *faker) Has(_ string) bool {
-	return false
+// Both the categories and the methods (e.g. call, helpers) are reported.
+func (f *faker) Has(key string) bool {
+	return !sobek.IsUndefined(f.Get(key))
 }
 
 // Keys implements sobek.DynamicObject.
+// Only the categories are enumerated, like the properties of a class instance.
 func (f *faker) Keys() []string {
 	return getCategoryNames()
 }
@@ -81,80 +331,166 @@ func (f *faker) Set(_ string, _ sobek.Value) bool {
 // call invokes faker function by name.
 // The faker function name is the first parameter, the rest of parameters passed to function.
 func (f *faker) call(call sobek.FunctionCall) sobek.Value {
-	function := call.Argument(0)
+	name, info := f.lookup("call", call.Argument(0))
+
+	call.Arguments = call.Arguments[1:]
 
+	return f.invoke(name, info, call)
+}
+
+// lookup returns faker function by name passed as func parameter of the method.
+func (f *faker) lookup(method string, function sobek.Value) (string, *gofakeit.Info) {
 	if sobek.IsUndefined(function) {
-		panic(f.runtime.NewTypeError(function))
+		f.throw(&ArgumentError{Function: method, Parameter: "func", Expected: "string", Reason: "missing parameter"})
 	}
 
-	info, found := lookupFunc(function.ToString().String())
+	name := function.ToString().String()
+
+	info, found := lookupFunc(name)
 	if !found {
-		panic(f.runtime.NewTypeError(function))
+		f.throw(&LookupError{Function: name})
 	}
 
-	call.Arguments = call.Arguments[1:]
+	if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
+		f.checkDeprecatedCategory(name[:idx])
+
+		name = name[idx+1:]
+	}
 
-	return f.invoke(info, call)
+	return name, info
 }
 
-func (f *faker) toMapParams(info *gofakeit.Info, call sobek.FunctionCall) *gofakeit.MapParams {
-	if len(info.Params) == 0 {
-		return nil
+func (f *faker) argumentError(name string, info *gofakeit.Info, param *gofakeit.Param, reason string) error {
+	err := &ArgumentError{Function: name, Category: info.Category, Reason: reason}
+
+	if param != nil {
+		err.Parameter = param.Field
+		err.Expected = jsType(param.Type)
 	}
 
-	params := gofakeit.NewMapParams()
+	return err
+}
 
-	for idx, param := range info.Params {
-		val := call.Argument(idx)
-		if sobek.IsUndefined(val) {
-			if len(param.Default) != 0 {
-				params.Add(param.Field, param.Default)
+func (f *faker) invoke(name string, info *gofakeit.Info, call sobek.FunctionCall) sobek.Value {
+	return f.invokeParams(name, info, call, nil, false)
+}
 
-				continue
-			}
+// invokeParams calls the generator function with the params converted from the arguments of the call,
+// or with the given params if they are prepared in advance (see compile).
+func (f *faker) invokeParams(
+	name string, info *gofakeit.Info, call sobek.FunctionCall, params *gofakeit.MapParams, prepared bool,
+) sobek.Value {
+	f.checkDeprecated(name)
+	f.rescope()
 
-			if param.Optional {
-				continue
-			}
+	if val, replayed := f.replayed(name); replayed {
+		return f.toValue(val)
+	}
 
-			panic(f.runtime.NewTypeError("missing parameter: %s", param.Field))
-		}
+	start := time.Now()
 
-		var arr []string
+	if !prepared {
+		params = f.toMapParams(name, info, call)
+	}
 
-		if f.runtime.ExportTo(val, &arr) == nil {
-			(*params)[param.Field] = arr
-		} else {
-			params.Add(param.Field, val.String())
-		}
+	val, err := f.generator(name, info)(f.rand, params, info)
+	if err != nil {
+		f.throw(f.argumentError(name, info, nil, err.Error()))
 	}
 
-	return params
+	output := f.applyDatasets(name, f.output(val))
+	result := f.toValue(output)
+
+	f.record(name, call.Arguments, output)
+
+	f.measure(name, start, 1)
+
+	return result
 }
 
-func (f *faker) invoke(info *gofakeit.Info, call sobek.FunctionCall) sobek.Value {
-	params := f.toMapParams(info, call)
+// output returns the generated value converted according to the options of the instance.
+// Time values are converted to RFC 3339 strings in UTC if the rfc3339 option is set,
+// structs are converted to plain objects.
+func (f *faker) output(val any) any {
+	if t, isTime := val.(time.Time); isTime && f.opts.rfc3339 {
+		return t.UTC().Format(time.RFC3339)
+	}
 
-	val, err := info.Generate(f.rand, params, info)
-	if err != nil {
-		panic(f.runtime.NewGoError(err))
+	return plainValue(val)
+}
+
+// categoryMethods contains the names of the category methods implemented at JavaScript level
+// instead of generator functions, e.g. the schema based request body methods of the internet category.
+var categoryMethods = map[string][]string{ //nolint:gochecknoglobals
+	"internet":  {"queryString", "formUrlEncoded", "multipartForm", "qrPng"},
+	"word":      {"markov"},
+	"messaging": {"stream"},
+	"fuzz":      {"mutate"},
+	"iot":       {"sensorReading"},
+	"product":   {"barcodePng"},
+	"numbers":   {"float32Array", "intArray"},
+}
+
+// categoryMethod returns the JavaScript level method of the category (e.g. internet.queryString).
+// The returned functions are cached like the bound generator functions.
+func (f *faker) categoryMethod(category string, key string) (sobek.Value, bool) {
+	var fun any
+
+	switch category + "." + key {
+	case "internet.queryString":
+		fun = f.queryString
+	case "internet.formUrlEncoded":
+		fun = f.formURLEncoded
+	case "internet.multipartForm":
+		fun = f.multipartForm
+	case "internet.qrPng":
+		fun = f.qrPng
+	case "word.markov":
+		fun = f.markov
+	case "messaging.stream":
+		fun = f.stream
+	case "fuzz.mutate":
+		fun = f.mutate
+	case "iot.sensorReading":
+		fun = f.sensorReading
+	case "product.barcodePng":
+		fun = f.barcodePng
+	case "numbers.float32Array":
+		fun = f.float32Array
+	case "numbers.intArray":
+		fun = f.intArray
+	default:
+		return nil, false
 	}
 
-	return f.runtime.ToValue(val)
+	if val, found := f.members[category+"."+key]; found {
+		return val, true
+	}
+
+	val := f.runtime.ToValue(fun)
+
+	f.members[category+"."+key] = val
+
+	return val, true
 }
 
 type category struct {
 	faker *faker
+	name  string
 	funcs map[string]*gofakeit.Info
 }
 
 func newCategory(faker *faker, name string) *category {
+	faker.checkDeprecatedCategory(name)
+
+	name = resolveCategory(name)
+
 	funcs, ok := lookupCategory(name)
 	if !ok {
 		return nil
 	}
 
-	return &category{faker: faker, funcs: funcs}
+	return &category{faker: faker, name: name, funcs: funcs}
 }
 
 // Delete implements sobek.DynamicObject.
@@ -164,24 +500,41 @@ func (c *category) Delete(_ string) bool {
 
 // Get implements sobek.DynamicObject.
 func (c *category) Get(key string) sobek.Value {
-	info, ok := c.funcs[key]
+	if fun, found := c.faker.categoryMethod(c.name, key); found {
+		return fun
+	}
+
+	info, ok := c.funcs[resolveFunc(key)]
 	if !ok {
 		return sobek.Undefined()
 	}
 
-	return c.faker.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
-		return c.faker.invoke(info, call)
-	})
+	return c.faker.bind(c.name, key, info)
 }
 
 // Has implements sobek.DynamicObject.
-func (c *category) Has(_ string) bool {
-	return false
+func (c *category) Has(key string) bool {
+	if _, found := c.funcs[resolveFunc(key)]; found {
+		return true
+	}
+
+	return slices.Contains(categoryMethods[c.name], key)
 }
 
 // Keys implements sobek.DynamicObject.
+// The function names are enumerated in alphabetical order, deprecated names are omitted.
 func (c *category) Keys() []string {
-	return []string{}
+	keys := make([]string, 0, len(c.funcs))
+
+	for name := range c.funcs {
+		keys = append(keys, name)
+	}
+
+	keys = append(keys, categoryMethods[c.name]...)
+
+	slices.Sort(keys)
+
+	return keys
 }
 
 // Set implements sobek.DynamicObject.
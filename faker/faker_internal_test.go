@@ -1,13 +1,24 @@
 package faker
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/grafana/sobek"
+	"github.com/makiuchi-d/gozxing/qrcode/decoder"
+	"github.com/makiuchi-d/gozxing/qrcode/encoder"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
+	"go.k6.io/k6/v2/js/common"
+	"go.k6.io/k6/v2/js/modulestest"
 )
 
 func Test_faker_dynamic(t *testing.T) {
@@ -24,7 +35,9 @@ func Test_faker_dynamic(t *testing.T) {
 	require.False(t, sobek.IsUndefined(faker.Get("zen")))
 
 	// Has
-	require.False(t, faker.Has("zen"))
+	require.True(t, faker.Has("zen"))
+	require.True(t, faker.Has("call"))
+	require.False(t, faker.Has("no such category"))
 
 	// Keys
 	require.NotEmpty(t, faker.Keys())
@@ -42,7 +55,7 @@ func Test_faker_invoke(t *testing.T) {
 
 	require.True(t, ok)
 
-	val := faker.invoke(info, sobek.FunctionCall{This: sobek.Undefined()})
+	val := faker.invoke("username", info, sobek.FunctionCall{This: sobek.Undefined()})
 
 	require.False(t, sobek.IsUndefined(val))
 
@@ -54,7 +67,7 @@ func Test_faker_invoke(t *testing.T) {
 	}
 
 	require.Panics(t, func() {
-		faker.invoke(info, sobek.FunctionCall{This: sobek.Undefined()})
+		faker.invoke("username", info, sobek.FunctionCall{This: sobek.Undefined()})
 	})
 }
 
@@ -80,10 +93,12 @@ func Test_category_dynamic(t *testing.T) {
 	require.True(t, sobek.IsUndefined(category.Get("no such function")))
 
 	// Has
-	require.False(t, category.Has("username"))
+	require.True(t, category.Has("username"))
+	require.False(t, category.Has("no such function"))
 
 	// Keys
-	require.Empty(t, category.Keys())
+	require.Contains(t, category.Keys(), "username")
+	require.IsNonDecreasing(t, category.Keys())
 
 	// Set
 	require.False(t, category.Set("foo", category.faker.runtime.ToValue(42)))
@@ -102,12 +117,12 @@ func Test_faker_toMapParams(t *testing.T) {
 	var call sobek.FunctionCall
 
 	require.Panics(t, func() {
-		faker.toMapParams(info, call)
+		faker.toMapParams("intRange", info, call)
 	})
 
 	call.Arguments = append(call.Arguments, runtime.ToValue(1), runtime.ToValue(42))
 
-	mparams := faker.toMapParams(info, call)
+	mparams := faker.toMapParams("intRange", info, call)
 
 	require.NotNil(t, mparams)
 	require.Equal(t, &gofakeit.MapParams{"min": []string{"1"}, "max": []string{"42"}}, mparams)
@@ -121,7 +136,7 @@ func Test_faker_toMapParams(t *testing.T) {
 
 	call.Arguments = []sobek.Value{runtime.ToValue(1)}
 
-	mparams = faker.toMapParams(info, call)
+	mparams = faker.toMapParams("intRange", info, call)
 
 	require.NotNil(t, mparams)
 	require.Equal(t, &gofakeit.MapParams{"min": []string{"1"}, "max": []string{"24"}}, mparams)
@@ -129,8 +144,576 @@ func Test_faker_toMapParams(t *testing.T) {
 	info.Params[0].Optional = true
 	call.Arguments = nil
 
-	mparams = faker.toMapParams(info, call)
+	mparams = faker.toMapParams("intRange", info, call)
 
 	require.NotNil(t, mparams)
 	require.Equal(t, &gofakeit.MapParams{"max": []string{"24"}}, mparams)
 }
+
+func Test_describeParams(t *testing.T) {
+	t.Parallel()
+
+	info, ok := lookupFunc("intRange")
+
+	require.True(t, ok)
+
+	desc := describeParams(info)
+
+	require.Same(t, desc, describeParams(info))
+	require.Equal(t, []bool{true, true}, desc.numbers)
+	require.Equal(t, []bool{true, true}, desc.options)
+	require.Nil(t, desc.defaults)
+
+	info, ok = lookupFunc("sentence")
+
+	require.True(t, ok)
+	require.Equal(t, &gofakeit.MapParams{"wordcount": []string{"5"}, "maxBytes": []string{"0"}}, describeParams(info).defaults)
+}
+
+func Test_faker_cache(t *testing.T) {
+	t.Parallel()
+
+	faker := newFaker(11, sobek.New())
+
+	require.Same(t, faker.Get("call"), faker.Get("call"))
+	require.Same(t, faker.Get("zen"), faker.Get("zen"))
+
+	category := newCategory(faker, "zen")
+
+	require.Same(t, category.Get("username"), category.Get("username"))
+	require.NotSame(t, category.Get("username"), newCategory(faker, "internet").Get("username"))
+}
+
+func Test_faker_toMapParams_passthrough(t *testing.T) {
+	t.Parallel()
+
+	vu := modulestest.NewRuntime(t).VU
+	runtime := vu.Runtime()
+	faker := newFakerForVU(11, runtime, vu)
+
+	info := &gofakeit.Info{
+		Category: "misc",
+		Params: []gofakeit.Param{
+			{Field: "data", Type: "map[string]any"},
+			{Field: "str", Type: "CustomType"},
+		},
+	}
+
+	call := sobek.FunctionCall{Arguments: []sobek.Value{
+		runtime.ToValue(map[string]any{"foo": "bar"}),
+		runtime.ToValue("dummy"),
+	}}
+
+	mparams := faker.toMapParams("custom", info, call)
+
+	require.Equal(t, &gofakeit.MapParams{"data": []string{`{"foo":"bar"}`}, "str": []string{"dummy"}}, mparams)
+	_, found := warned.Load("custom.data")
+	require.True(t, found)
+
+	_, found = warned.Load("custom.str")
+	require.True(t, found)
+
+	data, err := info.GetMap(mparams, "data")
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"foo": "bar"}, data)
+}
+
+//nolint:paralleltest // replaces the deprecation tables
+func Test_faker_checkDeprecated(t *testing.T) {
+	SetDeprecations(t,
+		map[string]Deprecation{"cardCvv": {Replacement: "creditCardCVV", Since: "v0.5.0", Removal: "v1.0.0"}},
+		map[string]Deprecation{"card": {Replacement: "payment", Since: "v0.5.0", Removal: "v1.0.0"}},
+	)
+
+	vu := modulestest.NewRuntime(t).VU
+	faker := newFakerForVU(11, vu.Runtime(), vu)
+
+	info, ok := lookupFunc("cardCvv")
+
+	require.True(t, ok)
+	require.Same(t, GetFuncLookups()["creditCardCVV"], info)
+
+	require.False(t, sobek.IsUndefined(newCategory(faker, "payment").Get("cardCvv")))
+	require.True(t, sobek.IsUndefined(newCategory(faker, "person").Get("cardCvv")))
+	require.NotContains(t, newCategory(faker, "payment").Keys(), "cardCvv")
+
+	faker.checkDeprecated("creditCardCVV")
+
+	_, found := warned.Load("deprecated.creditCardCVV")
+	require.False(t, found)
+
+	faker.checkDeprecated("cardCvv")
+
+	_, found = warned.Load("deprecated.cardCvv")
+	require.True(t, found)
+
+	info, ok = lookupFunc("card.cardCvv")
+
+	require.True(t, ok)
+	require.Same(t, GetFuncLookups()["creditCardCVV"], info)
+
+	require.Equal(t, "payment", newCategory(faker, "card").name)
+
+	_, found = warned.Load("deprecated.category.card")
+	require.True(t, found)
+}
+
+func Test_faker_creditCardCvv(t *testing.T) {
+	t.Parallel()
+
+	logger, hook := logtest.NewNullLogger()
+	vu := modulestest.NewRuntime(t).VU
+	vu.InitEnvField.Logger = logger
+
+	vm := vu.Runtime()
+
+	require.NoError(t, vm.Set("faker", vm.NewDynamicObject(newFakerForVU(11, vm, vu))))
+
+	val, err := vm.RunString(`[faker.payment.creditCardCvv(), faker.payment.creditCardCvv(), faker.call("creditCardCvv")]`)
+
+	require.NoError(t, err)
+
+	for _, cvv := range val.Export().([]any) { //nolint:forcetypeassert
+		require.Regexp(t, `^[0-9]{3,4}$`, cvv)
+	}
+
+	require.Len(t, hook.AllEntries(), 1)
+	require.Equal(t,
+		"faker: creditCardCvv is deprecated since v0.5.0 and will be removed in v1.0.0, use creditCardCVV instead",
+		hook.LastEntry().Message,
+	)
+}
+
+func Test_faker_warnOnce(t *testing.T) {
+	t.Parallel()
+
+	faker := newFaker(11, sobek.New())
+
+	faker.warnOnce("test.warnOnce", "warning")
+
+	_, found := warned.Load("test.warnOnce")
+	require.False(t, found)
+
+	faker.vu = modulestest.NewRuntime(t).VU
+
+	faker.warnOnce("test.warnOnce", "warning")
+
+	_, found = warned.Load("test.warnOnce")
+	require.True(t, found)
+}
+
+func Test_columnar_encoding(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte{0x06, 1, 0x02, 0, 0x02, 1}, appendLevels(nil, []bool{true, true, true, false, true}))
+	require.Equal(t, []byte{0x05, 0x01}, appendBitmap(nil, []bool{true, false, true, false, false, false, false, false, true}))
+
+	var w thriftWriter
+
+	w.open()
+	w.i32(1, -1)
+	w.i64(3, 300)
+	w.binary(20, "ab")
+	w.end()
+
+	require.Equal(t, []byte{0x15, 0x01, 0x26, 0xd8, 0x04, 0x08, 0x28, 0x02, 'a', 'b', 0}, w.buff)
+
+	data := encodeFlatbuffer(fbTable{fbInt16(4), {}, fbRef(fbString("x"))})
+
+	require.Equal(t, []byte{
+		16, 0, 0, 0, // root offset
+		10, 0, 12, 0, 4, 0, 0, 0, 8, 0, 0, 0, // vtable and padding
+		12, 0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, // table
+		1, 0, 0, 0, 'x', 0, 0, 0, 0, 0, 0, 0, // string and padding
+	}, data)
+}
+
+func Test_qrCode_reference(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(11)) //nolint:gosec
+
+	// one byte less than the capacity of every version (1-15), so the reference encoder selects the same version
+	for _, size := range []int{13, 25, 41, 61, 83, 105, 121, 151, 179, 212, 250, 286, 330, 361, 411} {
+		content := make([]byte, size)
+
+		for idx := range content {
+			content[idx] = byte('a' + r.Intn(26))
+		}
+
+		ref, werr := encoder.Encoder_encodeWithoutHint(string(content), decoder.ErrorCorrectionLevel_M)
+		require.NoError(t, werr)
+
+		code, err := newUnmaskedQRCode(content)
+		require.NoError(t, err)
+
+		// the mask is chosen by slightly different penalty rules, the other modules must be the same
+		code.applyMask(ref.GetMaskPattern())
+		code.drawFormat(ref.GetMaskPattern())
+
+		matrix := ref.GetMatrix()
+
+		require.Equal(t, matrix.GetWidth(), code.size, size)
+
+		for y, row := range code.modules {
+			for x, dark := range row {
+				require.Equal(t, matrix.Get(x, y) == 1, dark, "size %d, module %d,%d", size, x, y)
+			}
+		}
+	}
+}
+
+func Test_eventTypes(t *testing.T) {
+	t.Parallel()
+
+	// the event package of k6 is internal, its Type is reached through the parameter of Subscriber.Subscribe
+	subscribe, found := reflect.TypeFor[common.Events]().Field(0).Type.MethodByName("Subscribe")
+
+	require.True(t, found)
+
+	typ := subscribe.Type.In(0).Elem()
+
+	for val, name := range map[uint64]string{eventTestEnd: "TestEnd", eventExit: "Exit"} {
+		event := reflect.New(typ).Elem()
+		event.SetUint(val)
+
+		require.Equal(t, name, fmt.Sprint(event.Interface()))
+	}
+}
+
+func Test_faker_snapshot_replay(t *testing.T) {
+	t.Parallel()
+
+	faker := newFaker(11, sobek.New())
+
+	require.NotEmpty(t, faker.snapshot())
+
+	faker.replay = &replay{values: map[string][]any{}, next: map[string]int{}}
+
+	require.Panics(t, func() { faker.snapshot() })
+}
+
+func Test_upsCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 4, upsCheckDigit("999AA1012345678"))
+}
+
+func Test_vinCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, byte('X'), vinCheckDigit("1M8GDM9AXKP042788"))
+	require.Equal(t, byte('1'), vinCheckDigit("11111111111111111"))
+}
+
+func Test_cpfCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 2, cpfCheckDigit("529982247"))
+	require.Equal(t, 5, cpfCheckDigit("5299822472"))
+}
+
+func Test_mod1110CheckDigit(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 9, mod1110CheckDigit("8609574271"))
+	require.Equal(t, 6, mod1110CheckDigit("4703689281"))
+}
+
+func Test_vatSchemes(t *testing.T) {
+	t.Parallel()
+
+	// published examples of valid VAT numbers
+	valid := map[string]struct{ body, check string }{
+		"ATU13585627":    {"1358562", "7"},
+		"BE0776091951":   {"07760919", "51"},
+		"DE136695976":    {"13669597", "6"},
+		"DK13585628":     {"1358562", "8"},
+		"ESA58818501":    {"A5881850", "1"},
+		"FI20774740":     {"2077474", "0"},
+		"FR40303265045":  {"303265045", "40"},
+		"IT00743110157":  {"0074311015", "7"},
+		"NL004495445B01": {"00449544", "5"},
+		"PL8567346215":   {"856734621", "5"},
+		"PT501964843":    {"50196484", "3"},
+		"SE556188840401": {"556188840", "4"},
+	}
+
+	for number, parts := range valid {
+		scheme, found := vatSchemes[number[:2]]
+
+		require.True(t, found, number)
+
+		check, ok := scheme.check(parts.body)
+
+		require.True(t, ok, number)
+		require.Equal(t, parts.check, check, number)
+		require.Equal(t, number, fmt.Sprintf(scheme.layout, parts.body, check))
+	}
+}
+
+func Test_avroSchema_encode(t *testing.T) {
+	t.Parallel()
+
+	schema, err := parseAvroSchema(`{
+		"type": "record", "name": "User", "namespace": "example",
+		"fields": [
+			{"name": "name", "type": "string"},
+			{"name": "age", "type": "int"},
+			{"name": "email", "type": ["null", "string"], "default": null},
+			{"name": "tags", "type": {"type": "array", "items": "long"}},
+			{"name": "role", "type": {"type": "enum", "name": "Role", "symbols": ["USER", "ADMIN"]}},
+			{"name": "manager", "type": ["null", "User"], "default": null}
+		]
+	}`)
+
+	require.NoError(t, err)
+
+	data, err := schema.encode(nil, map[string]any{
+		"name": "Bob", "age": int64(30), "tags": []any{int64(1), float64(-1)}, "role": "ADMIN",
+		"manager": map[string]any{"name": "", "age": int64(-1), "email": "a", "tags": []any{}, "role": "USER"},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []byte{
+		0x06, 'B', 'o', 'b', 0x3c, 0x00, 0x04, 0x02, 0x01, 0x00, 0x02,
+		0x02, 0x00, 0x01, 0x02, 0x02, 'a', 0x00, 0x00, 0x00,
+	}, data)
+
+	_, err = schema.encode(nil, map[string]any{"name": "Bob", "age": 1.5})
+
+	require.ErrorContains(t, err, "age: invalid value 1.5 (expected int)")
+
+	_, err = parseAvroSchema(`{"type": "record", "name": "User", "fields": [{"name": "id", "type": "uuid"}]}`)
+
+	require.ErrorIs(t, err, errInvalidAvroSchema)
+
+	r := rand.New(rand.NewSource(11)) //nolint:gosec
+
+	for range 20 {
+		_, err = schema.encode(nil, schema.generate(r, false, "", 0))
+
+		require.NoError(t, err)
+	}
+}
+
+func Test_protoMessage_encode(t *testing.T) {
+	t.Parallel()
+
+	file, err := parseProto(`
+		syntax = "proto3";
+		package example.v1;
+
+		/* test messages of the protobuf encoding guide */
+		message Test {
+			int32 a = 1;
+			string b = 2;
+			Inner c = 3;
+			repeated int32 d = 4 [packed = true];
+			map<string, int32> e = 5;
+			sint32 f = 6;
+			Kind kind = 7;
+			oneof choice {
+				double g = 8;
+				bytes h = 9;
+			}
+			repeated string long_name = 10;
+
+			message Inner { int32 a = 1; }
+		}
+
+		enum Kind { KIND_UNSPECIFIED = 0; KIND_B = 2; }
+
+		service Tests { rpc Get(Test) returns (Test); }
+	`)
+
+	require.NoError(t, err)
+
+	msg, err := file.message("")
+
+	require.NoError(t, err)
+
+	data, err := msg.encode(nil, map[string]any{
+		"a":        int64(150),
+		"b":        "testing",
+		"c":        map[string]any{"a": int64(150)},
+		"d":        []any{int64(1), int64(2), int64(3)},
+		"e":        map[string]any{"a": int64(1)},
+		"f":        int64(-1),
+		"kind":     "KIND_B",
+		"longName": []any{"x", "y"},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []byte{
+		0x08, 0x96, 0x01,
+		0x12, 0x07, 't', 'e', 's', 't', 'i', 'n', 'g',
+		0x1a, 0x03, 0x08, 0x96, 0x01,
+		0x22, 0x03, 0x01, 0x02, 0x03,
+		0x2a, 0x05, 0x0a, 0x01, 'a', 0x10, 0x01,
+		0x30, 0x01,
+		0x38, 0x02,
+		0x52, 0x01, 'x', 0x52, 0x01, 'y',
+	}, data)
+
+	data, err = msg.encode(nil, map[string]any{"a": int64(-1)})
+
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, data)
+
+	_, err = msg.encode(nil, map[string]any{"x": int64(1)})
+
+	require.ErrorContains(t, err, "x: invalid value: unknown field of Test message")
+
+	inner, err := file.message("Inner")
+
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 0}, inner.index)
+
+	_, err = parseProto(`message Test { Unknown a = 1; }`)
+
+	require.ErrorContains(t, err, "unknown type Unknown of field a")
+}
+
+func Test_holidayCalendars(t *testing.T) {
+	t.Parallel()
+
+	date := func(year int, month time.Month, dom int) time.Time {
+		return time.Date(year, month, dom, 0, 0, 0, 0, time.UTC)
+	}
+
+	require.Equal(t, date(2024, time.March, 31), easter(2024))
+	require.Equal(t, date(2025, time.April, 20), easter(2025))
+	require.Equal(t, date(2000, time.April, 23), easter(2000))
+
+	// Memorial Day, Thanksgiving and Labor Day
+	require.Equal(t, date(2024, time.May, 27), weekdayHoliday(time.May, time.Monday, -1)(2024))
+	require.Equal(t, date(2024, time.November, 28), weekdayHoliday(time.November, time.Thursday, 4)(2024))
+	require.Equal(t, date(2025, time.September, 1), weekdayHoliday(time.September, time.Monday, 1)(2025))
+
+	require.False(t, isBusinessDay(date(2024, time.May, 9), holidayCalendars["DE"])) // Ascension Day
+	require.True(t, isBusinessDay(date(2024, time.May, 9), holidayCalendars["US"]))
+	require.False(t, isBusinessDay(date(2024, time.May, 11), holidayCalendars["none"]))
+}
+
+func Test_plainValue(t *testing.T) {
+	t.Parallel()
+
+	person := &gofakeit.PersonInfo{
+		FirstName: "Bob",
+		Job:       &gofakeit.JobInfo{Title: "Developer"},
+	}
+
+	val := plainValue(person)
+
+	require.IsType(t, object{}, val)
+
+	fields := val.(object) //nolint:forcetypeassert
+
+	require.Equal(t, "firstName", fields[0].name)
+	require.Equal(t, "Bob", fields.get("firstName"))
+	require.Equal(t, "Developer", fields.get("job").(object).get("title")) //nolint:forcetypeassert
+	require.Nil(t, fields.get("address"))
+
+	require.Equal(t, []any{object{{"name", "Psycho"}, {"genre", "Mystery"}}},
+		plainValue([]gofakeit.MovieInfo{{Name: "Psycho", Genre: "Mystery"}}))
+
+	require.Equal(t, map[string]any{"app": "web"}, plainValue(map[string]string{"app": "web"}))
+
+	now := time.Now()
+
+	require.Equal(t, now, plainValue(now))
+	require.Equal(t, []string{"a"}, plainValue([]string{"a"}))
+	require.Nil(t, plainValue(nil))
+}
+
+func Test_object(t *testing.T) {
+	t.Parallel()
+
+	obj := object{{"name", "Bob"}, {"age", 42}, {"address", object{{"zip", "12345"}, {"city", "Springfield"}}}}
+
+	obj.set("age", 43)
+	obj.set("missing", true)
+
+	require.Equal(t, 43, obj.get("age"))
+	require.Nil(t, obj.get("missing"))
+
+	data, err := json.Marshal(obj)
+
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"Bob","age":43,"address":{"zip":"12345","city":"Springfield"}}`, string(data))
+
+	f := newFaker(1, sobek.New())
+
+	val := f.toValue(object{{"b", 1}, {"a", map[string]any{"d": 1, "c": []any{object{{"f", 1}, {"e", 2}}}}}})
+
+	require.Equal(t, []string{"b", "a"}, val.ToObject(f.runtime).Keys())
+
+	out, err := f.runtime.RunString(`(v) => JSON.stringify(v)`)
+
+	require.NoError(t, err)
+
+	stringify, _ := sobek.AssertFunction(out)
+	res, err := stringify(sobek.Undefined(), val)
+
+	require.NoError(t, err)
+	require.Equal(t, `{"b":1,"a":{"c":[{"f":1,"e":2}],"d":1}}`, res.String())
+}
+
+func Test_structFields(t *testing.T) {
+	t.Parallel()
+
+	typ := reflect.TypeFor[gofakeit.CreditCardInfo]()
+	fields := structFields(typ)
+
+	require.Equal(t, []structField{{0, "type"}, {1, "number"}, {2, "exp"}, {3, "cvv"}}, fields)
+	require.Same(t, &fields[0], &structFields(typ)[0])
+}
+
+func Test_valueBytes(t *testing.T) {
+	t.Parallel()
+
+	val := object{{"b", "<1>\n"}, {"a", object{{"c", []any{object{{"f", 1.5}}}}, {"d", nil}}}}
+
+	data, err := json.Marshal(val)
+
+	require.NoError(t, err)
+	require.Equal(t, `{"b":"\u003c1\u003e\n","a":{"c":[{"f":1.5}],"d":null}}`, string(data))
+	require.Equal(t, int64(len(data)), valueBytes(val))
+}
+
+func Test_recorder_close(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "record.jsonl")
+	rec := &recorder{path: path, buffered: true}
+
+	recordersMu.Lock()
+	recorders[path] = rec
+	recordersMu.Unlock()
+
+	require.NoError(t, rec.write(&recordEntry{Function: "firstName", Output: "Lura", Iteration: -1}))
+	require.NoError(t, rec.write(&recordEntry{Function: "intRange", Output: 5, VU: 1}))
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	require.NoError(t, err)
+	require.Empty(t, data, "the entries are buffered until the end of the test run")
+
+	require.NoError(t, rec.close())
+	require.NoError(t, rec.close())
+
+	data, err = os.ReadFile(path) //nolint:gosec
+	require.NoError(t, err)
+	require.Equal(t, `{"function":"firstName","output":"Lura","vu":0,"iteration":-1}
+{"function":"intRange","output":5,"vu":1,"iteration":0}
+`, string(data))
+
+	require.ErrorIs(t, rec.write(&recordEntry{Function: "firstName"}), errRecordClosed)
+
+	recordersMu.Lock()
+	_, found := recorders[path]
+	recordersMu.Unlock()
+
+	require.False(t, found)
+}
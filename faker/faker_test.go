@@ -1,10 +1,26 @@
 package faker_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/apache/arrow-go/v18/arrow/ipc"
 	"github.com/grafana/sobek"
 	"github.com/grafana/xk6-faker/faker"
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/parquet-go/parquet-go"
 	"github.com/stretchr/testify/require"
 )
 
@@ -120,3 +136,2541 @@ func Test_Faker_int_array_parameter(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(2), val.ToInteger())
 }
+
+func Test_Faker_errors(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	let err
+	try { new Faker(11).numbers.intRange(1) } catch (e) { err = e }
+	[err.name, err.function, err.parameter, err.expected, err.docs, err instanceof TypeError].join(",")
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t,
+		"FakerArgumentError,intRange,max,number,https://faker.x.k6.io/interfaces/Numbers.html#intrange,true",
+		val.String(),
+	)
+
+	val, err = vm.RunString(`
+	try { new Faker(11).call("no such function") } catch (e) { err = e }
+	[err.name, err.function].join(",")
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "FakerLookupError,no such function", val.String())
+
+	_, err = vm.RunString("new Faker(11).numbers.intRange('foo', 2)")
+
+	require.ErrorContains(t, err, "FakerArgumentError: intRange: parameter min: invalid value foo (expected number)")
+}
+
+func Test_Faker_rfc3339_option(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	val, err := vm.RunString(`
+	const faker = new Faker({ seed: 11, rfc3339: true })
+	;[faker.time.pastTime(), faker.time.futureTime.many(2)[1], Faker.fromState(faker.state()).time.pastTime()]
+	`)
+
+	require.NoError(t, err)
+
+	var values []string
+
+	require.NoError(t, vm.ExportTo(val, &values))
+
+	for _, value := range values {
+		ts, err := time.Parse(time.RFC3339, value)
+
+		require.NoError(t, err)
+		require.Equal(t, time.UTC, ts.Location())
+	}
+
+	val, err = vm.RunString(`typeof new Faker(11).time.pastTime()`)
+
+	require.NoError(t, err)
+	require.Equal(t, "object", val.String())
+}
+
+func Test_Faker_defaults_option(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	val, err := vm.RunString(`
+	const faker = new Faker({ seed: 11, defaults: { length: 5, "nanoid.length": 8, country: "DE" } })
+	;[
+	  faker.internet.password().length,
+	  faker.strings.nanoid().length,
+	  faker.strings.nanoid(3).length,
+	  /^[0-9]{11}$/.test(faker.person.nationalId()),
+	  Faker.fromState(faker.state()).internet.password().length,
+	]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(5), int64(8), int64(3), true, int64(5)}, val.Export())
+
+	_, err = vm.RunString(`new Faker({ defaults: { length: "foo" } }).internet.password()`)
+
+	require.ErrorContains(t, err, "FakerArgumentError: password: parameter length: invalid value foo (expected number)")
+
+	_, err = vm.RunString(`new Faker({ defaults: 1 })`)
+
+	require.ErrorContains(t, err, "invalid defaults")
+}
+
+func Test_Faker_metrics_option(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	_, err := vm.RunString(`new Faker({ metrics: true })`)
+
+	require.ErrorContains(t, err, "the metrics option can only be used in k6 scripts")
+}
+
+func Test_Faker_safe_option(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	val, err := vm.RunString(`
+	const faker = new Faker({ seed: 11, safe: true })
+	const safeEmail = /@example\.(com|net|org)$/
+	const safeDomain = /\.(test|example|example\.com|example\.net|example\.org)$/
+	const unassignedEIN = /^(00|07|08|09|17|18|19|28|29|49|69|70|78|79|89|96|97)-[0-9]{7}$/
+	const testCards = /^(4111111111111111|4242424242424242|4012888888881881|5555555555554444|5105105105105100|2223003122003222|378282246310005|371449635398431|30569309025904|38520000023237|6011111111111117|6011000990139424|3530111333300000|3566002020360505|6200000000000005)$/
+	const checks = {}
+
+	for (let i = 0; i < 50; i++) {
+	  const person = faker.person.person()
+
+	  checks.email = (checks.email ?? true) && safeEmail.test(faker.person.email())
+	  checks.disposableEmail = (checks.disposableEmail ?? true) && safeEmail.test(faker.internet.disposableEmail())
+	  checks.domainName = (checks.domainName ?? true) && safeDomain.test(faker.internet.domainName())
+	  checks.domainSuffix = (checks.domainSuffix ?? true) && /^(test|example|invalid|localhost)$/.test(faker.internet.domainSuffix())
+	  checks.url = (checks.url ?? true) && safeDomain.test(faker.internet.url().split("/")[2])
+	  checks.ipv4Address = (checks.ipv4Address ?? true) && /^192\.0\.2\.([1-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-4])$/.test(faker.internet.ipv4Address())
+	  checks.ipv6Address = (checks.ipv6Address ?? true) && /^2001:db8(:[0-9a-f]{1,4}){6}$/.test(faker.internet.ipv6Address())
+	  checks.phone = (checks.phone ?? true) && /^[2-9][0-9]{2}55501[0-9]{2}$/.test(faker.person.phone())
+	  checks.phoneFormatted = (checks.phoneFormatted ?? true) && /55501[0-9]{2}$/.test(faker.person.phoneFormatted().replace(/[^0-9]/g, ""))
+	  checks.creditCard = (checks.creditCard ?? true) && testCards.test(faker.payment.creditCard().number)
+	  checks.creditCardNumber = (checks.creditCardNumber ?? true) && testCards.test(faker.payment.creditCardNumber())
+	  checks.creditCardNumberVisa = (checks.creditCardNumberVisa ?? true) && /^4[0-9]{3} [0-9]{4} [0-9]{4} [0-9]{4}$/.test(faker.payment.creditCardNumber(["visa"], [], true))
+	  checks.creditCardNumberFormatted = (checks.creditCardNumberFormatted ?? true) && testCards.test(faker.payment.creditCardNumberFormatted().replaceAll("-", ""))
+	  checks.personEmail = (checks.personEmail ?? true) && safeEmail.test(person.contact.email)
+	  checks.personPhone = (checks.personPhone ?? true) && /^[2-9][0-9]{2}55501[0-9]{2}$/.test(person.contact.phone)
+	  checks.personCard = (checks.personCard ?? true) && testCards.test(person.creditCard.number)
+	  checks.personSSN = (checks.personSSN ?? true) && /^666[0-9]{6}$/.test(person.ssn)
+	  checks.ssn = (checks.ssn ?? true) && /^666[0-9]{6}$/.test(faker.person.ssn())
+	  checks.nationalId = (checks.nationalId ?? true) && /^666-/.test(faker.person.nationalId())
+	  checks.nationalIdGB = (checks.nationalIdGB ?? true) && /^QQ/.test(faker.person.nationalId("GB", { valid: false }))
+	  checks.ein = (checks.ein ?? true) && unassignedEIN.test(faker.company.ein())
+	  checks.organizationEin = (checks.organizationEin ?? true) && unassignedEIN.test(faker.company.organization().ein)
+	}
+
+	checks.restored = safeEmail.test(Faker.restore(faker.snapshot()).person.email())
+	checks.many = faker.person.email.many(20).every((email) => safeEmail.test(email))
+	checks.unsafe = !/^192\.0\.2\./.test(new Faker(11).internet.ipv4Address())
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_describe(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("faker", faker.New(11, vm)))
+
+	val, err := vm.RunString(`
+	const email = faker.describe("person.email")
+	const password = faker.describe("password")
+	const intRange = faker.describe("intRange")
+	;[
+	  email.name, email.category, email.pii, email.params.length,
+	  password.pii, password.params[5].name, password.params[5].type, password.params[5].default,
+	  intRange.pii, intRange.output,
+	]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		"email", "person", "email", int64(0),
+		"password", "length", "number", "12",
+		nil, "number",
+	}, val.Export())
+
+	_, err = vm.RunString(`faker.describe("foo")`)
+
+	require.ErrorContains(t, err, "FakerLookupError")
+}
+
+func Test_Faker_redactable(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("faker", faker.New(11, vm)))
+
+	val, err := vm.RunString(`
+	const person = faker.redactable("person")()
+	const checks = {
+	  email: /^\[\[pii:email\]\][^\[]+@[^\[]+\[\[\/pii\]\]$/.test(faker.redactable("person.email")()),
+	  args: /^\[\[pii:password\]\].{8}\[\[\/pii\]\]$/.test(faker.redactable("password")(true, true, true, false, false, 8)),
+	  nested: person.contact.phone.startsWith("[[pii:person]]") && person.address.latitude.endsWith("[[/pii]]"),
+	  age: /^\[\[pii:age\]\][0-9]+\[\[\/pii\]\]$/.test(faker.redactable("demographicAge")()),
+	  unmarked: !faker.redactable("word.noun")().includes("[[pii:"),
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_loadData(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const drugs = ["Aspirin", "Ibuprofen", "Paracetamol"]
+	const parts = JSON.stringify(["PN-1001", "PN-1002"])
+
+	faker.loadData({ firstNames: ["Zelda", "Yorick"], cities: ["Springfield"], products: parts })
+	faker.loadData({ productCategories: drugs }, { mode: "extend" })
+
+	const checks = { person: true, name: true, address: true, products: true, extend: false }
+
+	for (let i = 0; i < 50; i++) {
+	  const person = faker.person.person()
+	  const address = faker.address.address()
+
+	  checks.person &&= ["Zelda", "Yorick"].includes(person.firstName) && person.address.city === "Springfield"
+	  checks.person &&= person.address.address.includes("Springfield")
+	  checks.name &&= /^(Zelda|Yorick) /.test(faker.person.name())
+	  checks.address &&= address.city === "Springfield" && address.address.includes(", Springfield, ")
+	  checks.products &&= ["PN-1001", "PN-1002"].includes(faker.product.productName())
+	  checks.extend ||= drugs.includes(faker.product.productCategory())
+	}
+
+	checks.many = faker.person.firstName.many(10).every((name) => ["Zelda", "Yorick"].includes(name))
+	checks.lastName = !["Zelda", "Yorick"].includes(faker.person.lastName())
+	checks.isolated = !["Zelda", "Yorick"].includes(new Faker(11).person.firstName())
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	for script, msg := range map[string]string{
+		`new Faker(11).loadData({ drugs: ["Aspirin"] })`:               "drugs: unknown dataset",
+		`new Faker(11).loadData({ cities: [] })`:                       "cities: empty dataset",
+		`new Faker(11).loadData({ cities: [1, 2] })`:                   "cities: invalid item",
+		`new Faker(11).loadData({ cities: "{" })`:                      "invalid JSON",
+		`new Faker(11).loadData({ cities: ["A"] }, { mode: "merge" })`: "invalid value merge",
+	} {
+		_, err := vm.RunString(script)
+
+		require.ErrorContains(t, err, msg, script)
+	}
+}
+
+func Test_Faker_rng_option(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	val, err := vm.RunString(`
+	const generate = (faker) => [faker.person.firstName(), faker.numbers.uint64(), faker.strings.uuid()].join()
+	const checks = {}
+
+	for (const rng of ["frand", "pcg64", "xoshiro256"]) {
+	  const faker = new Faker(11, { rng })
+
+	  generate(faker)
+
+	  const state = faker.state()
+
+	  checks[rng] = generate(new Faker(11, { rng })) === generate(new Faker({ seed: 11, rng }))
+	  checks[rng + ".state"] = generate(faker) === generate(Faker.fromState(state))
+	}
+
+	checks.distinct = new Set(["frand", "pcg64", "xoshiro256"].map((rng) => generate(new Faker(11, { rng })))).size === 3
+	checks.default = generate(new Faker(11)) === generate(new Faker(11, { rng: "frand" }))
+	checks.crypto = generate(new Faker(11, { rng: "crypto" })) !== generate(new Faker(11, { rng: "crypto" }))
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11, { rng: "mt19937" })`)
+
+	require.ErrorContains(t, err, "invalid rng: mt19937 (expected frand, pcg64, xoshiro256 or crypto)")
+}
+
+func Test_Faker_sequenceHash(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const before = new Faker(11).person.firstName()
+
+	checks.format = /^v1:[0-9a-f]{64}$/.test(faker.sequenceHash())
+	checks.stable = faker.sequenceHash() === new Faker({ seed: 11 }).sequenceHash()
+	checks.untouched = faker.person.firstName() === before
+	checks.seed = faker.sequenceHash() !== new Faker(12).sequenceHash()
+	checks.count = faker.sequenceHash(10) !== faker.sequenceHash(11)
+	checks.rng = faker.sequenceHash() !== new Faker(11, { rng: "pcg64" }).sequenceHash()
+	checks.pinned = new Faker(11, { sequenceVersion: 1 }).sequenceHash() === faker.sequenceHash()
+	checks.state = Faker.fromState(new Faker(11, { sequenceVersion: 1 }).state()).sequenceHash() === faker.sequenceHash()
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	val, err = vm.RunString(`new Faker(11).sequenceHash()`)
+
+	require.NoError(t, err)
+	require.Equal(t, "v1:8f285253446d37fa0c87c966ea4d4b69333ce8f226bd3a15dd3f0f33d80f27a7", val.String())
+
+	_, err = vm.RunString(`new Faker(11, { sequenceVersion: 2 })`)
+
+	require.ErrorContains(t, err, "invalid sequenceVersion: unsupported sequence version: 2")
+
+	_, err = vm.RunString(`new Faker(11, { rng: "crypto" }).sequenceHash()`)
+
+	require.ErrorContains(t, err, "the crypto random source has no reproducible sequence")
+
+	_, err = vm.RunString(`new Faker(11).sequenceHash(0)`)
+
+	require.ErrorContains(t, err, "count")
+}
+
+func Test_Faker_serve(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const server = faker.serve("127.0.0.1:0", {
+	  "GET /users/{id}": { name: "person.firstName", age: ["intRange", 18, 20], address: { city: "city" } },
+	  "POST /orders": { schema: { id: "uuid" }, status: 201 },
+	  "/users": { schema: { email: "email" }, count: 3 },
+	  "/contacts": { email: { func: "email", nullable: 1 }, phone: { func: "phone", missing: 1 }, kind: { oneOf: [["intRange", 7, 7]] } },
+	})
+
+	server.url
+	`)
+
+	require.NoError(t, err)
+
+	get := func(method, path string) (int, any) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(t.Context(), method, val.String()+path, nil)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+
+		defer resp.Body.Close() //nolint:errcheck
+
+		var body any
+
+		if resp.Header.Get("Content-Type") == "application/json" {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		}
+
+		return resp.StatusCode, body
+	}
+
+	status, body := get(http.MethodGet, "/users/42")
+
+	require.Equal(t, http.StatusOK, status)
+	require.IsType(t, "", body.(map[string]any)["name"])
+	require.InDelta(t, 19, body.(map[string]any)["age"], 1)
+	require.Contains(t, body.(map[string]any)["address"], "city")
+
+	status, body = get(http.MethodPost, "/orders")
+
+	require.Equal(t, http.StatusCreated, status)
+	require.Len(t, body.(map[string]any)["id"], 36)
+
+	status, body = get(http.MethodGet, "/users")
+
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, body, 3)
+
+	status, _ = get(http.MethodGet, "/orders")
+
+	require.Equal(t, http.StatusMethodNotAllowed, status)
+
+	status, body = get(http.MethodGet, "/contacts")
+
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, map[string]any{"email": nil, "kind": float64(7)}, body)
+
+	same, err := vm.RunString(`faker.serve("127.0.0.1:0", {}).url`)
+
+	require.NoError(t, err)
+	require.Equal(t, val.String(), same.String())
+
+	_, err = vm.RunString(`server.close()`)
+
+	require.NoError(t, err)
+
+	// the classes of different VUs don't share the servers
+	require.NoError(t, vm.Set("VUFaker", faker.NewClass(vm, nil)))
+	require.NoError(t, vm.Set("OtherVUFaker", faker.NewClass(vm, nil)))
+
+	val, err = vm.RunString(`
+	const servers = [new VUFaker(1), new VUFaker(2), new OtherVUFaker(1)].map((f) => f.serve("127.0.0.1:0", {}))
+
+	servers.forEach((s) => s.close())
+	;[servers[0].url === servers[1].url, servers[0].url !== servers[2].url]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "true,true", val.String())
+
+	_, err = vm.RunString(`new Faker(11).serve("127.0.0.1:0", { "/users": { user: { ref: "user" } } })`)
+
+	require.ErrorContains(t, err, "ref fields are not supported")
+
+	_, err = vm.RunString(`new Faker(11).serve("127.0.0.1:0", { "FETCH": { id: "uuid" } })`)
+
+	require.ErrorContains(t, err, "invalid route")
+}
+
+func Test_Faker_messaging_stream(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const checks = {}
+	const stream = faker.messaging.stream({ user: "username", text: "sentence" }, { ratePerSecond: 4, jitter: 0.5 })
+	const { value } = stream.next()
+
+	checks.delay = value.delay >= 125 && value.delay <= 375
+	checks.message = typeof value.message.user === "string" && typeof value.message.text === "string"
+
+	let count = 0
+
+	for (const item of stream) {
+	  if (++count === 10) break
+	}
+
+	checks.iterator = count === 10
+	checks.steady = faker.messaging.stream({ id: "uuid" }, { ratePerSecond: 2 }).next().value.delay === 500
+
+	const timers = []
+	const received = []
+
+	globalThis.setTimeout = (fn, delay) => timers.push({ fn, delay })
+
+	stream.start((message) => received.push(message))
+
+	for (let i = 0; i < 5 && timers.length; i++) {
+	  timers.shift().fn()
+	}
+
+	stream.stop()
+
+	while (timers.length) timers.shift().fn()
+
+	checks.callback = received.length === 5 && typeof received[4].user === "string"
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`faker.messaging.stream({ id: "uuid" }, { jitter: 2 })`)
+
+	require.ErrorContains(t, err, "jitter")
+
+	vm = sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	_, err = vm.RunString(`new Faker(11).messaging.stream({ id: "uuid" }).start(() => {})`)
+
+	require.ErrorContains(t, err, "setTimeout is not available")
+}
+
+func Test_Faker_forms_fill(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	_, err := vm.RunString(`
+	const faker = new Faker(11)
+	const typed = {}
+	const element = (attrs) => ({
+	  getAttribute: async (name) => attrs[name] ?? null,
+	  fill: async (value) => { typed[attrs.name] = value },
+	})
+	const page = {
+	  $$: async () => [
+	    element({ name: "fname", autocomplete: "given-name" }),
+	    element({ name: "lname" }),
+	    element({ name: "contact", type: "email" }),
+	    element({ name: "zipcode" }),
+	    element({ name: "csrf", type: "hidden" }),
+	    element({ name: "newsletter", type: "checkbox" }),
+	  ],
+	  locator: (selector) => ({ fill: async (value) => { typed[selector] = value } }),
+	}
+
+	var detected, mapped
+
+	faker.forms.fill(page).then((filled) => { detected = filled })
+	faker.forms.fill(page, { "#first": "firstName", "#email": "email", "#age": ["intRange", 18, 18] })
+	  .then((filled) => { mapped = filled })
+	`)
+
+	require.NoError(t, err)
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const local = (email) => email.split("@")[0].replace(/[^a-z]/g, "")
+
+	checks.detected = Object.keys(detected).sort().join() === "contact,fname,lname,zipcode"
+	checks.typed = typed.fname === detected.fname && typed.csrf === undefined && typed.newsletter === undefined
+	checks.coherent = local(detected.contact).startsWith(detected.fname.toLowerCase().replace(/[^a-z]/g, ""))
+	checks.mapped = typed["#first"] === mapped["#first"] && typed["#age"] === "18"
+	checks.mappedCoherent = local(mapped["#email"]).startsWith(mapped["#first"].toLowerCase().replace(/[^a-z]/g, ""))
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`faker.forms.fill()`)
+
+	require.ErrorContains(t, err, "page")
+}
+
+func Test_Faker_fromSchema_invalid(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const schema = { name: "firstName", age: ["intRange", 18, 30], address: { city: "city", score: ["float64Range", 0, 1] } }
+	const get = (obj, path) => path.split(".").reduce((parent, key) => parent?.[key], obj)
+	const checks = {}
+
+	for (let i = 0; i < 50; i++) {
+	  const missing = faker.fromSchema(schema, { invalid: "missingRequired" })
+	  const wrong = faker.fromSchema(schema, { invalid: "wrongType" })
+	  const range = faker.fromSchema(schema, { invalid: "outOfRange" })
+
+	  checks.missing = (checks.missing ?? true) && missing.violation.rule === "missingRequired" &&
+	    get(missing.data, missing.violation.field) === undefined && Object.keys(missing.data.address).length +
+	    Object.keys(missing.data).length === 4
+
+	  checks.wrong = (checks.wrong ?? true) && wrong.violation.rule === "wrongType" &&
+	    typeof get(wrong.data, wrong.violation.field) === wrong.violation.actual &&
+	    wrong.violation.actual !== wrong.violation.expected
+
+	  const value = get(range.data, range.violation.field)
+
+	  checks.range = (checks.range ?? true) && ["age", "address.score"].includes(range.violation.field) &&
+	    value === range.violation.actual && (range.violation.field === "age" ? value < 18 || value > 30 : value < 0 || value > 1)
+	}
+
+	checks.plain = typeof faker.fromSchema(schema).name === "string" && faker.fromSchema(schema, {}).violation === undefined
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`faker.fromSchema({ name: "firstName" }, { invalid: "outOfRange" })`)
+
+	require.ErrorContains(t, err, "no field can be generated outOfRange")
+
+	_, err = vm.RunString(`faker.fromSchema({ name: "firstName" }, { invalid: "tooLong" })`)
+
+	require.ErrorContains(t, err, "invalid value tooLong")
+}
+
+func Test_Faker_fuzz_mutate(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const checks = {}
+	const seed = { name: "Jane", age: 42, tags: ["a", "b"], address: { city: "Paris" } }
+	const operators = new Set()
+
+	for (let i = 0; i < 200; i++) {
+	  const { data, operator, path } = faker.fuzz.mutate(seed)
+
+	  operators.add(operator)
+
+	  if (JSON.stringify(data) === JSON.stringify(seed)) checks["changed " + operator + " " + path] = false
+	}
+
+	checks.operators = operators.size === 6
+	checks.seed = JSON.stringify(seed) === '{"name":"Jane","age":42,"tags":["a","b"],"address":{"city":"Paris"}}'
+
+	const nulled = faker.fuzz.mutate({ name: "Jane" }, { operators: ["null"] })
+
+	checks.null = nulled.operator === "null" && (nulled.path === "" ? nulled.data === null : nulled.data.name === null)
+
+	const long = faker.fuzz.mutate("abc", { operators: ["overlong"] })
+
+	checks.overlong = long.path === "" && long.data.length >= 1024
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`faker.fuzz.mutate({ name: "Jane" }, { operators: ["shuffle"] })`)
+
+	require.ErrorContains(t, err, "unknown operator shuffle")
+
+	_, err = vm.RunString(`faker.fuzz.mutate(null)`)
+
+	require.ErrorContains(t, err, "no operator is applicable")
+}
+
+func Test_Faker_journey(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const options = {
+	  transitions: {
+	    browse: { browse: 2, search: 3, exit: 1 },
+	    search: { browse: 1, cart: 2, exit: 1 },
+	    cart: { search: 1, checkout: 2, exit: 0 },
+	  },
+	}
+	const checks = {}
+	const visitor = new Faker(11).journey(options)
+
+	checks.states = visitor.states.join() === "browse,search,exit,cart,checkout"
+
+	const walks = Array.from({ length: 50 }, () => visitor.walk())
+
+	checks.start = walks.every((walk) => walk[0] === "browse")
+	checks.end = walks.every((walk) => ["exit", "checkout"].includes(walk[walk.length - 1]))
+	checks.transitions = walks.every((walk) => walk.every((step, i) => i === 0 || step in options.transitions[walk[i - 1]]))
+	checks.zero = walks.every((walk) => !walk.join().includes("cart,exit"))
+	checks.limit = visitor.walk(2).length <= 2
+
+	const steps = Array.from({ length: 100 }, () => visitor.next())
+
+	checks.next = steps[0] === "browse" && steps.some((step, i) => i > 0 && step === "browse" && ["exit", "checkout"].includes(steps[i - 1]))
+
+	visitor.reset()
+
+	checks.reset = visitor.next() === "browse"
+
+	const again = new Faker(11).journey(options)
+
+	checks.seed = JSON.stringify(Array.from({ length: 50 }, () => again.walk())) === JSON.stringify(walks)
+	checks.custom = new Faker(11).journey({ ...options, start: "cart" }).walk()[0] === "cart"
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).journey({ transitions: { a: { b: 1 } }, start: "c" })`)
+
+	require.ErrorContains(t, err, "unknown state c")
+
+	_, err = vm.RunString(`new Faker(11).journey({ states: ["a"], transitions: { a: { b: 1 } } })`)
+
+	require.ErrorContains(t, err, "unknown state b")
+
+	_, err = vm.RunString(`new Faker(11).journey({ transitions: { a: { b: -1 } } })`)
+
+	require.ErrorContains(t, err, "invalid weight of a to b")
+}
+
+func Test_Faker_iot_sensorReading(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const readings = Array.from({ length: 200 }, () => faker.iot.sensorReading())
+
+	checks.type = readings.every((reading) => reading.type === "temperature" && reading.unit === "Cel")
+	checks.id = readings.every((reading) => !("id" in reading))
+	checks.drift = readings.every((reading, i) => i === 0 || Math.abs(reading.value - readings[i - 1].value) < 1)
+	checks.varies = new Set(readings.map((reading) => reading.value)).size > 10
+
+	const kitchen = faker.iot.sensorReading({ type: "Humidity", driftModel: "randomWalk", id: "kitchen" })
+	const cellar = faker.iot.sensorReading({ type: "humidity", id: "cellar" })
+
+	checks.options = kitchen.type === "humidity" && kitchen.unit === "%RH" && kitchen.id === "kitchen"
+	checks.independent = cellar.id === "cellar" && Math.abs(faker.iot.sensorReading({ type: "humidity", id: "kitchen" }).value - kitchen.value) < 5
+
+	const again = new Faker(11)
+
+	checks.seed = JSON.stringify(readings.map((reading) => reading.value)) ===
+	  JSON.stringify(Array.from({ length: 200 }, () => again.iot.sensorReading().value))
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).iot.sensorReading({ type: "wind" })`)
+
+	require.ErrorContains(t, err, "unknown sensor type")
+
+	_, err = vm.RunString(`new Faker(11).iot.sensorReading({ driftModel: "linear" })`)
+
+	require.ErrorContains(t, err, "unknown drift model")
+}
+
+func Test_Faker_product_barcodePng(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	// modules returns the bars (1) and spaces (0) of the barcode image without the quiet zones
+	modules := func(script string, moduleWidth int) string {
+		t.Helper()
+
+		val, err := vm.RunString(script)
+
+		require.NoError(t, err)
+
+		var data []byte
+
+		require.NoError(t, vm.ExportTo(val, &data))
+
+		img, err := png.Decode(bytes.NewReader(data))
+
+		require.NoError(t, err)
+
+		var buff strings.Builder
+
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x += moduleWidth {
+			if gray, _, _, _ := img.At(x, 0).RGBA(); gray == 0 {
+				buff.WriteByte('1')
+			} else {
+				buff.WriteByte('0')
+			}
+		}
+
+		return strings.Trim(buff.String(), "0")
+	}
+
+	ean := modules(`Array.from(new Uint8Array(new Faker(11).product.barcodePng("5901234123457")))`, 2)
+
+	// 5 is encoded by the LGGLLG parity of the left hand digits
+	require.Len(t, ean, 95)
+	require.Equal(t, "101"+"0001011"+"0100111"+"0110011"+"0010011"+"0111101"+"0011101"+"01010", ean[:50])
+	require.Equal(t, "1100110"+"1101100"+"1000010"+"1011100"+"1001110"+"1000100"+"101", ean[50:])
+
+	upc := modules(`Array.from(new Uint8Array(new Faker(11).product.barcodePng("036000291452", { moduleWidth: 1 })))`, 1)
+
+	require.Len(t, upc, 95)
+
+	code := modules(`Array.from(new Uint8Array(new Faker(11).product.barcodePng("PJJ123C", { height: 10 })))`, 2)
+
+	// start B, 7 characters, check symbol and stop (13 modules, the trailing bar is 2 modules wide)
+	require.Len(t, code, 9*11+13)
+	require.True(t, strings.HasPrefix(code, "11010010000"))
+	require.True(t, strings.HasSuffix(code, "1100011101011"))
+
+	gs1 := modules(`Array.from(new Uint8Array(new Faker(11).product.barcodePng("(01)09501101530003(10)AB1(21)12")))`, 2)
+
+	// start C and FNC1
+	require.True(t, strings.HasPrefix(gs1, "11010011100"+"11110101110"))
+
+	_, err := vm.RunString(`new Faker(11).product.barcodePng("12345", { symbology: "ean13" })`)
+
+	require.ErrorContains(t, err, "expected 13 digits")
+
+	_, err = vm.RunString(`new Faker(11).product.barcodePng("(01", {})`)
+
+	require.ErrorContains(t, err, "invalid barcode")
+
+	_, err = vm.RunString(`new Faker(11).product.barcodePng("123", { moduleWidth: 0 })`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+}
+
+func Test_Faker_internet_qrPng(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`Array.from(new Uint8Array(new Faker(11).internet.qrPng("https://k6.io", 290)))`)
+
+	require.NoError(t, err)
+
+	var data []byte
+
+	require.NoError(t, vm.ExportTo(val, &data))
+
+	img, err := png.Decode(bytes.NewReader(data))
+
+	require.NoError(t, err)
+	require.Equal(t, 290, img.Bounds().Dx())
+	require.Equal(t, 290, img.Bounds().Dy())
+
+	// version 1 has 21 modules, with the 4 modules wide quiet zones the scale is 10 pixels
+	dark := func(x, y int) bool {
+		gray, _, _, _ := img.At(40+x*10+5, 40+y*10+5).RGBA()
+
+		return gray == 0
+	}
+
+	for _, corner := range [][2]int{{0, 0}, {14, 0}, {0, 14}} {
+		for idx := range 7 {
+			require.True(t, dark(corner[0]+idx, corner[1]), "finder pattern top")
+			require.True(t, dark(corner[0], corner[1]+idx), "finder pattern left")
+			require.True(t, dark(corner[0]+idx, corner[1]+6), "finder pattern bottom")
+		}
+
+		require.False(t, dark(corner[0]+1, corner[1]+1), "finder pattern ring")
+		require.True(t, dark(corner[0]+3, corner[1]+3), "finder pattern center")
+	}
+
+	// timing pattern
+	for idx := 8; idx < 13; idx++ {
+		require.Equal(t, idx%2 == 0, dark(idx, 6))
+	}
+
+	// the codes of every version (1-15, filled to capacity) are decoded back by an independent reader
+	val, err = vm.RunString(`
+	const contents = [14, 26, 42, 62, 84, 106, 122, 152, 180, 213, 251, 287, 331, 362, 412].map((n) => "k6".repeat(206).slice(0, n))
+
+	for (const type of ["url", "wifi", "vcard"]) {
+	  contents.push(new Faker(11).internet.qrContent(type))
+	}
+
+	contents.map((content) => [content, Array.from(new Uint8Array(new Faker(11).internet.qrPng(content, 512)))])
+	`)
+
+	require.NoError(t, err)
+
+	var codes [][2]any
+
+	pureBarcode := map[gozxing.DecodeHintType]any{gozxing.DecodeHintType_PURE_BARCODE: true}
+
+	require.NoError(t, vm.ExportTo(val, &codes))
+
+	for _, code := range codes {
+		content := code[0].(string) //nolint:forcetypeassert
+
+		require.NoError(t, vm.ExportTo(vm.ToValue(code[1]), &data))
+
+		img, err := png.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+
+		bitmap, err := gozxing.NewBinaryBitmapFromImage(img)
+		require.NoError(t, err)
+
+		// the image is rendered, not photographed, so the code is read as a pure barcode
+		result, err := qrcode.NewQRCodeReader().Decode(bitmap, pureBarcode)
+		require.NoError(t, err, content)
+		require.Equal(t, content, result.GetText())
+	}
+
+	val, err = vm.RunString(`
+	const same = new Faker(11).internet.qrPng()
+	const other = new Faker(11).internet.qrPng()
+
+	new Uint8Array(same).join() === new Uint8Array(other).join() && same.byteLength > 0
+	`)
+
+	require.NoError(t, err)
+	require.True(t, val.ToBoolean())
+
+	val, err = vm.RunString(`new Faker(11).internet.qrPng("x".repeat(412), 100).byteLength`)
+
+	require.NoError(t, err)
+	require.Positive(t, val.ToInteger())
+
+	_, err = vm.RunString(`new Faker(11).internet.qrPng("x".repeat(413))`)
+
+	require.ErrorContains(t, err, "content too long for QR code")
+
+	_, err = vm.RunString(`new Faker(11).internet.qrPng("x", 0)`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+}
+
+func Test_Faker_iter(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const names = []
+
+	for (const name of new Faker(11).iter("username")) {
+	  if (names.push(name) === 3) break
+	}
+
+	checks.stream = names.join() === new Faker(11).many("username", 3).join()
+
+	const ranged = new Faker(11).iter("numbers.intRange", 2, 19)
+
+	checks.args = [1, 2, 3].map(() => ranged.next().value).every((n) => n >= 2 && n <= 19)
+
+	const indexed = new Faker(11).iter((index) => index * 2)
+
+	checks.function = indexed.next().value === 0 && indexed.next().value === 2
+
+	const users = new Faker(11).iter({ name: "username", id: "uuid" })
+	const { value, done } = users.next()
+
+	checks.schema = !done && typeof value.name === "string" && typeof value.id === "string"
+
+	users.return()
+
+	checks.return = users.next().done
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString("new Faker(11).iter()")
+
+	require.ErrorContains(t, err, "missing parameter")
+
+	_, err = vm.RunString("new Faker(11).iter('noSuchFunction')")
+
+	require.Error(t, err)
+}
+
+func Test_Faker_lazy(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const user = faker.lazy({ name: "username", id: "uuid", address: { city: "city", zip: "zip" } })
+
+	checks.keys = Object.keys(user).join() === "name,id,address"
+
+	const id = user.id
+
+	checks.cached = typeof id === "string" && user.id === id
+	checks.first = id === new Faker(11).call("uuid")
+	checks.nested = typeof user.address.city === "string" && user.address.city === user.address.city
+	checks.missing = user.email === undefined && !("email" in user) && "name" in user
+
+	user.name = "bob"
+	user.extra = 42
+
+	checks.assign = user.name === "bob" && user.extra === 42
+
+	delete user.id
+
+	checks.delete = !("id" in user)
+	checks.json = JSON.stringify(Object.keys(JSON.parse(JSON.stringify(user)))) === '["name","address","extra"]'
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_compile(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const schema = {
+		id: "uuid",
+		age: ["intRange", 18, 99],
+		address: { city: "city", zip: "zip" },
+		plan: { oneOf: ["word", { func: "intRange", args: [1, 3] }] },
+	}
+	const gen = new Faker(11).compile(schema)
+	const faker = new Faker(11)
+
+	checks.one = JSON.stringify(gen.one()) === JSON.stringify(faker.fromSchema(schema))
+	checks.many = JSON.stringify(gen.many(3)) === JSON.stringify([1, 2, 3].map(() => faker.fromSchema(schema)))
+	checks.empty = gen.many(0).length === 0
+	checks.range = gen.many(100).every((user) => user.age >= 18 && user.age <= 99)
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).compile({ age: ["intRange", "foo", 99] })`)
+
+	require.ErrorContains(t, err, "intRange")
+
+	_, err = vm.RunString(`new Faker(11).compile({ id: "uuid" }).many(-1)`)
+
+	require.ErrorContains(t, err, "invalid value -1")
+
+	_, err = vm.RunString(`new Faker(11).compile({ id: "uuid" }).many(1e18)`)
+
+	require.ErrorContains(t, err, "expected integer between 0 and 10000000")
+
+	_, err = vm.RunString(`new Faker({ seed: 11, maxTotalBytes: 1000 }).compile({ id: "uuid" }).many(1000)`)
+
+	require.ErrorContains(t, err, "exceeds maxTotalBytes 1000")
+}
+
+func Test_Faker_fromSchema_modifiers(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const schema = {
+	  id: "uuid",
+	  email: { func: "email", nullable: 0.3 },
+	  phone: { func: "phone", missing: 0.3 },
+	  contact: { oneOf: ["email", ["intRange", 1, 9], { city: "city" }], weights: [1, 1, 2] },
+	}
+	const records = faker.many("uuid", 200).map(() => faker.fromSchema(schema))
+	const share = (fn) => records.filter(fn).length / records.length
+
+	checks.nullable = share((r) => r.email === null) > 0.2 && share((r) => r.email === null) < 0.4
+	checks.missing = share((r) => !("phone" in r)) > 0.2 && share((r) => !("phone" in r)) < 0.4
+	checks.present = records.every((r) => typeof r.id === "string")
+	checks.oneOf = share((r) => typeof r.contact === "object") > 0.4 && share((r) => typeof r.contact === "number") > 0.1
+	checks.expect = records.every((r) => faker.expectations(schema)(r))
+	checks.required = faker.many("uuid", 20).every(() => {
+	  const { data } = faker.fromSchema(schema, { invalid: "wrongType" })
+
+	  return Object.keys(data).length === 4
+	})
+	checks.plain = new Faker(11).fromSchema({ id: "uuid" }).id === new Faker(11).fromSchema({ id: { func: "uuid", nullable: 0 } }).id
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	for _, spec := range []string{
+		`{ a: { func: "uuid", nullable: 2 } }`,
+		`{ a: { oneOf: [] } }`,
+		`{ a: { oneOf: ["uuid", "email"], weights: [1] } }`,
+		`{ a: { oneOf: ["uuid"], weights: [-1] } }`,
+	} {
+		_, err := vm.RunString("new Faker(11).fromSchema(" + spec + ")")
+
+		require.Error(t, err, spec)
+	}
+}
+
+func Test_Faker_collections(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const { orders, users, items } = faker.collections({
+	  orders: { schema: { id: "uuid", userId: { ref: "users.id" }, city: { ref: "users.address.city" } }, count: 50 },
+	  users: { id: "uuid", address: { city: "city" }, managerId: { ref: "users.id" } },
+	  items: { schema: { orderId: { ref: "orders.id" }, order: { ref: "orders" } }, count: 5 },
+	})
+	const userIds = users.map((u) => u.id)
+
+	checks.counts = users.length === 10 && orders.length === 50 && items.length === 5
+	checks.foreignKeys = orders.every((o) => userIds.includes(o.userId))
+	checks.nested = orders.every((o) => users.some((u) => u.id === o.userId) && typeof o.city === "string")
+	checks.grandchildren = items.every((i) => orders.some((o) => o.id === i.orderId) && typeof i.order.userId === "string")
+	checks.self = users[0].managerId === undefined && users.slice(1).every((u) => userIds.includes(u.managerId))
+	checks.scoped = faker.fromSchema({ id: { ref: "users.id" } }).id === undefined
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).collections({ a: { id: { ref: "b.id" } }, b: { id: { ref: "a.id" } } })`)
+
+	require.ErrorContains(t, err, "circular reference a -> b -> a")
+
+	_, err = vm.RunString(`new Faker(11).collections({ a: { schema: { id: "uuid" }, count: -1 } })`)
+
+	require.ErrorContains(t, err, "invalid value -1")
+}
+
+func Test_Faker_changes(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const dataset = [1, 2, 3, 4, 5].map((id) => ({ id, name: faker.call("username"), active: id % 2 === 0 }))
+	const changes = faker.changes(dataset, { updates: 20, inserts: 5, deletes: 4 })
+	const state = new Map(dataset.map((r) => [r.id, r]))
+	const ops = { insert: 0, update: 0, delete: 0 }
+	let consistent = true
+	let sequence = 0
+
+	for (const event of changes) {
+	  ops[event.op]++
+	  consistent &&= event.sequence === ++sequence
+
+	  if (event.op !== "insert") {
+	    consistent &&= JSON.stringify(state.get(event.key)) === JSON.stringify(event.before)
+	  }
+
+	  if (event.op === "delete") {
+	    consistent &&= event.after === null
+	    state.delete(event.key)
+	  } else {
+	    consistent &&= event.after.id === event.key
+	    state.set(event.key, event.after)
+	  }
+
+	  if (event.op === "update") {
+	    consistent &&= event.changed.length > 0 && !event.changed.includes("id")
+	  }
+	}
+
+	checks.counts = changes.length === 29 && ops.insert === 5 && ops.update === 20 && ops.delete === 4
+	checks.consistent = consistent
+	checks.state = JSON.stringify(changes.state()) === JSON.stringify([...state.values()])
+	checks.keys = [...state.keys()].every((key) => typeof key === "number") && state.size === 6
+	checks.untouched = dataset.length === 5 && dataset[0].id === 1
+	checks.done = changes.next().done
+
+	const generated = faker.changes([], { inserts: 3, schema: { id: "uuid", email: "email" } })
+	const inserted = [...generated]
+
+	checks.schema = inserted.length === 3 && inserted.every((e) => e.op === "insert" && e.after.email.includes("@"))
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).changes([{ id: 1 }], { deletes: 2 })`)
+
+	require.ErrorContains(t, err, "parameter deletes: invalid value 2")
+
+	_, err = vm.RunString(`new Faker(11).changes([{ id: 1 }], { updates: 1.5 })`)
+
+	require.ErrorContains(t, err, "invalid value 1.5")
+}
+
+func Test_Faker_estimateBytes(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const faker = new Faker(11)
+	const schema = { id: "uuid", name: "person.name", email: "email" }
+	const state = faker.state()
+	const estimate = faker.estimateBytes(schema, 1000)
+	const actual = JSON.stringify(new Faker(12).collections({ users: { schema, count: 1000 } }).users).length
+
+	checks.accuracy = Math.abs(estimate - actual) / actual < 0.1
+	checks.untouched = faker.state() === state
+	checks.empty = faker.estimateBytes(schema, 0) === 2
+	checks.function = faker.estimateBytes("uuid", 10) === 10 * 38 + 9 + 2
+
+	const limited = new Faker({ seed: 11, maxTotalBytes: 10000 })
+
+	checks.within = limited.many("uuid", 200).length === 200
+	checks.collections = limited.collections({ users: { schema, count: 10 } }).users.length === 10
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker({ seed: 11, maxTotalBytes: 10000 }).many("uuid", 1000)`)
+
+	require.ErrorContains(t, err, "uuid: parameter count: estimated output of 39000 bytes exceeds maxTotalBytes 10000")
+
+	_, err = vm.RunString(`new Faker({ seed: 11, maxTotalBytes: 100 }).many("uuid", 1000000)`)
+
+	require.ErrorContains(t, err, "1000000 values exceed maxTotalBytes 100")
+
+	_, err = vm.RunString(`new Faker({ seed: 11, maxTotalBytes: 300 }).zen.uuid.many(9)`)
+
+	require.ErrorContains(t, err, "output exceeds maxTotalBytes 300")
+
+	_, err = vm.RunString(`new Faker({ seed: 11, maxTotalBytes: 300 }).collections({ users: { id: "uuid" } })`)
+
+	require.ErrorContains(t, err, "collections: parameter spec: output exceeds maxTotalBytes 300")
+
+	_, err = vm.RunString(`new Faker({ maxTotalBytes: -1 })`)
+
+	require.ErrorContains(t, err, "invalid maxTotalBytes: -1 (expected positive number)")
+}
+
+func Test_Faker_parallel(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {}
+	const sequential = new Faker(11).many("email", 10000)
+	const single = new Faker({ seed: 11, parallel: 1 }).many("email", 10000)
+	const multi = new Faker({ seed: 11, parallel: 8 }).many("email", 10000)
+
+	checks.length = multi.length === 10000 && multi.every((email) => email.includes("@"))
+	checks.deterministic = JSON.stringify(single) === JSON.stringify(multi)
+	checks.sharded = JSON.stringify(sequential) !== JSON.stringify(multi)
+	checks.distinct = new Set(multi).size > 9900
+
+	const faker = new Faker({ seed: 11, parallel: true })
+	const people = faker.zen.person.many(5000)
+
+	checks.structs = people.length === 5000 && typeof people[4999].firstName === "string"
+	checks.prefix = JSON.stringify(new Faker({ seed: 11, parallel: 4 }).many("email", 100)) === JSON.stringify(multi.slice(0, 100))
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker({ seed: 11, parallel: 4 }).many("inventory", 10000, 100)`)
+
+	require.ErrorContains(t, err, "inventory slots must be between 1 and 54")
+
+	_, err = vm.RunString(`new Faker({ seed: 11, parallel: 4, maxTotalBytes: 100000 }).many("email", 100000)`)
+
+	require.ErrorContains(t, err, "exceeds maxTotalBytes 100000")
+
+	_, err = vm.RunString(`new Faker({ parallel: -1 })`)
+
+	require.ErrorContains(t, err, "invalid parallel: -1 (expected boolean or non-negative integer)")
+}
+
+func Test_Faker_options_parameter(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString("new Faker(11).numbers.intRange({min: 2, max: 19})")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(5), val.ToInteger())
+
+	val, err = vm.RunString("new Faker(11).word.sentence({wordCount: 3})")
+
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(val.String()), 3)
+
+	val, err = vm.RunString("new Faker(11).word.sentence({wordCount: 30, maxBytes: 50})")
+
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(val.String()), 50)
+
+	_, err = vm.RunString("new Faker(11).numbers.intRange({min: 2, maximum: 19})")
+
+	require.ErrorContains(t, err, "intRange: parameter maximum: unknown option")
+
+	_, err = vm.RunString("new Faker(11).numbers.intRange({min: 2})")
+
+	require.ErrorContains(t, err, "intRange: parameter max: missing parameter")
+
+	val, err = vm.RunString("new Faker(11).person.nationalId('GB', {fake: true})")
+
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(val.String(), "QQ"))
+
+	_, err = vm.RunString("new Faker(11).person.nationalId('GB', {country: 'US'})")
+
+	require.ErrorContains(t, err, "nationalId: parameter country: already passed as argument")
+
+	val, err = vm.RunString("new Faker(11).internet.oauthTokenResponse({scopes: ['read', 'write'], expiresIn: 60})")
+
+	require.NoError(t, err)
+	require.Equal(t, "read write", val.ToObject(vm).Get("scope").String())
+	require.Equal(t, int64(60), val.ToObject(vm).Get("expires_in").ToInteger())
+}
+
+func Test_Faker_null_parameter(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const calls = {
+	  "numbers.number": (faker) => faker.numbers.number({ min: null }),
+	  "numbers.uintRange": (faker) => faker.numbers.uintRange(null, 10),
+	  "address.latitudeRange": (faker) => faker.address.latitudeRange({ min: null, max: 45 }),
+	  "address.longitudeRange": (faker) => faker.address.longitudeRange({ max: null }),
+	  "payment.price": (faker) => faker.payment.price({ min: 10, max: null }),
+	  "finance.amount": (faker) => faker.finance.amount(null, null),
+	  "time.duration": (faker) => faker.time.duration({ min: null, unit: null }),
+	}
+
+	const defaults = {
+	  "numbers.number": (faker) => faker.numbers.number(),
+	  "numbers.uintRange": (faker) => faker.numbers.uintRange(0, 10),
+	  "address.latitudeRange": (faker) => faker.address.latitudeRange({ max: 45 }),
+	  "address.longitudeRange": (faker) => faker.address.longitudeRange(),
+	  "payment.price": (faker) => faker.payment.price({ min: 10 }),
+	  "finance.amount": (faker) => faker.finance.amount(),
+	  "time.duration": (faker) => faker.time.duration(),
+	}
+
+	Object.keys(calls).filter((name) => calls[name](new Faker(11)) !== defaults[name](new Faker(11)))
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString("new Faker(11).numbers.intRange({min: null, max: 19})")
+
+	require.ErrorContains(t, err, "intRange: parameter min: missing parameter")
+}
+
+func Test_Faker_many(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString("new Faker(11).many('username', 3)")
+
+	require.NoError(t, err)
+
+	var names []string
+
+	require.NoError(t, vm.ExportTo(val, &names))
+	require.Len(t, names, 3)
+	require.Equal(t, "Abshire5538", names[0])
+
+	val, err = vm.RunString("Array.isArray(new Faker(11).numbers.intRange.many(5, 2, 19))")
+
+	require.NoError(t, err)
+	require.True(t, val.ToBoolean())
+
+	val, err = vm.RunString("new Faker(11).numbers.intRange.many(5, {min: 2, max: 19})[0]")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(5), val.ToInteger())
+
+	_, err = vm.RunString("new Faker(11).many('username', -1)")
+
+	require.ErrorContains(t, err, "parameter count: invalid value -1")
+
+	for _, count := range []string{"1e18", "10000001", "2.5", "NaN", "Infinity"} {
+		_, err = vm.RunString("new Faker(11).many('email', " + count + ")")
+
+		require.ErrorContains(t, err, "parameter count: invalid value")
+		require.ErrorContains(t, err, "expected integer between 0 and 10000000")
+	}
+}
+
+func Benchmark_Faker_many(b *testing.B) {
+	for _, options := range []string{"{ seed: 11 }", "{ seed: 11, maxTotalBytes: 1e9 }"} {
+		for _, count := range []int{1000, 10000} {
+			b.Run(fmt.Sprintf("%s/%d", options, count), func(b *testing.B) {
+				vm := sobek.New()
+
+				require.NoError(b, vm.Set("Faker", faker.Constructor))
+
+				_, err := vm.RunString("const faker = new Faker(" + options + ")")
+				require.NoError(b, err)
+
+				script, err := sobek.Compile("", fmt.Sprintf("faker.many('person', %d)", count), false)
+				require.NoError(b, err)
+
+				b.ReportAllocs()
+
+				for b.Loop() {
+					if _, err := vm.RunProgram(script); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func Test_Faker_fromSchema(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	new Faker(11).fromSchema({
+	  name: "username",
+	  age: ["intRange", 18, 18],
+	  address: { city: "address.city", zip: { func: "intRange", args: [1, 1] } },
+	})
+	`)
+
+	require.NoError(t, err)
+
+	var obj map[string]any
+
+	require.NoError(t, vm.ExportTo(val, &obj))
+	require.Equal(t, "Abshire5538", obj["name"])
+	require.Equal(t, int64(18), obj["age"])
+	require.Equal(t, int64(1), obj["address"].(map[string]any)["zip"]) //nolint:forcetypeassert
+
+	_, err = vm.RunString(`new Faker(11).fromSchema({ name: "no such function" })`)
+
+	require.ErrorContains(t, err, "FakerLookupError")
+
+	val, err = vm.RunString(`
+	const recursive = { name: "username" }
+	recursive.self = recursive
+
+	const nested = { name: "username", child: { friends: { oneOf: ["username", recursive] } } }
+	let deep = { name: "username" }
+	for (let i = 0; i < 100; i++) deep = { child: deep }
+
+	const faker = new Faker(11)
+	const errorOf = (fn) => { try { fn(); return "" } catch (e) { return e.name + ": " + e.message } }
+	const reused = { city: "address.city" }
+
+	;[
+	  errorOf(() => faker.fromSchema(recursive)),
+	  errorOf(() => faker.compile(nested)),
+	  errorOf(() => faker.expectations(recursive)),
+	  errorOf(() => faker.lazy(deep)),
+	  errorOf(() => faker.fromSchema({ home: reused, work: reused })),
+	].join("\n")
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"FakerArgumentError: fromSchema: parameter schema: the schema contains itself (expected object)",
+		"FakerArgumentError: compile: parameter schema: the schema contains itself (expected object)",
+		"FakerArgumentError: expectations: parameter schema: the schema contains itself (expected object)",
+		"FakerArgumentError: lazy: parameter schema: nested deeper than 32 levels (expected object)",
+		"",
+	}, "\n"), val.String())
+}
+
+func Test_Faker_encode(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const schema = { type: "record", name: "User", fields: [{ name: "name", type: "string" }, { name: "age", type: "int" }] }
+
+	Array.from(new Uint8Array(faker.encode.avro(schema, { name: "Bob", age: 30 }, { schemaId: 7 })))
+	`)
+
+	require.NoError(t, err)
+
+	var data []byte
+
+	require.NoError(t, vm.ExportTo(val, &data))
+	require.Equal(t, []byte{0, 0, 0, 0, 7, 0x06, 'B', 'o', 'b', 0x3c}, data)
+
+	val, err = vm.RunString(`faker.encode.avro(schema).byteLength`)
+
+	require.NoError(t, err)
+	require.Positive(t, val.ToInteger())
+
+	val, err = vm.RunString(`
+	const proto = "syntax = \"proto3\"; message Key { string id = 1; } message Order { int32 total = 1; }"
+
+	Array.from(new Uint8Array(faker.encode.protobuf(proto, { total: 150 }, { message: "Order", schemaId: 1 })))
+	`)
+
+	require.NoError(t, err)
+	require.NoError(t, vm.ExportTo(val, &data))
+	require.Equal(t, []byte{0, 0, 0, 0, 1, 0x02, 0x02, 0x08, 0x96, 0x01}, data)
+
+	val, err = vm.RunString(`Array.from(new Uint8Array(faker.encode.cbor({ a: [1, -2, 1.5, "x"], bb: null, c: true })))`)
+
+	require.NoError(t, err)
+	require.NoError(t, vm.ExportTo(val, &data))
+	require.Equal(t, []byte{
+		0xa3, 0x61, 'a', 0x84, 0x01, 0x21, 0xfa, 0x3f, 0xc0, 0x00, 0x00, 0x61, 'x',
+		0x61, 'c', 0xf5, 0x62, 'b', 'b', 0xf6,
+	}, data)
+
+	val, err = vm.RunString(`
+	const readings = [
+	  { deviceId: "dev1", type: "temperature", value: 21.5, unit: "Cel", timestamp: "2024-03-15T10:20:00Z" },
+	  { n: "door", vb: false },
+	]
+
+	String.fromCharCode(...new Uint8Array(faker.encode.senml(readings, { format: "json", baseName: "urn:dev:" })))
+	`)
+
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`[{"bn":"urn:dev:","n":"dev1:temperature","u":"Cel","v":21.5,"t":1710498000},{"n":"door","vb":false}]`,
+		val.String(),
+	)
+
+	val, err = vm.RunString(`Array.from(new Uint8Array(faker.encode.senml({ n: "t", v: 1 })))`)
+
+	require.NoError(t, err)
+	require.NoError(t, vm.ExportTo(val, &data))
+	require.Equal(t, []byte{0x81, 0xa2, 0x00, 0x61, 't', 0x02, 0x01}, data)
+
+	_, err = vm.RunString(`faker.encode.senml([{ n: "t", speed: 1 }])`)
+
+	require.ErrorContains(t, err, "unknown field speed")
+
+	_, err = vm.RunString(`faker.encode.senml([{ n: "t", v: 1 }], { format: "xml" })`)
+
+	require.ErrorContains(t, err, "unknown SenML format")
+
+	_, err = vm.RunString(`faker.encode.avro(schema, { name: "Bob" })`)
+
+	require.ErrorContains(t, err, "avro: parameter record: age: invalid value: missing field")
+
+	_, err = vm.RunString(`faker.encode.protobuf("message {", {})`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+}
+
+//nolint:paralleltest // replaces the deprecation tables
+func Test_Faker_aliases(t *testing.T) {
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`JSON.stringify(new Faker(11).aliases())`)
+
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"functions":{"creditCardCvv":{"replacement":"creditCardCVV","since":"v0.5.0","removal":"v1.0.0"}},"categories":{}}`,
+		val.String(),
+	)
+
+	val, err = vm.RunString(`new Faker(11).payment.creditCardCvv() === new Faker(11).payment.creditCardCVV()`)
+
+	require.NoError(t, err)
+	require.True(t, val.ToBoolean())
+
+	faker.SetDeprecations(t,
+		map[string]faker.Deprecation{"cardCvv": {Replacement: "creditCardCVV", Since: "v0.5.0", Removal: "v1.0.0"}},
+		map[string]faker.Deprecation{"number": {Replacement: "numbers", Since: "v0.5.0", Removal: "v1.0.0"}},
+	)
+
+	val, err = vm.RunString(`
+	const faker = new Faker(11)
+	const aliases = faker.aliases()
+	const checks = {
+	  function: aliases.functions.cardCvv.replacement === "creditCardCVV" && aliases.functions.cardCvv.removal === "v1.0.0",
+	  category: aliases.categories.number.replacement === "numbers",
+	  renamed: faker.payment.cardCvv() === new Faker(11).payment.creditCardCVV(),
+	  moved: new Faker(11).number.intRange(1, 1000) === new Faker(11).numbers.intRange(1, 1000),
+	  qualified: new Faker(11).call("number.intRange", 1, 10) === new Faker(11).call("numbers.intRange", 1, 10),
+	  hidden: !Object.keys(faker).includes("number") && "number" in faker,
+	  deprecated: !Object.keys(faker.payment).includes("cardCvv") && "cardCvv" in faker.payment,
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_enumerable(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const { firstName, lastName } = faker.person
+	const person = { ...faker.person }
+	const checks = {
+	  categories: Object.keys(faker).includes("person") && !Object.keys(faker).includes("call"),
+	  functions: Object.keys(faker.person).includes("firstName"),
+	  sorted: Object.keys(faker.person).join() === Object.keys(faker.person).sort().join(),
+	  forms: Object.keys(faker.internet).includes("queryString") && "multipartForm" in faker.internet,
+	  has: "person" in faker && "call" in faker && !("nope" in faker) && !("nope" in faker.person),
+	  destructure: typeof firstName() === "string" && typeof lastName() === "string",
+	  spread: typeof person.firstName === "function" && Object.keys(person).length === Object.keys(faker.person).length,
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_properties(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const keys = Object.keys(faker).join()
+
+	faker.person.email()
+	faker.numbers.intArray(2, 1, 9)
+
+	const checks = {
+	  keys: Object.keys(faker).join() === keys,
+	  get: faker["person.email"] === undefined && faker["numbers.intArray"] === undefined,
+	  has: !("person.email" in faker) && !("numbers.intArray" in faker),
+	  cached: faker.person.email === faker.person.email,
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_cardinality(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const keys = faker.cardinality("uuid", 3)
+	const cycle = [keys.next(), keys.next(), keys.next(), keys.next()]
+	const random = faker.cardinality(() => faker.numbers.intRange(1, 5), 5, { mode: "random" })
+	const picked = new Set(faker.numbers.intRange.many(50, 1, 1).map(() => random.next()))
+	const args = faker.cardinality("intRange", 2, { args: [7, 8] })
+	const checks = {
+	  length: keys.length === 3 && new Set(keys.values).size === 3,
+	  roundRobin: cycle[0] === keys.values[0] && cycle[2] === keys.values[2] && cycle[3] === cycle[0],
+	  random: random.values.slice().sort().join() === "1,2,3,4,5" && [...picked].every(v => v >= 1 && v <= 5),
+	  args: args.values.slice().sort().join() === "7,8",
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).cardinality("boolean", 3)`)
+
+	require.ErrorContains(t, err, "cardinality: parameter count: only 2 distinct values generated")
+
+	_, err = vm.RunString(`new Faker(11).cardinality("uuid", 3, { mode: "sequential" })`)
+
+	require.ErrorContains(t, err, "invalid value sequential")
+
+	_, err = vm.RunString(`new Faker(11).cardinality("uuid", 1e18)`)
+
+	require.ErrorContains(t, err, "expected integer between 1 and 10000000")
+}
+
+func Test_Faker_markov(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const news = faker.word.markov()
+	const reviews = faker.word.markov({ builtin: "reviews", words: 20, order: 1 })
+	const custom = faker.word.markov({ corpus: "The cat sat on the mat. The dog sat on the log.", words: 7 })
+	const checks = {
+	  news: news.split(" ").length === 50 && /^[A-Z]/.test(news) && news.endsWith("."),
+	  reviews: reviews.split(" ").length === 20 && reviews.endsWith("."),
+	  custom: /^The (cat|dog) sat on the (mat|log)\. The\.$/.test(custom),
+	  empty: faker.word.markov({ words: 0 }) === "",
+	  reproducible: new Faker(11).word.markov() === news,
+	  enumerable: Object.keys(faker.word).includes("markov"),
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	for script, msg := range map[string]string{
+		`new Faker(11).word.markov({ builtin: "poems" })`:                 "unknown corpus poems",
+		`new Faker(11).word.markov({ order: 4 })`:                         "parameter order: invalid value",
+		`new Faker(11).word.markov({ corpus: "too short", order: 2 })`:    "corpus too short for the order",
+		`new Faker(11).word.markov({ corpus: "a b c", builtin: "news" })`: "conflicting options builtin, corpus",
+		`new Faker(11).word.markov({ corpusPath: "corpus.txt" })`:         "can only be loaded in the init context",
+	} {
+		_, err = vm.RunString(script)
+
+		require.ErrorContains(t, err, msg)
+	}
+}
+
+func Test_Faker_forms(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const schema = {
+	  q: ["randomString", ["a b&c=d+e"]],
+	  address: { city: ["randomString", ["New York"]] },
+	  id: ["intRange", 7, 7],
+	}
+
+	JSON.stringify([faker.internet.queryString(schema), faker.internet.formUrlEncoded(schema)])
+	`)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+	  "q=a%20b%26c%3Dd%2Be&address%5Bcity%5D=New%20York&id=7",
+	  "q=a+b%26c%3Dd%2Be&address%5Bcity%5D=New+York&id=7"
+	]`, val.String())
+
+	val, err = vm.RunString(`
+	const form = faker.internet.multipartForm(
+	  { name: ["randomString", ["Bob"]] },
+	  { avatar: { filename: "me.png", contentType: "image/png", size: 16 }, note: { content: "hello" } },
+	)
+
+	JSON.stringify({
+	  boundary: form.boundary,
+	  contentType: form.contentType,
+	  body: String.fromCharCode(...new Uint8Array(form.body)),
+	})
+	`)
+
+	require.NoError(t, err)
+
+	var form struct {
+		Boundary    string `json:"boundary"`
+		ContentType string `json:"contentType"`
+		Body        string `json:"body"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(val.String()), &form))
+	require.Equal(t, "multipart/form-data; boundary="+form.Boundary, form.ContentType)
+	require.True(t, strings.HasPrefix(form.Body, "--"+form.Boundary+"\r\n"))
+	require.True(t, strings.HasSuffix(form.Body, "--"+form.Boundary+"--\r\n"))
+	require.Contains(t, form.Body, "Content-Disposition: form-data; name=\"name\"\r\n\r\nBob\r\n")
+	require.Contains(t, form.Body, `Content-Disposition: form-data; name="avatar"; filename="me.png"`)
+	require.Contains(t, form.Body, "Content-Type: image/png")
+	require.Contains(t, form.Body, `filename="note.bin"`)
+	require.Contains(t, form.Body, "hello")
+
+	_, err = vm.RunString(`faker.internet.multipartForm({}, { file: { size: -1 } })`)
+
+	require.ErrorContains(t, err, "invalid size")
+
+	_, err = vm.RunString(`faker.internet.queryString("username")`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+}
+
+func Test_Faker_struct_output(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const person = faker.person.person()
+
+	JSON.stringify([
+	  Object.keys(person).includes("firstName"),
+	  typeof person.address.city,
+	  Object.getPrototypeOf(person) === Object.prototype,
+	  Object.keys({ ...faker.book.book() }).sort(),
+	])
+	`)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `[true, "string", true, ["author", "genre", "title"]]`, val.String())
+}
+
+func Test_Faker_key_order(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	// the keys and the value types only, the timestamps of the records depend on the current time
+	const shape = (val) => {
+	  if (Array.isArray(val)) return val.map(shape)
+	  if (val !== null && typeof val === "object") return Object.entries(val).map(([key, item]) => [key, shape(item)])
+	  return typeof val
+	}
+
+	const generate = () => {
+	  const faker = new Faker(11)
+	  const records = [faker.person.person(), faker.call("order"), faker.call("podManifest"), faker.call("labelSet"), faker.call("shipment")]
+
+	  return JSON.stringify(shape(records))
+	}
+
+	const first = generate()
+
+	JSON.stringify({
+	  same: Array.from({ length: 20 }, generate).every((json) => json === first),
+	  person: Object.keys(new Faker(11).person.person()).slice(0, 3),
+	  event: Object.keys(new Faker(11).call("cloudEvent")),
+	})
+	`)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+	  "same": true,
+	  "person": ["firstName", "lastName", "gender"],
+	  "event": ["specversion", "id", "source", "type", "time", "datacontenttype", "data"]
+	}`, val.String())
+}
+
+func Test_Faker_helpers(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	script := `
+	const faker = new Faker(11)
+	const arr = ["a", "b", "c", "d", "e"]
+	const obj = { x: 1, y: 2, z: 3 }
+
+	JSON.stringify({
+	  element: faker.helpers.arrayElement(arr),
+	  elements: faker.helpers.arrayElements(arr, 3),
+	  some: faker.helpers.arrayElements(arr),
+	  shuffled: faker.helpers.shuffle(arr),
+	  key: faker.helpers.objectKey(obj),
+	  value: faker.helpers.objectValue(obj),
+	  always: faker.helpers.maybe(() => "yes", 1),
+	  never: faker.helpers.maybe(() => "yes", 0) === undefined,
+	  original: arr,
+	})
+	`
+
+	val, err := vm.RunString(script)
+
+	require.NoError(t, err)
+
+	var result struct {
+		Element  string   `json:"element"`
+		Elements []string `json:"elements"`
+		Some     []string `json:"some"`
+		Shuffled []string `json:"shuffled"`
+		Key      string   `json:"key"`
+		Value    int      `json:"value"`
+		Always   string   `json:"always"`
+		Never    bool     `json:"never"`
+		Original []string `json:"original"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(val.String()), &result))
+
+	arr := []string{"a", "b", "c", "d", "e"}
+
+	require.Contains(t, arr, result.Element)
+	require.Len(t, result.Elements, 3)
+	require.Subset(t, arr, result.Elements)
+	require.NotEmpty(t, result.Some)
+	require.ElementsMatch(t, arr, result.Shuffled)
+	require.Contains(t, []string{"x", "y", "z"}, result.Key)
+	require.Contains(t, []int{1, 2, 3}, result.Value)
+	require.Equal(t, "yes", result.Always)
+	require.True(t, result.Never)
+	require.Equal(t, arr, result.Original)
+
+	other := sobek.New()
+
+	require.NoError(t, other.Set("Faker", faker.Constructor))
+
+	again, err := other.RunString(script)
+
+	require.NoError(t, err)
+	require.Equal(t, val.String(), again.String())
+
+	_, err = vm.RunString(`faker.helpers.arrayElement([])`)
+
+	require.ErrorContains(t, err, "empty array")
+
+	_, err = vm.RunString(`faker.helpers.maybe(() => 1, 2)`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+
+	_, err = vm.RunString(`faker.helpers.shuffle("abc")`)
+
+	require.ErrorContains(t, err, "FakerArgumentError")
+}
+
+func Test_Faker_recipe(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+
+	JSON.stringify([
+	  faker.recipe("name:username, age:intRange(5, 5), word: randomString(['a,b', \"c\"]), email"),
+	  faker.recipe("id: uuid()").id.length,
+	  faker.recipe("name:person.name, email:person.email, amount:finance.amount(10,500)"),
+	])
+	`)
+
+	require.NoError(t, err)
+
+	var data []any
+
+	require.NoError(t, json.Unmarshal([]byte(val.String()), &data))
+	require.Len(t, data, 3)
+
+	obj, ok := data[0].(map[string]any)
+
+	require.True(t, ok)
+	require.Equal(t, "Abshire5538", obj["name"])
+	require.InDelta(t, 5, obj["age"], 0)
+	require.Contains(t, []any{"a,b", "c"}, obj["word"])
+	require.Contains(t, obj, "email")
+	require.InDelta(t, 36, data[1], 0)
+
+	obj, ok = data[2].(map[string]any)
+
+	require.True(t, ok)
+	require.Contains(t, obj, "name")
+	require.Contains(t, obj, "email")
+
+	amount, ok := obj["amount"].(float64)
+
+	require.True(t, ok)
+	require.True(t, amount >= 10 && amount <= 500)
+	require.InDelta(t, amount, math.Round(amount*100)/100, 1e-9)
+
+	_, err = vm.RunString(`faker.recipe("name:username(")`)
+
+	require.ErrorContains(t, err, "unterminated parameter list")
+
+	_, err = vm.RunString(`faker.recipe("name:noSuchFunction")`)
+
+	require.ErrorContains(t, err, "no such generator function: noSuchFunction")
+}
+
+func Test_Faker_writeParquet(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+	dir := t.TempDir()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+	require.NoError(t, vm.Set("dir", dir))
+
+	_, err := vm.RunString(`
+	const faker = new Faker(11)
+	const schema = { id: "uuid", name: "firstName", age: "int8", score: "float64", active: "boolean" }
+	const rows = Array.from({ length: 100 }, () => faker.fromSchema(schema))
+
+	rows[1].age = null
+	rows[2].tags = ["a", "b"]
+
+	faker.writeParquet(dir + "/users.parquet", rows)
+	faker.writeArrow(dir + "/users.arrow", rows)
+	`)
+
+	require.NoError(t, err)
+
+	val, err := vm.RunString("JSON.stringify(rows)")
+
+	require.NoError(t, err)
+
+	var rows []map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(val.String()), &rows))
+
+	names := []string{"id", "name", "age", "score", "active", "tags"}
+
+	// the missing values are nulls, the nested values are JSON strings
+	for _, row := range rows {
+		for _, name := range names {
+			switch val := row[name].(type) {
+			case nil:
+				row[name] = nil
+			case []any:
+				text, err := json.Marshal(val)
+
+				require.NoError(t, err)
+
+				row[name] = string(text)
+			}
+		}
+	}
+
+	columns, values := readParquet(t, filepath.Join(dir, "users.parquet"))
+
+	require.Equal(t, names, columns)
+	require.Equal(t, rows, values)
+
+	columns, values = readArrow(t, filepath.Join(dir, "users.arrow"))
+
+	require.Equal(t, names, columns)
+	require.Equal(t, rows, values)
+
+	for script, reason := range map[string]string{
+		`faker.writeParquet("", rows)`:                      "parameter path: missing parameter",
+		`faker.writeParquet(dir + "/x.parquet", [])`:        "parameter dataset: empty array",
+		`faker.writeArrow(dir + "/x.arrow", [1, 2])`:        "parameter dataset: invalid item 1",
+		`faker.writeArrow(dir + "/x.arrow", "users")`:       "parameter dataset: invalid value",
+		`faker.writeParquet(dir + "/none/x.parquet", rows)`: "no such file or directory",
+	} {
+		_, err = vm.RunString(script)
+
+		require.ErrorContains(t, err, reason, script)
+	}
+}
+
+// readParquet reads the column names and the rows of the Parquet file using the parquet-go reader.
+// The integers are returned as float64, like the numbers decoded from JSON.
+func readParquet(t *testing.T, path string) ([]string, []map[string]any) {
+	t.Helper()
+
+	file, err := os.Open(path) //nolint:gosec
+	require.NoError(t, err)
+
+	defer file.Close() //nolint:errcheck
+
+	info, err := file.Stat()
+	require.NoError(t, err)
+
+	pfile, err := parquet.OpenFile(file, info.Size())
+	require.NoError(t, err)
+
+	columns := make([]string, 0, len(pfile.Schema().Columns()))
+
+	for _, path := range pfile.Schema().Columns() {
+		columns = append(columns, strings.Join(path, "."))
+	}
+
+	rows := make([]map[string]any, 0, pfile.NumRows())
+
+	for _, group := range pfile.RowGroups() {
+		reader := group.Rows()
+		buff := make([]parquet.Row, group.NumRows())
+
+		count, err := reader.ReadRows(buff)
+		if !errors.Is(err, io.EOF) {
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, reader.Close())
+
+		for _, prow := range buff[:count] {
+			row := make(map[string]any, len(columns))
+
+			for _, pval := range prow {
+				var val any
+
+				switch {
+				case pval.IsNull():
+				case pval.Kind() == parquet.Boolean:
+					val = pval.Boolean()
+				case pval.Kind() == parquet.Int64:
+					val = float64(pval.Int64())
+				case pval.Kind() == parquet.Double:
+					val = pval.Double()
+				default:
+					val = string(pval.ByteArray())
+				}
+
+				row[columns[pval.Column()]] = val
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return columns, rows
+}
+
+// readArrow reads the column names and the rows of the Arrow IPC file using the Apache Arrow reader.
+// The integers are returned as float64, like the numbers decoded from JSON.
+func readArrow(t *testing.T, path string) ([]string, []map[string]any) {
+	t.Helper()
+
+	file, err := os.Open(path) //nolint:gosec
+	require.NoError(t, err)
+
+	defer file.Close() //nolint:errcheck
+
+	reader, err := ipc.NewFileReader(file)
+	require.NoError(t, err)
+
+	defer reader.Close() //nolint:errcheck
+
+	columns := make([]string, 0, reader.Schema().NumFields())
+
+	for _, field := range reader.Schema().Fields() {
+		columns = append(columns, field.Name)
+	}
+
+	var rows []map[string]any
+
+	for idx := range reader.NumRecords() {
+		record, err := reader.Record(idx)
+		require.NoError(t, err)
+
+		for ridx := range int(record.NumRows()) {
+			row := make(map[string]any, len(columns))
+
+			for cidx, name := range columns {
+				val := record.Column(cidx).GetOneForMarshal(ridx)
+				if num, isInt := val.(int64); isInt {
+					val = float64(num)
+				}
+
+				row[name] = val
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return columns, rows
+}
+
+func Test_Faker_expectations(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const schema = { name: "username", age: ["intRange", 18, 99], summary: ["loremIpsumSentence", 3] }
+	const valid = faker.expectations(schema)
+
+	;[
+	  valid(faker.fromSchema(schema)),
+	  valid(JSON.stringify(faker.fromSchema(schema))),
+	  valid({ body: '{"name":"foo","age":42,"summary":"bar"}' }),
+	  valid({ body: '{"name":"foo","age":"42","summary":"bar"}' }),
+	  valid({ body: '{"name":"foo"}' }),
+	  valid({ body: 'not a json' }),
+	].join(",")
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "true,true,true,false,false,false", val.String())
+
+	val, err = vm.RunString(`
+	const constrained = {
+	  age: ["intRange", 18, 99],
+	  score: ["float64Range", 1.234, 1.236, 2],
+	  price: ["price", 1.001, 1.009],
+	  ratio: ["float32Range", 0.1, 0.2],
+	  code: ["digitN", 6],
+	  id: ["identifier", "cus_", 12],
+	  secret: ["passwordPolicy", 10, 14],
+	  text: ["textOfLength", 50],
+	  items: ["cartItems", 4],
+	  sku: "sku",
+	  plate: ["lexify", "??-??"],
+	  born: ["date", "RFC1123"],
+	  day: "dateRange",
+	  nested: { pin: ["numerify", "####"] },
+	  choice: { oneOf: [["intRange", 1, 9], ["letterN", 3]] },
+	}
+	const expect = faker.expectations(constrained)
+	const record = () => faker.fromSchema(constrained)
+	const broken = (patch) => JSON.stringify({ ...record(), ...patch })
+	const checks = {
+	  generated: faker.many("uuid", 100).every(() => expect(record())),
+	  range: !expect(broken({ age: 100 })) && !expect(broken({ score: 1.3 })),
+	  length: !expect(broken({ code: "12345" })) && !expect(broken({ secret: "short" })) && !expect(broken({ text: "short" })),
+	  prefix: !expect(broken({ id: "acct_aaaaaaaaaaaa" })) && expect(broken({ id: "cus_aaaaaaaaaaaa" })),
+	  items: !expect(broken({ items: [] })),
+	  format: !expect(broken({ sku: "ABC-1234X" })) && !expect(broken({ plate: "ab-c1" })) && !expect(broken({ day: "03/04/2005" })),
+	  date: !expect(broken({ born: "2005-04-03" })) && expect(broken({ born: "Wed, 28 Apr 1954 13:43:46 UTC" })),
+	  nested: !expect(broken({ nested: { pin: "12a4" } })),
+	  variants: expect(broken({ choice: 5 })) && expect(broken({ choice: "abc" })) && !expect(broken({ choice: 10 })),
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+}
+
+func Test_Faker_validate(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const rules = {
+	  email: "email",
+	  phone: "phone",
+	  card: ["required", "luhn"],
+	  born: { rule: "date", min: "1900-01-01", max: new Date(Date.UTC(2000, 0, 1)) },
+	  address: { zip: (v) => /^[0-9]{5}$/.test(v) || "invalid zip" },
+	}
+	const users = [
+	  { email: "foo@example.com", phone: "+14155552671", card: "4111-1111-1111-1111", born: "1980-05-01", address: { zip: "12345" } },
+	  { email: "foo@", phone: "555-1234", card: "4111-1111-1111-1112", born: "2010-05-01", address: { zip: "1" } },
+	  { email: "bar@example.com", phone: "+36301234567", born: new Date(1970, 1, 1), address: {} },
+	]
+
+	JSON.stringify({
+	  dataset: faker.validate(users, rules).map((v) => v.path + ":" + v.rule),
+	  value: faker.validate("4242 4242 4242 4242", "luhn").length,
+	  messages: faker.validate(users[1], rules).map((v) => v.message),
+	})
+	`)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+	  "dataset": [
+	    "[1].email:email", "[1].phone:e164", "[1].card:luhn", "[1].born:date", "[1].address.zip:custom",
+	    "[2].card:required", "[2].card:luhn", "[2].address.zip:custom"
+	  ],
+	  "value": 0,
+	  "messages": [
+	    "invalid email address", "invalid E.164 phone number", "invalid Luhn checksum",
+	    "date after 2000-01-01T00:00:00Z", "invalid zip"
+	  ]
+	}`, val.String())
+
+	_, err = vm.RunString(`faker.validate("foo", "iban")`)
+
+	require.ErrorContains(t, err, "validate: parameter rules: unknown rule iban")
+}
+
+func Test_Faker_adapt(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const created = faker.adapt((res) => ({ users: JSON.parse(res.body).id }))
+	const listed = faker.adapt((res) => ({ users: JSON.parse(res.body).map((u) => u.id) }))
+	const schema = { userId: { ref: "users" }, fallback: { ref: "orders", func: "intRange", args: [7, 7] }, none: { ref: "none" } }
+
+	const before = faker.ref("users")
+
+	created({ body: '{"id":"u1"}' })
+	listed({ body: '[{"id":"u2"},{"id":"u3"}]' })
+
+	const ids = new Set()
+	for (let i = 0; i < 50; i++) {
+	  ids.add(faker.fromSchema(schema).userId)
+	}
+
+	const obj = faker.fromSchema(schema)
+
+	JSON.stringify({ before: before === undefined, ids: [...ids].sort(), fallback: obj.fallback, none: "none" in obj && obj.none === undefined })
+	`)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"before":true,"ids":["u1","u2","u3"],"fallback":7,"none":true}`, val.String())
+
+	_, err = vm.RunString(`faker.adapt(42)`)
+
+	require.ErrorContains(t, err, "adapt: parameter fn: invalid value 42 (expected function)")
+}
+
+func Test_Faker_scenarioData(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const options = { rows: 3, fields: { name: "username" } }
+	const first = new Faker(11).scenarioData("first", options)
+	const again = new Faker(11).scenarioData("first", options)
+	const second = new Faker(11).scenarioData("second", options)
+
+	const a = [first.next().name, first.next().name, first.next().name, first.next().name]
+	const b = [again.next().name, again.next().name, again.next().name]
+	const c = [second.next().name, second.next().name, second.next().name]
+
+	;[first.length, a[0] == a[3], a[0] != a[1], a.slice(0, 3).join() == b.join(), a.slice(0, 3).join() != c.join()].join()
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "3,true,true,true,true", val.String())
+
+	_, err = vm.RunString(`new Faker(11).scenarioData("first", { fields: { name: "username" } })`)
+
+	require.ErrorContains(t, err, "parameter rows")
+
+	for _, rows := range []string{"0", "1e18", "2.5"} {
+		_, err = vm.RunString(`new Faker(11).scenarioData("first", { rows: ` + rows + `, fields: { name: "username" } })`)
+
+		require.ErrorContains(t, err, "parameter rows: invalid value")
+		require.ErrorContains(t, err, "expected integer between 1 and 10000000")
+	}
+
+	val, err = vm.RunString(`
+	const configured = new Faker(11)
+	configured.loadData({ firstNames: ["Zelda"] })
+	configured.scenarioData("first", { rows: 2, fields: { name: "firstName" } }).next().name
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "Zelda", val.String())
+}
+
+func Test_Faker_threadSafe(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	_, err := vm.RunString("new Faker(11, {threadSafe: true})")
+
+	require.ErrorContains(t, err, "unsupported threadSafe: a Faker instance can only be used by the VU which created it")
+
+	val, err := vm.RunString("new Faker(11, {threadSafe: false}).call('username')")
+
+	require.NoError(t, err)
+	require.Equal(t, "Abshire5538", val.String())
+}
+
+func Test_Faker_state(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	for _, seed := range []string{"11", ""} {
+		val, err := vm.RunString(`
+		  var f = new Faker(` + seed + `)
+		  f.many("username", 10); f.many("uuid", 10); f.many("float64", 10)
+		  var restored = Faker.fromState(f.state())
+		  ;[f.state() == restored.state(), f.zen.username() == restored.zen.username(), f.zen.uuid() == restored.zen.uuid()]
+		`)
+
+		require.NoError(t, err)
+		require.Equal(t, "true,true,true", val.String())
+	}
+
+	val, err := vm.RunString(`
+	  var f = new Faker(11)
+	  var size = f.state().length
+	  f.many("username", 10000)
+	  ;[f.state().length <= size, Faker.fromState(f.state()).zen.username() == f.zen.username()]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "true,true", val.String())
+
+	_, err = vm.RunString(`Faker.fromState("foo")`)
+
+	require.ErrorContains(t, err, "invalid Faker state")
+
+	_, err = vm.RunString(`new Faker(11, { rng: "crypto" }).state()`)
+
+	require.ErrorContains(t, err, "the state of the crypto random source can not be exported")
+
+	val, err = vm.RunString(`typeof Faker.restore(new Faker(11, { rng: "crypto" }).snapshot()).zen.username()`)
+
+	require.NoError(t, err)
+	require.Equal(t, "string", val.String())
+}
+
+func Test_Faker_snapshot(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.NewClass(vm, nil)))
+
+	val, err := vm.RunString(`
+	const faker = new Faker({ seed: 11, defaults: { length: 5 } })
+	const store = faker.adapt(() => ({ user: [{ id: 42 }] }))
+
+	store()
+	faker.zen.username()
+
+	const data = JSON.parse(JSON.stringify({ faker }))
+	const restored = Faker.restore(data.faker)
+	const values = [restored.ref("user").id, restored.internet.password(), restored.zen.username()]
+
+	;[
+	  data.faker === faker.snapshot(),
+	  values[0] === 42,
+	  values[1].length === 5,
+	  values.join() === [faker.ref("user").id, faker.internet.password(), faker.zen.username()].join(),
+	  Faker.fromState(faker.state()).ref("user") === undefined,
+	]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "true,true,true,true,true", val.String())
+
+	val, err = vm.RunString(`
+	const loaded = new Faker(11)
+	const avro = { type: "record", name: "User", fields: [{ name: "name", type: "string" }] }
+
+	loaded.loadData({ firstNames: ["Zelda"] })
+	loaded.recipe("name: firstName")
+	loaded.encode.avro(avro, { name: "Bob" })
+
+	const snapshot = loaded.snapshot()
+	const copy = Faker.restore(snapshot)
+
+	;[
+	  copy.snapshot() === snapshot,
+	  copy.person.firstName() === "Zelda",
+	  copy.recipe("name: firstName").name === "Zelda",
+	  new Uint8Array(copy.encode.avro(avro, { name: "Bob" })).length === 4,
+	]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "true,true,true,true", val.String())
+
+	_, err = vm.RunString(`Faker.restore("foo")`)
+
+	require.ErrorContains(t, err, "invalid Faker state")
+}
@@ -0,0 +1,184 @@
+package faker
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/grafana/sobek"
+)
+
+// formField is a name-value pair of a query string or form body.
+type formField struct {
+	name  string
+	value string
+}
+
+// formFields generates an object based on the schema and returns its properties as name-value pairs.
+// Nested objects are flattened using bracket notation (e.g. address[city]), arrays result in repeated names,
+// null and undefined values are omitted.
+func (f *faker) formFields(method string, schema sobek.Value) []formField {
+	var fields []formField
+
+	f.appendFormFields(&fields, "", f.generate(f.compileSchema(method, schema)))
+
+	return fields
+}
+
+func (f *faker) appendFormFields(fields *[]formField, name string, val sobek.Value) {
+	if val == nil || sobek.IsUndefined(val) || sobek.IsNull(val) {
+		return
+	}
+
+	obj, isObject := val.(*sobek.Object)
+
+	switch {
+	case isObject && obj.ClassName() == "Array":
+		var items []sobek.Value
+
+		_ = f.runtime.ExportTo(obj, &items)
+
+		for _, item := range items {
+			f.appendFormFields(fields, name, item)
+		}
+	case isOptions(val):
+		for _, key := range obj.Keys() {
+			field := key
+
+			if len(name) != 0 {
+				field = name + "[" + key + "]"
+			}
+
+			f.appendFormFields(fields, field, obj.Get(key))
+		}
+	default:
+		*fields = append(*fields, formField{name: name, value: val.String()})
+	}
+}
+
+// queryString generates a query string (without leading question mark) based on the schema.
+// Spaces are percent encoded (%20), as in URLs.
+func (f *faker) queryString(schema sobek.Value) string {
+	pairs := make([]string, 0)
+
+	for _, field := range f.formFields("queryString", schema) {
+		pairs = append(pairs, queryEscape(field.name)+"="+queryEscape(field.value))
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// formURLEncoded generates an application/x-www-form-urlencoded body based on the schema.
+// Spaces are encoded as plus signs, as in HTML form submissions.
+func (f *faker) formURLEncoded(schema sobek.Value) string {
+	pairs := make([]string, 0)
+
+	for _, field := range f.formFields("formUrlEncoded", schema) {
+		pairs = append(pairs, url.QueryEscape(field.name)+"="+url.QueryEscape(field.value))
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// queryEscape escapes the string for use in a query string, spaces are encoded as %20.
+// The plus sign itself is encoded by url.QueryEscape, so the replacement is safe.
+func queryEscape(str string) string {
+	return strings.ReplaceAll(url.QueryEscape(str), "+", "%20")
+}
+
+// multipartForm generates a multipart/form-data body based on the schema.
+// The files object contains the file parts by field name, each with optional filename, contentType,
+// size and content (string or ArrayBuffer) properties. Random content of the size (1 KiB by default) is used
+// if the content is missing.
+func (f *faker) multipartForm(schema sobek.Value, files sobek.Value) *sobek.Object {
+	const (
+		method         = "multipartForm"
+		boundaryLength = 24
+	)
+
+	fields := f.formFields(method, schema)
+
+	f.rescope()
+
+	var buff bytes.Buffer
+
+	writer := multipart.NewWriter(&buff)
+	boundary := "----FakerFormBoundary" + stringOf(f.rand, mixedAlphanum, boundaryLength)
+
+	_ = writer.SetBoundary(boundary)
+
+	for _, field := range fields {
+		_ = writer.WriteField(field.name, field.value)
+	}
+
+	if files != nil && !sobek.IsUndefined(files) && !sobek.IsNull(files) {
+		obj := f.objectArgument(method, "files", files)
+
+		for _, name := range obj.Keys() {
+			f.writeFormFile(writer, name, obj.Get(name))
+		}
+	}
+
+	_ = writer.Close()
+
+	result := f.runtime.NewObject()
+
+	_ = result.Set("body", f.runtime.NewArrayBuffer(buff.Bytes()))
+	_ = result.Set("boundary", boundary)
+	_ = result.Set("contentType", writer.FormDataContentType())
+
+	return result
+}
+
+// writeFormFile writes the file part of the multipart form described by the file specification object.
+func (f *faker) writeFormFile(writer *multipart.Writer, name string, spec sobek.Value) {
+	const (
+		method      = "multipartForm"
+		defaultSize = 1024
+	)
+
+	obj := f.objectArgument(method, name, spec)
+
+	filename, contentType, size := name+".bin", "application/octet-stream", int64(defaultSize)
+
+	if val := obj.Get("filename"); val != nil && !sobek.IsUndefined(val) {
+		filename = val.String()
+	}
+
+	if val := obj.Get("contentType"); val != nil && !sobek.IsUndefined(val) {
+		contentType = val.String()
+	}
+
+	if val := obj.Get("size"); val != nil && !sobek.IsUndefined(val) {
+		var ok bool
+
+		if size, ok = integerOf(val.Export()); !ok || size < 0 {
+			f.throw(&ArgumentError{Function: method, Parameter: name, Expected: "number", Reason: "invalid size " + val.String()})
+		}
+	}
+
+	var content []byte
+
+	if val := obj.Get("content"); val != nil && !sobek.IsUndefined(val) {
+		var ok bool
+
+		if content, ok = bytesOf(val.Export()); !ok {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: name, Expected: "string or ArrayBuffer", Reason: "invalid content",
+			})
+		}
+	} else {
+		content = randomBytes(f.rand, int(size))
+	}
+
+	header := make(textproto.MIMEHeader)
+
+	header.Set("Content-Disposition", multipart.FileContentDisposition(name, filename))
+	header.Set("Content-Type", contentType)
+
+	part, _ := writer.CreatePart(header)
+
+	_, _ = part.Write(content)
+}
@@ -0,0 +1,238 @@
+package faker
+
+import (
+	"strings"
+	"time"
+
+	"github.com/grafana/sobek"
+)
+
+// formFillMapped fills the fields of the page selected by the keys of the values (k6/browser locators).
+const formFillMapped = `(async (page, values) => {
+  for (const [selector, value] of Object.entries(values)) {
+    await page.locator(selector).fill(String(value))
+  }
+
+  return values
+})`
+
+// formFillDetected fills the input fields of the page whose purpose is detected by their attributes.
+const formFillDetected = `(async (page, detect) => {
+  const filled = {}
+
+  for (const element of await page.$$("input, textarea")) {
+    const attrs = {}
+
+    for (const name of ["autocomplete", "type", "name", "id"]) {
+      attrs[name] = (await element.getAttribute(name)) || ""
+    }
+
+    const value = detect(attrs)
+
+    if (value !== undefined) {
+      await element.fill(value)
+      filled[attrs.name || attrs.id || Object.keys(filled).length] = value
+    }
+  }
+
+  return filled
+})`
+
+// formAutocomplete contains the profile fields by HTML autocomplete token.
+//
+//nolint:gochecknoglobals
+var formAutocomplete = map[string]string{
+	"given-name":         "firstName",
+	"family-name":        "lastName",
+	"name":               "name",
+	"email":              "email",
+	"username":           "username",
+	"new-password":       "password",
+	"current-password":   "password",
+	"tel":                "phone",
+	"tel-national":       "phone",
+	"street-address":     "street",
+	"address-line1":      "street",
+	"address-level2":     "city",
+	"address-level1":     "state",
+	"postal-code":        "zip",
+	"country":            "country",
+	"country-name":       "country",
+	"organization":       "company",
+	"organization-title": "jobTitle",
+	"bday":               "birthdate",
+}
+
+// formTypes contains the profile fields by input type.
+//
+//nolint:gochecknoglobals
+var formTypes = map[string]string{
+	"email":    "email",
+	"tel":      "phone",
+	"password": "password",
+	"date":     "birthdate",
+}
+
+// formNames contains the profile fields by fragments of the input name or ID, in matching order.
+//
+//nolint:gochecknoglobals
+var formNames = [][2]string{
+	{"first", "firstName"}, {"given", "firstName"},
+	{"last", "lastName"}, {"family", "lastName"}, {"surname", "lastName"},
+	{"email", "email"}, {"mail", "email"},
+	{"user", "username"}, {"login", "username"},
+	{"pass", "password"},
+	{"phone", "phone"}, {"mobile", "phone"}, {"tel", "phone"},
+	{"zip", "zip"}, {"postal", "zip"}, {"postcode", "zip"},
+	{"city", "city"}, {"town", "city"},
+	{"state", "state"}, {"region", "state"}, {"province", "state"},
+	{"country", "country"},
+	{"company", "company"}, {"organization", "company"}, {"organisation", "company"},
+	{"title", "jobTitle"}, {"job", "jobTitle"},
+	{"street", "street"}, {"address", "street"},
+	{"birth", "birthdate"}, {"bday", "birthdate"}, {"dob", "birthdate"},
+	{"name", "name"},
+}
+
+// formSkippedTypes contains the input types never filled by the auto-detection.
+//
+//nolint:gochecknoglobals
+var formSkippedTypes = map[string]bool{
+	"hidden": true, "submit": true, "button": true, "reset": true, "image": true,
+	"checkbox": true, "radio": true, "file": true, "color": true, "range": true,
+}
+
+// forms returns the forms namespace object.
+func (f *faker) forms() *sobek.Object {
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("fill", f.fillForm)
+
+	return obj
+}
+
+// fillForm fills the form of the k6/browser page with coherent fake data (the name, the email, the address
+// etc. belong to the same person) and returns a promise of the filled values.
+//
+// The mapping is a schema keyed by locator selector, string field specifications naming a profile field
+// (e.g. firstName or email) use the coherent value. Without mapping the purpose of the input fields
+// is detected by their autocomplete, type, name and id attributes.
+func (f *faker) fillForm(page sobek.Value, mapping sobek.Value) sobek.Value {
+	const method = "forms.fill"
+
+	pageObj := f.objectArgument(method, "page", page)
+	profile := f.formProfile()
+
+	if mapping == nil || sobek.IsUndefined(mapping) {
+		detect := func(attrs map[string]string) sobek.Value {
+			if field := detectFormField(attrs); len(field) != 0 {
+				return f.runtime.ToValue(profile[field])
+			}
+
+			return sobek.Undefined()
+		}
+
+		return f.runFormScript(formFillDetected, pageObj, f.runtime.ToValue(detect))
+	}
+
+	obj := f.objectArgument(method, "mapping", mapping)
+	values := f.runtime.NewObject()
+
+	for _, selector := range obj.Keys() {
+		spec := obj.Get(selector)
+
+		if value, found := profile[spec.String()]; found && !isOptions(spec) {
+			_ = values.Set(selector, value)
+
+			continue
+		}
+
+		field := f.compileField(method, selector, spec)
+
+		_ = values.Set(selector, f.generateField(field))
+	}
+
+	return f.runFormScript(formFillMapped, pageObj, values)
+}
+
+// runFormScript calls the asynchronous JavaScript function of the script with the arguments.
+func (f *faker) runFormScript(script string, args ...sobek.Value) sobek.Value {
+	val, err := f.runtime.RunString(script)
+	if err != nil {
+		f.throw(err)
+	}
+
+	fn, _ := sobek.AssertFunction(val)
+
+	result, err := fn(sobek.Undefined(), args...)
+	if err != nil {
+		f.throw(err)
+	}
+
+	return result
+}
+
+// formProfile returns the coherent values of the form fields by profile field name.
+func (f *faker) formProfile() map[string]string {
+	generated := func(name string) string {
+		info, _ := lookupFunc(name)
+
+		return f.invoke(name, info, sobek.FunctionCall{This: sobek.Undefined()}).String()
+	}
+
+	first, last := generated("firstName"), generated("lastName")
+
+	const ageSpan = 62
+
+	birthdate := birthdateOf(f.rand, time.Now(), adultAge+f.rand.Intn(ageSpan))
+
+	return map[string]string{
+		"firstName": first,
+		"lastName":  last,
+		"name":      first + " " + last,
+		"email":     localPartOf(f.rand, emailPart(first), emailPart(last)) + "@" + generated("domainName"),
+		"username":  localPartOf(f.rand, emailPart(first), emailPart(last)),
+		"password":  generated("password"),
+		"phone":     generated("phone"),
+		"street":    generated("street"),
+		"city":      generated("city"),
+		"state":     generated("state"),
+		"zip":       generated("zip"),
+		"country":   generated("country"),
+		"company":   generated("company"),
+		"jobTitle":  generated("jobTitle"),
+		"birthdate": birthdate.Format(time.DateOnly),
+	}
+}
+
+// detectFormField returns the profile field of the input by its attributes, empty if it is not detected.
+func detectFormField(attrs map[string]string) string {
+	if formSkippedTypes[strings.ToLower(attrs["type"])] {
+		return ""
+	}
+
+	for _, token := range strings.Fields(strings.ToLower(attrs["autocomplete"])) {
+		if field, found := formAutocomplete[token]; found {
+			return field
+		}
+	}
+
+	if field, found := formTypes[strings.ToLower(attrs["type"])]; found {
+		return field
+	}
+
+	for _, attr := range []string{"name", "id"} {
+		value := strings.ToLower(attrs[attr])
+		if len(value) == 0 {
+			continue
+		}
+
+		for _, pair := range formNames {
+			if strings.Contains(value, pair[0]) {
+				return pair[1]
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,258 @@
+package faker
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+// sampleFile contains the parsed rows of a CSV or JSON dataset file.
+// The rows are immutable after parsing, so they are shared between the instances (and VUs).
+type sampleFile struct {
+	// rows contains the CSV rows as objects (by header name) or the items of the JSON array.
+	rows []any
+}
+
+// sampleFiles contains the parsed dataset files by absolute path.
+var sampleFiles sync.Map //nolint:gochecknoglobals
+
+// fromFile returns a generator sampling the rows (or the values of a column) of a CSV or JSON dataset file.
+//
+// The file is loaded in the init context, the path is relative to the script. CSV files must have a header row,
+// JSON files must contain an array. The next() method of the returned object picks a random row using the random
+// source of the instance, weighted by the numeric values of the weighted column if the option is set.
+func (f *faker) fromFile(filePath sobek.Value, options sobek.Value) *sobek.Object {
+	const method = "fromFile"
+
+	var column, weighted string
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("column"); val != nil && !sobek.IsUndefined(val) {
+			column = val.String()
+		}
+
+		if val := opts.Get("weighted"); val != nil && !sobek.IsUndefined(val) {
+			weighted = val.String()
+		}
+	}
+
+	file := f.sampleFile(method, filePath)
+
+	values := make([]any, len(file.rows))
+
+	for idx, row := range file.rows {
+		values[idx] = row
+
+		if len(column) != 0 {
+			values[idx] = f.sampleColumn(method, "column", row, column)
+		}
+	}
+
+	var cumulative []float64
+
+	if len(weighted) != 0 {
+		cumulative = f.sampleWeights(method, file.rows, weighted)
+	}
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("length", len(values))
+	_ = obj.Set("next", func() sobek.Value {
+		f.rescope()
+
+		if cumulative == nil {
+			return f.toValue(copyValue(values[f.rand.Intn(len(values))]))
+		}
+
+		// the first row whose cumulative weight exceeds the draw, rows of zero weight are never picked
+		draw := f.rand.Float64() * cumulative[len(cumulative)-1]
+		idx := sort.Search(len(cumulative), func(i int) bool { return cumulative[i] > draw })
+
+		return f.toValue(copyValue(values[idx]))
+	})
+
+	return obj
+}
+
+// initFilePath returns the absolute path of the file loaded in the init context, relative to the script.
+func (f *faker) initFilePath(method string, filePath sobek.Value) string {
+	if filePath == nil || sobek.IsUndefined(filePath) || len(filePath.String()) == 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "path", Expected: "string", Reason: "missing parameter"})
+	}
+
+	if f.vu == nil || f.vu.InitEnv() == nil {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "path", Expected: "string",
+			Reason: "the file can only be loaded in the init context",
+		})
+	}
+
+	return f.vu.InitEnv().GetAbsFilePath(filePath.String())
+}
+
+// readInitFile returns the content of the file at the absolute path returned by initFilePath.
+func (f *faker) readInitFile(method string, abs string) []byte {
+	fs, found := f.vu.InitEnv().FileSystems["file"]
+	if !found {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "path", Expected: "string", Reason: "no file system available",
+		})
+	}
+
+	data, err := fsext.ReadFile(fs, abs)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "path", Expected: "string", Reason: err.Error()})
+	}
+
+	return data
+}
+
+// sampleFile returns the parsed dataset file, the file is loaded and parsed only once.
+func (f *faker) sampleFile(method string, filePath sobek.Value) *sampleFile {
+	abs := f.initFilePath(method, filePath)
+
+	if file, found := sampleFiles.Load(abs); found {
+		return file.(*sampleFile) //nolint:forcetypeassert
+	}
+
+	data := f.readInitFile(method, abs)
+
+	rows, reason := parseSampleFile(abs, data)
+	if len(reason) != 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "path", Expected: "CSV or JSON file", Reason: reason})
+	}
+
+	file, _ := sampleFiles.LoadOrStore(abs, &sampleFile{rows: rows})
+
+	return file.(*sampleFile) //nolint:forcetypeassert
+}
+
+// parseSampleFile returns the rows of the CSV or JSON file (by extension), or the reason of the failure.
+func parseSampleFile(filePath string, data []byte) ([]any, string) {
+	var rows []any
+
+	switch ext := strings.ToLower(path.Ext(filePath)); ext {
+	case ".json":
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, "invalid JSON array: " + err.Error()
+		}
+	case ".csv":
+		records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+		if err != nil {
+			return nil, "invalid CSV: " + err.Error()
+		}
+
+		if len(records) == 0 {
+			return nil, "missing CSV header"
+		}
+
+		for _, record := range records[1:] {
+			row := make(map[string]any, len(records[0]))
+
+			for idx, name := range records[0] {
+				row[name] = record[idx]
+			}
+
+			rows = append(rows, row)
+		}
+	default:
+		return nil, "unsupported file format " + ext
+	}
+
+	if len(rows) == 0 {
+		return nil, "empty dataset"
+	}
+
+	return rows, ""
+}
+
+// sampleColumn returns the value of the column of the row, throws FakerArgumentError if the row has no such column.
+func (f *faker) sampleColumn(method string, param string, row any, column string) any {
+	obj, isObject := row.(map[string]any)
+
+	val, found := obj[column]
+	if !isObject || !found {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: param, Expected: "column name", Reason: "missing column " + column,
+		})
+	}
+
+	return val
+}
+
+// sampleWeights returns the cumulative weights of the rows, the weights are the values of the column.
+func (f *faker) sampleWeights(method string, rows []any, column string) []float64 {
+	cumulative := make([]float64, len(rows))
+
+	var total float64
+
+	for idx, row := range rows {
+		var weight float64
+
+		switch val := f.sampleColumn(method, "weighted", row, column).(type) {
+		case float64:
+			weight = val
+		case string:
+			var err error
+
+			if weight, err = strconv.ParseFloat(strings.TrimSpace(val), 64); err != nil {
+				weight = -1
+			}
+		default:
+			weight = -1
+		}
+
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: "weighted", Expected: "non-negative number",
+				Reason: "invalid weight in row " + strconv.Itoa(idx+1),
+			})
+		}
+
+		total += weight
+		cumulative[idx] = total
+	}
+
+	if total == 0 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "weighted", Expected: "positive number", Reason: "zero total weight",
+		})
+	}
+
+	return cumulative
+}
+
+// copyValue returns a deep copy of the JSON value, so the shared rows are not modified by the scripts.
+func copyValue(val any) any {
+	switch typed := val.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(typed))
+
+		for key, item := range typed {
+			copied[key] = copyValue(item)
+		}
+
+		return copied
+	case []any:
+		copied := make([]any, len(typed))
+
+		for idx, item := range typed {
+			copied[idx] = copyValue(item)
+		}
+
+		return copied
+	default:
+		return val
+	}
+}
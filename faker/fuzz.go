@@ -0,0 +1,461 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+var (
+	errUnknownNumberType       = errors.New("unknown number type")
+	errUnknownInjectionContext = errors.New("unknown injection context")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("boundarynumbers", gofakeit.Info{
+		Display:     "Boundary Numbers",
+		Category:    "fuzz",
+		Description: "Numbers at and just beyond the limits of a numeric type, e.g. for overflow and precision tests",
+		Example:     "[-129, -128, -127, -1, 0, 1, 126, 127, 128]",
+		Output:      "[]float64",
+		Params: []gofakeit.Param{
+			{
+				Field:       "type",
+				Display:     "Type",
+				Type:        "string",
+				Default:     "all",
+				Options:     fuzzKinds(boundaryNumbers),
+				Description: "Numeric type whose limits are returned, all for every type",
+			},
+		},
+		Generate: boundarynumbers,
+	})
+
+	gofakeit.AddFuncLookup("injectionstrings", gofakeit.Info{
+		Display:     "Injection Strings",
+		Category:    "fuzz",
+		Description: "Well known harmless injection probes of a context, e.g. for input validation tests",
+		Example:     `["' OR '1'='1", "' OR 1=1 --", "' UNION SELECT NULL --"]`,
+		Output:      "[]string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "context",
+				Display:     "Context",
+				Type:        "string",
+				Default:     "all",
+				Options:     fuzzKinds(injectionStrings),
+				Description: "Context of the injection, all for every context",
+			},
+		},
+		Generate: injectionstrings,
+	})
+}
+
+// boundaryNumbers contains the boundary numbers by numeric type.
+//
+//nolint:gochecknoglobals,mnd
+var boundaryNumbers = map[string][]float64{
+	"int8":   intBoundaries(math.MinInt8, math.MaxInt8),
+	"int16":  intBoundaries(math.MinInt16, math.MaxInt16),
+	"int32":  intBoundaries(math.MinInt32, math.MaxInt32),
+	"int64":  intBoundaries(math.MinInt64, math.MaxInt64),
+	"uint8":  intBoundaries(0, math.MaxUint8),
+	"uint16": intBoundaries(0, math.MaxUint16),
+	"uint32": intBoundaries(0, math.MaxUint32),
+	"uint64": intBoundaries(0, math.MaxUint64),
+	"safe":   intBoundaries(-(1<<53 - 1), 1<<53-1),
+	"float32": {
+		0, math.Copysign(0, -1), math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32,
+		math.MaxFloat32, -math.MaxFloat32, 1 << 24, 1<<24 + 1,
+	},
+	"float64": {
+		0, math.Copysign(0, -1), math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		math.Float64frombits(1 << 52), math.Nextafter(1, 2) - 1, math.MaxFloat64, -math.MaxFloat64,
+		math.Inf(1), math.Inf(-1), math.NaN(),
+	},
+}
+
+// injectionStrings contains harmless injection probes by context.
+// The probes only reveal missing escaping, they don't modify or destroy data.
+//
+//nolint:gochecknoglobals
+var injectionStrings = map[string][]string{
+	"sql": {
+		"' OR '1'='1", "' OR 1=1 --", "' UNION SELECT NULL --", "admin'--", "1' AND '1'='2", "\" OR \"\"=\"",
+	},
+	"nosql": {
+		`{"$gt": ""}`, `{"$ne": null}`, `{"$where": "1 == 1"}`, "'; return true; var x='", "[$ne]=1",
+	},
+	"xss": {
+		"<script>alert(1)</script>", `"><img src=x onerror=alert(1)>`, "javascript:alert(1)",
+		"<svg onload=alert(1)>", `'';!--"<XSS>=&{()}`,
+	},
+	"command": {
+		"; id", "| whoami", "`id`", "$(id)", "&& echo injected",
+	},
+	"path": {
+		"../../../../etc/passwd", `..\..\..\..\windows\win.ini`, "%2e%2e%2f%2e%2e%2fetc%2fpasswd",
+		"/etc/passwd%00.png", "....//....//etc/passwd",
+	},
+	"ldap": {
+		"*", "*)(uid=*))(|(uid=*", "admin)(&)", "*)(|(objectClass=*)",
+	},
+	"template": {
+		"{{7*7}}", "${7*7}", "<%= 7*7 %>", "#{7*7}", "{{constructor.constructor('return 1')()}}",
+	},
+	"header": {
+		"value\r\nX-Injected: true", "value\r\n\r\n<html>", "%0d%0aSet-Cookie: injected=1",
+	},
+}
+
+// intBoundaries returns the boundary numbers of an integer type: the limits, their neighbours and -1, 0, 1.
+func intBoundaries(low, high float64) []float64 {
+	return uniqueNumbers([]float64{low - 1, low, low + 1, -1, 0, 1, high - 1, high, high + 1})
+}
+
+// uniqueNumbers returns the numbers without duplicates, keeping the order (0 and -0 are different).
+func uniqueNumbers(nums []float64) []float64 {
+	seen := make(map[uint64]bool, len(nums))
+	unique := make([]float64, 0, len(nums))
+
+	for _, num := range nums {
+		if bits := math.Float64bits(num); !seen[bits] {
+			seen[bits] = true
+			unique = append(unique, num)
+		}
+	}
+
+	return unique
+}
+
+// fuzzKinds returns the sorted keys of the values by kind, preceded by all.
+func fuzzKinds[T any](values map[string][]T) []string {
+	names := make([]string, 0, len(values))
+
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return append([]string{"all"}, names...)
+}
+
+// fuzzValues returns a copy of the values of the kind (case insensitive), the values of all kinds for all.
+func fuzzValues[T any](values map[string][]T, kind string, unknown error) ([]T, error) {
+	if strings.EqualFold(kind, "all") {
+		var all []T
+
+		for _, name := range fuzzKinds(values)[1:] {
+			all = append(all, values[name]...)
+		}
+
+		return all, nil
+	}
+
+	for name, list := range values {
+		if strings.EqualFold(name, kind) {
+			return append([]T{}, list...), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", unknown, kind)
+}
+
+func boundarynumbers(_ *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	kind, err := info.GetString(m, "type")
+	if err != nil {
+		return nil, err
+	}
+
+	nums, err := fuzzValues(boundaryNumbers, kind, errUnknownNumberType)
+	if err != nil {
+		return nil, err
+	}
+
+	return uniqueNumbers(nums), nil
+}
+
+func injectionstrings(_ *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	context, err := info.GetString(m, "context")
+	if err != nil {
+		return nil, err
+	}
+
+	return fuzzValues(injectionStrings, context, errUnknownInjectionContext)
+}
+
+// Mutation operators of fuzz.mutate.
+const (
+	// mutateBitFlip flips a bit of a string character, a number or a boolean.
+	mutateBitFlip = "bitFlip"
+	// mutateTruncate truncates a string or an array, or removes a property of an object.
+	mutateTruncate = "truncate"
+	// mutateOverlong repeats a string or the elements of an array to an excessive length.
+	mutateOverlong = "overlong"
+	// mutateNull replaces a value with null.
+	mutateNull = "null"
+	// mutateBoundary replaces a number with a boundary number.
+	mutateBoundary = "boundary"
+	// mutateInjection replaces a string with an injection string.
+	mutateInjection = "injection"
+)
+
+//nolint:gochecknoglobals
+var mutateOperators = []string{
+	mutateBitFlip, mutateTruncate, mutateOverlong, mutateNull, mutateBoundary, mutateInjection,
+}
+
+// mutateNode is a value of the mutated payload and the function replacing it.
+type mutateNode struct {
+	path  []string
+	value sobek.Value
+	set   func(val sobek.Value)
+}
+
+// mutation is a mutation operator applicable to a value of the payload.
+type mutation struct {
+	node     *mutateNode
+	operator string
+}
+
+// mutate returns a copy of the input (a seed payload) with a single structured mutation at a random place,
+// together with the applied operator and the dotted path of the mutated value (empty for the input itself).
+// The operators option restricts the applicable mutation operators, all operators are used by default.
+func (f *faker) mutate(input sobek.Value, options sobek.Value) *sobek.Object {
+	const method = "fuzz.mutate"
+
+	operators := mutateOperators
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("operators"); val != nil && !sobek.IsUndefined(val) {
+			operators = nil
+
+			for _, item := range f.arrayArgument(method, "operators", val) {
+				if !slices.Contains(mutateOperators, item.String()) {
+					f.throw(&ArgumentError{
+						Function: method, Parameter: "operators", Expected: strings.Join(mutateOperators, ", "),
+						Reason: "unknown operator " + item.String(),
+					})
+				}
+
+				operators = append(operators, item.String())
+			}
+		}
+	}
+
+	data := f.copyPayload(input)
+	nodes := []*mutateNode{{value: data, set: func(val sobek.Value) { data = val }}}
+	nodes = f.payloadNodes(data, nil, nodes)
+
+	var candidates []*mutation
+
+	for _, node := range nodes {
+		for _, operator := range operators {
+			if f.canMutate(node.value, operator) {
+				candidates = append(candidates, &mutation{node: node, operator: operator})
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "input", Expected: "payload with a mutable value",
+			Reason: "no operator is applicable",
+		})
+	}
+
+	f.rescope()
+
+	chosen := candidates[f.rand.Intn(len(candidates))]
+
+	chosen.node.set(f.mutateValue(chosen.node.value, chosen.operator))
+
+	result := f.runtime.NewObject()
+
+	_ = result.Set("data", data)
+	_ = result.Set("operator", chosen.operator)
+	_ = result.Set("path", strings.Join(chosen.node.path, "."))
+
+	return result
+}
+
+// copyPayload returns a deep copy of the plain objects and arrays of the value.
+func (f *faker) copyPayload(val sobek.Value) sobek.Value {
+	obj, isObject := val.(*sobek.Object)
+	if !isObject {
+		return val
+	}
+
+	switch obj.ClassName() {
+	case "Array":
+		var items []sobek.Value
+
+		_ = f.runtime.ExportTo(obj, &items)
+
+		copied := make([]any, len(items))
+
+		for idx, item := range items {
+			copied[idx] = f.copyPayload(item)
+		}
+
+		return f.runtime.NewArray(copied...)
+	case "Object":
+		copied := f.runtime.NewObject()
+
+		for _, key := range obj.Keys() {
+			_ = copied.Set(key, f.copyPayload(obj.Get(key)))
+		}
+
+		return copied
+	default:
+		return val
+	}
+}
+
+// payloadNodes appends the nested values of the plain objects and arrays of the value to the nodes.
+func (f *faker) payloadNodes(val sobek.Value, path []string, nodes []*mutateNode) []*mutateNode {
+	obj, isObject := val.(*sobek.Object)
+	if !isObject || (obj.ClassName() != "Array" && obj.ClassName() != "Object") {
+		return nodes
+	}
+
+	keys := obj.Keys()
+	if obj.ClassName() == "Array" {
+		keys = make([]string, obj.Get("length").ToInteger())
+
+		for idx := range keys {
+			keys[idx] = strconv.Itoa(idx)
+		}
+	}
+
+	for _, key := range keys {
+		child := obj.Get(key)
+		childPath := append(append([]string{}, path...), key)
+
+		nodes = append(nodes, &mutateNode{
+			path:  childPath,
+			value: child,
+			set:   func(val sobek.Value) { _ = obj.Set(key, val) },
+		})
+
+		nodes = f.payloadNodes(child, childPath, nodes)
+	}
+
+	return nodes
+}
+
+// canMutate returns true if the operator is applicable to the value.
+func (f *faker) canMutate(val sobek.Value, operator string) bool {
+	if val == nil || sobek.IsUndefined(val) || sobek.IsNull(val) {
+		return false
+	}
+
+	switch typed := val.Export().(type) {
+	case string:
+		return operator == mutateOverlong || operator == mutateNull || operator == mutateInjection ||
+			(len(typed) != 0 && (operator == mutateBitFlip || operator == mutateTruncate))
+	case int64, float64:
+		return operator == mutateBitFlip || operator == mutateNull || operator == mutateBoundary
+	case bool:
+		return operator == mutateBitFlip || operator == mutateNull
+	case []any:
+		return operator == mutateNull || (len(typed) != 0 && (operator == mutateTruncate || operator == mutateOverlong))
+	case map[string]any:
+		return operator == mutateNull || (len(typed) != 0 && operator == mutateTruncate)
+	default:
+		return false
+	}
+}
+
+// mutateValue returns the value mutated by the operator, the value must be applicable (see canMutate).
+func (f *faker) mutateValue(val sobek.Value, operator string) sobek.Value {
+	const (
+		minLength = 1024
+		maxLength = 8192
+		minItems  = 100
+		maxItems  = 1000
+		intBits   = 53
+		floatBits = 64
+	)
+
+	if operator == mutateNull {
+		return sobek.Null()
+	}
+
+	switch typed := val.Export().(type) {
+	case string:
+		runes := []rune(typed)
+
+		switch operator {
+		case mutateBitFlip:
+			idx := f.rand.Intn(len(runes))
+			// only the lower 7 bits are flipped, so the result remains a valid character
+			runes[idx] ^= 1 << f.rand.Intn(7) //nolint:mnd
+
+			return f.runtime.ToValue(string(runes))
+		case mutateTruncate:
+			return f.runtime.ToValue(string(runes[:f.rand.Intn(len(runes))]))
+		case mutateOverlong:
+			if len(typed) == 0 {
+				typed = "A"
+			}
+
+			length := minLength + f.rand.Intn(maxLength-minLength)
+
+			return f.runtime.ToValue(strings.Repeat(typed, length/len(typed)+1)[:length])
+		default:
+			all, _ := fuzzValues(injectionStrings, "all", errUnknownInjectionContext)
+
+			return f.runtime.ToValue(all[f.rand.Intn(len(all))])
+		}
+	case int64:
+		if operator == mutateBitFlip {
+			return f.runtime.ToValue(typed ^ 1<<f.rand.Intn(intBits))
+		}
+	case float64:
+		if operator == mutateBitFlip {
+			return f.runtime.ToValue(math.Float64frombits(math.Float64bits(typed) ^ 1<<f.rand.Intn(floatBits)))
+		}
+	case bool:
+		return f.runtime.ToValue(!typed)
+	case []any:
+		obj := val.ToObject(f.runtime)
+
+		if operator == mutateTruncate {
+			_ = obj.Set("length", f.rand.Intn(len(typed)))
+
+			return obj
+		}
+
+		items := make([]any, minItems+f.rand.Intn(maxItems-minItems))
+
+		for idx := range items {
+			items[idx] = obj.Get(strconv.Itoa(idx % len(typed)))
+		}
+
+		return f.runtime.NewArray(items...)
+	case map[string]any:
+		obj := val.ToObject(f.runtime)
+		keys := obj.Keys()
+
+		_ = obj.Delete(keys[f.rand.Intn(len(keys))])
+
+		return obj
+	}
+
+	// boundary numbers
+	all, _ := fuzzValues(boundaryNumbers, "all", errUnknownNumberType)
+
+	return f.runtime.ToValue(all[f.rand.Intn(len(all))])
+}
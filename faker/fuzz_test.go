@@ -0,0 +1,71 @@
+package faker_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_boundarynumbers(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("boundarynumbers")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("type", "Int8")
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Equal(t, []float64{-129, -128, -127, -1, 0, 1, 126, 127, 128}, val)
+
+	val, err = info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Contains(t, val, float64(math.MaxUint32+1))
+	require.Contains(t, val, math.Inf(-1))
+
+	params = gofakeit.NewMapParams()
+	params.Add("type", "int128")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown number type")
+}
+
+func Test_injectionstrings(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("injectionstrings")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("context", "sql")
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Contains(t, val, "' OR '1'='1")
+	require.NotContains(t, val, "<script>alert(1)</script>")
+
+	val, err = info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Contains(t, val, "<script>alert(1)</script>")
+
+	params = gofakeit.NewMapParams()
+	params.Add("context", "graphql")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown injection context")
+}
@@ -0,0 +1,308 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errInvalidPlayers = errors.New("match players must be between 2 and 100")
+
+// maxPlayers is the maximum number of players of a match.
+const maxPlayers = 100
+
+// gameModes contains the game modes of the matches.
+var gameModes = []string{"deathmatch", "team-deathmatch", "capture-the-flag", "domination", "battle-royale"} //nolint:gochecknoglobals
+
+// gameMaps contains the names of the maps of the matches.
+var gameMaps = []string{ //nolint:gochecknoglobals
+	"Dust Valley", "Frost Harbor", "Neon District", "Sunken Temple", "Iron Citadel", "Crimson Canyon", "Skyline",
+}
+
+// gameRegions contains the server regions of the matches and lobbies.
+var gameRegions = []string{"eu-west", "eu-central", "us-east", "us-west", "ap-southeast", "sa-east"} //nolint:gochecknoglobals
+
+// lobbyAdjectives and lobbyNouns contain the words of the lobby names.
+var (
+	lobbyAdjectives = []string{ //nolint:gochecknoglobals
+		"Crimson", "Silent", "Frozen", "Golden", "Savage", "Shadow", "Rapid", "Wild", "Iron", "Cosmic", "Lucky",
+	}
+	lobbyNouns = []string{ //nolint:gochecknoglobals
+		"Dragons", "Wolves", "Vipers", "Titans", "Ravens", "Knights", "Rangers", "Phantoms", "Hunters", "Legends",
+	}
+)
+
+// rankTiers contains the ranked tiers with their minimum ratings, from the highest.
+//
+//nolint:gochecknoglobals,mnd
+var rankTiers = []struct {
+	name   string
+	rating int
+}{
+	{"grandmaster", 2600}, {"master", 2300}, {"diamond", 2000}, {"platinum", 1700},
+	{"gold", 1400}, {"silver", 1100}, {"bronze", 0},
+}
+
+// telemetryEvents contains the in-game events of the player telemetry, the first of them is the most frequent.
+var telemetryEvents = []string{ //nolint:gochecknoglobals
+	"move", "move", "move", "shoot", "shoot", "hit", "ability_used", "item_pickup", "death",
+}
+
+func init() {
+	gofakeit.AddFuncLookup("matchresult", gofakeit.Info{
+		Display:     "Match Result",
+		Category:    "game",
+		Description: "Result of a two team multiplayer match with the statistics of the players, the team scores are the kills of their players",
+		Example: `{
+	"matchId": "6f1c2d3e-8a4b-4c5d-9e6f-7a8b9c0d1e2f",
+	"mode": "team-deathmatch",
+	"map": "Frost Harbor",
+	"region": "eu-west",
+	"startedAt": "2024-05-03T19:42:00Z",
+	"durationSeconds": 1184,
+	"teams": [{"team": "red", "score": 24}, {"team": "blue", "score": 19}],
+	"winner": "red",
+	"players": [
+		{"playerId": "b7e0...", "gamertag": "VioletPuma", "team": "red", "kills": 13, "deaths": 8, "assists": 5, "score": 1560, "won": true},
+		{"playerId": "1c4a...", "gamertag": "SneakyOtter", "team": "blue", "kills": 10, "deaths": 12, "assists": 3, "score": 1150, "won": false}
+	]
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "players", Display: "Players", Type: "int", Default: "10", Description: "Number of players, split into two teams"},
+		},
+		Generate: matchresult,
+	})
+
+	gofakeit.AddFuncLookup("leaderboardentry", gofakeit.Info{
+		Display:     "Leaderboard Entry",
+		Category:    "game",
+		Description: "Entry of a ranked leaderboard, the tier and the rank follow from the rating of the player",
+		Example: `{
+	"rank": 1842,
+	"playerId": "3b9f0c5e-2d7a-4e1b-8c6f-5a4d3e2f1b0c",
+	"gamertag": "CleverBadger",
+	"rating": 1735,
+	"tier": "platinum",
+	"wins": 212,
+	"losses": 187,
+	"winRate": 0.531,
+	"updatedAt": "2024-05-03T19:42:00Z"
+}`,
+		Output:   "map[string]any",
+		Generate: leaderboardentry,
+	})
+
+	gofakeit.AddFuncLookup("playertelemetrybatch", gofakeit.Info{
+		Display:     "Player Telemetry Batch",
+		Category:    "game",
+		Description: "Successive in-game telemetry events of a player session, the position moves continuously between the events",
+		Example: `[
+	{"sessionId": "0d6e...", "playerId": "3b9f...", "sequence": 0, "event": "session_start", "timestamp": "2024-05-03T19:42:00.000Z", "position": {"x": 120.5, "y": 0, "z": -48.2}, "fps": 144, "pingMs": 32},
+	{"sessionId": "0d6e...", "playerId": "3b9f...", "sequence": 1, "event": "move", "timestamp": "2024-05-03T19:42:00.850Z", "position": {"x": 123.1, "y": 0, "z": -46.9}, "fps": 141, "pingMs": 35}
+]`,
+		Output: "[]map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "10", Description: "Number of events"},
+		},
+		Generate: playertelemetrybatch,
+	})
+
+	gofakeit.AddFuncLookup("lobbyname", gofakeit.Info{
+		Display:     "Lobby Name",
+		Category:    "game",
+		Description: "Name of a multiplayer game lobby with its region and number",
+		Example:     "Crimson Dragons [eu-west] #4821",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return pickOf(lobbyAdjectives...)(r) + " " + pickOf(lobbyNouns...)(r) +
+				" [" + pickOf(gameRegions...)(r) + "] #" + digits(r, 4), nil //nolint:mnd
+		},
+	})
+}
+
+func matchresult(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		maxAge       = 7 * 24 * time.Hour
+		minDuration  = 300
+		maxDuration  = 1800
+		maxKills     = 25
+		killPoints   = 100
+		assistPoints = 50
+		winBonus     = 200
+	)
+
+	count, err := info.GetInt(m, "players")
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 2 || count > maxPlayers {
+		return nil, fmt.Errorf("%w: %d", errInvalidPlayers, count)
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+	teams := [2]string{"red", "blue"}
+	scores := [2]int{}
+	players := make([]object, count)
+
+	for idx := range players {
+		team := idx % len(teams)
+		kills := r.Intn(maxKills + 1)
+		scores[team] += kills
+
+		players[idx] = object{
+			{"playerId", fake.UUID()},
+			{"gamertag", fake.Gamertag()},
+			{"team", teams[team]},
+			{"kills", kills},
+			{"deaths", r.Intn(maxKills + 1)},
+			{"assists", r.Intn(maxKills/2 + 1)},
+		}
+	}
+
+	// a tie is resolved in favour of the first team
+	winner := 0
+	if scores[1] > scores[0] {
+		winner = 1
+	}
+
+	for idx, player := range players {
+		won := idx%len(teams) == winner
+		score := player.get("kills").(int)*killPoints + player.get("assists").(int)*assistPoints //nolint:forcetypeassert
+
+		if won {
+			score += winBonus
+		}
+
+		players[idx] = append(player, property{"score", score}, property{"won", won})
+	}
+
+	return object{
+		{"matchId", fake.UUID()},
+		{"mode", pickOf(gameModes...)(r)},
+		{"map", pickOf(gameMaps...)(r)},
+		{"region", pickOf(gameRegions...)(r)},
+		{"startedAt", time.Now().UTC().Add(-time.Duration(r.Int63n(int64(maxAge)))).Truncate(time.Minute).Format(time.RFC3339)},
+		{"durationSeconds", minDuration + r.Intn(maxDuration-minDuration+1)},
+		{"teams", []object{
+			{{"team", teams[0]}, {"score", scores[0]}},
+			{{"team", teams[1]}, {"score", scores[1]}},
+		}},
+		{"winner", teams[winner]},
+		{"players", players},
+	}, nil
+}
+
+func leaderboardentry(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		meanRating  = 1500
+		deviation   = 350
+		minRating   = 0
+		maxRating   = 3000
+		maxGames    = 1000
+		players     = 100000
+		maxAge      = 24 * time.Hour
+		thousandths = 1000
+	)
+
+	fake := &gofakeit.Faker{Rand: r}
+	rating := min(max(int(r.NormFloat64()*deviation)+meanRating, minRating), maxRating)
+
+	tier := rankTiers[len(rankTiers)-1].name
+	for _, candidate := range rankTiers {
+		if rating >= candidate.rating {
+			tier = candidate.name
+
+			break
+		}
+	}
+
+	// the share of the players with higher rating, assuming normally distributed ratings
+	above := 0.5 * math.Erfc(float64(rating-meanRating)/(deviation*math.Sqrt2)) //nolint:mnd
+
+	// the win rate is between 40% and 60%, above 50% for the players rated above the mean
+	games := 1 + r.Intn(maxGames)
+	wins := int(math.Round(float64(games) * (0.6 - 0.2*above))) //nolint:mnd
+
+	return object{
+		{"rank", 1 + int(above*players)},
+		{"playerId", fake.UUID()},
+		{"gamertag", fake.Gamertag()},
+		{"rating", rating},
+		{"tier", tier},
+		{"wins", wins},
+		{"losses", games - wins},
+		{"winRate", math.Round(float64(wins)/float64(games)*thousandths) / thousandths},
+		{"updatedAt", time.Now().UTC().Add(-time.Duration(r.Int63n(int64(maxAge)))).Truncate(time.Second).Format(time.RFC3339)},
+	}, nil
+}
+
+func playertelemetrybatch(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		mapSize    = 500
+		maxStep    = 5
+		maxDelay   = 2000
+		minFPS     = 30
+		maxFPS     = 240
+		minPing    = 10
+		maxPing    = 150
+		maxJitter  = 5
+		tenth      = 10
+		millisFmt  = "2006-01-02T15:04:05.000Z07:00"
+		startEvent = "session_start"
+	)
+
+	count, err := info.GetInt(m, "count")
+	if err != nil {
+		return nil, err
+	}
+
+	if count <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidBatchSize, count)
+	}
+
+	if err := checkCount(count); err != nil {
+		return nil, err
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+	session, player := fake.UUID(), fake.UUID()
+
+	when := time.Now().UTC().Add(-time.Duration(count*maxDelay) * time.Millisecond).Truncate(time.Millisecond)
+	x, z := fake.Float64Range(-mapSize, mapSize), fake.Float64Range(-mapSize, mapSize)
+	fps, ping := minFPS+r.Intn(maxFPS-minFPS+1), minPing+r.Intn(maxPing-minPing+1)
+	round := func(val float64) float64 { return math.Round(val*tenth) / tenth }
+
+	batch := make([]object, count)
+
+	for idx := range batch {
+		event := startEvent
+
+		if idx > 0 {
+			event = pickOf(telemetryEvents...)(r)
+			when = when.Add(time.Duration(1+r.Intn(maxDelay)) * time.Millisecond)
+			x = min(max(x+fake.Float64Range(-maxStep, maxStep), -mapSize), mapSize)
+			z = min(max(z+fake.Float64Range(-maxStep, maxStep), -mapSize), mapSize)
+			fps = min(max(fps+r.Intn(2*maxJitter+1)-maxJitter, minFPS), maxFPS)
+			ping = min(max(ping+r.Intn(2*maxJitter+1)-maxJitter, minPing), maxPing)
+		}
+
+		batch[idx] = object{
+			{"sessionId", session},
+			{"playerId", player},
+			{"sequence", idx},
+			{"event", event},
+			{"timestamp", when.Format(millisFmt)},
+			{"position", object{{"x", round(x)}, {"y", 0.0}, {"z", round(z)}}},
+			{"fps", fps},
+			{"pingMs", ping},
+		}
+	}
+
+	return batch, nil
+}
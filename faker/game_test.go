@@ -0,0 +1,142 @@
+package faker_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_matchresult(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("matchresult")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, count := range []int{2, 7, 10, 100} {
+		params := gofakeit.NewMapParams()
+		params.Add("players", fmt.Sprint(count))
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		match, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		players, ok := match["players"].([]map[string]any)
+
+		require.True(t, ok)
+		require.Len(t, players, count)
+
+		kills := map[any]int{}
+
+		for _, player := range players {
+			kills[player["team"]] += player["kills"].(int) //nolint:forcetypeassert
+
+			require.Equal(t, player["team"] == match["winner"], player["won"])
+		}
+
+		for _, team := range match["teams"].([]map[string]any) { //nolint:forcetypeassert
+			require.Equal(t, kills[team["team"]], team["score"])
+		}
+	}
+
+	for _, count := range []string{"1", "101"} {
+		params := gofakeit.NewMapParams()
+		params.Add("players", count)
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, "match players must be between 2 and 100")
+	}
+}
+
+func Test_leaderboardentry(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("leaderboardentry")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 50 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+
+		entry, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+		require.GreaterOrEqual(t, entry["rank"], 1)
+		require.Positive(t, entry["wins"].(int)+entry["losses"].(int)) //nolint:forcetypeassert
+		require.InDelta(t, 0.5, entry["winRate"], 0.11)
+	}
+}
+
+func Test_playertelemetrybatch(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("playertelemetrybatch")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("count", "20")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+
+	batch, ok := faker.PlainMaps(val).([]map[string]any)
+
+	require.True(t, ok)
+	require.Len(t, batch, 20)
+	require.Equal(t, "session_start", batch[0]["event"])
+
+	var last time.Time
+
+	for idx, event := range batch {
+		when, err := time.Parse(time.RFC3339Nano, event["timestamp"].(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.True(t, when.After(last))
+		require.Equal(t, idx, event["sequence"])
+		require.Equal(t, batch[0]["sessionId"], event["sessionId"])
+
+		last = when
+	}
+
+	for count, msg := range map[string]string{
+		"0":      "batch size must be positive: 0",
+		"100001": "too many items: 100001",
+	} {
+		params = gofakeit.NewMapParams()
+		params.Add("count", count)
+
+		_, err = info.Generate(testRand(t), params, info)
+
+		require.ErrorContains(t, err, msg)
+	}
+}
+
+func Test_lobbyname(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("lobbyname")
+
+	require.NotNil(t, info)
+
+	val, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^[A-Z][a-z]+ [A-Z][a-z]+ \[[a-z]+-[a-z]+\] #\d{4}$`, val)
+}
@@ -0,0 +1,222 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidCoordinates = errors.New("coordinates must be [latitude, longitude]")
+	errInvalidTrace       = errors.New("trace needs at least 2 points, positive speed and non-negative jitter")
+)
+
+const (
+	// metersPerDegree is the length of a degree of latitude (and of longitude at the equator) in meters.
+	metersPerDegree = 111_320.0
+	// routeDetour is the maximum sideways displacement of a route segment midpoint relative to the segment length.
+	routeDetour = 0.2
+	// routeDepth is the number of midpoint displacement rounds, the route has 2^routeDepth segments.
+	routeDepth = 4
+)
+
+func init() {
+	gofakeit.AddFuncLookup("gpstrace", gofakeit.Info{
+		Display:     "GPS Trace",
+		Category:    "address",
+		Description: "Ordered GPS fixes of a vehicle travelling on a plausible winding route from start to end",
+		Example: `[
+	{"lat": 47.497912, "lon": 19.040235, "timestamp": "2024-03-15T10:20:00Z"},
+	{"lat": 47.499843, "lon": 19.046512, "timestamp": "2024-03-15T10:20:37Z"}
+]`,
+		Output: "[]map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "start",
+				Display:     "Start",
+				Type:        "[]float64",
+				Optional:    true,
+				Description: "Latitude and longitude of the start, random if empty",
+			},
+			{
+				Field:       "end",
+				Display:     "End",
+				Type:        "[]float64",
+				Optional:    true,
+				Description: "Latitude and longitude of the end, random point at most 20 km from the start if empty",
+			},
+			{Field: "points", Display: "Points", Type: "int", Default: "20", Description: "Number of GPS fixes"},
+			{Field: "speedKmh", Display: "Speed", Type: "float", Default: "50", Description: "Average speed in km/h"},
+			{
+				Field:       "jitter",
+				Display:     "Jitter",
+				Type:        "float",
+				Default:     "5",
+				Description: "Standard deviation of the GPS measurement error in meters",
+			},
+		},
+		Generate: gpstrace,
+	})
+}
+
+// geoPoint is a point on a local plane: the offsets from an origin in meters (north and east).
+type geoPoint struct {
+	north float64
+	east  float64
+}
+
+// coordinatesParam returns the latitude and longitude of the parameter, false if the parameter is missing.
+func coordinatesParam(m *gofakeit.MapParams, info *gofakeit.Info, field string) (float64, float64, bool, error) {
+	values, err := info.GetStringArray(m, field)
+	if err != nil || len(values) == 0 {
+		return 0, 0, false, nil //nolint:nilerr
+	}
+
+	if len(values) != 2 { //nolint:mnd
+		return 0, 0, false, fmt.Errorf("%w: %s %v", errInvalidCoordinates, field, values)
+	}
+
+	lat, errLat := strconv.ParseFloat(values[0], 64)
+	lon, errLon := strconv.ParseFloat(values[1], 64)
+
+	if errLat != nil || errLon != nil || math.Abs(lat) > 90 || math.Abs(lon) > 180 {
+		return 0, 0, false, fmt.Errorf("%w: %s %v", errInvalidCoordinates, field, values)
+	}
+
+	return lat, lon, true, nil
+}
+
+// windingRoute returns the route from the origin to the end, made winding by random midpoint displacement.
+func windingRoute(r *rand.Rand, end geoPoint) []geoPoint {
+	route := []geoPoint{{}, end}
+
+	for range routeDepth {
+		next := make([]geoPoint, 0, 2*len(route)-1)
+
+		for idx := range len(route) - 1 {
+			from, to := route[idx], route[idx+1]
+			offset := routeDetour * (2*r.Float64() - 1)
+
+			// the midpoint is moved perpendicular to the segment, proportionally to its length
+			next = append(next, from, geoPoint{
+				north: (from.north+to.north)/2 - offset*(to.east-from.east),
+				east:  (from.east+to.east)/2 + offset*(to.north-from.north),
+			})
+		}
+
+		route = append(next, route[len(route)-1])
+	}
+
+	return route
+}
+
+// alongRoute returns the point at the distance along the route.
+func alongRoute(route []geoPoint, distance float64) geoPoint {
+	for idx := range len(route) - 1 {
+		from, to := route[idx], route[idx+1]
+		length := math.Hypot(to.north-from.north, to.east-from.east)
+
+		if distance <= length && length > 0 {
+			ratio := distance / length
+
+			return geoPoint{
+				north: from.north + ratio*(to.north-from.north),
+				east:  from.east + ratio*(to.east-from.east),
+			}
+		}
+
+		distance -= length
+	}
+
+	return route[len(route)-1]
+}
+
+func gpstrace(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) { //nolint:funlen
+	const (
+		maxLatitude = 70
+		maxDistance = 20_000
+		minDistance = 1_000
+		kmhToMps    = 3.6
+		precision   = 1e6
+	)
+
+	points, err := info.GetInt(m, "points")
+	if err != nil {
+		return nil, err
+	}
+
+	speed, err := info.GetFloat64(m, "speedKmh")
+	if err != nil {
+		return nil, err
+	}
+
+	jitter, err := info.GetFloat64(m, "jitter")
+	if err != nil {
+		return nil, err
+	}
+
+	if points < 2 || !(speed > 0) || !(jitter >= 0) {
+		return nil, fmt.Errorf("%w: points %d, speed %g, jitter %g", errInvalidTrace, points, speed, jitter)
+	}
+
+	if err := checkCount(points); err != nil {
+		return nil, err
+	}
+
+	lat, lon, found, err := coordinatesParam(m, info, "start")
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		lat, lon = (2*r.Float64()-1)*maxLatitude, (2*r.Float64()-1)*180
+	}
+
+	// the route is generated on the plane tangent to the start point
+	lonScale := metersPerDegree * math.Cos(lat*math.Pi/180)
+
+	endLat, endLon, found, err := coordinatesParam(m, info, "end")
+	if err != nil {
+		return nil, err
+	}
+
+	end := geoPoint{north: (endLat - lat) * metersPerDegree, east: (endLon - lon) * lonScale}
+
+	if !found {
+		distance, bearing := minDistance+r.Float64()*(maxDistance-minDistance), r.Float64()*2*math.Pi
+		end = geoPoint{north: distance * math.Cos(bearing), east: distance * math.Sin(bearing)}
+	}
+
+	route := windingRoute(r, end)
+
+	var length float64
+
+	for idx := range len(route) - 1 {
+		length += math.Hypot(route[idx+1].north-route[idx].north, route[idx+1].east-route[idx].east)
+	}
+
+	step := length / float64(points-1)
+	interval := time.Duration(step / (speed / kmhToMps) * float64(time.Second))
+	now := time.Now().UTC().Truncate(time.Second)
+	trace := make([]object, points)
+
+	for idx := range trace {
+		point := alongRoute(route, step*float64(idx))
+
+		point.north += r.NormFloat64() * jitter
+		point.east += r.NormFloat64() * jitter
+
+		trace[idx] = object{
+			{"lat", math.Round((lat+point.north/metersPerDegree)*precision) / precision},
+			{"lon", math.Round(math.Remainder(lon+point.east/lonScale, 360)*precision) / precision},
+			{"timestamp", now.Add(time.Duration(idx) * interval).Truncate(time.Second).Format(time.RFC3339)},
+		}
+	}
+
+	return trace, nil
+}
@@ -0,0 +1,87 @@
+package faker_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_gpstrace(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("gpstrace")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	(*params)["start"] = []string{"47.4979", "19.0402"}
+	(*params)["end"] = []string{"47.5300", "19.1000"}
+	params.Add("points", "30")
+	params.Add("speedKmh", "36")
+	params.Add("jitter", "0")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+
+	trace, ok := faker.PlainMaps(val).([]map[string]any)
+
+	require.True(t, ok)
+	require.Len(t, trace, 30)
+	require.InDelta(t, 47.4979, trace[0]["lat"], 1e-6)
+	require.InDelta(t, 19.0402, trace[0]["lon"], 1e-6)
+	require.InDelta(t, 47.53, trace[29]["lat"], 1e-6)
+	require.InDelta(t, 19.1, trace[29]["lon"], 1e-6)
+
+	var prev time.Time
+
+	for idx, fix := range trace {
+		ts, err := time.Parse(time.RFC3339, fix["timestamp"].(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+
+		if idx > 0 {
+			require.True(t, ts.After(prev))
+
+			// consecutive fixes are close to each other at 10 m/s
+			dlat := (fix["lat"].(float64) - trace[idx-1]["lat"].(float64)) * 111_320         //nolint:forcetypeassert
+			dlon := (fix["lon"].(float64) - trace[idx-1]["lon"].(float64)) * 111_320 * 0.676 //nolint:forcetypeassert
+
+			require.Less(t, math.Hypot(dlat, dlon), 12*ts.Sub(prev).Seconds()+10)
+		}
+
+		prev = ts
+	}
+
+	val, err = info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Len(t, val, 20)
+
+	params = gofakeit.NewMapParams()
+	(*params)["start"] = []string{"95", "19"}
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "coordinates must be [latitude, longitude]")
+
+	params = gofakeit.NewMapParams()
+	params.Add("points", "1")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "trace needs at least 2 points")
+
+	params = gofakeit.NewMapParams()
+	params.Add("points", "100001")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "too many items: 100001")
+}
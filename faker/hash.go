@@ -0,0 +1,59 @@
+package faker
+
+import (
+	"crypto/md5"  //nolint:gosec
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"math/rand"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func init() {
+	addHashLookup("md5", "MD5", "MD5 message digest (128 bits)", "900150983cd24fb0d6963f7d28e17f72", md5.New)
+	addHashLookup("sha1", "SHA1", "SHA-1 message digest (160 bits)", "a9993e364706816aba3e25717850c26c9cd0d89d", sha1.New)
+	addHashLookup("sha256", "SHA256", "SHA-256 message digest (256 bits)",
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", sha256.New)
+	addHashLookup("crc32", "CRC32", "CRC-32 (IEEE) checksum", "352441c2",
+		func() hash.Hash { return crc32.NewIEEE() })
+}
+
+// addHashLookup registers a generator of the hash function in the strings category.
+func addHashLookup(key string, display string, description string, example string, newHash func() hash.Hash) {
+	gofakeit.AddFuncLookup(key, gofakeit.Info{
+		Display:  display,
+		Category: "string",
+		Description: description + " in hexadecimal format, of the input if given (e.g. for content digests)," +
+			" otherwise of random data",
+		Example: example,
+		Output:  "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "input",
+				Display:     "Input",
+				Type:        "string",
+				Optional:    true,
+				Description: "Value to hash, random data is hashed if not given",
+			},
+		},
+		Generate: func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+			return hashOf(r, m, info, newHash())
+		},
+	})
+}
+
+// hashOf returns the hexadecimal digest of the input parameter or of random data if it is missing.
+func hashOf(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, digest hash.Hash) (any, error) {
+	const randomLength = 32
+
+	if input, err := info.GetString(m, "input"); err == nil {
+		digest.Write([]byte(input))
+	} else {
+		digest.Write(randomBytes(r, randomLength))
+	}
+
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
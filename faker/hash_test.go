@@ -0,0 +1,47 @@
+package faker_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hash(t *testing.T) {
+	t.Parallel()
+
+	// digests of "abc"
+	expected := map[string]string{
+		"md5":    "900150983cd24fb0d6963f7d28e17f72",
+		"sha1":   "a9993e364706816aba3e25717850c26c9cd0d89d",
+		"sha256": "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"crc32":  "352441c2",
+	}
+
+	for name, digest := range expected {
+		info := gofakeit.GetFuncLookup(name)
+
+		require.NotNil(t, info)
+
+		r := testRand(t)
+
+		params := gofakeit.NewMapParams()
+		params.Add("input", "abc")
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Equal(t, digest, val)
+
+		val, err = info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Len(t, val, len(digest))
+		require.NotEqual(t, digest, val)
+
+		other, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+		require.NoError(t, err)
+		require.NotEqual(t, val, other)
+	}
+}
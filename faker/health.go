@@ -0,0 +1,229 @@
+package faker
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func init() {
+	gofakeit.AddFuncLookup("icd10code", gofakeit.Info{
+		Display:     "ICD10 Code",
+		Category:    "health",
+		Description: "Code of a common diagnosis in the ICD-10-CM classification",
+		Example:     "E11.9",
+		Output:      "string",
+		Params:      nil,
+		Generate:    icd10code,
+	})
+
+	gofakeit.AddFuncLookup("npi", gofakeit.Info{
+		Display:     "NPI",
+		Category:    "health",
+		Description: "US National Provider Identifier with valid check digit",
+		Example:     "1245319599",
+		Output:      "string",
+		Params:      nil,
+		Generate:    npi,
+	})
+
+	gofakeit.AddFuncLookup("bloodtype", gofakeit.Info{
+		Display:     "Blood Type",
+		Category:    "health",
+		Description: "ABO and Rh blood type, distributed according to its frequency in the population",
+		Example:     "O+",
+		Output:      "string",
+		Params:      nil,
+		Generate:    bloodtype,
+	})
+
+	gofakeit.AddFuncLookup("allergy", gofakeit.Info{
+		Display:     "Allergy",
+		Category:    "health",
+		Description: "Common allergen causing an allergic reaction",
+		Example:     "Peanut",
+		Output:      "string",
+		Params:      nil,
+		Generate:    allergy,
+	})
+
+	gofakeit.AddFuncLookup("patient", gofakeit.Info{
+		Display:     "Patient",
+		Category:    "health",
+		Description: "Patient record with personal information, blood type, allergies and diagnoses",
+		Example: `{
+	"id": "590c1440-9888-45b0-bd51-a817ee07c3f2",
+	"mrn": "58223841",
+	"firstName": "Linda",
+	"lastName": "Garcia",
+	"gender": "female",
+	"birthDate": "1967-03-21",
+	"bloodType": "A+",
+	"allergies": ["Penicillin"],
+	"conditions": ["I10", "E78.5"]
+}`,
+		Output:   "map[string]any",
+		Params:   nil,
+		Generate: patient,
+	})
+
+	gofakeit.AddFuncLookup("fhirpatient", gofakeit.Info{
+		Display:     "FHIR Patient",
+		Category:    "health",
+		Description: "Minimal valid FHIR R4 Patient resource",
+		Example: `{
+	"resourceType": "Patient",
+	"id": "590c1440-9888-45b0-bd51-a817ee07c3f2",
+	"identifier": [{"system": "urn:oid:2.16.840.1.113883.19.5", "value": "58223841"}],
+	"active": true,
+	"name": [{"use": "official", "family": "Garcia", "given": ["Linda"]}],
+	"gender": "female",
+	"birthDate": "1967-03-21"
+}`,
+		Output:   "map[string]any",
+		Params:   nil,
+		Generate: fhirpatient,
+	})
+}
+
+// icd10codes contains codes of common diagnoses in the ICD-10-CM classification.
+var icd10codes = []string{ //nolint:gochecknoglobals
+	"I10",     // Essential (primary) hypertension
+	"E11.9",   // Type 2 diabetes mellitus without complications
+	"E78.5",   // Hyperlipidemia, unspecified
+	"J06.9",   // Acute upper respiratory infection, unspecified
+	"M54.5",   // Low back pain
+	"F41.1",   // Generalized anxiety disorder
+	"F32.9",   // Major depressive disorder, single episode, unspecified
+	"J45.909", // Unspecified asthma, uncomplicated
+	"K21.9",   // Gastro-esophageal reflux disease without esophagitis
+	"E66.9",   // Obesity, unspecified
+	"N39.0",   // Urinary tract infection, site not specified
+	"R51.9",   // Headache, unspecified
+	"J02.9",   // Acute pharyngitis, unspecified
+	"E03.9",   // Hypothyroidism, unspecified
+	"M17.9",   // Osteoarthritis of knee, unspecified
+	"I25.10",  // Atherosclerotic heart disease of native coronary artery
+	"J44.9",   // Chronic obstructive pulmonary disease, unspecified
+	"I48.91",  // Unspecified atrial fibrillation
+	"G43.909", // Migraine, unspecified
+	"Z00.00",  // General adult medical examination without abnormal findings
+}
+
+// bloodtypes contains the blood types and their approximate frequency in percent.
+var bloodtypes = weightedStrings{ //nolint:gochecknoglobals
+	values:  []string{"O+", "A+", "B+", "AB+", "O-", "A-", "B-", "AB-"},
+	weights: []float64{37.4, 35.7, 8.5, 3.4, 6.6, 6.3, 1.5, 0.6},
+}
+
+// allergies contains common allergens.
+var allergies = []string{ //nolint:gochecknoglobals
+	"Peanut", "Tree nut", "Milk", "Egg", "Wheat", "Soy", "Fish", "Shellfish", "Sesame",
+	"Penicillin", "Sulfonamide", "Aspirin", "Ibuprofen", "Latex", "Pollen", "Dust mite",
+	"Mold", "Cat dander", "Dog dander", "Bee venom",
+}
+
+func icd10code(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return icd10codes[r.Intn(len(icd10codes))], nil
+}
+
+func npi(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	// The check digit is calculated using the Luhn algorithm over the number prefixed with 80840.
+	const (
+		prefix     = "80840"
+		baseLength = 8
+	)
+
+	// The first digit is 1 for individuals and 2 for organizations.
+	base := string("12"[r.Intn(2)]) + digits(r, baseLength)
+
+	return base + strconv.Itoa(luhnCheckDigit(prefix+base)), nil
+}
+
+func bloodtype(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return bloodtypes.sample(r), nil
+}
+
+func allergy(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return allergies[r.Intn(len(allergies))], nil
+}
+
+// patientRecord contains the fields shared by the patient generators.
+type patientRecord struct {
+	id        string
+	mrn       string
+	firstName string
+	lastName  string
+	gender    string
+	birthDate string
+}
+
+func newPatientRecord(r *rand.Rand) *patientRecord {
+	const mrnLength = 8
+
+	demography := getDemographics().Countries["US"]
+	gender := randomGender(r)
+	birthDate := birthdateOf(r, time.Now(), demography.age(r))
+
+	return &patientRecord{
+		id:        (&gofakeit.Faker{Rand: r}).UUID(),
+		mrn:       digits(r, mrnLength),
+		firstName: demography.firstName(r, gender),
+		lastName:  demography.Last.sample(r),
+		gender:    gender,
+		birthDate: birthDate.Format(time.DateOnly),
+	}
+}
+
+// pickSome returns up to limit distinct random items of the list.
+func pickSome(r *rand.Rand, list []string, limit int) []string {
+	count := r.Intn(limit + 1)
+	picked := make([]string, 0, count)
+
+	for _, idx := range r.Perm(len(list))[:count] {
+		picked = append(picked, list[idx])
+	}
+
+	return picked
+}
+
+func patient(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const maxItems = 3
+
+	rec := newPatientRecord(r)
+
+	return object{
+		{"id", rec.id},
+		{"mrn", rec.mrn},
+		{"firstName", rec.firstName},
+		{"lastName", rec.lastName},
+		{"gender", rec.gender},
+		{"birthDate", rec.birthDate},
+		{"bloodType", bloodtypes.sample(r)},
+		{"allergies", pickSome(r, allergies, maxItems)},
+		{"conditions", pickSome(r, icd10codes, maxItems)},
+	}, nil
+}
+
+// mrnSystem is the identifier system of the medical record numbers (example OID from the HL7 documentation).
+const mrnSystem = "urn:oid:2.16.840.1.113883.19.5"
+
+func fhirpatient(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	rec := newPatientRecord(r)
+
+	return object{
+		{"resourceType", "Patient"},
+		{"id", rec.id},
+		{"identifier", []any{object{{"system", mrnSystem}, {"value", rec.mrn}}}},
+		{"active", true},
+		{"name", []any{object{
+			{"use", "official"},
+			{"family", rec.lastName},
+			{"given", []any{rec.firstName}},
+		}}},
+		{"gender", rec.gender},
+		{"birthDate", rec.birthDate},
+	}, nil
+}
@@ -0,0 +1,58 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_npi(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("npi")
+
+	require.NotNil(t, info)
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^[12][0-9]{9}$`), val)
+
+		// The check digit is valid if the Luhn checksum of the number prefixed with 80840 is valid.
+		result, err := vm.RunString(`new Faker().validate("80840` + val.(string) + `", "luhn").length`) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.Equal(t, int64(0), result.ToInteger())
+	}
+}
+
+func Test_fhirpatient(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("fhirpatient")
+
+	require.NotNil(t, info)
+
+	val, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+
+	resource, ok := faker.PlainMaps(val).(map[string]any)
+
+	require.True(t, ok)
+	require.Equal(t, "Patient", resource["resourceType"])
+	require.Contains(t, []string{"male", "female"}, resource["gender"])
+	require.Regexp(t, regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`), resource["birthDate"])
+	require.Len(t, resource["name"], 1)
+}
@@ -0,0 +1,206 @@
+package faker
+
+import (
+	"github.com/grafana/sobek"
+)
+
+// helpers returns the object containing the helper methods operating on user provided data (faker.helpers).
+// The helpers use the random source of the instance, so the results are reproducible.
+func (f *faker) helpers() *sobek.Object {
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("arrayElement", f.arrayElement)
+	_ = obj.Set("arrayElements", f.arrayElements)
+	_ = obj.Set("shuffle", f.shuffle)
+	_ = obj.Set("objectKey", f.objectKey)
+	_ = obj.Set("objectValue", f.objectValue)
+	_ = obj.Set("maybe", f.maybe)
+	_ = obj.Set("multiple", f.multiple)
+
+	return obj
+}
+
+// arrayArgument returns the items of the array parameter.
+func (f *faker) arrayArgument(method string, param string, val sobek.Value) []sobek.Value {
+	obj, isObject := val.(*sobek.Object)
+	if !isObject || obj.ClassName() != "Array" {
+		f.throw(&ArgumentError{Function: method, Parameter: param, Expected: "array", Reason: "invalid value"})
+	}
+
+	var items []sobek.Value
+
+	_ = f.runtime.ExportTo(obj, &items)
+
+	return items
+}
+
+// arrayElement returns a random element of the array.
+func (f *faker) arrayElement(array sobek.Value) sobek.Value {
+	items := f.arrayArgument("arrayElement", "array", array)
+	if len(items) == 0 {
+		f.throw(&ArgumentError{Function: "arrayElement", Parameter: "array", Expected: "array", Reason: "empty array"})
+	}
+
+	f.rescope()
+
+	return items[f.rand.Intn(len(items))]
+}
+
+// arrayElements returns count distinct random elements of the array in random order.
+// If the count is missing, a random number of elements (at least one) is returned.
+func (f *faker) arrayElements(array sobek.Value, count sobek.Value) *sobek.Object {
+	items := f.arrayArgument("arrayElements", "array", array)
+
+	f.rescope()
+
+	var size int
+
+	switch {
+	case count == nil || sobek.IsUndefined(count):
+		if len(items) != 0 {
+			size = 1 + f.rand.Intn(len(items))
+		}
+	case count.ToInteger() < 0:
+		f.throw(&ArgumentError{
+			Function: "arrayElements", Parameter: "count", Expected: "non-negative number",
+			Reason: "invalid value " + count.String(),
+		})
+	default:
+		size = int(min(count.ToInteger(), int64(len(items))))
+	}
+
+	picked := make([]any, 0, size)
+
+	for _, idx := range f.rand.Perm(len(items))[:size] {
+		picked = append(picked, items[idx])
+	}
+
+	return f.runtime.NewArray(picked...)
+}
+
+// shuffle returns a new array containing the elements of the array in random order.
+func (f *faker) shuffle(array sobek.Value) *sobek.Object {
+	items := f.arrayArgument("shuffle", "array", array)
+
+	f.rescope()
+
+	shuffled := make([]any, len(items))
+
+	for idx, perm := range f.rand.Perm(len(items)) {
+		shuffled[idx] = items[perm]
+	}
+
+	return f.runtime.NewArray(shuffled...)
+}
+
+// objectKey returns a random own key of the object.
+func (f *faker) objectKey(object sobek.Value) string {
+	keys := f.objectArgument("objectKey", "object", object).Keys()
+	if len(keys) == 0 {
+		f.throw(&ArgumentError{Function: "objectKey", Parameter: "object", Expected: "object", Reason: "empty object"})
+	}
+
+	f.rescope()
+
+	return keys[f.rand.Intn(len(keys))]
+}
+
+// objectValue returns the value of a random own key of the object.
+func (f *faker) objectValue(object sobek.Value) sobek.Value {
+	obj := f.objectArgument("objectValue", "object", object)
+
+	keys := obj.Keys()
+	if len(keys) == 0 {
+		f.throw(&ArgumentError{Function: "objectValue", Parameter: "object", Expected: "object", Reason: "empty object"})
+	}
+
+	f.rescope()
+
+	return obj.Get(keys[f.rand.Intn(len(keys))])
+}
+
+// maybe calls the function with the given probability (0.5 by default) and returns its result,
+// otherwise it returns undefined. The probability can also be passed as an options object ({probability: 0.2}).
+func (f *faker) maybe(fn sobek.Value, probability sobek.Value) sobek.Value {
+	const defaultProbability = 0.5
+
+	callable, isFunction := sobek.AssertFunction(fn)
+	if !isFunction {
+		f.throw(&ArgumentError{Function: "maybe", Parameter: "fn", Expected: "function", Reason: "invalid value"})
+	}
+
+	chance := defaultProbability
+
+	if isOptions(probability) {
+		probability = probability.ToObject(f.runtime).Get("probability")
+	}
+
+	if probability != nil && !sobek.IsUndefined(probability) {
+		if chance = probability.ToFloat(); !(chance >= 0 && chance <= 1) {
+			f.throw(&ArgumentError{
+				Function: "maybe", Parameter: "probability", Expected: "number between 0 and 1",
+				Reason: "invalid value " + probability.String(),
+			})
+		}
+	}
+
+	f.rescope()
+
+	if f.rand.Float64() >= chance {
+		return sobek.Undefined()
+	}
+
+	val, err := callable(sobek.Undefined())
+	if err != nil {
+		panic(err)
+	}
+
+	return val
+}
+
+// multiple calls the function count times (3 by default) and returns the results in an array.
+// The count option is a number or a range ({min, max}), the function is called with the index
+// as second argument, like the callback of Array.from.
+func (f *faker) multiple(fn sobek.Value, options sobek.Value) *sobek.Object {
+	const defaultCount = 3
+
+	callable, isFunction := sobek.AssertFunction(fn)
+	if !isFunction {
+		f.throw(&ArgumentError{Function: "multiple", Parameter: "fn", Expected: "function", Reason: "invalid value"})
+	}
+
+	f.rescope()
+
+	count := defaultCount
+
+	if isOptions(options) {
+		opts := options.ToObject(f.runtime)
+
+		if isOptions(opts.Get("count")) {
+			rng := opts.Get("count").ToObject(f.runtime)
+			low := f.compatCount("multiple", rng, "min", 0)
+			high := f.compatCount("multiple", rng, "max", low)
+
+			if low > high {
+				f.throw(&ArgumentError{Function: "multiple", Parameter: "count", Reason: "max is less than min"})
+			}
+
+			count = low + f.rand.Intn(high-low+1)
+		} else {
+			count = f.compatCount("multiple", opts, "count", defaultCount)
+		}
+	}
+
+	items := make([]any, count)
+
+	for idx := range items {
+		val, err := callable(sobek.Undefined(), sobek.Undefined(), f.runtime.ToValue(idx))
+		if err != nil {
+			panic(err)
+		}
+
+		items[idx] = val
+	}
+
+	return f.runtime.NewArray(items...)
+}
@@ -0,0 +1,172 @@
+package faker
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func init() {
+	gofakeit.AddFuncLookup("uuidv7", gofakeit.Info{
+		Display:     "UUID V7",
+		Category:    "string",
+		Description: "Time-ordered UUID version 7 of the current time, with random bits from the seeded generator",
+		Example:     "018e4a5c-7c1b-7a3e-9f2d-3b8c4e1a6d70",
+		Output:      "string",
+		Params:      nil,
+		Generate:    uuidv7,
+	})
+
+	gofakeit.AddFuncLookup("ulid", gofakeit.Info{
+		Display:     "ULID",
+		Category:    "string",
+		Description: "Universally Unique Lexicographically Sortable Identifier of the current time",
+		Example:     "01HSD5RZ0X8K3VQ7M2YB9T4NCE",
+		Output:      "string",
+		Params:      nil,
+		Generate:    ulid,
+	})
+
+	gofakeit.AddFuncLookup("ksuid", gofakeit.Info{
+		Display:     "KSUID",
+		Category:    "string",
+		Description: "K-Sortable Unique Identifier of the current time, 27 base62 characters",
+		Example:     "2dUqZ3kF9mVbX1rTq8sW4yN0aLc",
+		Output:      "string",
+		Params:      nil,
+		Generate:    ksuid,
+	})
+
+	gofakeit.AddFuncLookup("nanoid", gofakeit.Info{
+		Display:     "Nanoid",
+		Category:    "string",
+		Description: "URL-friendly random identifier of the alphabet",
+		Example:     "V1StGXR8_Z5jdHi6B-myT",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{Field: "length", Display: "Length", Type: "int", Default: "21", Description: "Number of characters"},
+			{
+				Field:       "alphabet",
+				Display:     "Alphabet",
+				Type:        "string",
+				Default:     nanoidAlphabet,
+				Description: "Characters to be used",
+			},
+		},
+		Generate: nanoid,
+	})
+}
+
+const (
+	// nanoidAlphabet is the URL-friendly default alphabet of NanoID.
+	nanoidAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// crockfordBase32 is the alphabet of ULIDs.
+	crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// base62 is the alphabet of KSUIDs.
+	base62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// ksuidEpoch is the start of the KSUID timestamps (2014-05-13T16:53:20Z).
+	ksuidEpoch = 1_400_000_000
+)
+
+func uuidv7(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		version = 0x70
+		variant = 0x80
+	)
+
+	// 48 bits timestamp followed by version, variant and random bits
+	data := binary.BigEndian.AppendUint64(nil, uint64(time.Now().UnixMilli()))[2:] //nolint:gosec
+	data = append(data, randomBytes(r, 10)...)                                     //nolint:mnd
+
+	data[6] = data[6]&0x0f | version
+	data[8] = data[8]&0x3f | variant
+
+	str := hex.EncodeToString(data)
+
+	return str[0:8] + "-" + str[8:12] + "-" + str[12:16] + "-" + str[16:20] + "-" + str[20:], nil
+}
+
+func ulid(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		length = 26
+		bits   = 5
+		mask   = 1<<bits - 1
+	)
+
+	// 48 bits timestamp followed by 80 random bits, 130 bits encoded in 26 characters
+	hi := uint64(time.Now().UnixMilli())<<16 | uint64(r.Intn(1<<16)) //nolint:gosec,mnd
+	lo := r.Uint64()
+
+	var buff [length]byte
+
+	for idx := length - 1; idx >= 0; idx-- {
+		buff[idx] = crockfordBase32[lo&mask]
+		lo = lo>>bits | hi<<(64-bits)
+		hi >>= bits
+	}
+
+	return string(buff[:]), nil
+}
+
+func ksuid(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const length = 27
+
+	data := binary.BigEndian.AppendUint32(nil, uint32(time.Now().Unix()-ksuidEpoch)) //nolint:gosec
+	data = append(data, randomBytes(r, 16)...)                                       //nolint:mnd
+
+	str := new(big.Int).SetBytes(data).Text(len(base62))
+
+	// big.Int uses lowercase letters for digits 10-35 and uppercase ones for 36-61
+	str = strings.Map(func(chr rune) rune {
+		switch {
+		case chr >= 'a' && chr <= 'z':
+			return 'A' + chr - 'a'
+		case chr >= 'A' && chr <= 'Z':
+			return 'a' + chr - 'A'
+		default:
+			return chr
+		}
+	}, str)
+
+	return strings.Repeat("0", length-len(str)) + str, nil
+}
+
+func nanoid(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	length, err := info.GetInt(m, "length")
+	if err != nil {
+		return nil, err
+	}
+
+	if length < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeLength, length)
+	}
+
+	alphabet, err := info.GetString(m, "alphabet")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(alphabet) == 0 {
+		return nil, errEmptyAlphabet
+	}
+
+	return runesOf(r, alphabet, length), nil
+}
+
+// runesOf returns a random string of n characters of the alphabet, which may contain any Unicode characters.
+func runesOf(r *rand.Rand, alphabet string, n int) string {
+	chars := []rune(alphabet)
+	buff := make([]rune, n)
+
+	for idx := range buff {
+		buff[idx] = chars[r.Intn(len(chars))]
+	}
+
+	return string(buff)
+}
@@ -0,0 +1,135 @@
+package faker_test
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_uuidv7(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("uuidv7")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+	format := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	for range 20 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, format, val)
+
+		str := strings.ReplaceAll(val.(string)[:13], "-", "") //nolint:forcetypeassert
+		ms, ok := new(big.Int).SetString(str, 16)
+
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now(), time.UnixMilli(ms.Int64()), time.Minute)
+	}
+}
+
+func Test_ulid(t *testing.T) {
+	t.Parallel()
+
+	const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	info := gofakeit.GetFuncLookup("ulid")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 20 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`), val)
+
+		var ms int64
+
+		for _, chr := range val.(string)[:10] { //nolint:forcetypeassert
+			ms = ms<<5 | int64(strings.IndexRune(crockford, chr))
+		}
+
+		require.WithinDuration(t, time.Now(), time.UnixMilli(ms), time.Minute)
+	}
+}
+
+func Test_ksuid(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("ksuid")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 20 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^[0-9A-Za-z]{27}$`), val)
+	}
+}
+
+func Test_nanoid(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("nanoid")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^[A-Za-z0-9_-]{21}$`), val)
+
+	params := gofakeit.NewMapParams()
+	params.Add("length", "8")
+	params.Add("alphabet", "αβγ")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^[αβγ]{8}$`), val)
+
+	params = gofakeit.NewMapParams()
+	params.Add("length", "-1")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "negative length")
+
+	params = gofakeit.NewMapParams()
+	params.Add("alphabet", "")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "empty alphabet")
+}
+
+func Test_ids_seed(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("nanoid")
+
+	require.NotNil(t, info)
+
+	first, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+
+	second, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+}
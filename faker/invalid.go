@@ -0,0 +1,198 @@
+package faker
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+// Kinds of intentional violations of the invalid option of fromSchema.
+const (
+	// invalidMissingRequired removes a field, all fields of the schema are considered required.
+	invalidMissingRequired = "missingRequired"
+	// invalidWrongType replaces a field value with a value of another type.
+	invalidWrongType = "wrongType"
+	// invalidOutOfRange replaces the value of a field generated with min and max parameters
+	// (e.g. ["intRange", 1, 10]) with a value outside the range.
+	invalidOutOfRange = "outOfRange"
+)
+
+// schemaLeaf is a field of a (nested) schema generated by a generator function.
+type schemaLeaf struct {
+	path  []string
+	field *schemaField
+}
+
+// schemaLeaves returns the fields of the schema generated by generator functions, including the nested ones.
+func schemaLeaves(s *schema, prefix []string) []*schemaLeaf {
+	var leaves []*schemaLeaf
+
+	for _, field := range s.fields {
+		path := append(append([]string{}, prefix...), field.name)
+
+		if field.nested != nil {
+			leaves = append(leaves, schemaLeaves(field.nested, path)...)
+
+			continue
+		}
+
+		leaves = append(leaves, &schemaLeaf{path: path, field: field})
+	}
+
+	return leaves
+}
+
+// fromSchemaInvalid generates an object based on the schema with exactly one violation of the kind,
+// and returns it together with the description of the violation ({rule, field, expected, actual}).
+func (f *faker) fromSchemaInvalid(compiled *schema, kind string) *sobek.Object {
+	const method = "fromSchema"
+
+	leaves := schemaLeaves(compiled, nil)
+
+	if kind == invalidOutOfRange {
+		ranged := leaves[:0:0]
+
+		for _, leaf := range leaves {
+			if _, _, found := f.fieldRange(leaf.field); found {
+				ranged = append(ranged, leaf)
+			}
+		}
+
+		leaves = ranged
+	}
+
+	if len(leaves) == 0 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "invalid", Expected: "schema with a field to violate",
+			Reason: "no field can be generated " + kind,
+		})
+	}
+
+	// the modifiers are ignored, the fields are all required
+	data := f.generate(compiled.required())
+
+	f.rescope()
+
+	leaf := leaves[f.rand.Intn(len(leaves))]
+
+	parent := data
+	for _, name := range leaf.path[:len(leaf.path)-1] {
+		parent = parent.Get(name).ToObject(f.runtime)
+	}
+
+	name := leaf.path[len(leaf.path)-1]
+	current := parent.Get(name)
+
+	violation := f.runtime.NewObject()
+
+	_ = violation.Set("rule", kind)
+	_ = violation.Set("field", strings.Join(leaf.path, "."))
+
+	switch kind {
+	case invalidMissingRequired:
+		_ = parent.Delete(name)
+
+		_ = violation.Set("expected", "present")
+		_ = violation.Set("actual", "missing")
+	case invalidWrongType:
+		const maxWrongNumber = 1000
+
+		// strings are replaced with numbers, other values with strings
+		var wrong any = (&gofakeit.Faker{Rand: f.rand}).Word()
+
+		if _, isString := current.Export().(string); isString {
+			wrong = f.rand.Intn(maxWrongNumber)
+		}
+
+		_ = parent.Set(name, wrong)
+		_ = violation.Set("expected", jsTypeOf(current))
+		_ = violation.Set("actual", jsTypeOf(f.runtime.ToValue(wrong)))
+	case invalidOutOfRange:
+		low, high, _ := f.fieldRange(leaf.field)
+		span := math.Max(high-low, 1)
+		offset := math.Ceil(span * (f.rand.Float64() + 0.1))
+
+		value := high + offset
+		if f.rand.Intn(2) == 0 {
+			value = low - offset
+		}
+
+		_ = parent.Set(name, value)
+		_ = violation.Set("expected", formatNumber(low)+".."+formatNumber(high))
+		_ = violation.Set("actual", value)
+	}
+
+	result := f.runtime.NewObject()
+
+	_ = result.Set("data", data)
+	_ = result.Set("violation", violation)
+
+	return result
+}
+
+// fieldRange returns the min and max parameter values of the field, false if the function has no range.
+func (f *faker) fieldRange(field *schemaField) (float64, float64, bool) {
+	if field.info == nil {
+		return 0, 0, false
+	}
+
+	var hasMin, hasMax bool
+
+	for _, param := range field.info.Params {
+		hasMin = hasMin || param.Field == "min"
+		hasMax = hasMax || param.Field == "max"
+	}
+
+	if !hasMin || !hasMax {
+		return 0, 0, false
+	}
+
+	params := f.toMapParams(field.function, field.info, sobek.FunctionCall{
+		This: sobek.Undefined(), Arguments: field.args,
+	})
+	if params == nil {
+		return 0, 0, false
+	}
+
+	low, errLow := strconv.ParseFloat(firstOf((*params)["min"]), 64)
+	high, errHigh := strconv.ParseFloat(firstOf((*params)["max"]), 64)
+
+	return low, high, errLow == nil && errHigh == nil
+}
+
+func firstOf(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func formatNumber(num float64) string {
+	return strconv.FormatFloat(num, 'f', -1, 64)
+}
+
+// jsTypeOf returns the JavaScript type of the value (array for arrays, null for null).
+func jsTypeOf(val sobek.Value) string {
+	if sobek.IsUndefined(val) {
+		return "undefined"
+	}
+
+	switch val.Export().(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int64, float64:
+		return "number"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
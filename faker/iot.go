@@ -0,0 +1,412 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+var (
+	errUnknownSensorType = errors.New("unknown sensor type")
+	errUnknownDriftModel = errors.New("unknown drift model")
+	errUnknownIDScheme   = errors.New("unknown device id scheme")
+	errInvalidBatchSize  = errors.New("batch size must be positive")
+	errInvalidTopic      = errors.New("invalid MQTT topic")
+)
+
+// Drift models of the sensor readings.
+const (
+	// driftMeanReverting drifts around the typical value of the sensor (Ornstein-Uhlenbeck process).
+	driftMeanReverting = "meanReverting"
+	// driftRandomWalk drifts freely within the range of the sensor.
+	driftRandomWalk = "randomWalk"
+)
+
+// meanReversion is the fraction of the distance from the typical value recovered in a step.
+const meanReversion = 0.05
+
+// sensorSpec describes the readings of a sensor type.
+type sensorSpec struct {
+	unit     string
+	typical  float64
+	step     float64
+	min      float64
+	max      float64
+	decimals int
+}
+
+// sensorSpecs contains the sensor types.
+//
+//nolint:gochecknoglobals,mnd
+var sensorSpecs = map[string]*sensorSpec{
+	"temperature": {unit: "Cel", typical: 21, step: 0.1, min: -40, max: 85, decimals: 2},
+	"humidity":    {unit: "%RH", typical: 45, step: 0.3, min: 0, max: 100, decimals: 1},
+	"pressure":    {unit: "hPa", typical: 1013.25, step: 0.2, min: 870, max: 1085, decimals: 2},
+	"co2":         {unit: "ppm", typical: 600, step: 5, min: 400, max: 5000, decimals: 0},
+	"light":       {unit: "lx", typical: 300, step: 10, min: 0, max: 100_000, decimals: 0},
+	"voltage":     {unit: "V", typical: 3.7, step: 0.005, min: 3, max: 4.2, decimals: 3},
+}
+
+// deviceIDSchemes contains the generators of the device identifier schemes.
+//
+//nolint:gochecknoglobals
+var deviceIDSchemes = map[string]func(r *rand.Rand) string{
+	"uuid": func(r *rand.Rand) string { return (&gofakeit.Faker{Rand: r}).UUID() },
+	"mac": func(r *rand.Rand) string {
+		return strings.ReplaceAll(strings.ToLower((&gofakeit.Faker{Rand: r}).MacAddress()), ":", "")
+	},
+	"imei":   imeiOf,
+	"serial": func(r *rand.Rand) string { return skuOf(r, "SN-????-########") },
+}
+
+// topicLocations contains the generators of the location levels of the MQTT topics, from the widest.
+//
+//nolint:gochecknoglobals
+var topicLocations = []func(r *rand.Rand) string{
+	func(r *rand.Rand) string { return "site-" + digits(r, 2) },
+	func(r *rand.Rand) string { return "building-" + strconv.Itoa(1+r.Intn(9)) },
+	func(r *rand.Rand) string { return "floor-" + strconv.Itoa(r.Intn(20)) },
+	func(r *rand.Rand) string { return "room-" + digits(r, 3) },
+}
+
+// topicChannels contains the last levels of the MQTT topics, the kind of the messages of the device.
+//
+//nolint:gochecknoglobals
+var topicChannels = []string{"telemetry", "status", "events", "commands", "config"}
+
+func init() {
+	sensorType := gofakeit.Param{
+		Field:       "sensorType",
+		Display:     "Sensor Type",
+		Type:        "string",
+		Default:     "temperature",
+		Options:     slices.Sorted(maps.Keys(sensorSpecs)),
+		Description: "Type of the sensor",
+	}
+
+	driftModel := gofakeit.Param{
+		Field:       "driftModel",
+		Display:     "Drift Model",
+		Type:        "string",
+		Default:     driftMeanReverting,
+		Options:     []string{driftMeanReverting, driftRandomWalk},
+		Description: "Model of the drift of the successive readings",
+	}
+
+	gofakeit.AddFuncLookup("deviceid", gofakeit.Info{
+		Display:     "Device ID",
+		Category:    "iot",
+		Description: "Identifier of a connected device in the given scheme",
+		Example:     "352099001761481",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "scheme",
+				Display:     "Scheme",
+				Type:        "string",
+				Default:     "uuid",
+				Options:     slices.Sorted(maps.Keys(deviceIDSchemes)),
+				Description: "Scheme of the identifier: UUID, MAC address, IMEI with check digit or serial number",
+			},
+		},
+		Generate: deviceid,
+	})
+
+	gofakeit.AddFuncLookup("mqtttopic", gofakeit.Info{
+		Display:     "MQTT Topic",
+		Category:    "iot",
+		Description: "MQTT topic of a device: the tenant, the location levels, the device id and the message channel",
+		Example:     "acme/site-07/building-3/sn-kqzt-40918273/telemetry",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "levels",
+				Display:     "Levels",
+				Type:        "int",
+				Default:     "5",
+				Description: "Number of topic levels, between 2 (device id and channel) and 7",
+			},
+			{
+				Field:       "deviceId",
+				Display:     "Device ID",
+				Type:        "string",
+				Optional:    true,
+				Description: "Identifier of the device, random serial number if empty",
+			},
+		},
+		Generate: mqtttopic,
+	})
+
+	gofakeit.AddFuncLookup("telemetrybatch", gofakeit.Info{
+		Display:     "Telemetry Batch",
+		Category:    "iot",
+		Description: "Successive readings of a sensor at one minute intervals, slowly drifting instead of white noise",
+		Example: `[
+	{"deviceId": "9f4c4bd2-5d8e-4f43-a1e6-2c3a7c5d8b11", "type": "temperature", "value": 21.37, "unit": "Cel", "timestamp": "2024-03-15T10:20:00Z"},
+	{"deviceId": "9f4c4bd2-5d8e-4f43-a1e6-2c3a7c5d8b11", "type": "temperature", "value": 21.42, "unit": "Cel", "timestamp": "2024-03-15T10:21:00Z"}
+]`,
+		Output: "[]map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "10", Description: "Number of readings"},
+			sensorType,
+			driftModel,
+		},
+		Generate: telemetrybatch,
+	})
+}
+
+// imeiOf returns a random IMEI with valid Luhn check digit.
+func imeiOf(r *rand.Rand) string {
+	const bodyLength = 14
+
+	body := digits(r, bodyLength)
+
+	var sum int
+
+	// the check digit is calculated from right to left, doubling every second digit starting from the rightmost
+	for idx := len(body) - 1; idx >= 0; idx-- {
+		digit := int(body[idx] - '0')
+
+		if (len(body)-1-idx)%2 == 0 {
+			if digit *= 2; digit > 9 { //nolint:mnd
+				digit -= 9
+			}
+		}
+
+		sum += digit
+	}
+
+	return body + string(rune('0'+(10-sum%10)%10)) //nolint:mnd
+}
+
+// sensorParams returns the sensor spec and the drift model by name.
+func sensorParams(sensorType string, driftModel string) (*sensorSpec, string, error) {
+	spec, found := sensorSpecs[strings.ToLower(sensorType)]
+	if !found {
+		return nil, "", fmt.Errorf("%w: %s", errUnknownSensorType, sensorType)
+	}
+
+	for _, model := range []string{driftMeanReverting, driftRandomWalk} {
+		if strings.EqualFold(model, driftModel) {
+			return spec, model, nil
+		}
+	}
+
+	return nil, "", fmt.Errorf("%w: %s", errUnknownDriftModel, driftModel)
+}
+
+// initial returns a random initial reading near the typical value.
+func (s *sensorSpec) initial(r *rand.Rand) float64 {
+	const spread = 10
+
+	return s.clamp(s.typical + r.NormFloat64()*s.step*spread)
+}
+
+// next returns the reading following the value according to the drift model.
+func (s *sensorSpec) next(r *rand.Rand, model string, value float64) float64 {
+	if model == driftMeanReverting {
+		value += meanReversion * (s.typical - value)
+	}
+
+	return s.clamp(value + r.NormFloat64()*s.step)
+}
+
+func (s *sensorSpec) clamp(value float64) float64 {
+	return math.Min(s.max, math.Max(s.min, value))
+}
+
+// round returns the value rounded to the precision of the sensor.
+func (s *sensorSpec) round(value float64) float64 {
+	scale := math.Pow10(s.decimals)
+
+	return math.Round(value*scale) / scale
+}
+
+func deviceid(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	scheme, err := info.GetString(m, "scheme")
+	if err != nil {
+		return nil, err
+	}
+
+	generate, found := deviceIDSchemes[strings.ToLower(scheme)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownIDScheme, scheme)
+	}
+
+	return generate(r), nil
+}
+
+// topicTenant returns the first level of the topic: the lower case first word of a company name.
+func topicTenant(r *rand.Rand) string {
+	word, _, _ := strings.Cut((&gofakeit.Faker{Rand: r}).Company(), " ")
+
+	tenant := strings.Map(func(chr rune) rune {
+		if unicode.IsLetter(chr) || unicode.IsDigit(chr) {
+			return unicode.ToLower(chr)
+		}
+
+		return -1
+	}, word)
+
+	if len(tenant) == 0 {
+		return "tenant"
+	}
+
+	return tenant
+}
+
+func mqtttopic(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const fixedLevels = 3 // the tenant, the device id and the channel
+
+	levels, err := info.GetInt(m, "levels")
+	if err != nil {
+		return nil, err
+	}
+
+	if levels < fixedLevels-1 || levels > fixedLevels+len(topicLocations) {
+		return nil, fmt.Errorf("%w: levels must be between %d and %d", errInvalidTopic, fixedLevels-1,
+			fixedLevels+len(topicLocations))
+	}
+
+	device, err := info.GetString(m, "deviceId")
+	if err != nil || len(device) == 0 {
+		device = strings.ToLower(deviceIDSchemes["serial"](r))
+	}
+
+	// the wildcard characters and the level separator are not allowed in the levels of a topic name
+	if strings.ContainsAny(device, "/+#\x00") {
+		return nil, fmt.Errorf("%w: device id %q", errInvalidTopic, device)
+	}
+
+	topic := make([]string, 0, levels)
+
+	if levels > fixedLevels-1 {
+		topic = append(topic, topicTenant(r))
+	}
+
+	for _, location := range topicLocations[:max(0, levels-fixedLevels)] {
+		topic = append(topic, location(r))
+	}
+
+	topic = append(topic, device, topicChannels[r.Intn(len(topicChannels))])
+
+	return strings.Join(topic, "/"), nil
+}
+
+func telemetrybatch(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	count, err := info.GetInt(m, "count")
+	if err != nil {
+		return nil, err
+	}
+
+	if count <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidBatchSize, count)
+	}
+
+	if err := checkCount(count); err != nil {
+		return nil, err
+	}
+
+	sensorType, err := info.GetString(m, "sensorType")
+	if err != nil {
+		return nil, err
+	}
+
+	driftModel, err := info.GetString(m, "driftModel")
+	if err != nil {
+		return nil, err
+	}
+
+	spec, model, err := sensorParams(sensorType, driftModel)
+	if err != nil {
+		return nil, err
+	}
+
+	device := deviceIDSchemes["uuid"](r)
+	start := time.Now().UTC().Truncate(time.Minute).Add(-time.Duration(count-1) * time.Minute)
+	value := spec.initial(r)
+	batch := make([]object, count)
+
+	for idx := range batch {
+		if idx > 0 {
+			value = spec.next(r, model, value)
+		}
+
+		batch[idx] = object{
+			{"deviceId", device},
+			{"type", strings.ToLower(sensorType)},
+			{"value", spec.round(value)},
+			{"unit", spec.unit},
+			{"timestamp", start.Add(time.Duration(idx) * time.Minute).Format(time.RFC3339)},
+		}
+	}
+
+	return batch, nil
+}
+
+// sensorReading returns the next reading of a sensor of the instance. The successive readings of the
+// sensor type drift slowly according to the drift model, instead of being independent random values.
+// The id option identifies the sensor, so several sensors of the same type drift independently.
+func (f *faker) sensorReading(options sobek.Value) *sobek.Object {
+	const method = "iot.sensorReading"
+
+	sensorType, driftModel, id := "temperature", driftMeanReverting, ""
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		for name, target := range map[string]*string{"type": &sensorType, "driftModel": &driftModel, "id": &id} {
+			if val := opts.Get(name); val != nil && !sobek.IsUndefined(val) {
+				*target = val.String()
+			}
+		}
+	}
+
+	spec, model, err := sensorParams(sensorType, driftModel)
+	if err != nil {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "options", Expected: "known type and drift model", Reason: err.Error(),
+		})
+	}
+
+	f.rescope()
+
+	sensorType = strings.ToLower(sensorType)
+	key := sensorType + "/" + id
+
+	if f.sensors == nil {
+		f.sensors = make(map[string]float64)
+	}
+
+	value, found := f.sensors[key]
+	if found {
+		value = spec.next(f.rand, model, value)
+	} else {
+		value = spec.initial(f.rand)
+	}
+
+	f.sensors[key] = value
+
+	reading := f.runtime.NewObject()
+
+	if len(id) != 0 {
+		_ = reading.Set("id", id)
+	}
+
+	_ = reading.Set("type", sensorType)
+	_ = reading.Set("value", spec.round(value))
+	_ = reading.Set("unit", spec.unit)
+	_ = reading.Set("timestamp", time.Now().UTC().Format(time.RFC3339))
+
+	return reading
+}
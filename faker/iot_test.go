@@ -0,0 +1,175 @@
+package faker_test
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deviceid(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("deviceid")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	patterns := map[string]string{
+		"uuid":   `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`,
+		"mac":    `^[0-9a-f]{12}$`,
+		"imei":   `^[0-9]{15}$`,
+		"serial": `^SN-[A-Z]{4}-[0-9]{8}$`,
+	}
+
+	for scheme, pattern := range patterns {
+		params := gofakeit.NewMapParams()
+		params.Add("scheme", scheme)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(pattern), val, scheme)
+
+		if scheme != "imei" {
+			continue
+		}
+
+		// Luhn check: the sum of the digits, doubling every second from the right, is divisible by 10
+		var sum int
+
+		for idx, chr := range val.(string) { //nolint:forcetypeassert
+			digit := int(chr - '0')
+
+			if idx%2 == 1 {
+				if digit *= 2; digit > 9 {
+					digit -= 9
+				}
+			}
+
+			sum += digit
+		}
+
+		require.Zero(t, sum%10, val)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("scheme", "ean")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown device id scheme")
+}
+
+func Test_telemetrybatch(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("telemetrybatch")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, model := range []string{"meanReverting", "randomwalk"} {
+		params := gofakeit.NewMapParams()
+		params.Add("count", "200")
+		params.Add("sensorType", "humidity")
+		params.Add("driftModel", model)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		batch, ok := faker.PlainMaps(val).([]map[string]any)
+
+		require.True(t, ok)
+		require.Len(t, batch, 200)
+
+		for idx, reading := range batch {
+			require.Equal(t, batch[0]["deviceId"], reading["deviceId"])
+			require.Equal(t, "humidity", reading["type"])
+			require.Equal(t, "%RH", reading["unit"])
+
+			value, _ := reading["value"].(float64)
+
+			require.GreaterOrEqual(t, value, 0.0)
+			require.LessOrEqual(t, value, 100.0)
+
+			if idx == 0 {
+				continue
+			}
+
+			// successive readings drift slowly instead of jumping around
+			prev, _ := batch[idx-1]["value"].(float64)
+
+			require.Less(t, math.Abs(value-prev), 3.0, model)
+			require.Greater(t, reading["timestamp"], batch[idx-1]["timestamp"])
+		}
+	}
+
+	for field, value := range map[string]string{"count": "0", "sensorType": "wind", "driftModel": "linear"} {
+		params := gofakeit.NewMapParams()
+		params.Add(field, value)
+
+		_, err := info.Generate(r, params, info)
+
+		require.Error(t, err, field)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("count", "100001")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "too many items: 100001")
+}
+
+func Test_mqtttopic(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("mqtttopic")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for levels := 2; levels <= 7; levels++ {
+		params := gofakeit.NewMapParams()
+		params.Add("levels", strconv.Itoa(levels))
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Len(t, strings.Split(val.(string), "/"), levels) //nolint:forcetypeassert
+		require.NotContains(t, val, "+")
+		require.NotContains(t, val, "#")
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("deviceId", "thermostat-42")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^[a-z0-9]+/site-\d{2}/building-\d/thermostat-42/[a-z]+$`, val)
+
+	params = gofakeit.NewMapParams()
+	params.Add("deviceId", "sensors/+")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid MQTT topic")
+
+	params = gofakeit.NewMapParams()
+	params.Add("levels", "8")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "levels must be between 2 and 7")
+}
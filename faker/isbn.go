@@ -0,0 +1,107 @@
+package faker
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func init() {
+	gofakeit.AddFuncLookup("isbn13", gofakeit.Info{
+		Display:     "ISBN-13",
+		Category:    "book",
+		Description: "International Standard Book Number in the 13 digit format with a valid check digit",
+		Example:     "9780306406157",
+		Output:      "string",
+		Generate:    isbn13,
+	})
+
+	gofakeit.AddFuncLookup("isbn10", gofakeit.Info{
+		Display:     "ISBN-10",
+		Category:    "book",
+		Description: "International Standard Book Number in the legacy 10 character format with a valid check character",
+		Example:     "0306406152",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return withMod11Check(digits(r, 9)), nil //nolint:mnd
+		},
+	})
+
+	gofakeit.AddFuncLookup("issn", gofakeit.Info{
+		Display:     "ISSN",
+		Category:    "book",
+		Description: "International Standard Serial Number of a periodical with a valid check character",
+		Example:     "0317-8471",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			issn := withMod11Check(digits(r, 7)) //nolint:mnd
+
+			return issn[:4] + "-" + issn[4:], nil
+		},
+	})
+
+	gofakeit.AddFuncLookup("doi", gofakeit.Info{
+		Display:     "DOI",
+		Category:    "book",
+		Description: "Digital Object Identifier of a publication: the registrant prefix and the publisher assigned suffix",
+		Example:     "10.1016/j.cell.2019.08.012",
+		Output:      "string",
+		Generate:    doi,
+	})
+}
+
+// isbn13 returns an ISBN-13 of the 978 or the 979 prefix. The 979 prefix is used only with the
+// registration groups assigned to books (8, 10, 11 and 12), 979-0 is the prefix of the ISMN (printed music).
+func isbn13(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const length = 12
+
+	prefix := pickOf("978", "979")(r)
+	if prefix == "979" {
+		prefix += pickOf("8", "10", "11", "12")(r)
+	}
+
+	return withCheckDigit(prefix + digits(r, length-len(prefix))), nil
+}
+
+// withMod11Check returns the digits followed by their modulus 11 check character (ISBN-10, ISSN),
+// the weights are decreasing from the length of the result to 2 and the check character of 10 is X.
+func withMod11Check(body string) string {
+	const modulus = 11
+
+	var sum int
+
+	for idx := range body {
+		sum += int(body[idx]-'0') * (len(body) + 1 - idx)
+	}
+
+	switch check := (modulus - sum%modulus) % modulus; check {
+	case modulus - 1:
+		return body + "X"
+	default:
+		return body + strconv.Itoa(check)
+	}
+}
+
+func doi(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		minRegistrant = 1000
+		registrants   = 9000
+		years         = 30
+		journalLength = 4
+	)
+
+	prefix := "10." + strconv.Itoa(minRegistrant+r.Intn(registrants))
+	year := strconv.Itoa(time.Now().Year() - r.Intn(years))
+
+	// the suffix styles of large publishers: journal, year, issue and article, or journal, year and article number
+	if r.Intn(2) == 0 {
+		journal := (&gofakeit.Faker{Rand: r}).LetterN(journalLength)
+
+		return prefix + "/j." + strings.ToLower(journal) + "." + year + "." + digits(r, 2) + "." + digits(r, 3), nil //nolint:mnd
+	}
+
+	return prefix + "/s" + digits(r, 5) + "-" + year[1:] + "-" + digits(r, 5) + "-" + digits(r, 1), nil //nolint:mnd
+}
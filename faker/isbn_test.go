@@ -0,0 +1,58 @@
+package faker_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+// mod11Valid reports whether the weighted sum of the ISBN-10 or ISSN characters is divisible by 11.
+func mod11Valid(code string) bool {
+	var sum int
+
+	for idx, chr := range code {
+		digit := int(chr - '0')
+		if chr == 'X' {
+			digit = 10
+		}
+
+		sum += digit * (len(code) - idx)
+	}
+
+	return sum%11 == 0
+}
+
+func Test_book_identifiers(t *testing.T) {
+	t.Parallel()
+
+	r := testRand(t)
+
+	validators := map[string]struct {
+		pattern string
+		valid   func(string) bool
+	}{
+		"isbn13": {`^(978\d{10}|9798\d{9}|9791[012]\d{8})$`, gs1Valid},
+		"isbn10": {`^\d{9}[\dX]$`, mod11Valid},
+		"issn":   {`^\d{4}-\d{3}[\dX]$`, func(code string) bool { return mod11Valid(strings.ReplaceAll(code, "-", "")) }},
+		"doi":    {`^10\.\d{4}/(j\.[a-z]{4}\.\d{4}\.\d{2}\.\d{3}|s\d{5}-\d{3}-\d{5}-\d)$`, func(string) bool { return true }},
+	}
+
+	for name, validator := range validators {
+		info := gofakeit.GetFuncLookup(name)
+
+		require.NotNil(t, info, name)
+
+		for range 100 {
+			val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+			require.NoError(t, err)
+			require.Regexp(t, validator.pattern, val, name)
+			require.True(t, validator.valid(val.(string)), val) //nolint:forcetypeassert
+		}
+	}
+
+	require.True(t, mod11Valid("0306406152"))
+	require.True(t, mod11Valid("03178471"))
+}
@@ -0,0 +1,82 @@
+package faker
+
+import (
+	"slices"
+
+	"github.com/grafana/sobek"
+)
+
+// iter returns an iterator of the successive values of the seeded stream of the instance,
+// so the values can be consumed by for...of loops and iterator utilities without materializing arrays.
+//
+// The source of the values is a generator function name (the rest of parameters passed to the function),
+// a function (called with the index of the value) or a schema object (like the fromSchema method).
+// The iterator never ends on its own, the consumer stops it by breaking the loop or calling the return method.
+func (f *faker) iter(call sobek.FunctionCall) sobek.Value {
+	const method = "iter"
+
+	// the arguments are copied, because the runtime reuses the slice of the call
+	args := slices.Clone(call.Arguments[min(1, len(call.Arguments)):])
+	next := f.iterSource(method, call.Argument(0), sobek.FunctionCall{Arguments: args})
+
+	var (
+		index int
+		done  bool
+	)
+
+	result := func(val sobek.Value) *sobek.Object {
+		obj := f.runtime.NewObject()
+
+		_ = obj.Set("value", val)
+		_ = obj.Set("done", done)
+
+		return obj
+	}
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("next", func() *sobek.Object {
+		if done {
+			return result(sobek.Undefined())
+		}
+
+		val := next(index)
+		index++
+
+		return result(val)
+	})
+
+	_ = obj.Set("return", func(val sobek.Value) *sobek.Object {
+		done = true
+
+		return result(val)
+	})
+
+	_ = obj.SetSymbol(sobek.SymIterator, func(call sobek.FunctionCall) sobek.Value { return call.This })
+
+	return obj
+}
+
+// iterSource returns the function producing the value of the given index from the source of the iterator.
+func (f *faker) iterSource(method string, source sobek.Value, call sobek.FunctionCall) func(index int) sobek.Value {
+	if fun, isFunction := sobek.AssertFunction(source); isFunction {
+		return func(index int) sobek.Value {
+			val, err := fun(sobek.Undefined(), f.runtime.ToValue(index))
+			if err != nil {
+				panic(err)
+			}
+
+			return val
+		}
+	}
+
+	if obj, isObject := source.(*sobek.Object); isObject {
+		compiled := f.compileSchema(method, obj)
+
+		return func(int) sobek.Value { return f.generate(compiled) }
+	}
+
+	name, info := f.lookup(method, source)
+
+	return func(int) sobek.Value { return f.invoke(name, info, call) }
+}
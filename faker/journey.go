@@ -0,0 +1,156 @@
+package faker
+
+import (
+	"math"
+	"slices"
+
+	"github.com/grafana/sobek"
+)
+
+// defaultJourneySteps is the default maximum number of states of a walk.
+const defaultJourneySteps = 100
+
+// journeyState is a state of the journey state machine and its weighted outgoing transitions.
+// A state without transitions is a terminal state.
+type journeyState struct {
+	targets []string
+	weights []float64
+}
+
+// journey returns a sampler walking the weighted state machine of a user journey,
+// e.g. browse, search, add to cart and checkout steps of a shop visitor.
+//
+// The transitions option maps the states to the weights of their target states, the states option lists
+// the states (by default the states appearing in the transitions), the start option is the initial state
+// (by default the first state). The next() method of the returned sampler returns the next state,
+// the walk restarts at the start state after a terminal state (a state without transitions).
+// The walk() method returns a complete journey from the start state to a terminal state.
+func (f *faker) journey(options sobek.Value) *sobek.Object {
+	const method = "journey"
+
+	opts := f.objectArgument(method, "options", options)
+	states, order := f.journeyStates(method, opts)
+	start := order[0]
+
+	if val := opts.Get("start"); val != nil && !sobek.IsUndefined(val) {
+		if start = val.String(); states[start] == nil {
+			f.throw(&ArgumentError{Function: method, Parameter: "start", Expected: "state", Reason: "unknown state " + start})
+		}
+	}
+
+	step := func(from string) string {
+		state := states[from]
+		if len(state.targets) == 0 {
+			return start
+		}
+
+		f.rescope()
+
+		return state.targets[weightedIndex(f.rand, state.weights)]
+	}
+
+	var current string
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("states", order)
+	_ = obj.Set("next", func() string {
+		if len(current) == 0 {
+			current = start
+		} else {
+			current = step(current)
+		}
+
+		return current
+	})
+	_ = obj.Set("walk", func(maxSteps sobek.Value) []string {
+		limit := defaultJourneySteps
+
+		if maxSteps != nil && !sobek.IsUndefined(maxSteps) {
+			if limit = int(maxSteps.ToInteger()); limit <= 0 {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "maxSteps", Expected: "positive number",
+					Reason: "invalid value " + maxSteps.String(),
+				})
+			}
+		}
+
+		path := []string{start}
+
+		for len(path) < limit && len(states[path[len(path)-1]].targets) != 0 {
+			path = append(path, step(path[len(path)-1]))
+		}
+
+		return path
+	})
+	_ = obj.Set("reset", func() { current = "" })
+
+	return obj
+}
+
+// journeyStates returns the states of the journey options by name and the names in declaration order.
+func (f *faker) journeyStates(method string, opts *sobek.Object) (map[string]*journeyState, []string) {
+	transitions := f.objectArgument(method, "transitions", opts.Get("transitions"))
+
+	var order []string
+
+	if val := opts.Get("states"); val != nil && !sobek.IsUndefined(val) {
+		for _, item := range f.arrayArgument(method, "states", val) {
+			if !slices.Contains(order, item.String()) {
+				order = append(order, item.String())
+			}
+		}
+	} else {
+		for _, from := range transitions.Keys() {
+			targets := f.objectArgument(method, "transitions", transitions.Get(from))
+
+			for _, name := range append([]string{from}, targets.Keys()...) {
+				if !slices.Contains(order, name) {
+					order = append(order, name)
+				}
+			}
+		}
+	}
+
+	if len(order) == 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "states", Expected: "array of states", Reason: "no states"})
+	}
+
+	states := make(map[string]*journeyState, len(order))
+
+	for _, name := range order {
+		states[name] = new(journeyState)
+	}
+
+	for _, from := range transitions.Keys() {
+		state, found := states[from]
+		if !found {
+			f.throw(&ArgumentError{Function: method, Parameter: "transitions", Expected: "state", Reason: "unknown state " + from})
+		}
+
+		targets := f.objectArgument(method, "transitions", transitions.Get(from))
+
+		for _, to := range targets.Keys() {
+			if states[to] == nil {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "transitions", Expected: "state", Reason: "unknown state " + to,
+				})
+			}
+
+			weight := targets.Get(to).ToFloat()
+			if !(weight >= 0) || math.IsInf(weight, 0) {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "transitions", Expected: "non-negative weight",
+					Reason: "invalid weight of " + from + " to " + to,
+				})
+			}
+
+			if weight > 0 {
+				state.targets = append(state.targets, to)
+				state.weights = append(state.weights, weight)
+			}
+		}
+	}
+
+	return states, order
+}
@@ -0,0 +1,266 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errNoContainers = errors.New("pod must have at least one container")
+
+func init() {
+	gofakeit.AddFuncLookup("k8sresourcename", gofakeit.Info{
+		Display:     "Resource Name",
+		Category:    "k8s",
+		Description: "DNS-1123 compliant Kubernetes resource name with random suffix",
+		Example:     "checkout-api-x7k2p",
+		Output:      "string",
+		Params:      nil,
+		Generate:    k8sresourcename,
+	})
+
+	gofakeit.AddFuncLookup("k8slabelset", gofakeit.Info{
+		Display:     "Label Set",
+		Category:    "k8s",
+		Description: "Kubernetes labels with valid keys and values, using the recommended app.kubernetes.io labels",
+		Example:     `{"app.kubernetes.io/name": "checkout-api", "app.kubernetes.io/version": "1.4.2", "tier": "backend"}`,
+		Output:      "map[string]string",
+		Params: []gofakeit.Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "3", Description: "Number of labels"},
+		},
+		Generate: k8slabelset,
+	})
+
+	gofakeit.AddFuncLookup("k8snamespace", gofakeit.Info{
+		Display:     "Namespace",
+		Category:    "k8s",
+		Description: "DNS-1123 compliant Kubernetes namespace name of a team and environment",
+		Example:     "payments-staging",
+		Output:      "string",
+		Params:      nil,
+		Generate:    k8snamespace,
+	})
+
+	gofakeit.AddFuncLookup("k8spodmanifest", gofakeit.Info{
+		Display:  "Pod Manifest",
+		Category: "k8s",
+		Description: "Minimal valid Kubernetes Pod manifest, its JSON serialization is accepted " +
+			"by the Kubernetes API and kubectl (JSON is valid YAML)",
+		Example: `{
+	"apiVersion": "v1",
+	"kind": "Pod",
+	"metadata": {
+		"name": "checkout-api-x7k2p",
+		"namespace": "payments-staging",
+		"labels": {"app.kubernetes.io/name": "checkout-api"}
+	},
+	"spec": {
+		"containers": [{
+			"name": "nginx",
+			"image": "nginx:1.25",
+			"ports": [{"containerPort": 8080, "protocol": "TCP"}],
+			"resources": {"requests": {"cpu": "100m", "memory": "128Mi"}, "limits": {"cpu": "500m", "memory": "512Mi"}}
+		}]
+	}
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "containers", Display: "Containers", Type: "int", Default: "1", Description: "Number of containers"},
+		},
+		Generate: k8spodmanifest,
+	})
+}
+
+// k8sApps contains typical workload names.
+var k8sApps = []string{ //nolint:gochecknoglobals
+	"api-gateway", "auth-service", "cart", "checkout-api", "catalog", "frontend", "inventory", "ledger",
+	"notification-worker", "orders", "payments", "recommendation", "search", "session-store", "shipping",
+	"user-profile",
+}
+
+// k8sImages contains container images of common workloads.
+var k8sImages = []string{ //nolint:gochecknoglobals
+	"nginx:1.25", "redis:7.2-alpine", "postgres:16", "busybox:1.36", "envoyproxy/envoy:v1.29.1",
+	"grafana/alloy:v1.1.0", "ghcr.io/example/app:v2.3.1", "registry.k8s.io/pause:3.9",
+}
+
+// k8sTeams and k8sEnvs contain the parts of namespace names.
+var (
+	k8sTeams = []string{ //nolint:gochecknoglobals
+		"billing", "data", "identity", "ml", "observability", "payments", "platform", "search", "storefront",
+	}
+	k8sEnvs = []string{"dev", "qa", "staging", "prod"} //nolint:gochecknoglobals
+)
+
+// k8sLabels contains the label keys and the function generating their values.
+var k8sLabels = []struct { //nolint:gochecknoglobals
+	key   string
+	value func(r *rand.Rand) string
+}{
+	{"app.kubernetes.io/name", func(r *rand.Rand) string { return k8sApps[r.Intn(len(k8sApps))] }},
+	{"app.kubernetes.io/instance", func(r *rand.Rand) string { return newResourceName(r) }},
+	{"app.kubernetes.io/version", func(r *rand.Rand) string { return (&gofakeit.Faker{Rand: r}).AppVersion() }},
+	{"app.kubernetes.io/component", pickOf("server", "database", "cache", "worker", "proxy")},
+	{"app.kubernetes.io/part-of", pickOf("webshop", "backoffice", "analytics", "platform")},
+	{"app.kubernetes.io/managed-by", pickOf("helm", "kustomize", "argocd", "flux")},
+	{"tier", pickOf("frontend", "backend", "data")},
+	{"environment", func(r *rand.Rand) string { return k8sEnvs[r.Intn(len(k8sEnvs))] }},
+	{"team", func(r *rand.Rand) string { return k8sTeams[r.Intn(len(k8sTeams))] }},
+	{"release", pickOf("stable", "canary")},
+}
+
+// pickOf returns a function which returns a random value of the values.
+func pickOf(values ...string) func(r *rand.Rand) string {
+	return func(r *rand.Rand) string {
+		return values[r.Intn(len(values))]
+	}
+}
+
+func k8sresourcename(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return newResourceName(r), nil
+}
+
+// newResourceName returns a workload name with a random suffix, like the names of generated pods.
+func newResourceName(r *rand.Rand) string {
+	// alphabet of the random suffixes generated by Kubernetes (no vowels and confusing characters)
+	const (
+		alphabet     = "bcdfghjklmnpqrstvwxz2456789"
+		suffixLength = 5
+	)
+
+	suffix := make([]byte, suffixLength)
+
+	for idx := range suffix {
+		suffix[idx] = alphabet[r.Intn(len(alphabet))]
+	}
+
+	return k8sApps[r.Intn(len(k8sApps))] + "-" + string(suffix)
+}
+
+func k8slabelset(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	count, err := info.GetInt(m, "count")
+	if err != nil {
+		return nil, err
+	}
+
+	return newLabelSet(r, count)
+}
+
+// newLabelSet returns count labels (at most the number of known label keys), the name label is always included.
+func newLabelSet(r *rand.Rand, count int) (map[string]string, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeCount, count)
+	}
+
+	count = min(count, len(k8sLabels))
+	labels := make(map[string]string, count)
+
+	if count == 0 {
+		return labels, nil
+	}
+
+	labels[k8sLabels[0].key] = k8sLabels[0].value(r)
+
+	for _, idx := range r.Perm(len(k8sLabels) - 1)[:count-1] {
+		label := k8sLabels[idx+1]
+		labels[label.key] = label.value(r)
+	}
+
+	return labels, nil
+}
+
+func k8snamespace(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return newNamespace(r), nil
+}
+
+func newNamespace(r *rand.Rand) string {
+	return k8sTeams[r.Intn(len(k8sTeams))] + "-" + k8sEnvs[r.Intn(len(k8sEnvs))]
+}
+
+func k8spodmanifest(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		labelCount = 3
+		minPort    = 1024
+		maxPort    = 9999
+	)
+
+	count, err := info.GetInt(m, "containers")
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("%w: %d", errNoContainers, count)
+	}
+
+	if err := checkCount(count); err != nil {
+		return nil, err
+	}
+
+	labels, _ := newLabelSet(r, labelCount)
+	containers := make([]object, count)
+	names := make(map[string]bool, count)
+
+	for idx := range containers {
+		image := k8sImages[r.Intn(len(k8sImages))]
+
+		// container names must be unique DNS-1123 labels
+		name := dns1123Label(image[strings.LastIndex(image, "/")+1 : strings.LastIndex(image, ":")])
+		for names[name] {
+			name += "-" + string(alphanum[r.Intn(len(alphanum))])
+		}
+
+		names[name] = true
+
+		containers[idx] = object{
+			{"name", name},
+			{"image", image},
+			{"ports", []object{
+				{{"containerPort", minPort + r.Intn(maxPort-minPort)}, {"protocol", "TCP"}},
+			}},
+			{"resources", object{
+				{"requests", object{{"cpu", pickOf("50m", "100m", "250m")(r)}, {"memory", pickOf("64Mi", "128Mi", "256Mi")(r)}}},
+				{"limits", object{{"cpu", pickOf("500m", "1", "2")(r)}, {"memory", pickOf("512Mi", "1Gi", "2Gi")(r)}}},
+			}},
+		}
+	}
+
+	return object{
+		{"apiVersion", "v1"},
+		{"kind", "Pod"},
+		{"metadata", object{
+			{"name", newResourceName(r)},
+			{"namespace", newNamespace(r)},
+			{"labels", labels},
+		}},
+		{"spec", object{
+			{"containers", containers},
+		}},
+	}, nil
+}
+
+// alphanum contains the lower case alphanumeric characters allowed in DNS-1123 labels.
+const alphanum = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// dns1123Label converts the text to DNS-1123 label: at most 63 lower case alphanumeric characters or '-',
+// starting and ending with an alphanumeric character.
+func dns1123Label(text string) string {
+	const maxLength = 63
+
+	label := strings.Map(func(chr rune) rune {
+		if strings.ContainsRune(alphanum, chr) {
+			return chr
+		}
+
+		return '-'
+	}, strings.ToLower(text))
+
+	if len(label) > maxLength {
+		label = label[:maxLength]
+	}
+
+	return strings.Trim(label, "-")
+}
@@ -0,0 +1,117 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+// dns1123Label is the format of DNS-1123 labels used for most Kubernetes resource names.
+var dns1123Label = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+func Test_k8sresourcename(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("k8sresourcename")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, dns1123Label, val)
+	}
+}
+
+func Test_k8slabelset(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("k8slabelset")
+
+	require.NotNil(t, info)
+
+	labelValue := regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]{0,61})?[A-Za-z0-9]$`)
+
+	params := gofakeit.NewMapParams()
+	params.Add("count", "5")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+	require.IsType(t, map[string]string{}, val)
+
+	labels := val.(map[string]string) //nolint:forcetypeassert
+
+	require.Len(t, labels, 5)
+	require.Contains(t, labels, "app.kubernetes.io/name")
+
+	for _, value := range labels {
+		require.Regexp(t, labelValue, value)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("count", "-1")
+
+	_, err = info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "negative count: -1")
+}
+
+func Test_k8spodmanifest(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("k8spodmanifest")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("containers", "20")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+
+	pod := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "v1", pod["apiVersion"])
+	require.Equal(t, "Pod", pod["kind"])
+
+	metadata := pod["metadata"].(map[string]any) //nolint:forcetypeassert
+
+	require.Regexp(t, dns1123Label, metadata["name"])
+	require.Regexp(t, dns1123Label, metadata["namespace"])
+
+	containers := pod["spec"].(map[string]any)["containers"].([]map[string]any) //nolint:forcetypeassert
+
+	require.Len(t, containers, 20)
+
+	names := make(map[any]bool)
+
+	for _, container := range containers {
+		require.Regexp(t, dns1123Label, container["name"])
+		require.NotContains(t, names, container["name"])
+		require.NotEmpty(t, container["image"])
+
+		names[container["name"]] = true
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("containers", "0")
+
+	_, err = info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "pod must have at least one container: 0")
+
+	params = gofakeit.NewMapParams()
+	params.Add("containers", "100001")
+
+	_, err = info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "too many items: 100001")
+}
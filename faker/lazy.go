@@ -0,0 +1,112 @@
+package faker
+
+import (
+	"slices"
+
+	"github.com/grafana/sobek"
+)
+
+// lazy returns an object with the fields of the schema (like fromSchema), but the field values
+// are generated on first access and then cached, so only the used fields cost generation time.
+// Nested schemas are lazy objects too. Since the values are generated in the order of access,
+// the same seed produces the same values only if the fields are accessed in the same order.
+func (f *faker) lazy(schema sobek.Value) *sobek.Object {
+	return f.runtime.NewDynamicObject(newLazyObject(f, f.compileSchema("lazy", schema)))
+}
+
+// lazyObject is the JavaScript object of the lazy method.
+type lazyObject struct {
+	faker  *faker
+	fields []*schemaField
+	values map[string]sobek.Value
+}
+
+func newLazyObject(faker *faker, compiled *schema) *lazyObject {
+	return &lazyObject{
+		faker:  faker,
+		fields: slices.Clone(compiled.fields),
+		values: make(map[string]sobek.Value, len(compiled.fields)),
+	}
+}
+
+// field returns the field of the schema by name.
+func (o *lazyObject) field(key string) (*schemaField, bool) {
+	idx := slices.IndexFunc(o.fields, func(field *schemaField) bool { return field.name == key })
+	if idx < 0 {
+		return nil, false
+	}
+
+	return o.fields[idx], true
+}
+
+// Get implements sobek.DynamicObject.
+// The value of the field is generated on first access.
+func (o *lazyObject) Get(key string) sobek.Value {
+	if val, found := o.values[key]; found {
+		return val
+	}
+
+	field, found := o.field(key)
+	if !found {
+		return sobek.Undefined()
+	}
+
+	// the missing fields are removed on first access
+	if o.faker.chance(field.missing) {
+		o.Delete(key)
+
+		return sobek.Undefined()
+	}
+
+	var val sobek.Value
+
+	if field.nested != nil {
+		val = o.faker.runtime.NewDynamicObject(newLazyObject(o.faker, field.nested))
+	} else {
+		val = o.faker.generateField(field)
+	}
+
+	o.values[key] = val
+
+	return val
+}
+
+// Set implements sobek.DynamicObject.
+// The assigned value overrides the generated one, new properties are added after the fields of the schema.
+func (o *lazyObject) Set(key string, val sobek.Value) bool {
+	if _, found := o.field(key); !found {
+		o.fields = append(o.fields, &schemaField{name: key})
+	}
+
+	o.values[key] = val
+
+	return true
+}
+
+// Has implements sobek.DynamicObject.
+func (o *lazyObject) Has(key string) bool {
+	_, found := o.field(key)
+
+	return found
+}
+
+// Delete implements sobek.DynamicObject.
+func (o *lazyObject) Delete(key string) bool {
+	o.fields = slices.DeleteFunc(o.fields, func(field *schemaField) bool { return field.name == key })
+
+	delete(o.values, key)
+
+	return true
+}
+
+// Keys implements sobek.DynamicObject.
+// The fields are enumerated in the order of the schema, without generating their values.
+func (o *lazyObject) Keys() []string {
+	keys := make([]string, len(o.fields))
+
+	for idx, field := range o.fields {
+		keys[idx] = field.name
+	}
+
+	return keys
+}
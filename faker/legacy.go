@@ -0,0 +1,147 @@
+package faker
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"github.com/iancoleman/strcase"
+	"go.k6.io/k6/v2/js/modules"
+)
+
+// NewLegacyForVU returns an object exposing the flat API of the legacy releases (e.g. faker.Uuid(),
+// faker.FirstName()) bound to the given k6 VU (which can be nil).
+// The legacy methods are mapped onto the generator functions of a Faker instance created with the seed,
+// the first call of each method logs a warning naming its replacement.
+func NewLegacyForVU(seed int64, runtime *sobek.Runtime, vu modules.VU) *sobek.Object {
+	return runtime.NewDynamicObject(&legacy{faker: newFakerForVU(seed, runtime, vu)})
+}
+
+// legacy is the object exposing the legacy flat API.
+type legacy struct {
+	faker *faker
+}
+
+// legacyImageSize is the maximum width and height of the images generated by the legacy image methods.
+const legacyImageSize = 4096
+
+// legacyMethods contains the legacy methods without generator function (the binary image generators).
+//
+//nolint:gochecknoglobals
+var legacyMethods = map[string]func(f *faker, width int64, height int64) []byte{
+	"ImageJpeg": func(f *faker, width int64, height int64) []byte {
+		return (&gofakeit.Faker{Rand: f.rand}).ImageJpeg(int(width), int(height))
+	},
+	"ImagePng": func(f *faker, width int64, height int64) []byte {
+		return (&gofakeit.Faker{Rand: f.rand}).ImagePng(int(width), int(height))
+	},
+}
+
+// legacyNames returns the generator function names by their lowercase form, so the legacy method names
+// match regardless of the case of the abbreviations (e.g. Uuid and UUID, HttpMethod and HTTPMethod).
+//
+//nolint:gochecknoglobals
+var legacyNames = sync.OnceValue(func() map[string]string {
+	names := make(map[string]string)
+
+	for name := range GetFuncLookups() {
+		names[strings.ToLower(name)] = name
+	}
+
+	return names
+})
+
+// Delete implements sobek.DynamicObject.
+func (l *legacy) Delete(_ string) bool {
+	return false
+}
+
+// Get implements sobek.DynamicObject.
+// The legacy method names are the generator function names starting with uppercase letter.
+func (l *legacy) Get(key string) sobek.Value {
+	f := l.faker
+
+	if val, found := f.members["legacy."+key]; found {
+		return val
+	}
+
+	var val sobek.Value
+
+	if method, found := legacyMethods[key]; found {
+		val = f.runtime.ToValue(func(width sobek.Value, height sobek.Value) sobek.ArrayBuffer {
+			l.warnLegacy(key, "")
+
+			w, h := width.ToInteger(), height.ToInteger()
+			if w < 1 || h < 1 || w > legacyImageSize || h > legacyImageSize {
+				f.throw(&ArgumentError{
+					Function: key, Parameter: "width", Expected: "width and height between 1 and 4096",
+					Reason: "invalid value " + width.String() + "x" + height.String(),
+				})
+			}
+
+			return f.runtime.NewArrayBuffer(method(f, w, h))
+		})
+	} else {
+		name, found := legacyNames()[strings.ToLower(key)]
+		if !found || len(key) == 0 || strings.ToUpper(key[:1]) != key[:1] {
+			return sobek.Undefined()
+		}
+
+		info, _ := lookupFunc(name)
+		replacement := info.Category + "." + name + "()"
+
+		val = f.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
+			l.warnLegacy(key, replacement)
+
+			return f.invoke(name, info, call)
+		})
+	}
+
+	f.members["legacy."+key] = val
+
+	return val
+}
+
+// warnLegacy emits a one-time migration warning for the legacy method.
+// The replacement is the method of the Faker class to be used instead, empty if there is none.
+func (l *legacy) warnLegacy(key string, replacement string) {
+	if len(replacement) == 0 {
+		l.faker.warnOnce("legacy."+key, "faker: %s of k6/x/faker/legacy is deprecated without replacement", key)
+
+		return
+	}
+
+	l.faker.warnOnce("legacy."+key,
+		"faker: %s of k6/x/faker/legacy is deprecated, use %s of k6/x/faker instead", key, replacement,
+	)
+}
+
+// Has implements sobek.DynamicObject.
+func (l *legacy) Has(key string) bool {
+	return !sobek.IsUndefined(l.Get(key))
+}
+
+// Keys implements sobek.DynamicObject.
+// The legacy methods are enumerated in alphabetical order.
+func (l *legacy) Keys() []string {
+	keys := make([]string, 0, len(legacyNames())+len(legacyMethods))
+
+	for _, name := range legacyNames() {
+		keys = append(keys, strcase.ToCamel(name))
+	}
+
+	for name := range legacyMethods {
+		keys = append(keys, name)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Set implements sobek.DynamicObject.
+func (l *legacy) Set(_ string, _ sobek.Value) bool {
+	return false
+}
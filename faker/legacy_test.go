@@ -0,0 +1,60 @@
+package faker_test
+
+import (
+	"testing"
+
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Legacy(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("faker", faker.NewLegacyForVU(11, vm, nil)))
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const checks = {
+	  uuid: faker.Uuid() === new Faker(11).strings.uuid(),
+	  abbreviation: typeof faker.UUID() === "string" && typeof faker.HttpMethod() === "string",
+	  params: faker.IntRange(5, 5) === 5,
+	  deprecated: faker.CreditCardCvv().length === 3,
+	  jpeg: new Uint8Array(faker.ImageJpeg(8, 8)).subarray(0, 2).join() === "255,216",
+	  png: new Uint8Array(faker.ImagePng(8, 8)).subarray(1, 4).join() === "80,78,71",
+	  keys: Object.keys(faker).includes("FirstName") && Object.keys(faker).includes("ImagePng"),
+	  unknown: faker.firstName === undefined && faker.Nope === undefined && !("Nope" in faker),
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`faker.ImageJpeg(0, 10)`)
+
+	require.ErrorContains(t, err, "width and height between 1 and 4096")
+}
+
+func Test_Legacy_sameEngine(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("faker", faker.New(11, vm)))
+	require.NoError(t, vm.Set("compat", faker.NewCompatForVU(11, vm, nil)))
+	require.NoError(t, vm.Set("legacy", faker.NewLegacyForVU(11, vm, nil)))
+
+	val, err := vm.RunString(`
+	const expected = [faker.person.firstName(), faker.strings.uuid(), faker.call("email")]
+	const actual = [legacy.FirstName(), legacy.Uuid(), legacy.Email()]
+
+	JSON.stringify(expected) === JSON.stringify(actual) && compat.person.firstName() === expected[0]
+	`)
+
+	require.NoError(t, err)
+	require.True(t, val.ToBoolean())
+}
@@ -2,6 +2,7 @@ package faker
 
 import (
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -21,6 +22,26 @@ func getCategoryNames() []string {
 	return _categoryNames
 }
 
+// Deprecation describes a deprecated function name.
+type Deprecation struct {
+	// Replacement is the name of the function to be used instead.
+	Replacement string
+	// Since is the release in which the function name was deprecated.
+	Since string
+	// Removal is the release in which the function name will be removed.
+	Removal string
+}
+
+// GetDeprecations returns the deprecated function names.
+func GetDeprecations() map[string]Deprecation {
+	return deprecations
+}
+
+// GetCategoryDeprecations returns the deprecated category names.
+func GetCategoryDeprecations() map[string]Deprecation {
+	return categoryDeprecations
+}
+
 // GetCategoryFuncs returns fake functions by category.
 func GetCategoryFuncs() map[string]map[string]*gofakeit.Info {
 	requireFuncLookups()
@@ -36,14 +57,41 @@ func lookupCategory(name string) (map[string]*gofakeit.Info, bool) {
 	return funcs, ok
 }
 
+// lookupFunc returns the function by name.
+// The name can be qualified by the category name (e.g. "person.firstName"), deprecated function
+// and category names are resolved to their replacements.
 func lookupFunc(name string) (*gofakeit.Info, bool) {
 	requireFuncLookups()
 
-	fun, ok := _funcLookups[name]
+	if cname, fname, qualified := strings.Cut(name, "."); qualified {
+		fun, ok := _categoryFuncs[resolveCategory(cname)][resolveFunc(fname)]
+
+		return fun, ok
+	}
+
+	fun, ok := _funcLookups[resolveFunc(name)]
 
 	return fun, ok
 }
 
+// resolveFunc returns the name of the replacement function if the function name is deprecated.
+func resolveFunc(name string) string {
+	if deprecation, deprecated := deprecations[name]; deprecated {
+		return deprecation.Replacement
+	}
+
+	return name
+}
+
+// resolveCategory returns the name of the replacement category if the category name is deprecated.
+func resolveCategory(name string) string {
+	if deprecation, deprecated := categoryDeprecations[name]; deprecated {
+		return deprecation.Replacement
+	}
+
+	return name
+}
+
 //nolint:gochecknoglobals
 var (
 	convertLookupsOnce sync.Once
@@ -92,6 +140,18 @@ var (
 		"creditCardCvv": "creditCardCVV",
 	}
 
+	// deprecations contains the deprecated function names of the released versions, which are still available
+	// until the removal release, but emit a warning on use.
+	deprecations = map[string]Deprecation{
+		// released before funcRename fixed the abbreviation
+		"creditCardCvv": {Replacement: "creditCardCVV", Since: "v0.5.0", Removal: "v1.0.0"},
+	}
+
+	// categoryDeprecations contains the deprecated category names of the released versions, the functions
+	// of the replacement category are available under them until the removal release, but emit a warning on use.
+	// No released category name is deprecated yet (the categories renamed by categoryRename were never available).
+	categoryDeprecations = map[string]Deprecation{}
+
 	categoryRename = map[string]string{
 		"auth":   "internet",
 		"image":  "internet",
@@ -12,7 +12,7 @@ func TestGetFuncLookups(t *testing.T) {
 
 	funcs := faker.GetFuncLookups()
 
-	require.Len(t, funcs, 303)
+	require.Len(t, funcs, 417)
 	require.Contains(t, funcs, "intRange")
 	require.Contains(t, funcs, "randomString")
 }
@@ -22,7 +22,7 @@ func TestGetCategoryFuncs(t *testing.T) {
 
 	categories := faker.GetCategoryFuncs()
 
-	require.Len(t, categories, 29)
+	require.Len(t, categories, 38)
 	require.Contains(t, categories, "zen")
 	require.Contains(t, categories, "numbers")
 
@@ -30,3 +30,17 @@ func TestGetCategoryFuncs(t *testing.T) {
 	require.Contains(t, categories["numbers"], "intRange")
 	require.Same(t, categories["zen"]["intRange"], categories["numbers"]["intRange"])
 }
+
+func TestGetPIIClasses(t *testing.T) {
+	t.Parallel()
+
+	funcs := faker.GetFuncLookups()
+
+	for name, class := range faker.GetPIIClasses() {
+		require.Contains(t, funcs, name)
+		require.NotEmpty(t, class)
+	}
+
+	require.Equal(t, "email", faker.GetPIIClasses()["email"])
+	require.NotContains(t, faker.GetPIIClasses(), "intRange")
+}
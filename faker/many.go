@@ -0,0 +1,123 @@
+package faker
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+// maxCount is the maximum number of values generated by one bulk call.
+// The generated values are held in memory at once, so larger counts are rejected instead of exhausting the memory.
+const maxCount = 10_000_000
+
+// many invokes faker function by name multiple times and returns the generated values as an array.
+// The faker function name is the first parameter, the count is the second one,
+// the rest of parameters passed to function.
+func (f *faker) many(call sobek.FunctionCall) sobek.Value {
+	name, info := f.lookup("many", call.Argument(0))
+
+	call.Arguments = call.Arguments[1:]
+
+	return f.invokeMany(name, info, call)
+}
+
+// invokeMany calls the generator function as many times as the first parameter specifies.
+// The parameters of the generator function are converted only once.
+// If the parallel option is set, the values are generated on multiple goroutines (see generateParallel).
+func (f *faker) invokeMany(name string, info *gofakeit.Info, call sobek.FunctionCall) sobek.Value {
+	f.checkDeprecated(name)
+	f.rescope()
+
+	count := f.countArgument(name, info.Category, "count", call.Argument(0), 0)
+
+	call.Arguments = call.Arguments[1:]
+
+	budget := f.budget(func(reason string) {
+		f.throw(&ArgumentError{Function: name, Category: info.Category, Parameter: "count", Reason: reason})
+	})
+
+	budget.batch(count)
+
+	start := time.Now()
+	params := f.toMapParams(name, info, call)
+	values := make([]any, count)
+	generate := f.generator(name, info)
+
+	if f.opts.parallel > 0 && f.replay == nil {
+		var idx int
+
+		err := f.generateParallel(generate, params, info, len(values), func(val any) {
+			values[idx] = f.applyDatasets(name, val)
+
+			f.record(name, call.Arguments, values[idx])
+
+			budget.add(values[idx])
+
+			idx++
+		})
+		if err != nil {
+			f.throw(f.argumentError(name, info, nil, err.Error()))
+		}
+
+		result := f.toValue(values)
+
+		f.measure(name, start, len(values))
+
+		return result
+	}
+
+	for idx := range values {
+		if val, replayed := f.replayed(name); replayed {
+			values[idx] = val
+
+			budget.add(val)
+
+			continue
+		}
+
+		val, err := generate(f.rand, params, info)
+		if err != nil {
+			f.throw(f.argumentError(name, info, nil, err.Error()))
+		}
+
+		values[idx] = f.applyDatasets(name, f.output(val))
+
+		f.record(name, call.Arguments, values[idx])
+
+		budget.add(values[idx])
+	}
+
+	result := f.toValue(values)
+
+	f.measure(name, start, len(values))
+
+	return result
+}
+
+// countArgument returns the count parameter of a bulk generation method.
+// It throws FakerArgumentError if the value is not an integer between low and maxCount.
+func (f *faker) countArgument(function, category, parameter string, value sobek.Value, low int) int {
+	expected := "integer between " + strconv.Itoa(low) + " and " + strconv.Itoa(maxCount)
+
+	if value == nil || sobek.IsUndefined(value) {
+		f.throw(&ArgumentError{
+			Function: function, Category: category, Parameter: parameter, Expected: expected, Reason: "missing parameter",
+		})
+	}
+
+	num := value.ToFloat()
+	if math.IsNaN(num) || num != math.Trunc(num) || num < float64(low) || num > maxCount {
+		f.throw(&ArgumentError{
+			Function:  function,
+			Category:  category,
+			Parameter: parameter,
+			Expected:  expected,
+			Reason:    "invalid value " + value.String(),
+		})
+	}
+
+	return int(num)
+}
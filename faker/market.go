@@ -0,0 +1,322 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidPrice      = errors.New("price must be positive")
+	errInvalidVolatility = errors.New("volatility must not be negative")
+	errInvalidDepth      = errors.New("depth must be positive")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("ohlccandle", gofakeit.Info{
+		Display:     "OHLC Candle",
+		Category:    "finance",
+		Description: "One minute OHLC candle of a random walk from the base price, with consistent high, low and volume",
+		Example: `{
+	"timestamp": "2024-03-15T10:20:00Z",
+	"open": 100,
+	"high": 101.37,
+	"low": 99.42,
+	"close": 100.88,
+	"volume": 48213
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "basePrice",
+				Display:     "Base Price",
+				Type:        "float",
+				Default:     "100",
+				Description: "Open price of the candle",
+			},
+			{
+				Field:       "volatility",
+				Display:     "Volatility",
+				Type:        "float",
+				Default:     "0.01",
+				Description: "Standard deviation of the log return of the candle period (e.g. 0.01 for 1%)",
+			},
+		},
+		Generate: ohlccandle,
+	})
+
+	gofakeit.AddFuncLookup("tickerquote", gofakeit.Info{
+		Display:     "Ticker Quote",
+		Category:    "finance",
+		Description: "Stock quote with last trade, bid/ask spread, day range and change from the previous close",
+		Example: `{
+	"symbol": "AAPL",
+	"last": 191.23,
+	"bid": 191.22,
+	"ask": 191.25,
+	"bidSize": 300,
+	"askSize": 1200,
+	"open": 189.7,
+	"high": 191.8,
+	"low": 189.12,
+	"previousClose": 190,
+	"change": 1.23,
+	"changePercent": 0.65,
+	"volume": 5132764,
+	"timestamp": "2024-03-15T10:20:00Z"
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "symbol",
+				Display:     "Symbol",
+				Type:        "string",
+				Default:     "any",
+				Description: "Ticker symbol (e.g. MSFT), any for a random well-known stock",
+			},
+		},
+		Generate: tickerquote,
+	})
+
+	gofakeit.AddFuncLookup("orderbooksnapshot", gofakeit.Info{
+		Display:     "Order Book Snapshot",
+		Category:    "finance",
+		Description: "Level 2 order book of a stock with bids in descending and asks in ascending price order",
+		Example: `{
+	"symbol": "MSFT",
+	"timestamp": "2024-03-15T10:20:00Z",
+	"bids": [{"price": 419.98, "size": 300}, {"price": 419.96, "size": 1100}],
+	"asks": [{"price": 420.01, "size": 500}, {"price": 420.04, "size": 800}]
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "depth", Display: "Depth", Type: "int", Default: "5", Description: "Number of price levels per side"},
+		},
+		Generate: orderbooksnapshot,
+	})
+
+	gofakeit.AddFuncLookup("amount", gofakeit.Info{
+		Display:     "Amount",
+		Category:    "finance",
+		Description: "Monetary amount between min and max, rounded to cents",
+		Example:     "254.37",
+		Output:      "float64",
+		Params: []gofakeit.Param{
+			{Field: "min", Display: "Min", Type: "float", Default: "0", Description: "Minimum amount"},
+			{Field: "max", Display: "Max", Type: "float", Default: "1000", Description: "Maximum amount"},
+		},
+		Generate: amount,
+	})
+}
+
+// tickerPrices contains well-known stock symbols and their typical prices.
+//
+//nolint:gochecknoglobals,mnd
+var tickerPrices = map[string]float64{
+	"AAPL": 190, "MSFT": 420, "AMZN": 180, "GOOGL": 170, "NVDA": 120, "TSLA": 180, "META": 500,
+	"JPM": 200, "V": 275, "KO": 62, "NFLX": 620, "DIS": 100, "INTC": 30, "AMD": 160,
+	"BA": 180, "IBM": 180, "ORCL": 125, "PEP": 170, "WMT": 65, "XOM": 115,
+}
+
+// tickerSymbols contains the sorted well-known stock symbols.
+var tickerSymbols = cloudTypes(tickerPrices)[1:] //nolint:gochecknoglobals
+
+const (
+	// tickSize is the minimum price increment of stocks.
+	tickSize = 0.01
+	// lotSize is the number of shares of a round lot.
+	lotSize = 100
+)
+
+// tickerOf returns the symbol and its typical price, a random well-known stock if the symbol is "any".
+// Unknown symbols get a random typical price.
+func tickerOf(r *rand.Rand, symbol string) (string, float64) {
+	const (
+		minPrice  = 5
+		priceSpan = 495
+	)
+
+	if strings.EqualFold(symbol, "any") {
+		symbol = tickerSymbols[r.Intn(len(tickerSymbols))]
+	}
+
+	symbol = strings.ToUpper(symbol)
+
+	price, known := tickerPrices[symbol]
+	if !known {
+		price = roundCents(minPrice + r.Float64()*priceSpan)
+	}
+
+	return symbol, price
+}
+
+// marketTime returns the current time truncated to minutes in RFC3339 format.
+func marketTime() string {
+	return time.Now().UTC().Truncate(time.Minute).Format(time.RFC3339)
+}
+
+func ohlccandle(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		ticks      = 60
+		baseVolume = 10_000
+	)
+
+	basePrice, err := info.GetFloat64(m, "basePrice")
+	if err != nil {
+		return nil, err
+	}
+
+	volatility, err := info.GetFloat64(m, "volatility")
+	if err != nil {
+		return nil, err
+	}
+
+	if basePrice <= 0 {
+		return nil, fmt.Errorf("%w: %g", errInvalidPrice, basePrice)
+	}
+
+	if volatility < 0 {
+		return nil, fmt.Errorf("%w: %g", errInvalidVolatility, volatility)
+	}
+
+	step := volatility / math.Sqrt(ticks)
+	price, high, low := basePrice, basePrice, basePrice
+
+	for range ticks {
+		price *= math.Exp(step * r.NormFloat64())
+		high, low = max(high, price), min(low, price)
+	}
+
+	// larger moves come with larger volume
+	activity := 1.0
+	if volatility > 0 {
+		activity += math.Abs(math.Log(price/basePrice)) / volatility
+	}
+
+	return object{
+		{"timestamp", marketTime()},
+		{"open", roundCents(basePrice)},
+		{"high", roundCents(high)},
+		{"low", roundCents(low)},
+		{"close", roundCents(price)},
+		{"volume", int(baseVolume * activity * (0.5 + r.Float64()))},
+	}, nil
+}
+
+func tickerquote(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		dailyVolatility    = 0.02
+		intradayVolatility = 0.005
+		maxSpreadTicks     = 3
+		maxLots            = 50
+		minVolume          = 100_000
+		volumeSpan         = 20_000_000
+		percent            = 100
+	)
+
+	symbol, err := info.GetString(m, "symbol")
+	if err != nil {
+		return nil, err
+	}
+
+	symbol, previousClose := tickerOf(r, symbol)
+
+	open := roundCents(previousClose * math.Exp(intradayVolatility*r.NormFloat64()))
+	last := roundCents(max(previousClose*math.Exp(dailyVolatility*r.NormFloat64()), maxSpreadTicks*tickSize))
+	high := roundCents(max(open, last) * (1 + math.Abs(intradayVolatility*r.NormFloat64())))
+	low := roundCents(min(open, last) * (1 - math.Abs(intradayVolatility*r.NormFloat64())))
+	change := roundCents(last - previousClose)
+
+	return object{
+		{"symbol", symbol},
+		{"last", last},
+		{"bid", roundCents(last - float64(r.Intn(maxSpreadTicks))*tickSize)},
+		{"ask", roundCents(last + float64(1+r.Intn(maxSpreadTicks))*tickSize)},
+		{"bidSize", lotSize * (1 + r.Intn(maxLots))},
+		{"askSize", lotSize * (1 + r.Intn(maxLots))},
+		{"open", open},
+		{"high", high},
+		{"low", low},
+		{"previousClose", previousClose},
+		{"change", change},
+		{"changePercent", roundCents(change / previousClose * percent)},
+		{"volume", minVolume + r.Intn(volumeSpan)},
+		{"timestamp", marketTime()},
+	}, nil
+}
+
+func orderbooksnapshot(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		volatility   = 0.01
+		maxLevelGap  = 3
+		maxLevelLots = 20
+	)
+
+	depth, err := info.GetInt(m, "depth")
+	if err != nil {
+		return nil, err
+	}
+
+	if depth < 1 {
+		return nil, fmt.Errorf("%w: %d", errInvalidDepth, depth)
+	}
+
+	if err := checkCount(depth); err != nil {
+		return nil, err
+	}
+
+	symbol, price := tickerOf(r, "any")
+	mid := roundCents(price * math.Exp(volatility*r.NormFloat64()))
+
+	// levels returns the price levels of one side, sign is -1 for bids and 1 for asks
+	levels := func(sign float64) []object {
+		side := make([]object, depth)
+		level := mid
+
+		if sign > 0 {
+			level += tickSize
+		}
+
+		for idx := range side {
+			// deeper levels have larger resting size
+			side[idx] = object{
+				{"price", roundCents(level)},
+				{"size", lotSize * (1 + r.Intn(maxLevelLots)) * (1 + idx/2)},
+			}
+
+			level += sign * float64(1+r.Intn(maxLevelGap)) * tickSize
+		}
+
+		return side
+	}
+
+	return object{
+		{"symbol", symbol},
+		{"timestamp", marketTime()},
+		{"bids", levels(-1)},
+		{"asks", levels(1)},
+	}, nil
+}
+
+func amount(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	low, err := info.GetFloat64(m, "min")
+	if err != nil {
+		return nil, err
+	}
+
+	high, err := info.GetFloat64(m, "max")
+	if err != nil {
+		return nil, err
+	}
+
+	if low > high {
+		return nil, fmt.Errorf("%w: min %g is greater than max %g", errInvalidRange, low, high)
+	}
+
+	return roundCents(low + r.Float64()*(high-low)), nil
+}
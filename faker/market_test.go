@@ -0,0 +1,137 @@
+package faker_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ohlccandle(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("ohlccandle")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("basePrice", "250")
+	params.Add("volatility", "0.05")
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		candle, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+		require.InDelta(t, 250.0, candle["open"], 0)
+		require.GreaterOrEqual(t, candle["high"], candle["open"])
+		require.GreaterOrEqual(t, candle["high"], candle["close"])
+		require.LessOrEqual(t, candle["low"], candle["open"])
+		require.LessOrEqual(t, candle["low"], candle["close"])
+		require.Positive(t, candle["volume"])
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("basePrice", "0")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "price must be positive")
+
+	params = gofakeit.NewMapParams()
+	params.Add("volatility", "-1")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "volatility must not be negative")
+}
+
+func Test_tickerquote(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("tickerquote")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for symbol, expected := range map[string]string{"any": "", "msft": "MSFT", "ACME": "ACME"} {
+		params := gofakeit.NewMapParams()
+		params.Add("symbol", symbol)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		quote, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		if len(expected) != 0 {
+			require.Equal(t, expected, quote["symbol"])
+		}
+
+		require.LessOrEqual(t, quote["bid"], quote["last"])
+		require.Greater(t, quote["ask"], quote["last"])
+		require.GreaterOrEqual(t, quote["high"], quote["last"])
+		require.LessOrEqual(t, quote["low"], quote["last"])
+		require.InDelta(t, quote["last"].(float64)-quote["previousClose"].(float64), quote["change"], 0.005) //nolint:forcetypeassert
+	}
+}
+
+func Test_orderbooksnapshot(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("orderbooksnapshot")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("depth", "10")
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+
+	book, ok := faker.PlainMaps(val).(map[string]any)
+
+	require.True(t, ok)
+
+	bids, ok := book["bids"].([]map[string]any)
+
+	require.True(t, ok)
+
+	asks, ok := book["asks"].([]map[string]any)
+
+	require.True(t, ok)
+	require.Len(t, bids, 10)
+	require.Len(t, asks, 10)
+	require.Less(t, bids[0]["price"], asks[0]["price"])
+
+	for idx := 1; idx < 10; idx++ {
+		require.Less(t, bids[idx]["price"], bids[idx-1]["price"])
+		require.Greater(t, asks[idx]["price"], asks[idx-1]["price"])
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("depth", "0")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "depth must be positive")
+
+	params = gofakeit.NewMapParams()
+	params.Add("depth", "100001")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "too many items: 100001")
+}
@@ -0,0 +1,308 @@
+package faker
+
+import (
+	"embed"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/js/common"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+//go:embed corpus/*.txt
+var corpora embed.FS
+
+// Defaults and limits of the markov method.
+const (
+	defaultMarkovWords = 50
+	defaultMarkovOrder = 2
+	maxMarkovOrder     = 3
+)
+
+// markovChain is a word level Markov chain trained from a corpus.
+// The chain is immutable after training, so it is shared between the instances (and VUs).
+type markovChain struct {
+	order int
+	// next contains the words following the prefix (order words joined by space),
+	// repeated words represent the transition probabilities.
+	next map[string][]string
+	// starts contains the prefixes starting a sentence.
+	starts [][]string
+}
+
+// builtinCorpora contains the names of the built-in corpora.
+var builtinCorpora = []string{"news", "reviews"} //nolint:gochecknoglobals
+
+// chains contains the trained chains by corpus identity (built-in corpus name or absolute file path) and order.
+var chains sync.Map //nolint:gochecknoglobals
+
+// trainMarkov returns the chain of the given order trained from the corpus text.
+// If the corpus contains less words than the order plus one, nil is returned.
+func trainMarkov(text string, order int) *markovChain {
+	words := strings.Fields(text)
+	if len(words) <= order {
+		return nil
+	}
+
+	chain := &markovChain{order: order, next: make(map[string][]string)}
+
+	for idx := 0; idx+order < len(words); idx++ {
+		prefix := words[idx : idx+order]
+
+		if idx == 0 || isSentenceEnd(words[idx-1]) {
+			chain.starts = append(chain.starts, prefix)
+		}
+
+		key := strings.Join(prefix, " ")
+		chain.next[key] = append(chain.next[key], words[idx+order])
+	}
+
+	if len(chain.starts) == 0 {
+		chain.starts = append(chain.starts, words[:order])
+	}
+
+	return chain
+}
+
+// isSentenceEnd reports whether the word ends a sentence.
+func isSentenceEnd(word string) bool {
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?")
+}
+
+// generate returns text of the given number of words. A new sentence is started
+// when the chain reaches the end of the corpus, the text always ends with a full stop.
+func (c *markovChain) generate(f *faker, count int) string {
+	words := make([]string, 0, count)
+	prefix := []string{}
+
+	for len(words) < count {
+		successors := c.next[strings.Join(prefix, " ")]
+
+		if len(prefix) < c.order || len(successors) == 0 {
+			start := c.starts[f.rand.Intn(len(c.starts))]
+
+			if len(words) != 0 && !isSentenceEnd(words[len(words)-1]) {
+				words[len(words)-1] = strings.TrimRightFunc(words[len(words)-1], unicode.IsPunct) + "."
+			}
+
+			words = append(words, start[:min(len(start), count-len(words))]...)
+			prefix = slices.Clone(start)
+
+			continue
+		}
+
+		word := successors[f.rand.Intn(len(successors))]
+
+		words = append(words, word)
+		prefix = append(prefix[1:], word)
+	}
+
+	last := strings.TrimRightFunc(words[len(words)-1], unicode.IsPunct)
+	if isSentenceEnd(words[len(words)-1]) {
+		last = words[len(words)-1]
+	} else {
+		last += "."
+	}
+
+	words[len(words)-1] = last
+
+	return strings.Join(words, " ")
+}
+
+// markov generates text statistically similar to a corpus using a word level Markov chain.
+//
+// The corpus is one of the built-in corpora (builtin option, news or reviews), the text of the corpus option
+// or the file of the corpusPath option. The file is loaded in the init context, the chain trained from it
+// is shared between the VUs. The words option is the number of words (50 by default),
+// the order option is the number of words the next word depends on (2 by default).
+func (f *faker) markov(options sobek.Value) string {
+	const method = "markov"
+
+	var opts *sobek.Object
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts = f.objectArgument(method, "options", options)
+	} else {
+		opts = f.runtime.NewObject()
+	}
+
+	words := f.markovInt(opts, "words", defaultMarkovWords)
+	if words < 0 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "words", Expected: "non-negative number", Reason: "invalid value",
+		})
+	}
+
+	order := f.markovInt(opts, "order", defaultMarkovOrder)
+	if order < 1 || order > maxMarkovOrder {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "order", Expected: "number between 1 and 3", Reason: "invalid value",
+		})
+	}
+
+	chain := f.markovChain(opts, order)
+
+	if words == 0 {
+		return ""
+	}
+
+	f.rescope()
+
+	return chain.generate(f, words)
+}
+
+// markovInt returns the integer option or the default value if the option is missing.
+func (f *faker) markovInt(opts *sobek.Object, name string, def int) int {
+	val := opts.Get(name)
+	if val == nil || sobek.IsUndefined(val) {
+		return def
+	}
+
+	num, ok := integerOf(val.Export())
+	if !ok {
+		f.throw(&ArgumentError{
+			Function: "markov", Parameter: name, Expected: "number", Reason: "invalid value " + val.String(),
+		})
+	}
+
+	return int(num)
+}
+
+// markovChain returns the chain of the corpus selected by the options.
+func (f *faker) markovChain(opts *sobek.Object, order int) *markovChain {
+	const method = "markov"
+
+	var sources []string
+
+	for _, name := range []string{"builtin", "corpus", "corpusPath"} {
+		if val := opts.Get(name); val != nil && !sobek.IsUndefined(val) {
+			sources = append(sources, name)
+		}
+	}
+
+	if len(sources) > 1 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: sources[1], Expected: "one of builtin, corpus or corpusPath",
+			Reason: "conflicting options " + strings.Join(sources, ", "),
+		})
+	}
+
+	source := "builtin"
+	value := "news"
+
+	if len(sources) != 0 {
+		source = sources[0]
+		value = opts.Get(source).String()
+	}
+
+	switch source {
+	case "builtin":
+		return f.cachedChain(method, source, "builtin:"+value, order, func() (string, string) {
+			if !slices.Contains(builtinCorpora, value) {
+				return "", "unknown corpus " + value
+			}
+
+			data, _ := corpora.ReadFile("corpus/" + value + ".txt")
+
+			return string(data), ""
+		})
+	case "corpus":
+		return f.trainedChain(method, source, value, order)
+	default:
+		key, load := f.corpusFile(value)
+
+		return f.cachedChain(method, source, key, order, load)
+	}
+}
+
+// trainedChain trains a chain from the corpus text, throws FakerArgumentError if the corpus is too short.
+func (f *faker) trainedChain(method string, source string, text string, order int) *markovChain {
+	chain := trainMarkov(text, order)
+	if chain == nil {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: source, Expected: "text", Reason: "corpus too short for the order",
+		})
+	}
+
+	return chain
+}
+
+// cachedChain returns the chain of the corpus identified by the key from the cache, or trains and caches it.
+func (f *faker) cachedChain(
+	method string,
+	source string,
+	key string,
+	order int,
+	load func() (string, string),
+) *markovChain {
+	key += "#" + strconv.Itoa(order)
+
+	if chain, found := chains.Load(key); found {
+		return chain.(*markovChain) //nolint:forcetypeassert
+	}
+
+	text, reason := load()
+	if len(reason) != 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: source, Expected: "corpus", Reason: reason})
+	}
+
+	chain, _ := chains.LoadOrStore(key, f.trainedChain(method, source, text, order))
+
+	return chain.(*markovChain) //nolint:forcetypeassert
+}
+
+// corpusFile returns the cache key and the loader of the corpus file. The path is resolved relative to
+// the script in the init context, the resolved path is remembered, so the chains trained in the init context
+// are also available in the VU context.
+func (f *faker) corpusFile(path string) (string, func() (string, string)) {
+	var env *common.InitEnvironment
+
+	if f.vu != nil {
+		env = f.vu.InitEnv()
+	}
+
+	if env == nil {
+		key, found := f.corpusKeys[path]
+		if !found {
+			key = "file:" + path
+		}
+
+		return key, func() (string, string) { return "", "the corpus file can only be loaded in the init context" }
+	}
+
+	if len(path) == 0 {
+		return "file:", func() (string, string) { return "", "empty path" }
+	}
+
+	abs := env.GetAbsFilePath(path)
+	key := "file:" + abs
+
+	if f.corpusKeys == nil {
+		f.corpusKeys = make(map[string]string)
+	}
+
+	f.corpusKeys[path] = key
+
+	return key, func() (string, string) {
+		fs, found := env.FileSystems["file"]
+		if !found {
+			return "", "no file system available"
+		}
+
+		data, err := fsext.ReadFile(fs, abs)
+		if err != nil {
+			return "", err.Error()
+		}
+
+		if !utf8.Valid(data) {
+			return "", "the corpus file is not valid UTF-8 text"
+		}
+
+		return string(data), ""
+	}
+}
@@ -0,0 +1,288 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownGenerator = errors.New("unknown generator function")
+
+func init() {
+	gofakeit.AddFuncLookup("cloudevent", gofakeit.Info{
+		Display:     "Cloud Event",
+		Category:    "messaging",
+		Description: "CloudEvents 1.0 event in structured JSON format with fake data of the event type",
+		Example: `{
+	"specversion": "1.0",
+	"id": "0d5e8a8c-51f4-4d3a-9d6e-3c2f0b9a1e47",
+	"source": "/order-service",
+	"type": "com.centralmarkets.order.created",
+	"time": "2024-03-15T10:20:00Z",
+	"datacontenttype": "application/json",
+	"data": {"id": "ORD-48213377", "status": "pending", "total": 106.97}
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "type",
+				Display:     "Type",
+				Type:        "string",
+				Default:     "any",
+				Description: "Event type (e.g. com.example.order.created), any for a random business event",
+			},
+			{
+				Field:       "source",
+				Display:     "Source",
+				Type:        "string",
+				Default:     "any",
+				Description: "URI reference of the event producer, any for the service of the event type",
+			},
+			{
+				Field:       "data",
+				Display:     "Data",
+				Type:        "string",
+				Default:     "any",
+				Description: "Name of the generator function of the event data (e.g. person), any for the one of the event type",
+			},
+		},
+		Generate: unsafeOf(cloudevent),
+	})
+
+	gofakeit.AddFuncLookup("kafkarecord", gofakeit.Info{
+		Display:     "Kafka Record",
+		Category:    "messaging",
+		Description: "Kafka record with topic, partition, offset, timestamp, headers, key and fake value",
+		Example: `{
+	"topic": "order-events",
+	"partition": 3,
+	"offset": 1843027,
+	"timestamp": 1710498000000,
+	"headers": {"content-type": "application/json", "event-type": "order.created", "traceparent": "00-4bf92f..."},
+	"key": "0d5e8a8c-51f4-4d3a-9d6e-3c2f0b9a1e47",
+	"value": {"id": "ORD-48213377", "status": "pending", "total": 106.97}
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "keySchema",
+				Display:     "Key Schema",
+				Type:        "string",
+				Default:     "uuid",
+				Description: "Name of the generator function of the record key",
+			},
+			{
+				Field:       "valueSchema",
+				Display:     "Value Schema",
+				Type:        "string",
+				Default:     "any",
+				Description: "Name of the generator function of the record value, any for a random business event",
+			},
+		},
+		Generate: unsafeOf(kafkarecord),
+	})
+
+	gofakeit.AddFuncLookup("amqpmessage", gofakeit.Info{
+		Display:     "AMQP Message",
+		Category:    "messaging",
+		Description: "AMQP 0-9-1 message with exchange, routing key, properties and fake JSON body",
+		Example: `{
+	"exchange": "order.events",
+	"routingKey": "order.created",
+	"properties": {"contentType": "application/json", "deliveryMode": 2, "timestamp": 1710498000},
+	"body": {"id": "ORD-48213377", "status": "pending", "total": 106.97}
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "body",
+				Display:     "Body",
+				Type:        "string",
+				Default:     "any",
+				Description: "Name of the generator function of the message body, any for a random business event",
+			},
+		},
+		Generate: unsafeOf(amqpmessage),
+	})
+}
+
+// messageEvent is a business event: the entity, the action and the generator function of the event data.
+type messageEvent struct {
+	entity string
+	action string
+	data   string
+}
+
+// messageEvents contains typical business events of the generators.
+var messageEvents = []messageEvent{ //nolint:gochecknoglobals
+	{"order", "created", "commerce.order"},
+	{"order", "cancelled", "commerce.order"},
+	{"cart", "updated", "commerce.cartItems"},
+	{"customer", "registered", "person"},
+	{"product", "updated", "product"},
+	{"patient", "admitted", "health.patient"},
+	{"organization", "created", "company.organization"},
+}
+
+// newMessageEvent returns a random business event. Unless the generator function of the data is "any",
+// it is a created event of the entity named after the function.
+func newMessageEvent(r *rand.Rand, data string) messageEvent {
+	event := messageEvents[r.Intn(len(messageEvents))]
+
+	if !strings.EqualFold(data, "any") {
+		event.data, event.action = data, "created"
+
+		if idx := strings.LastIndexByte(data, '.'); idx >= 0 {
+			data = data[idx+1:]
+		}
+
+		event.entity = strings.ToLower(data)
+	}
+
+	return event
+}
+
+// generateWith calls the generator function of the name with default parameters,
+// the safe replacement in safe mode.
+func generateWith(r *rand.Rand, name string, safe bool) (any, error) {
+	info, found := lookupFunc(name)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownGenerator, name)
+	}
+
+	return generatorOf(safe, name, info)(r, nil, info)
+}
+
+// traceparent returns a W3C Trace Context traceparent header value.
+func traceparent(r *rand.Rand) string {
+	const (
+		traceIDLength = 32
+		spanIDLength  = 16
+	)
+
+	return "00-" + hexOf(r, traceIDLength) + "-" + hexOf(r, spanIDLength) + "-01"
+}
+
+func cloudevent(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	typ, err := info.GetString(m, "type")
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := info.GetString(m, "source")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := info.GetString(m, "data")
+	if err != nil {
+		return nil, err
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+	event := newMessageEvent(r, data)
+
+	if strings.EqualFold(typ, "any") {
+		typ = "com." + domainOf(fake.Company()) + "." + event.entity + "." + event.action
+	}
+
+	if strings.EqualFold(source, "any") {
+		source = "/" + event.entity + "-service"
+	}
+
+	payload, err := generateWith(r, event.data, safe)
+	if err != nil {
+		return nil, err
+	}
+
+	return object{
+		{"specversion", "1.0"},
+		{"id", fake.UUID()},
+		{"source", source},
+		{"type", typ},
+		{"time", time.Now().UTC().Format(time.RFC3339)},
+		{"datacontenttype", "application/json"},
+		{"data", payload},
+	}, nil
+}
+
+func kafkarecord(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	const (
+		partitions = 12
+		maxOffset  = 10_000_000
+	)
+
+	keySchema, err := info.GetString(m, "keySchema")
+	if err != nil {
+		return nil, err
+	}
+
+	valueSchema, err := info.GetString(m, "valueSchema")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := generateWith(r, keySchema, safe)
+	if err != nil {
+		return nil, err
+	}
+
+	event := newMessageEvent(r, valueSchema)
+
+	value, err := generateWith(r, event.data, safe)
+	if err != nil {
+		return nil, err
+	}
+
+	return object{
+		{"topic", event.entity + "-events"},
+		{"partition", r.Intn(partitions)},
+		{"offset", r.Int63n(maxOffset)},
+		{"timestamp", time.Now().UnixMilli()},
+		{"headers", object{
+			{"content-type", "application/json"},
+			{"event-type", event.entity + "." + event.action},
+			{"traceparent", traceparent(r)},
+		}},
+		{"key", key},
+		{"value", value},
+	}, nil
+}
+
+func amqpmessage(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	const persistent = 2
+
+	data, err := info.GetString(m, "body")
+	if err != nil {
+		return nil, err
+	}
+
+	event := newMessageEvent(r, data)
+
+	body, err := generateWith(r, event.data, safe)
+	if err != nil {
+		return nil, err
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	return object{
+		{"exchange", event.entity + ".events"},
+		{"routingKey", event.entity + "." + event.action},
+		{"properties", object{
+			{"contentType", "application/json"},
+			{"deliveryMode", persistent},
+			{"messageId", fake.UUID()},
+			{"correlationId", fake.UUID()},
+			{"timestamp", time.Now().Unix()},
+			{"type", event.entity + "." + event.action},
+			{"appId", event.entity + "-service"},
+			{"headers", object{{"traceparent", traceparent(r)}}},
+		}},
+		{"body", body},
+	}, nil
+}
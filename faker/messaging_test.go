@@ -0,0 +1,115 @@
+package faker_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cloudevent(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("cloudevent")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+
+	event := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "1.0", event["specversion"])
+	require.Regexp(t, regexp.MustCompile(`^com\.[a-z0-9]+\.[a-z]+\.[a-z]+$`), event["type"])
+	require.True(t, strings.HasPrefix(event["source"].(string), "/")) //nolint:forcetypeassert
+	require.NotNil(t, event["data"])
+
+	params := gofakeit.NewMapParams()
+	params.Add("type", "com.example.user.signup")
+	params.Add("source", "https://example.com/users")
+	params.Add("data", "person.email")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+
+	event = faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "com.example.user.signup", event["type"])
+	require.Equal(t, "https://example.com/users", event["source"])
+	require.Contains(t, event["data"], "@")
+
+	params = gofakeit.NewMapParams()
+	params.Add("data", "noSuchFunction")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown generator function")
+}
+
+func Test_kafkarecord(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("kafkarecord")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+
+	record := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Regexp(t, regexp.MustCompile(`^[0-9a-f-]{36}$`), record["key"])
+	require.True(t, strings.HasSuffix(record["topic"].(string), "-events")) //nolint:forcetypeassert
+	require.NotNil(t, record["value"])
+
+	headers := record["headers"].(map[string]any) //nolint:forcetypeassert
+
+	require.Regexp(t, regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-01$`), headers["traceparent"])
+
+	params := gofakeit.NewMapParams()
+	params.Add("keySchema", "username")
+	params.Add("valueSchema", "commerce.order")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+
+	record = faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "order-events", record["topic"])
+	require.IsType(t, "", record["key"])
+	require.Contains(t, record["value"], "trackingNumber")
+}
+
+func Test_amqpmessage(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("amqpmessage")
+
+	require.NotNil(t, info)
+
+	val, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+
+	message := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.True(t, strings.HasSuffix(message["exchange"].(string), ".events")) //nolint:forcetypeassert
+	require.NotEmpty(t, message["routingKey"])
+	require.NotNil(t, message["body"])
+
+	properties := message["properties"].(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "application/json", properties["contentType"])
+	require.Equal(t, 2, properties["deliveryMode"])
+}
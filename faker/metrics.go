@@ -0,0 +1,87 @@
+package faker
+
+import (
+	"time"
+
+	"go.k6.io/k6/v2/js/modules"
+	"go.k6.io/k6/v2/metrics"
+)
+
+// Names of the data generation metrics.
+const (
+	callsMetricName = "faker_calls"
+	timeMetricName  = "faker_generation_time"
+)
+
+// fakerMetrics contains the k6 metrics of data generation, enabled by the metrics constructor option.
+type fakerMetrics struct {
+	calls *metrics.Metric
+	time  *metrics.Metric
+	err   error
+}
+
+// registerMetrics registers the data generation metrics in the metrics registry of the VU.
+// The metrics can only be registered in the init context, nil is returned outside of it.
+// The registration is idempotent, the registry returns the already registered metrics.
+func registerMetrics(vu modules.VU) *fakerMetrics {
+	if vu == nil || vu.InitEnv() == nil || vu.InitEnv().Registry == nil {
+		return nil
+	}
+
+	registry := vu.InitEnv().Registry
+	mtr := new(fakerMetrics)
+
+	if mtr.calls, mtr.err = registry.NewMetric(callsMetricName, metrics.Counter); mtr.err != nil {
+		return mtr
+	}
+
+	mtr.time, mtr.err = registry.NewMetric(timeMetricName, metrics.Trend, metrics.Time)
+
+	return mtr
+}
+
+// enableMetrics enables the data generation metrics of the instance.
+func (f *faker) enableMetrics(mtr *fakerMetrics) {
+	if mtr == nil {
+		panic(f.runtime.NewTypeError("the metrics option can only be used in k6 scripts"))
+	}
+
+	if mtr.err != nil {
+		panic(f.runtime.NewTypeError(mtr.err.Error()))
+	}
+
+	f.metrics = mtr
+}
+
+// measure emits the data generation metrics of count calls of the generator function started at start.
+// The samples are tagged with the function name and the current tags of the VU.
+// Nothing is emitted if the metrics are disabled or outside of the VU context.
+func (f *faker) measure(name string, start time.Time, count int) {
+	if f.metrics == nil || f.vu == nil || f.vu.State() == nil {
+		return
+	}
+
+	now := time.Now()
+	state := f.vu.State()
+	ctm := state.Tags.GetCurrentValues()
+	tags := ctm.Tags.With("function", name)
+
+	metrics.PushIfNotDone(f.vu.Context(), state.Samples, metrics.ConnectedSamples{
+		Samples: []metrics.Sample{
+			{
+				TimeSeries: metrics.TimeSeries{Metric: f.metrics.calls, Tags: tags},
+				Time:       now,
+				Value:      float64(count),
+				Metadata:   ctm.Metadata,
+			},
+			{
+				TimeSeries: metrics.TimeSeries{Metric: f.metrics.time, Tags: tags},
+				Time:       now,
+				Value:      metrics.D(now.Sub(start)),
+				Metadata:   ctm.Metadata,
+			},
+		},
+		Tags: tags,
+		Time: now,
+	})
+}
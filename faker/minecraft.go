@@ -0,0 +1,226 @@
+package faker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errInvalidSlots = errors.New("inventory slots must be between 1 and 54")
+
+// maxSlots is the number of slots of a large chest, the largest inventory.
+const maxSlots = 54
+
+// minecraftItem is an item kind of the inventories.
+type minecraftItem struct {
+	id       string
+	maxStack int
+	// durability is the number of uses of the damageable items, zero for the others.
+	durability int
+}
+
+// minecraftItems contains the items of the inventories, tools, weapons and armor are added on init.
+//
+//nolint:gochecknoglobals,mnd
+var minecraftItems = []minecraftItem{
+	{"coal", 64, 0}, {"raw_iron", 64, 0}, {"raw_copper", 64, 0}, {"raw_gold", 64, 0}, {"iron_ingot", 64, 0},
+	{"gold_ingot", 64, 0}, {"redstone", 64, 0}, {"lapis_lazuli", 64, 0}, {"diamond", 64, 0}, {"emerald", 64, 0},
+	{"cobblestone", 64, 0}, {"dirt", 64, 0}, {"torch", 64, 0}, {"stick", 64, 0}, {"arrow", 64, 0},
+	{"apple", 64, 0}, {"bread", 64, 0}, {"baked_potato", 64, 0}, {"cooked_beef", 64, 0}, {"golden_carrot", 64, 0},
+	{"ender_pearl", 16, 0}, {"snowball", 16, 0}, {"egg", 16, 0},
+	{"bow", 1, 384}, {"shield", 1, 336}, {"trident", 1, 250}, {"fishing_rod", 1, 64}, {"flint_and_steel", 1, 64},
+}
+
+// minecraftTiers contains the materials of the tools and armor with the durability of the tools
+// and the durability factor of the armor, zero if there is no item of that kind.
+//
+//nolint:gochecknoglobals,mnd
+var minecraftTiers = []struct {
+	name  string
+	tools int
+	armor int
+}{
+	{"wooden", 59, 0}, {"leather", 0, 5}, {"chainmail", 0, 15}, {"stone", 131, 0}, {"iron", 250, 15},
+	{"golden", 32, 7}, {"diamond", 1561, 33}, {"netherite", 2031, 37},
+}
+
+// minecraftVersions contains the game versions of the servers with their protocol numbers.
+//
+//nolint:gochecknoglobals,mnd
+var minecraftVersions = []struct {
+	name     string
+	protocol int
+}{
+	{"1.8.9", 47}, {"1.12.2", 340}, {"1.16.5", 754}, {"1.18.2", 758}, {"1.19.4", 762},
+	{"1.20.1", 763}, {"1.20.4", 765}, {"1.20.6", 766}, {"1.21.1", 767}, {"1.21.4", 769},
+}
+
+func init() {
+	// the tools and weapons of the tiers with their durability
+	for _, tier := range minecraftTiers {
+		for _, tool := range []string{"sword", "pickaxe", "axe", "shovel", "hoe"} {
+			if tier.tools > 0 {
+				minecraftItems = append(minecraftItems, minecraftItem{tier.name + "_" + tool, 1, tier.tools})
+			}
+		}
+	}
+
+	// the durability of the armor pieces is the durability factor of the material times the base of the piece
+	for _, tier := range minecraftTiers {
+		for _, part := range []minecraftItem{{"helmet", 1, 11}, {"chestplate", 1, 16}, {"leggings", 1, 15}, {"boots", 1, 13}} { //nolint:mnd
+			if tier.armor > 0 {
+				minecraftItems = append(minecraftItems, minecraftItem{tier.name + "_" + part.id, 1, tier.armor * part.durability})
+			}
+		}
+	}
+
+	gofakeit.AddFuncLookup("inventory", gofakeit.Info{
+		Display:     "Inventory",
+		Category:    "minecraft",
+		Description: "Occupied slots of a Minecraft inventory with namespaced item identifiers, stack sizes and tool damage",
+		Example: `[
+	{"slot": 0, "id": "minecraft:diamond_pickaxe", "count": 1, "damage": 312},
+	{"slot": 1, "id": "minecraft:torch", "count": 48},
+	{"slot": 4, "id": "minecraft:ender_pearl", "count": 7}
+]`,
+		Output: "[]map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "slots", Display: "Slots", Type: "int", Default: "36", Description: "Number of slots of the inventory, 36 for the player, 27 or 54 for chests"},
+		},
+		Generate: inventory,
+	})
+
+	gofakeit.AddFuncLookup("chunkcoordinates", gofakeit.Info{
+		Display:     "Chunk Coordinates",
+		Category:    "minecraft",
+		Description: "Block position in a Minecraft dimension with the coordinates of its chunk, chunk section and region file",
+		Example: `{
+	"dimension": "minecraft:overworld",
+	"x": -1234, "y": 64, "z": 5678,
+	"chunkX": -78, "chunkZ": 354, "sectionY": 4,
+	"regionX": -3, "regionZ": 11,
+	"regionFile": "r.-3.11.mca"
+}`,
+		Output:   "map[string]any",
+		Generate: chunkcoordinates,
+	})
+
+	gofakeit.AddFuncLookup("serverstatusjson", gofakeit.Info{
+		Display:     "Server Status JSON",
+		Category:    "minecraft",
+		Description: "Status response of a Minecraft server to the server list ping, in JSON format",
+		Example:     `{"version":{"name":"1.20.4","protocol":765},"players":{"max":100,"online":2,"sample":[{"name":"VioletPuma","id":"b7e04c1a-5f2d-4e3b-9a8c-1d2e3f4a5b6c"},{"name":"SneakyOtter","id":"1c4a9e2b-3d5f-4a6b-8c7d-9e0f1a2b3c4d"}]},"description":{"text":"Crimson Dragons SMP"},"enforcesSecureChat":true}`,
+		Output:      "string",
+		Generate:    serverstatusjson,
+	})
+}
+
+func inventory(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const fill = 0.7
+
+	slots, err := info.GetInt(m, "slots")
+	if err != nil {
+		return nil, err
+	}
+
+	if slots < 1 || slots > maxSlots {
+		return nil, fmt.Errorf("%w: %d", errInvalidSlots, slots)
+	}
+
+	items := make([]object, 0, slots)
+
+	for slot := range slots {
+		if r.Float64() >= fill {
+			continue
+		}
+
+		item := minecraftItems[r.Intn(len(minecraftItems))]
+		entry := object{
+			{"slot", slot},
+			{"id", "minecraft:" + item.id},
+			{"count", 1 + r.Intn(item.maxStack)},
+		}
+
+		if item.durability > 0 {
+			entry = append(entry, property{"damage", r.Intn(item.durability)})
+		}
+
+		items = append(items, entry)
+	}
+
+	return items, nil
+}
+
+func chunkcoordinates(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	// the dimensions with their build height limits, the horizontal range is the explored area around the spawn
+	dimensions := []struct {
+		name   string
+		minY   int
+		maxY   int
+		radius int
+	}{
+		{"minecraft:overworld", -64, 319, 10000},
+		{"minecraft:the_nether", 0, 127, 1250},
+		{"minecraft:the_end", 0, 255, 2000},
+	}
+
+	dim := dimensions[r.Intn(len(dimensions))]
+	x, z := r.Intn(2*dim.radius+1)-dim.radius, r.Intn(2*dim.radius+1)-dim.radius
+	y := dim.minY + r.Intn(dim.maxY-dim.minY+1)
+
+	// chunks are 16x16 columns of blocks, sections are 16 blocks high, regions are 32x32 chunks
+	chunkX, chunkZ := x>>4, z>>4             //nolint:mnd
+	regionX, regionZ := chunkX>>5, chunkZ>>5 //nolint:mnd
+
+	return object{
+		{"dimension", dim.name},
+		{"x", x},
+		{"y", y},
+		{"z", z},
+		{"chunkX", chunkX},
+		{"chunkZ", chunkZ},
+		{"sectionY", y >> 4}, //nolint:mnd
+		{"regionX", regionX},
+		{"regionZ", regionZ},
+		{"regionFile", fmt.Sprintf("r.%d.%d.mca", regionX, regionZ)},
+	}, nil
+}
+
+func serverstatusjson(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		maxSample  = 12
+		nameLength = 16
+	)
+
+	fake := &gofakeit.Faker{Rand: r}
+	version := minecraftVersions[r.Intn(len(minecraftVersions))]
+	capacities := []int{20, 50, 100, 200, 500}
+	capacity := capacities[r.Intn(len(capacities))]
+	online := r.Intn(capacity + 1)
+	sample := make([]object, min(online, maxSample))
+
+	for idx := range sample {
+		// player names are 3 to 16 characters long
+		name := strings.ReplaceAll(fake.Gamertag(), " ", "_")
+
+		sample[idx] = object{{"name", name[:min(len(name), nameLength)]}, {"id", fake.UUID()}}
+	}
+
+	motd := pickOf(lobbyAdjectives...)(r) + " " + pickOf(lobbyNouns...)(r) + " " + pickOf("SMP", "Survival", "Creative", "Skyblock", "Network")(r)
+
+	data, err := json.Marshal(map[string]any{
+		"version":            map[string]any{"name": version.name, "protocol": version.protocol},
+		"players":            map[string]any{"max": capacity, "online": online, "sample": sample},
+		"description":        map[string]any{"text": motd},
+		"enforcesSecureChat": r.Intn(2) == 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,115 @@
+package faker_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_inventory(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("inventory")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, slots := range []string{"1", "27", "36", "54"} {
+		params := gofakeit.NewMapParams()
+		params.Add("slots", slots)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		items, ok := faker.PlainMaps(val).([]map[string]any)
+
+		require.True(t, ok)
+
+		for _, item := range items {
+			require.Regexp(t, `^minecraft:[a-z_]+$`, item["id"])
+			require.Positive(t, item["count"])
+			require.LessOrEqual(t, item["count"], 64)
+
+			if _, damageable := item["damage"]; damageable {
+				require.Equal(t, 1, item["count"])
+			}
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("slots", "55")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "inventory slots must be between 1 and 54")
+}
+
+func Test_chunkcoordinates(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("chunkcoordinates")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 50 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+
+		pos, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		x, z := pos["x"].(int), pos["z"].(int) //nolint:forcetypeassert
+
+		require.LessOrEqual(t, pos["chunkX"].(int)*16, x)     //nolint:forcetypeassert
+		require.Greater(t, (pos["chunkX"].(int)+1)*16, x)     //nolint:forcetypeassert
+		require.LessOrEqual(t, pos["regionZ"].(int)*32*16, z) //nolint:forcetypeassert
+		require.Greater(t, (pos["regionZ"].(int)+1)*32*16, z) //nolint:forcetypeassert
+		require.Regexp(t, `^r\.-?\d+\.-?\d+\.mca$`, pos["regionFile"])
+	}
+}
+
+func Test_serverstatusjson(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("serverstatusjson")
+
+	require.NotNil(t, info)
+
+	val, err := info.Generate(testRand(t), nil, info)
+
+	require.NoError(t, err)
+
+	var status struct {
+		Version struct {
+			Name     string `json:"name"`
+			Protocol int    `json:"protocol"`
+		} `json:"version"`
+		Players struct {
+			Max    int `json:"max"`
+			Online int `json:"online"`
+			Sample []struct {
+				Name string `json:"name"`
+				ID   string `json:"id"`
+			} `json:"sample"`
+		} `json:"players"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(val.(string)), &status)) //nolint:forcetypeassert
+	require.NotEmpty(t, status.Version.Name)
+	require.Positive(t, status.Version.Protocol)
+	require.LessOrEqual(t, status.Players.Online, status.Players.Max)
+	require.Len(t, status.Players.Sample, min(status.Players.Online, 12))
+
+	for _, player := range status.Players.Sample {
+		require.LessOrEqual(t, len(player.Name), 16)
+	}
+}
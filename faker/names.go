@@ -0,0 +1,242 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownNamePlaceholder = errors.New("unknown name format placeholder")
+
+// anyCulture selects the culture of the generated names randomly.
+const anyCulture = "any"
+
+// honorifics contains the honorifics of the cultures by gender.
+// In Japanese the honorific follows the name, so the name format should put it after the name.
+//
+//nolint:gochecknoglobals
+var honorifics = map[string]map[string][]string{
+	"US": {"male": {"Mr.", "Dr."}, "female": {"Ms.", "Mrs.", "Miss", "Dr."}},
+	"GB": {"male": {"Mr", "Dr"}, "female": {"Ms", "Mrs", "Miss", "Dr"}},
+	"DE": {"male": {"Herr", "Dr."}, "female": {"Frau", "Dr."}},
+	"FR": {"male": {"M.", "Dr"}, "female": {"Mme", "Mlle", "Dr"}},
+	"BR": {"male": {"Sr.", "Dr."}, "female": {"Sra.", "Srta.", "Dra."}},
+	"JP": {"male": {"様", "さん"}, "female": {"様", "さん"}},
+}
+
+// genderPronouns contains the personal pronouns of the genders.
+//
+//nolint:gochecknoglobals
+var genderPronouns = map[string]string{
+	"male":   "he/him",
+	"female": "she/her",
+}
+
+func init() {
+	gender := gofakeit.Param{
+		Field:       "gender",
+		Display:     "Gender",
+		Type:        "string",
+		Default:     "any",
+		Options:     []string{"any", "male", "female"},
+		Description: "Gender of the person",
+	}
+
+	culture := gofakeit.Param{
+		Field:       "culture",
+		Display:     "Culture",
+		Type:        "string",
+		Default:     anyCulture,
+		Options:     append([]string{anyCulture}, demographicCountries()...),
+		Description: "ISO 3166-1 alpha-2 code of the country whose names are used, any for a random country",
+	}
+
+	addNameOptions("firstname", gender, culture)
+
+	gofakeit.AddFuncLookup("fullname", gofakeit.Info{
+		Display:     "Full Name",
+		Category:    "person",
+		Description: "Full name formatted by the format, the name parts share the gender and the culture",
+		Example:     "Dr. Mary Smith",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "format",
+				Display:     "Format",
+				Type:        "string",
+				Default:     "{firstName} {lastName}",
+				Description: "Format of the name with {honorific}, {firstName}, {lastName} and {suffix} placeholders",
+			},
+			gender,
+			culture,
+		},
+		Generate: fullname,
+	})
+
+	gofakeit.AddFuncLookup("honorific", gofakeit.Info{
+		Display:     "Honorific",
+		Category:    "person",
+		Description: "Title or form of address used with the name of a person of the gender and the culture",
+		Example:     "Mrs.",
+		Output:      "string",
+		Params:      []gofakeit.Param{gender, culture},
+		Generate:    honorific,
+	})
+
+	gofakeit.AddFuncLookup("genderpronouns", gofakeit.Info{
+		Display:     "Gender Pronouns",
+		Category:    "person",
+		Description: "Personal pronouns used to refer to a person of the gender",
+		Example:     "she/her",
+		Output:      "string",
+		Params:      []gofakeit.Param{gender},
+		Generate:    genderpronouns,
+	})
+}
+
+// addNameOptions adds the gender and the culture parameters to the name generator function of the key.
+// Without the options the original generator is used, so the generated names of a seed don't change.
+func addNameOptions(key string, gender gofakeit.Param, culture gofakeit.Param) {
+	info := *gofakeit.GetFuncLookup(key)
+	generate := info.Generate
+
+	info.Params = append(slices.Clone(info.Params), gender, culture)
+
+	info.Generate = func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+		genderName, err := info.GetString(m, "gender")
+		if err != nil {
+			return nil, err
+		}
+
+		cultureName, err := info.GetString(m, "culture")
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(genderName, "any") && strings.EqualFold(cultureName, anyCulture) {
+			return generate(r, m, info)
+		}
+
+		country, err := cultureParam(r, m, info)
+		if err != nil {
+			return nil, err
+		}
+
+		gender, err := genderParam(r, m, info)
+		if err != nil {
+			return nil, err
+		}
+
+		return getDemographics().Countries[country].firstName(r, gender), nil
+	}
+
+	gofakeit.AddFuncLookup(key, info)
+}
+
+// cultureParam returns the country code of the culture parameter, a random country for any.
+func cultureParam(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (string, error) {
+	culture, err := info.GetString(m, "culture")
+	if err != nil {
+		return "", err
+	}
+
+	countries := demographicCountries()
+
+	if strings.EqualFold(culture, anyCulture) {
+		return countries[r.Intn(len(countries))], nil
+	}
+
+	if country := strings.ToUpper(culture); slices.Contains(countries, country) {
+		return country, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", errUnknownCountry, culture)
+}
+
+func fullname(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	format, err := info.GetString(m, "format")
+	if err != nil {
+		return nil, err
+	}
+
+	country, err := cultureParam(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	gender, err := genderParam(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	demography := getDemographics().Countries[country]
+
+	var (
+		buff strings.Builder
+		rest = format
+	)
+
+	for {
+		before, after, found := strings.Cut(rest, "{")
+		buff.WriteString(before)
+
+		if !found {
+			break
+		}
+
+		placeholder, after, closed := strings.Cut(after, "}")
+		if !closed {
+			return nil, fmt.Errorf("%w: {%s", errUnknownNamePlaceholder, placeholder)
+		}
+
+		switch placeholder {
+		case "honorific":
+			buff.WriteString(randomHonorific(r, country, gender))
+		case "firstName":
+			buff.WriteString(demography.firstName(r, gender))
+		case "lastName":
+			buff.WriteString(demography.Last.sample(r))
+		case "suffix":
+			buff.WriteString((&gofakeit.Faker{Rand: r}).NameSuffix())
+		default:
+			return nil, fmt.Errorf("%w: {%s}", errUnknownNamePlaceholder, placeholder)
+		}
+
+		rest = after
+	}
+
+	return buff.String(), nil
+}
+
+func randomHonorific(r *rand.Rand, country string, gender string) string {
+	titles := honorifics[country][gender]
+
+	return titles[r.Intn(len(titles))]
+}
+
+func honorific(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	country, err := cultureParam(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	gender, err := genderParam(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return randomHonorific(r, country, gender), nil
+}
+
+func genderpronouns(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	gender, err := genderParam(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return genderPronouns[gender], nil
+}
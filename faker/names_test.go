@@ -0,0 +1,121 @@
+package faker_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_firstname_options(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("firstname")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("gender", "female")
+	params.Add("culture", "de")
+
+	for range 20 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.NotContains(t, []string{"Peter", "Michael", "Thomas"}, val)
+	}
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, val)
+
+	params = gofakeit.NewMapParams()
+	params.Add("culture", "XX")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "no demographic data for country")
+}
+
+func Test_fullname(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("fullname")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("format", "{honorific} {firstName} {lastName}")
+	params.Add("gender", "male")
+	params.Add("culture", "GB")
+
+	for range 20 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^(Mr|Dr) \S+ \S+$`), val)
+	}
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(val.(string)), 2) //nolint:forcetypeassert
+
+	params = gofakeit.NewMapParams()
+	params.Add("format", "{lastName}, {middleName}")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown name format placeholder: {middleName}")
+}
+
+func Test_honorific(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("honorific")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("gender", "female")
+	params.Add("culture", "FR")
+
+	for range 20 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Contains(t, []string{"Mme", "Mlle", "Dr"}, val)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("gender", "other")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown gender")
+}
+
+func Test_genderpronouns(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("genderpronouns")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("gender", "male")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+	require.Equal(t, "he/him", val)
+}
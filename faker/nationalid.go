@@ -0,0 +1,262 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownIDCountry = errors.New("no national identification number format for country")
+
+func init() {
+	gofakeit.AddFuncLookup("nationalid", gofakeit.Info{
+		Display:     "National ID",
+		Category:    "person",
+		Description: "National identification number (US SSN, GB NINO, BR CPF, DE Steuer-ID) with valid or invalid checksum",
+		Example:     "512-31-4412",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "country",
+				Display:     "Country",
+				Type:        "string",
+				Default:     "US",
+				Options:     []string{"US", "GB", "BR", "DE"},
+				Description: "ISO 3166-1 alpha-2 country code",
+			},
+			{
+				Field:       "valid",
+				Display:     "Valid",
+				Type:        "bool",
+				Default:     "true",
+				Description: "Generate number passing the format and checksum rules, otherwise breaking one of them",
+			},
+			{
+				Field:   "fake",
+				Display: "Fake",
+				Type:    "bool",
+				Default: "false",
+				Description: "Generate clearly fake number which is never issued (US area 666, GB prefix QQ, " +
+					"BR repeated digits, DE leading zero), but has valid format and checksum",
+			},
+		},
+		Generate: nationalid,
+	})
+}
+
+func nationalid(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	country, err := info.GetString(m, "country")
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := info.GetBool(m, "valid")
+	if err != nil {
+		return nil, err
+	}
+
+	fake, err := info.GetBool(m, "fake")
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToUpper(country) {
+	case "US":
+		return ssnOf(r, valid, fake), nil
+	case "GB", "UK":
+		return ninoOf(r, valid, fake), nil
+	case "BR":
+		return cpfOf(r, valid, fake), nil
+	case "DE":
+		return steuerIDOf(r, valid, fake), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownIDCountry, country)
+	}
+}
+
+// ssnOf returns a US Social Security Number. Valid numbers have area 001-899 (except 666),
+// non-zero group and serial, invalid numbers break one of these rules and
+// fake numbers use the area 666, which is never issued.
+func ssnOf(r *rand.Rand, valid, fake bool) string {
+	const (
+		maxArea   = 899
+		maxGroup  = 99
+		maxSerial = 9999
+		fakeArea  = 666
+	)
+
+	// rules broken by invalid numbers
+	const (
+		zeroArea = iota
+		highArea
+		zeroGroup
+		zeroSerial
+		rules
+	)
+
+	area := 1 + r.Intn(maxArea)
+	if area == fakeArea {
+		area++
+	}
+
+	group, serial := 1+r.Intn(maxGroup), 1+r.Intn(maxSerial)
+
+	switch {
+	case fake:
+		area = fakeArea
+	case valid:
+	default:
+		switch r.Intn(rules) {
+		case zeroArea:
+			area = 0
+		case highArea:
+			area = maxArea + 1 + r.Intn(maxGroup)
+		case zeroGroup:
+			group = 0
+		case zeroSerial:
+			serial = 0
+		}
+	}
+
+	return fmt.Sprintf("%03d-%02d-%04d", area, group, serial)
+}
+
+// ninoOf returns a UK National Insurance number. Valid numbers have allowed prefix letters and
+// suffix A-D, invalid numbers have disallowed prefix and fake numbers have the prefix QQ,
+// which is reserved for examples.
+func ninoOf(r *rand.Rand, valid, fake bool) string {
+	const (
+		first    = "ABCEGHJKLMNOPRSTWXYZ" // D, F, I, Q, U and V are not allowed
+		second   = "ABCEGHJKLMNPRSTWXYZ"  // D, F, I, O, Q, U and V are not allowed
+		suffixes = "ABCD"
+	)
+
+	disallowed := []string{"BG", "GB", "KN", "NK", "NT", "TN", "ZZ"}
+
+	var prefix string
+
+	switch {
+	case fake:
+		prefix = "QQ"
+	case valid:
+		for prefix == "" || slices.Contains(disallowed, prefix) {
+			prefix = string([]byte{first[r.Intn(len(first))], second[r.Intn(len(second))]})
+		}
+	default:
+		prefix = disallowed[r.Intn(len(disallowed))]
+	}
+
+	return prefix + skuOf(r, "######") + string(suffixes[r.Intn(len(suffixes))])
+}
+
+// cpfOf returns a Brazilian CPF number with two mod 11 check digits. Invalid numbers have wrong
+// check digits and fake numbers consist of a repeated digit, which passes the checksum but is never issued.
+func cpfOf(r *rand.Rand, valid, fake bool) string {
+	const (
+		length = 9
+		base   = 10
+	)
+
+	var body string
+
+	if fake {
+		body = strings.Repeat(strconv.Itoa(r.Intn(base)), length)
+	} else {
+		for body == "" || strings.Count(body, body[:1]) == len(body) {
+			body = digits(r, length)
+		}
+	}
+
+	first := cpfCheckDigit(body)
+	second := cpfCheckDigit(body + strconv.Itoa(first))
+
+	if !valid && !fake {
+		second = (second + 1 + r.Intn(base-1)) % base
+	}
+
+	code := body + strconv.Itoa(first) + strconv.Itoa(second)
+
+	return code[0:3] + "." + code[3:6] + "." + code[6:9] + "-" + code[9:]
+}
+
+// cpfCheckDigit returns the CPF check digit of the digits, the weights are descending from len+1 to 2.
+func cpfCheckDigit(body string) int {
+	const modulus = 11
+
+	var sum int
+
+	for idx := range len(body) {
+		sum += int(body[idx]-'0') * (len(body) + 1 - idx)
+	}
+
+	if rem := sum % modulus; rem >= 2 {
+		return modulus - rem
+	}
+
+	return 0
+}
+
+// steuerIDOf returns a German tax identification number (Steuerliche Identifikationsnummer).
+// Within the first 10 digits exactly one digit occurs twice, the first digit is not zero
+// and the last digit is the ISO 7064 MOD 11,10 check digit. Invalid numbers have wrong
+// check digit and fake numbers have leading zero.
+func steuerIDOf(r *rand.Rand, valid, fake bool) string {
+	const (
+		length = 10
+		base   = 10
+	)
+
+	var buff []byte
+
+	for len(buff) == 0 || (buff[0] == '0') != fake {
+		perm := r.Perm(base)
+		buff = make([]byte, 0, length)
+
+		for _, digit := range perm[:length-1] {
+			buff = append(buff, byte('0'+digit))
+		}
+
+		dup := buff[r.Intn(len(buff))]
+		pos := r.Intn(len(buff) + 1)
+
+		buff = append(buff[:pos], append([]byte{dup}, buff[pos:]...)...)
+	}
+
+	check := mod1110CheckDigit(string(buff))
+
+	if !valid && !fake {
+		check = (check + 1 + r.Intn(base-1)) % base
+	}
+
+	return string(buff) + strconv.Itoa(check)
+}
+
+// mod1110CheckDigit returns the ISO 7064 MOD 11,10 check digit of the digits (used by German tax numbers).
+func mod1110CheckDigit(body string) int {
+	const (
+		base    = 10
+		modulus = 11
+	)
+
+	product := base
+
+	for idx := range len(body) {
+		sum := (int(body[idx]-'0') + product) % base
+		if sum == 0 {
+			sum = base
+		}
+
+		product = sum * 2 % modulus
+	}
+
+	if check := modulus - product; check != base {
+		return check
+	}
+
+	return 0
+}
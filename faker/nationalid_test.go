@@ -0,0 +1,94 @@
+package faker_test
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_nationalid(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("nationalid")
+
+	require.NotNil(t, info)
+
+	formats := map[string]*regexp.Regexp{
+		"US": regexp.MustCompile(`^[0-9]{3}-[0-9]{2}-[0-9]{4}$`),
+		"GB": regexp.MustCompile(`^[A-Z]{2}[0-9]{6}[A-D]$`),
+		"BR": regexp.MustCompile(`^[0-9]{3}\.[0-9]{3}\.[0-9]{3}-[0-9]{2}$`),
+		"DE": regexp.MustCompile(`^[0-9]{11}$`),
+	}
+
+	r := testRand(t)
+
+	for country, format := range formats {
+		for _, mode := range []struct{ valid, fake string }{{"true", "false"}, {"false", "false"}, {"true", "true"}} {
+			params := gofakeit.NewMapParams()
+			params.Add("country", country)
+			params.Add("valid", mode.valid)
+			params.Add("fake", mode.fake)
+
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Regexp(t, format, val, country)
+
+			str := val.(string) //nolint:forcetypeassert
+
+			valid := mode.valid == "true" && mode.fake == "false"
+			fake := mode.fake == "true"
+
+			switch country {
+			case "US":
+				area, _ := strconv.Atoi(str[:3])
+
+				require.Equal(t, valid, area != 0 && area != 666 && area < 900 && str[4:6] != "00" && str[7:] != "0000", str)
+				require.Equal(t, fake, area == 666, str)
+			case "GB":
+				require.Equal(t, fake, strings.HasPrefix(str, "QQ"), str)
+				require.Equal(t, !valid && !fake, regexp.MustCompile(`^(BG|GB|KN|NK|NT|TN|ZZ)`).MatchString(str), str)
+			case "BR":
+				digits := strings.NewReplacer(".", "", "-", "").Replace(str)
+
+				require.Equal(t, valid || fake, cpfValid(digits), str)
+				require.Equal(t, fake, strings.Count(digits, digits[:1]) == len(digits), str)
+			case "DE":
+				require.Equal(t, fake, str[0] == '0', str)
+			}
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("country", "XX")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "no national identification number format for country: XX")
+}
+
+// cpfValid reports whether the 11 digit CPF number has valid check digits.
+func cpfValid(digits string) bool {
+	for _, length := range []int{9, 10} {
+		var sum int
+
+		for idx := range length {
+			sum += int(digits[idx]-'0') * (length + 1 - idx)
+		}
+
+		check := 0
+		if rem := sum % 11; rem >= 2 {
+			check = 11 - rem
+		}
+
+		if int(digits[length]-'0') != check {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,340 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/netip"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidOUI       = errors.New("invalid OUI, expected 3 hexadecimal octets")
+	errInvalidIPVersion = errors.New("invalid IP version, expected v4 or v6")
+	errInvalidPrefixLen = errors.New("invalid prefix length")
+	errInvalidCIDR      = errors.New("invalid CIDR")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("macaddressvendor", gofakeit.Info{
+		Display:     "MAC Address Vendor",
+		Category:    "internet",
+		Description: "MAC address with the organizationally unique identifier (OUI) of a network equipment vendor",
+		Example:     "00:50:56:a3:1f:7c",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "oui",
+				Display:     "OUI",
+				Type:        "string",
+				Default:     "any",
+				Description: "OUI of the vendor (e.g. 00:50:56), any for the OUI of a well-known vendor",
+			},
+		},
+		Generate: macaddressvendor,
+	})
+
+	gofakeit.AddFuncLookup("cidr", gofakeit.Info{
+		Display:     "CIDR",
+		Category:    "internet",
+		Description: "Network address in CIDR notation",
+		Example:     "172.58.96.0/20",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "version",
+				Display:     "Version",
+				Type:        "string",
+				Default:     "v4",
+				Options:     []string{"v4", "v6"},
+				Description: "IP version",
+			},
+			{
+				Field:       "prefixLen",
+				Display:     "Prefix Length",
+				Type:        "int",
+				Default:     "0",
+				Description: "Length of the network prefix in bits, 0 for a random typical length (v4: 8-30, v6: 32-64)",
+			},
+		},
+		Generate: unsafeOf(cidr),
+	})
+
+	gofakeit.AddFuncLookup("ipincidr", gofakeit.Info{
+		Display:     "IP In CIDR",
+		Category:    "internet",
+		Description: "IP address within the network, the network and broadcast addresses of IPv4 networks are excluded",
+		Example:     "10.83.112.7",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "cidr",
+				Display:     "CIDR",
+				Type:        "string",
+				Default:     "10.0.0.0/8",
+				Description: "Network address in CIDR notation",
+			},
+		},
+		Generate: unsafeOf(ipincidr),
+	})
+
+	gofakeit.AddFuncLookup("portwellknown", gofakeit.Info{
+		Display:     "Port Well Known",
+		Category:    "internet",
+		Description: "Well-known port number of a common network service",
+		Example:     "443",
+		Output:      "int",
+		Params:      nil,
+		Generate:    portwellknown,
+	})
+
+	gofakeit.AddFuncLookup("portephemeral", gofakeit.Info{
+		Display:     "Port Ephemeral",
+		Category:    "internet",
+		Description: "Port number from the dynamic (ephemeral) range 49152-65535",
+		Example:     "53817",
+		Output:      "int",
+		Params:      nil,
+		Generate:    portephemeral,
+	})
+
+	gofakeit.AddFuncLookup("asn", gofakeit.Info{
+		Display:     "ASN",
+		Category:    "internet",
+		Description: "Public autonomous system number, excluding the documentation and private use ranges",
+		Example:     "15169",
+		Output:      "int",
+		Params:      nil,
+		Generate:    asn,
+	})
+}
+
+// vendorOUIs contains the OUIs of well-known network equipment and virtualization vendors.
+var vendorOUIs = []string{ //nolint:gochecknoglobals
+	"00:00:0c", // Cisco
+	"00:03:93", // Apple
+	"00:0c:29", // VMware
+	"00:15:5d", // Microsoft (Hyper-V)
+	"00:16:3e", // Xen
+	"00:1a:11", // Google
+	"00:1b:21", // Intel
+	"00:50:56", // VMware
+	"08:00:27", // Oracle (VirtualBox)
+	"28:6f:7f", // Cisco
+	"3c:22:fb", // Apple
+	"52:54:00", // QEMU/KVM
+	"b8:27:eb", // Raspberry Pi Foundation
+	"dc:a6:32", // Raspberry Pi Trading
+	"f0:18:98", // Apple
+	"fc:fb:fb", // Cisco
+}
+
+// wellKnownPorts contains the port numbers of common network services.
+var wellKnownPorts = []int{ //nolint:gochecknoglobals
+	20, 21, 22, 23, 25, 53, 67, 68, 69, 80, 88, 110, 123, 137, 143, 161, 162, 179, 389, 443, 445, 465, 514,
+	515, 587, 636, 873, 993, 995,
+}
+
+func macaddressvendor(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const octets = 3
+
+	oui, err := info.GetString(m, "oui")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(oui, "any") {
+		oui = vendorOUIs[r.Intn(len(vendorOUIs))]
+	}
+
+	hex := strings.ToLower(strings.NewReplacer(":", "", "-", "", ".", "").Replace(oui))
+	if len(hex) != octets*2 || strings.Trim(hex, "0123456789abcdef") != "" {
+		return nil, fmt.Errorf("%w: %s", errInvalidOUI, oui)
+	}
+
+	return hex[0:2] + ":" + hex[2:4] + ":" + hex[4:6] + ":" + hexOf(r, 2) + ":" + hexOf(r, 2) + ":" + hexOf(r, 2), nil
+}
+
+func cidr(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	version, err := info.GetString(m, "version")
+	if err != nil {
+		return nil, err
+	}
+
+	bits, err := info.GetInt(m, "prefixLen")
+	if err != nil {
+		return nil, err
+	}
+
+	var addr netip.Addr
+
+	switch strings.ToLower(version) {
+	case "v4", "4", "ipv4":
+		addr = randomIPv4(r, safe)
+	case "v6", "6", "ipv6":
+		addr = randomIPv6(r, safe)
+	default:
+		return nil, fmt.Errorf("%w: %s", errInvalidIPVersion, version)
+	}
+
+	if bits == 0 {
+		bits = typicalPrefixLen(r, addr, safe)
+	}
+
+	if safe {
+		// the network is within the documentation range of the address
+		bits = max(bits, documentationPrefix(addr).Bits())
+	}
+
+	prefix, err := addr.Prefix(bits)
+	if err != nil || bits < 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidPrefixLen, bits)
+	}
+
+	return prefix.String(), nil
+}
+
+// typicalPrefixLen returns a random prefix length used typically for networks of the address family.
+func typicalPrefixLen(r *rand.Rand, addr netip.Addr, safe bool) int {
+	const (
+		minV4 = 8
+		maxV4 = 30
+		minV6 = 32
+		maxV6 = 64
+	)
+
+	if addr.Is4() {
+		if safe {
+			return documentationPrefix(addr).Bits() + r.Intn(maxV4-documentationPrefix(addr).Bits()+1)
+		}
+
+		return minV4 + r.Intn(maxV4-minV4+1)
+	}
+
+	return minV6 + r.Intn(maxV6-minV6+1)
+}
+
+// randomIPv4 returns a random unicast IPv4 address (first octet 1-223, except 127),
+// an address of the RFC 5737 documentation ranges in safe mode.
+func randomIPv4(r *rand.Rand, safe bool) netip.Addr {
+	if safe {
+		return addrInPrefix(r, documentationIPv4[r.Intn(len(documentationIPv4))])
+	}
+
+	const (
+		maxUnicast = 223
+		loopback   = 127
+	)
+
+	var octets [4]byte
+
+	_, _ = r.Read(octets[:])
+
+	for octets[0] == 0 || octets[0] == loopback || octets[0] > maxUnicast {
+		octets[0] = byte(1 + r.Intn(maxUnicast))
+	}
+
+	return netip.AddrFrom4(octets)
+}
+
+// randomIPv6 returns a random global unicast IPv6 address (2000::/3),
+// an address of the RFC 3849 documentation range in safe mode.
+func randomIPv6(r *rand.Rand, safe bool) netip.Addr {
+	if safe {
+		return addrInPrefix(r, documentationIPv6)
+	}
+
+	const (
+		globalUnicast = 0x20
+		prefixMask    = 0x1f
+	)
+
+	var octets [16]byte
+
+	_, _ = r.Read(octets[:])
+
+	octets[0] = globalUnicast | octets[0]&prefixMask
+
+	return netip.AddrFrom16(octets)
+}
+
+func ipincidr(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	str, err := info.GetString(m, "cidr")
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidCIDR, str)
+	}
+
+	if safe {
+		prefix = safePrefix(prefix.Masked())
+	}
+
+	return addrInPrefix(r, prefix.Masked()).String(), nil
+}
+
+// addrInPrefix returns a random address of the network. The network and broadcast addresses
+// of IPv4 networks are excluded, if the network has more than two addresses.
+func addrInPrefix(r *rand.Rand, prefix netip.Prefix) netip.Addr {
+	// the network and broadcast addresses
+	const reserved = 2
+
+	network := prefix.Addr()
+	hostBits := network.BitLen() - prefix.Bits()
+
+	if hostBits == 0 {
+		return network
+	}
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(hostBits))
+	offset := new(big.Int)
+
+	if network.Is4() && size.Cmp(big.NewInt(reserved)) > 0 {
+		offset.Rand(r, size.Sub(size, big.NewInt(reserved)))
+		offset.Add(offset, big.NewInt(1))
+	} else {
+		offset.Rand(r, size)
+	}
+
+	base := network.AsSlice()
+	sum := new(big.Int).Add(new(big.Int).SetBytes(base), offset).FillBytes(make([]byte, len(base)))
+
+	addr, _ := netip.AddrFromSlice(sum)
+
+	return addr
+}
+
+func portwellknown(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return wellKnownPorts[r.Intn(len(wellKnownPorts))], nil
+}
+
+func portephemeral(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		minPort = 49152
+		maxPort = 65535
+	)
+
+	return minPort + r.Intn(maxPort-minPort+1), nil
+}
+
+func asn(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	// 16-bit public range is 1-64495 (64496-64511 documentation, 64512-65534 private use),
+	// 32-bit public range is 131072-4199999999 (4200000000-4294967294 private use).
+	const (
+		max16 = 64495
+		min32 = 131072
+		max32 = 4199999999
+	)
+
+	if r.Intn(2) == 0 {
+		return 1 + r.Intn(max16), nil
+	}
+
+	return int(min32 + r.Int63n(max32-min32+1)), nil
+}
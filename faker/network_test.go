@@ -0,0 +1,149 @@
+package faker_test
+
+import (
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_macaddressvendor(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("macaddressvendor")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	params := gofakeit.NewMapParams()
+	params.Add("oui", "00-50-56")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^00:50:56(:[0-9a-f]{2}){3}$`), val)
+
+	val, err = info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^[0-9a-f]{2}(:[0-9a-f]{2}){5}$`), val)
+
+	params = gofakeit.NewMapParams()
+	params.Add("oui", "00:50")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid OUI")
+}
+
+func Test_cidr(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("cidr")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, version := range []string{"v4", "v6"} {
+		for _, bits := range []string{"0", "16", "24", "32"} {
+			params := gofakeit.NewMapParams()
+			params.Add("version", version)
+			params.Add("prefixLen", bits)
+
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+
+			prefix, err := netip.ParsePrefix(val.(string)) //nolint:forcetypeassert
+
+			require.NoError(t, err)
+			require.Equal(t, prefix.Masked(), prefix)
+			require.Equal(t, version == "v4", prefix.Addr().Is4())
+
+			if bits != "0" {
+				require.Equal(t, bits, val.(string)[len(val.(string))-2:]) //nolint:forcetypeassert
+			}
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("prefixLen", "33")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid prefix length: 33")
+}
+
+func Test_ipincidr(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("ipincidr")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, network := range []string{"10.0.0.0/8", "192.168.1.0/30", "192.168.1.7/32", "2001:db8::/32"} {
+		params := gofakeit.NewMapParams()
+		params.Add("cidr", network)
+
+		prefix := netip.MustParsePrefix(network)
+
+		for range 50 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+
+			addr := netip.MustParseAddr(val.(string)) //nolint:forcetypeassert
+
+			require.True(t, prefix.Contains(addr), val)
+
+			if network == "192.168.1.0/30" {
+				require.NotEqual(t, "192.168.1.0", val)
+				require.NotEqual(t, "192.168.1.3", val)
+			}
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("cidr", "10.0.0.0")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid CIDR: 10.0.0.0")
+}
+
+func Test_ports_asn(t *testing.T) {
+	t.Parallel()
+
+	r := testRand(t)
+
+	ranges := map[string][2]int{
+		"portwellknown": {1, 1023},
+		"portephemeral": {49152, 65535},
+		"asn":           {1, 4199999999},
+	}
+
+	for name, bounds := range ranges {
+		info := gofakeit.GetFuncLookup(name)
+
+		require.NotNil(t, info)
+
+		for range 50 {
+			val, err := info.Generate(r, nil, info)
+
+			require.NoError(t, err)
+			require.IsType(t, 0, val)
+			require.GreaterOrEqual(t, val, bounds[0])
+			require.LessOrEqual(t, val, bounds[1])
+
+			if name == "asn" {
+				require.False(t, val.(int) >= 64496 && val.(int) <= 131071, val) //nolint:forcetypeassert
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"slices"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidBits      = errors.New("bits must be between 1 and 1024")
+	errInvalidPrecision = errors.New("precision must be between -1 and 15")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("int53", gofakeit.Info{
+		Display:     "Int53",
+		Category:    "number",
+		Description: "Integer within the safe integer range of JavaScript, so it keeps its precision as a number",
+		Example:     "-3926385614528027",
+		Output:      "int64",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return r.Int63n(2*maxSafeInteger+1) - maxSafeInteger, nil
+		},
+	})
+
+	gofakeit.AddFuncLookup("bigint", gofakeit.Info{
+		Display:     "Big Int",
+		Category:    "number",
+		Description: "Non-negative integer of the given number of bits as BigInt, e.g. for 64-bit identifiers",
+		Example:     "14862318364071846927",
+		Output:      "bigint",
+		Params: []gofakeit.Param{
+			{Field: "bits", Display: "Bits", Type: "int", Default: "64", Description: "Number of bits of the integer"},
+		},
+		Generate: bigint,
+	})
+
+	addPrecision("float64range")
+}
+
+func bigint(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const maxBits = 1024
+
+	bits, err := info.GetInt(m, "bits")
+	if err != nil {
+		return nil, err
+	}
+
+	if bits < 1 || bits > maxBits {
+		return nil, fmt.Errorf("%w: %d", errInvalidBits, bits)
+	}
+
+	return new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(bits))), nil
+}
+
+// addPrecision adds the precision parameter to the float generator function of the key.
+// The generated value is rounded to the given number of decimal digits.
+func addPrecision(key string) {
+	const maxPrecision = 15
+
+	info := *gofakeit.GetFuncLookup(key)
+	generate := info.Generate
+
+	info.Params = append(slices.Clone(info.Params), gofakeit.Param{
+		Field:       "precision",
+		Display:     "Precision",
+		Type:        "int",
+		Default:     "-1",
+		Description: "Number of decimal digits, -1 for no rounding",
+	})
+
+	info.Generate = func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+		precision, err := info.GetInt(m, "precision")
+		if err != nil {
+			return nil, err
+		}
+
+		if precision < -1 || precision > maxPrecision {
+			return nil, fmt.Errorf("%w: %d", errInvalidPrecision, precision)
+		}
+
+		val, err := generate(r, m, info)
+		if err != nil || precision < 0 {
+			return val, err
+		}
+
+		scale := math.Pow10(precision)
+
+		return math.Round(val.(float64)*scale) / scale, nil //nolint:forcetypeassert
+	}
+
+	gofakeit.AddFuncLookup(key, info)
+}
@@ -0,0 +1,133 @@
+package faker_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_int53(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("int53")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.LessOrEqual(t, math.Abs(float64(val.(int64))), float64(1<<53-1)) //nolint:forcetypeassert
+	}
+}
+
+func Test_bigint(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("bigint")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+	params := gofakeit.NewMapParams()
+
+	params.Add("bits", "128")
+
+	var wide bool
+
+	for range 20 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.LessOrEqual(t, val.(*big.Int).BitLen(), 128) //nolint:forcetypeassert
+
+		wide = wide || val.(*big.Int).BitLen() > 64 //nolint:forcetypeassert
+	}
+
+	require.True(t, wide)
+
+	params = gofakeit.NewMapParams()
+	params.Add("bits", "0")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "bits must be between 1 and 1024")
+}
+
+func Test_float64Range_precision(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const checks = {
+	  rounded: faker.numbers.float64Range(1, 2, 2) * 100 % 1 === 0,
+	  unrounded: faker.numbers.float64Range(1, 2) * 100 % 1 !== 0,
+	  bigint: typeof faker.numbers.bigInt() === "bigint" && faker.numbers.bigInt(200) < 2n ** 200n,
+	  int53: Number.isSafeInteger(faker.numbers.int53()),
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).numbers.float64Range(1, 2, 16)`)
+
+	require.ErrorContains(t, err, "precision must be between -1 and 15")
+}
+
+func Test_Faker_numbers_typedArrays(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker(11)
+	const floats = faker.numbers.float32Array(1000, -1, 1)
+	const ints = faker.numbers.intArray(1000, 5, 9)
+	const checks = {
+	  float32: floats instanceof Float32Array && floats.length === 1000 && floats.every((val) => val >= -1 && val <= 1),
+	  int32: ints instanceof Int32Array && ints.length === 1000 && ints.every((val) => val >= 5 && val <= 9),
+	  spread: new Set(ints).size === 5,
+	  defaults: faker.numbers.float32Array(10).every((val) => val >= 0 && val <= 1) && faker.numbers.intArray(3).length === 3,
+	  empty: faker.numbers.intArray(0).length === 0,
+	  seeded: new Faker(11).numbers.float32Array(5).join() === new Faker(11).numbers.float32Array(5).join(),
+	  keys: Object.keys(faker.numbers).includes("float32Array"),
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = vm.RunString(`new Faker(11).numbers.intArray(10, 5, 1)`)
+
+	require.ErrorContains(t, err, "invalid range 5-1")
+
+	_, err = vm.RunString(`new Faker(11).numbers.intArray(10, 0, 2 ** 40)`)
+
+	require.ErrorContains(t, err, "int32 range")
+
+	_, err = vm.RunString(`new Faker(11).numbers.float32Array(-1)`)
+
+	require.ErrorContains(t, err, "integer between 0 and 10000000")
+
+	_, err = vm.RunString(`new Faker(11).numbers.intArray(1e18)`)
+
+	require.ErrorContains(t, err, "integer between 0 and 10000000")
+}
@@ -0,0 +1,166 @@
+package faker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errInvalidExpiresIn = errors.New("invalid expiresIn, expected positive number of seconds")
+
+func init() {
+	gofakeit.AddFuncLookup("oauthtokenresponse", gofakeit.Info{
+		Display:     "OAuth Token Response",
+		Category:    "internet",
+		Description: "OAuth 2.0 / OpenID Connect token endpoint response with JWT access and ID tokens of consistent claims",
+		Example: `{
+	"access_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6ImF0K2p3dCJ9.eyJpc3MiOi...",
+	"token_type": "Bearer",
+	"expires_in": 3600,
+	"refresh_token": "Yk3nQ0x7c9LrVZp2Hq8sW4tEo1uJfGdA6mBhNiKyCe5",
+	"id_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJpc3MiOi...",
+	"scope": "openid profile email offline_access"
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "scopes",
+				Display:     "Scopes",
+				Type:        "[]string",
+				Optional:    true,
+				Description: "Granted scopes, openid, profile, email and offline_access if empty; the ID token is included for openid scope",
+			},
+			{
+				Field:       "expiresIn",
+				Display:     "Expires In",
+				Type:        "int",
+				Default:     "3600",
+				Description: "Lifetime of the access token in seconds",
+			},
+		},
+		Generate: unsafeOf(oauthtokenresponse),
+	})
+}
+
+func oauthtokenresponse(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	const (
+		secretLength  = 32
+		refreshLength = 32
+		nonceLength   = 16
+	)
+
+	scopes, err := info.GetStringArray(m, "scopes")
+	if err != nil || len(scopes) == 0 {
+		scopes = []string{"openid", "profile", "email", "offline_access"}
+	}
+
+	expiresIn, err := info.GetInt(m, "expiresIn")
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresIn <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidExpiresIn, expiresIn)
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+	person := fake.Person()
+	domain := domainOf(fake.Company())
+
+	secret := randomBytes(r, secretLength)
+	tld := "com"
+	if safe {
+		tld = "example"
+	}
+
+	issuer := "https://auth." + domain + "." + tld + "/"
+	subject := fake.UUID()
+	clientID := hexOf(r, secretLength)
+	now := time.Now().UTC().Unix()
+	exp := now + int64(expiresIn)
+
+	accessToken := signJWT(secret, "at+jwt", map[string]any{
+		"iss":       issuer,
+		"sub":       subject,
+		"aud":       "https://api." + domain + "." + tld,
+		"client_id": clientID,
+		"scope":     strings.Join(scopes, " "),
+		"iat":       now,
+		"exp":       exp,
+		"jti":       fake.UUID(),
+	})
+
+	response := object{
+		{"access_token", accessToken},
+		{"token_type", "Bearer"},
+		{"expires_in", expiresIn},
+		{"refresh_token", base64.RawURLEncoding.EncodeToString(randomBytes(r, refreshLength))},
+		{"scope", strings.Join(scopes, " ")},
+	}
+
+	if !slices.Contains(scopes, "openid") {
+		return response, nil
+	}
+
+	claims := map[string]any{
+		"iss":       issuer,
+		"sub":       subject,
+		"aud":       clientID,
+		"iat":       now,
+		"exp":       exp,
+		"auth_time": now,
+		"nonce":     base64.RawURLEncoding.EncodeToString(randomBytes(r, nonceLength)),
+		"at_hash":   accessTokenHash(accessToken),
+	}
+
+	if slices.Contains(scopes, "profile") {
+		claims["name"] = person.FirstName + " " + person.LastName
+		claims["given_name"] = person.FirstName
+		claims["family_name"] = person.LastName
+	}
+
+	if slices.Contains(scopes, "email") {
+		claims["email"] = strings.ToLower(person.FirstName + "." + person.LastName + "@" + domain + "." + tld)
+		claims["email_verified"] = fake.Bool()
+	}
+
+	return append(response, property{"id_token", signJWT(secret, "JWT", claims)}), nil
+}
+
+// signJWT returns the claims as compact serialized JWT signed with HMAC SHA-256.
+func signJWT(secret []byte, typ string, claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": typ})
+	payload, _ := json.Marshal(claims)
+
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// accessTokenHash returns the at_hash claim of the access token: left half of its SHA-256 hash.
+func accessTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+}
+
+// randomBytes returns n random bytes.
+func randomBytes(r *rand.Rand, n int) []byte {
+	buff := make([]byte, n)
+
+	_, _ = r.Read(buff)
+
+	return buff
+}
@@ -0,0 +1,89 @@
+package faker_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeJWT(t *testing.T, token string) map[string]any {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+
+	require.Len(t, parts, 3)
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+
+	require.NoError(t, err)
+
+	var claims map[string]any
+
+	require.NoError(t, json.Unmarshal(payload, &claims))
+
+	return claims
+}
+
+func Test_oauthtokenresponse(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("oauthtokenresponse")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+
+	res := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "Bearer", res["token_type"])
+	require.Equal(t, 3600, res["expires_in"])
+	require.Equal(t, "openid profile email offline_access", res["scope"])
+	require.NotEmpty(t, res["refresh_token"])
+
+	access := decodeJWT(t, res["access_token"].(string)) //nolint:forcetypeassert
+	id := decodeJWT(t, res["id_token"].(string))         //nolint:forcetypeassert
+
+	require.Equal(t, access["iss"], id["iss"])
+	require.Equal(t, access["sub"], id["sub"])
+	require.Equal(t, access["client_id"], id["aud"])
+	require.Equal(t, access["exp"], id["exp"])
+	require.InDelta(t, 3600, access["exp"].(float64)-access["iat"].(float64), 0) //nolint:forcetypeassert
+	require.Contains(t, id, "email")
+	require.Contains(t, id, "name")
+
+	sum := sha256.Sum256([]byte(res["access_token"].(string))) //nolint:forcetypeassert
+
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:16]), id["at_hash"])
+
+	params := gofakeit.NewMapParams()
+	params.Add("scopes", "read")
+	params.Add("scopes", "write")
+	params.Add("expiresIn", "60")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+
+	res = faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+	require.Equal(t, "read write", res["scope"])
+	require.Equal(t, 60, res["expires_in"])
+	require.NotContains(t, res, "id_token")
+
+	params = gofakeit.NewMapParams()
+	params.Add("expiresIn", "0")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid expiresIn")
+}
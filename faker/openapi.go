@@ -0,0 +1,631 @@
+package faker
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	errUniqueItems = errors.New("not enough distinct items")
+	errSchemaDepth = errors.New("schema nesting too deep")
+	errSchemaLimit = errors.New("schema limit too large")
+)
+
+const (
+	// maxSchemaDepth is the maximum nesting depth of the generated values, it stops recursive schemas
+	// (e.g. a tree node with child nodes) and recursive allOf, oneOf and anyOf references.
+	maxSchemaDepth = 6
+	// maxSchemaItems is the maximum number of generated array items.
+	maxSchemaItems = 1000
+	// maxSchemaLength is the maximum length of the generated strings.
+	maxSchemaLength = 10000
+)
+
+// openapiSpec is a parsed OpenAPI 3 specification.
+type openapiSpec struct {
+	doc map[string]any
+	// operations contains the operations (e.g. POST /users) in the order of the paths and methods.
+	operations []string
+}
+
+// openapiSpecs contains the parsed specifications by absolute path, shared between the VUs.
+var openapiSpecs sync.Map //nolint:gochecknoglobals
+
+// openapiMethods contains the HTTP methods of the operations of a path item, in listing order.
+//
+//nolint:gochecknoglobals
+var openapiMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// openapi returns the generator of the request bodies of the operations of an OpenAPI 3 specification.
+//
+// The specification (JSON or YAML) is loaded in the init context, the path is relative to the script.
+// The requestBody method of the returned object generates a JSON request body of the operation
+// (e.g. "POST /users") which is valid according to the schema: the formats, enums, required properties,
+// minimum/maximum and length constraints are honored. Local references (#/components/...) are resolved.
+func (f *faker) openapi(specPath sobek.Value) *sobek.Object {
+	const method = "openapi"
+
+	abs := f.initFilePath(method, specPath)
+
+	spec, found := openapiSpecs.Load(abs)
+	if !found {
+		parsed, reason := parseOpenAPI(abs, f.readInitFile(method, abs))
+		if len(reason) != 0 {
+			f.throw(&ArgumentError{Function: method, Parameter: "path", Expected: "OpenAPI 3 specification", Reason: reason})
+		}
+
+		spec, _ = openapiSpecs.LoadOrStore(abs, parsed)
+	}
+
+	return f.openapiObject(spec.(*openapiSpec)) //nolint:forcetypeassert
+}
+
+// parseOpenAPI returns the parsed specification, or the reason of the failure.
+// The files with .json extension are parsed as JSON, the others as YAML.
+func parseOpenAPI(filePath string, data []byte) (*openapiSpec, string) {
+	var doc map[string]any
+
+	var err error
+
+	if strings.EqualFold(path.Ext(filePath), ".json") {
+		err = json.Unmarshal(data, &doc)
+	} else {
+		err = yaml.Unmarshal(data, &doc)
+	}
+
+	if err != nil {
+		return nil, "invalid specification: " + err.Error()
+	}
+
+	if version, _ := doc["openapi"].(string); !strings.HasPrefix(version, "3.") {
+		return nil, "unsupported OpenAPI version"
+	}
+
+	spec := &openapiSpec{doc: doc}
+	paths, _ := doc["paths"].(map[string]any)
+
+	keys := make([]string, 0, len(paths))
+	for key := range paths {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		item, _ := paths[key].(map[string]any)
+
+		for _, method := range openapiMethods {
+			if _, found := item[method]; found {
+				spec.operations = append(spec.operations, strings.ToUpper(method)+" "+key)
+			}
+		}
+	}
+
+	return spec, ""
+}
+
+// openapiObject returns the JavaScript object of the specification.
+func (f *faker) openapiObject(spec *openapiSpec) *sobek.Object {
+	obj := f.runtime.NewObject()
+
+	operations := make([]any, len(spec.operations))
+	for idx, op := range spec.operations {
+		operations[idx] = op
+	}
+
+	_ = obj.Set("operations", operations)
+	_ = obj.Set("requestBody", func(operation string) any {
+		schema := f.openapiRequestSchema(spec, operation)
+
+		f.rescope()
+
+		gen := &schemaGenerator{r: f.rand, doc: spec.doc, safe: f.opts.safe}
+		body := gen.generate(schema, "", 0)
+
+		if gen.err != nil {
+			f.throw(&ArgumentError{Function: "requestBody", Parameter: "operation", Reason: gen.err.Error()})
+		}
+
+		return body
+	})
+
+	return obj
+}
+
+// openapiRequestSchema returns the schema of the JSON request body of the operation.
+func (f *faker) openapiRequestSchema(spec *openapiSpec, operation string) map[string]any {
+	const method = "requestBody"
+
+	verb, route, _ := strings.Cut(strings.TrimSpace(operation), " ")
+	paths, _ := spec.doc["paths"].(map[string]any)
+	item, _ := resolveRef(spec.doc, paths[strings.TrimSpace(route)]).(map[string]any)
+	op, _ := item[strings.ToLower(verb)].(map[string]any)
+
+	if op == nil {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "operation", Expected: "one of " + strings.Join(spec.operations, ", "),
+			Reason: "unknown operation " + operation,
+		})
+	}
+
+	body, _ := resolveRef(spec.doc, op["requestBody"]).(map[string]any)
+	content, _ := body["content"].(map[string]any)
+
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+
+	// application/json first, then the other JSON media types (e.g. application/merge-patch+json)
+	slices.SortFunc(mediaTypes, func(a, b string) int {
+		return strings.Compare(strings.TrimPrefix(a, "application/json"), strings.TrimPrefix(b, "application/json"))
+	})
+
+	for _, mediaType := range mediaTypes {
+		if !strings.HasPrefix(mediaType, "application/json") &&
+			!strings.HasSuffix(strings.Split(mediaType, ";")[0], "+json") {
+			continue
+		}
+
+		media, _ := content[mediaType].(map[string]any)
+
+		if schema, found := media["schema"]; found {
+			resolved, _ := resolveRef(spec.doc, schema).(map[string]any)
+
+			return resolved
+		}
+	}
+
+	f.throw(&ArgumentError{
+		Function: method, Parameter: "operation", Expected: "operation with JSON request body",
+		Reason: "no JSON request body of " + operation,
+	})
+
+	return nil
+}
+
+// resolveRef returns the value of the local reference ($ref: "#/components/schemas/User"),
+// or the value itself if it is not a reference.
+func resolveRef(doc map[string]any, val any) any {
+	const maxRefs = 32
+
+	for range maxRefs {
+		obj, isObject := val.(map[string]any)
+		if !isObject {
+			return val
+		}
+
+		ref, isRef := obj["$ref"].(string)
+		if !isRef || !strings.HasPrefix(ref, "#/") {
+			return val
+		}
+
+		var target any = doc
+
+		for _, token := range strings.Split(ref[2:], "/") {
+			token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+			parent, _ := target.(map[string]any)
+			target = parent[token]
+		}
+
+		val = target
+	}
+
+	return nil
+}
+
+// schemaGenerator generates values valid according to JSON Schema (OpenAPI flavor).
+type schemaGenerator struct {
+	r   *rand.Rand
+	doc map[string]any
+	// safe is set in safe mode, the identifiers are reserved ones.
+	safe bool
+	// err is the first error of the generation, if the schema can't be satisfied.
+	err error
+}
+
+// generate returns a random value of the schema. String properties without format are generated
+// by the generator function named after the property if there is such, so the body contains realistic data.
+// The nesting depth is limited by maxSchemaDepth: deeper objects are empty, deeper arrays have no items.
+func (g *schemaGenerator) generate(schema map[string]any, field string, depth int) any { //nolint:cyclop
+	if depth > maxSchemaDepth {
+		g.fail(fmt.Errorf("%w: %s exceeds depth %d", errSchemaDepth, cmp.Or(field, "body"), maxSchemaDepth))
+
+		return nil
+	}
+
+	schema = g.merge(schema, field, depth)
+
+	if values, found := schema["enum"].([]any); found && len(values) != 0 {
+		return values[g.r.Intn(len(values))]
+	}
+
+	if val, found := schema["const"]; found {
+		return val
+	}
+
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if branches, found := schema[key].([]any); found && len(branches) != 0 {
+			branch, _ := resolveRef(g.doc, branches[g.r.Intn(len(branches))]).(map[string]any)
+
+			return g.generate(branch, field, depth+1)
+		}
+	}
+
+	switch schemaType(schema) {
+	case "object":
+		if depth >= maxSchemaDepth {
+			return map[string]any{}
+		}
+
+		return g.object(schema, depth)
+	case "array":
+		return g.array(schema, field, depth)
+	case "integer":
+		return g.integer(schema)
+	case "number":
+		return g.number(schema)
+	case "boolean":
+		return g.r.Intn(2) == 1
+	case "null":
+		return nil
+	default:
+		return g.string(schema, field)
+	}
+}
+
+// merge returns the schema with the allOf subschemas merged into it.
+// The allOf subschemas nested deeper than maxSchemaDepth are not merged, the error is recorded.
+func (g *schemaGenerator) merge(schema map[string]any, field string, depth int) map[string]any {
+	all, found := schema["allOf"].([]any)
+	if !found {
+		return schema
+	}
+
+	if depth > maxSchemaDepth {
+		g.fail(fmt.Errorf("%w: allOf of %s exceeds depth %d", errSchemaDepth, cmp.Or(field, "body"), maxSchemaDepth))
+
+		return schema
+	}
+
+	merged := make(map[string]any, len(schema))
+	properties := make(map[string]any)
+
+	var required []any
+
+	subschemas := []map[string]any{schema}
+
+	for _, item := range all {
+		sub, _ := resolveRef(g.doc, item).(map[string]any)
+		subschemas = append(subschemas, g.merge(sub, field, depth+1))
+	}
+
+	for _, sub := range subschemas {
+		for key, val := range sub {
+			switch key {
+			case "allOf":
+			case "properties":
+				props, _ := val.(map[string]any)
+				for name, prop := range props {
+					properties[name] = prop
+				}
+			case "required":
+				items, _ := val.([]any)
+				required = append(required, items...)
+			default:
+				merged[key] = val
+			}
+		}
+	}
+
+	merged["properties"] = properties
+	merged["required"] = required
+
+	return merged
+}
+
+// schemaType returns the type of the schema, the first non-null type if it is a list (OpenAPI 3.1).
+func schemaType(schema map[string]any) string {
+	switch typ := schema["type"].(type) {
+	case string:
+		return typ
+	case []any:
+		for _, item := range typ {
+			if str, _ := item.(string); str != "null" {
+				return str
+			}
+		}
+
+		return "null"
+	}
+
+	if _, found := schema["properties"]; found {
+		return "object"
+	}
+
+	if _, found := schema["items"]; found {
+		return "array"
+	}
+
+	return "string"
+}
+
+// object returns an object with the required properties and some of the optional ones.
+func (g *schemaGenerator) object(schema map[string]any, depth int) map[string]any {
+	properties, _ := schema["properties"].(map[string]any)
+	required, _ := schema["required"].([]any)
+	obj := make(map[string]any, len(properties))
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	// sorted, so the same seed generates the same body
+	slices.Sort(names)
+
+	for _, name := range names {
+		prop, _ := resolveRef(g.doc, properties[name]).(map[string]any)
+
+		if readOnly, _ := prop["readOnly"].(bool); readOnly {
+			continue
+		}
+
+		if !slices.Contains(required, any(name)) && g.r.Intn(2) == 0 {
+			continue
+		}
+
+		obj[name] = g.generate(prop, name, depth+1)
+	}
+
+	return obj
+}
+
+// array returns an array of items, the number of items is between minItems and maxItems.
+// The duplicates of unique items are regenerated a bounded number of times, so the array is shorter
+// if there are not enough distinct items (e.g. booleans), the error is recorded if it is below minItems.
+// At maxSchemaDepth the array is empty, the error is recorded if minItems requires items.
+func (g *schemaGenerator) array(schema map[string]any, field string, depth int) []any {
+	const (
+		defaultMaxItems = 3
+		maxDuplicates   = 100
+	)
+
+	if depth >= maxSchemaDepth {
+		if numberOf(schema["minItems"], 0) > 0 {
+			g.fail(fmt.Errorf("%w: %s requires items at depth %d", errSchemaDepth, cmp.Or(field, "body"), maxSchemaDepth))
+		}
+
+		return []any{}
+	}
+
+	minItems := g.limit(numberOf(schema["minItems"], 1), maxSchemaItems, "minItems of "+cmp.Or(field, "body"))
+	maxItems := min(max(int(numberOf(schema["maxItems"], float64(max(minItems, defaultMaxItems)))), 0), maxSchemaItems)
+	items, _ := resolveRef(g.doc, schema["items"]).(map[string]any)
+	count := minItems + g.r.Intn(max(maxItems-minItems+1, 1))
+	unique, _ := schema["uniqueItems"].(bool)
+
+	values := make([]any, 0, count)
+
+	for duplicates := 0; len(values) < count; {
+		val := g.generate(items, field, depth+1)
+
+		if unique && slices.ContainsFunc(values, func(item any) bool { return reflect.DeepEqual(item, val) }) {
+			if duplicates++; duplicates < maxDuplicates {
+				continue
+			}
+
+			if len(values) < minItems {
+				g.fail(fmt.Errorf("%w: %s requires %d unique items, generated %d",
+					errUniqueItems, cmp.Or(field, "body"), minItems, len(values)))
+			}
+
+			break
+		}
+
+		values = append(values, val)
+	}
+
+	return values
+}
+
+// limit returns the non-negative integer value of a size constraint (e.g. minItems),
+// the error is recorded if it is above the limit, and the limit is returned.
+func (g *schemaGenerator) limit(val float64, limit int, name string) int {
+	if val > float64(limit) {
+		g.fail(fmt.Errorf("%w: %s %.0f is above %d", errSchemaLimit, name, val, limit))
+
+		return limit
+	}
+
+	return max(int(val), 0)
+}
+
+// fail records the error, if it is the first one of the generation.
+func (g *schemaGenerator) fail(err error) {
+	if g.err == nil {
+		g.err = err
+	}
+}
+
+// bounds returns the inclusive bounds of the numeric schema,
+// exclusive bounds are supported in both OpenAPI 3.0 (boolean) and 3.1 (number) style.
+func bounds(schema map[string]any, step float64, defaultMax float64) (float64, float64) {
+	low := numberOf(schema["minimum"], 0)
+	high := numberOf(schema["maximum"], math.Max(low, 0)+defaultMax)
+
+	if _, found := schema["minimum"]; !found && high < low {
+		low = high - defaultMax
+	}
+
+	switch val := schema["exclusiveMinimum"].(type) {
+	case bool:
+		if val {
+			low += step
+		}
+	case nil:
+	default:
+		low = numberOf(val, low) + step
+	}
+
+	switch val := schema["exclusiveMaximum"].(type) {
+	case bool:
+		if val {
+			high -= step
+		}
+	case nil:
+	default:
+		high = numberOf(val, high) - step
+	}
+
+	return low, high
+}
+
+func (g *schemaGenerator) integer(schema map[string]any) int64 {
+	const defaultMax = 1000
+
+	low, high := bounds(schema, 1, defaultMax)
+	minInt, maxInt := int64(math.Ceil(low)), int64(math.Floor(high))
+
+	if multiple := int64(numberOf(schema["multipleOf"], 1)); multiple > 1 {
+		first := int64(math.Ceil(float64(minInt) / float64(multiple)))
+		last := int64(math.Floor(float64(maxInt) / float64(multiple)))
+
+		if last < first {
+			return minInt
+		}
+
+		return (first + g.r.Int63n(last-first+1)) * multiple
+	}
+
+	if maxInt < minInt {
+		return minInt
+	}
+
+	return minInt + g.r.Int63n(maxInt-minInt+1)
+}
+
+func (g *schemaGenerator) number(schema map[string]any) float64 {
+	const (
+		defaultMax = 1000
+		cents      = 100
+		step       = 0.01
+	)
+
+	low, high := bounds(schema, step, defaultMax)
+
+	if multiple := numberOf(schema["multipleOf"], 0); multiple > 0 {
+		first, last := math.Ceil(low/multiple), math.Floor(high/multiple)
+
+		if last < first {
+			return low
+		}
+
+		return (first + float64(g.r.Int63n(int64(last-first)+1))) * multiple
+	}
+
+	val := math.Round((low+g.r.Float64()*(high-low))*cents) / cents
+
+	return math.Min(math.Max(val, low), high)
+}
+
+// string returns a string of the format, the pattern or the property name, within the length bounds.
+func (g *schemaGenerator) string(schema map[string]any, field string) string {
+	fake := &gofakeit.Faker{Rand: g.r}
+	format, _ := schema["format"].(string)
+
+	const maxAge = 365 * 24 * time.Hour
+
+	past := time.Now().Add(-time.Duration(g.r.Int63n(int64(maxAge)))).UTC()
+
+	// the formats have fixed structure, the length constraints are not applied
+	switch format {
+	case "email":
+		return emailAddress(g.r, g.safe)
+	case "uuid":
+		return fake.UUID()
+	case "date":
+		return past.Format(time.DateOnly)
+	case "date-time":
+		return past.Format(time.RFC3339)
+	case "time":
+		return past.Format(time.TimeOnly) + "Z"
+	case "uri", "url", "uri-reference", "iri":
+		return urlAddress(g.r, g.safe)
+	case "hostname", "idn-hostname":
+		return domainName(g.r, g.safe)
+	case "ipv4":
+		return ipv4Address(g.r, g.safe)
+	case "ipv6":
+		return ipv6Address(g.r, g.safe)
+	case "byte":
+		return base64.StdEncoding.EncodeToString([]byte(fake.LoremIpsumWord()))
+	}
+
+	var str string
+
+	switch pattern, _ := schema["pattern"].(string); {
+	case len(pattern) != 0:
+		return fake.Regex(pattern)
+	case format == "password":
+		str = fake.Password(true, true, true, false, false,
+			g.limit(numberOf(schema["minLength"], defaultPasswordLength), maxSchemaLength, "minLength of "+cmp.Or(field, "body")))
+	default:
+		str = avroString(g.r, "", field, g.safe)
+	}
+
+	minLength := g.limit(numberOf(schema["minLength"], 0), maxSchemaLength, "minLength of "+cmp.Or(field, "body"))
+
+	return fitLength(g.r, str, minLength, int(numberOf(schema["maxLength"], 0)))
+}
+
+// defaultPasswordLength is the length of the passwords generated for password format without minLength.
+const defaultPasswordLength = 12
+
+// fitLength returns the string truncated to maxLength (if positive) or padded with letters to minLength.
+func fitLength(r *rand.Rand, str string, minLength int, maxLength int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+
+	runes := []rune(str)
+
+	for len(runes) < minLength {
+		runes = append(runes, rune(letters[r.Intn(len(letters))]))
+	}
+
+	if maxLength > 0 && len(runes) > maxLength {
+		runes = runes[:maxLength]
+	}
+
+	return string(runes)
+}
+
+// numberOf returns the numeric value (JSON or YAML decoded), or the default if it is not a number.
+func numberOf(val any, def float64) float64 {
+	switch num := val.(type) {
+	case float64:
+		return num
+	case int:
+		return float64(num)
+	case int64:
+		return float64(num)
+	case uint64:
+		return float64(num)
+	default:
+		return def
+	}
+}
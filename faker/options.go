@@ -0,0 +1,159 @@
+package faker
+
+import (
+	"math"
+	"reflect"
+	goruntime "runtime"
+	"strings"
+
+	"github.com/grafana/sobek"
+)
+
+// Seed scopes of the Faker instance.
+const (
+	// scopeInstance uses the same random sequence for the whole lifetime of the instance.
+	scopeInstance = "instance"
+	// scopeVU mixes the VU ID into the seed.
+	scopeVU = "vu"
+	// scopeIteration mixes the scenario name, the VU ID and the iteration number into the seed.
+	scopeIteration = "iteration"
+)
+
+// options contains the options of the Faker constructor.
+type options struct {
+	// seed is the random seed, used when the options object is the first constructor parameter.
+	seed int64
+	// seedScope is the scope of the random seed, one of scopeInstance, scopeVU or scopeIteration.
+	seedScope string
+	// rfc3339 makes the generator functions return time values as RFC 3339 strings.
+	rfc3339 bool
+	// defaults contains the default values of the generator function parameters by lowercase parameter name,
+	// optionally prefixed with the lowercase function name (e.g. length or password.length).
+	defaults map[string]sobek.Value
+	// metrics enables the data generation metrics (faker_calls and faker_generation_time).
+	metrics bool
+	// rng is the random source, one of rngFrand (default), rngPCG64, rngXoshiro or rngCrypto.
+	rng string
+	// safe makes the generator functions generate only reserved or documentation identifiers
+	// (e.g. example.com emails, 192.0.2.0/24 addresses, 555 phone numbers and test credit cards).
+	safe bool
+	// sequenceVersion is the pinned version of the deterministic sequence algorithm, 0 if not pinned.
+	// The version is asserted by the constructor (see assertSequenceVersion), it doesn't select a behavior.
+	sequenceVersion int
+	// maxTotalBytes is the budget of the JSON size of the values generated by a bulk generation call, 0 if unlimited.
+	maxTotalBytes int64
+	// parallel is the number of worker goroutines of the bulk generation, 0 if the values are generated sequentially.
+	parallel int
+}
+
+// parseOptions parses the options object passed to the Faker constructor.
+func parseOptions(runtime *sobek.Runtime, val sobek.Value) *options {
+	opts := &options{seedScope: scopeInstance}
+
+	if !isOptions(val) {
+		return opts
+	}
+
+	obj := val.ToObject(runtime)
+
+	if v := obj.Get("seed"); v != nil {
+		opts.seed = v.ToInteger()
+	}
+
+	// A Faker instance, like any JavaScript object, can only be used by the VU which created it,
+	// so there is nothing to share between VUs or workers.
+	if v := obj.Get("threadSafe"); v != nil && v.ToBoolean() {
+		panic(runtime.NewTypeError("unsupported threadSafe: a Faker instance can only be used by the VU which created it"))
+	}
+
+	if v := obj.Get("rfc3339"); v != nil {
+		opts.rfc3339 = v.ToBoolean()
+	}
+
+	if v := obj.Get("metrics"); v != nil {
+		opts.metrics = v.ToBoolean()
+	}
+
+	if v := obj.Get("safe"); v != nil {
+		opts.safe = v.ToBoolean()
+	}
+
+	if v := obj.Get("defaults"); v != nil && !sobek.IsUndefined(v) {
+		opts.defaults = parseDefaults(runtime, v)
+	}
+
+	if v := obj.Get("rng"); v != nil && !sobek.IsUndefined(v) {
+		switch rng := v.String(); rng {
+		case rngFrand, rngPCG64, rngXoshiro, rngCrypto:
+			opts.rng = rng
+		default:
+			panic(runtime.NewTypeError("invalid rng: %s (expected frand, pcg64, xoshiro256 or crypto)", rng))
+		}
+	}
+
+	if v := obj.Get("sequenceVersion"); v != nil && !sobek.IsUndefined(v) {
+		opts.sequenceVersion = int(v.ToInteger())
+
+		if err := assertSequenceVersion(opts.sequenceVersion); err != nil {
+			panic(runtime.NewTypeError("invalid sequenceVersion: %s", err.Error()))
+		}
+	}
+
+	if v := obj.Get("maxTotalBytes"); v != nil && !sobek.IsUndefined(v) {
+		if opts.maxTotalBytes = v.ToInteger(); opts.maxTotalBytes <= 0 {
+			panic(runtime.NewTypeError("invalid maxTotalBytes: %s (expected positive number)", v.String()))
+		}
+	}
+
+	if v := obj.Get("parallel"); v != nil && !sobek.IsUndefined(v) {
+		opts.parallel = parseParallel(runtime, v)
+	}
+
+	if v := obj.Get("seedScope"); v != nil && !sobek.IsUndefined(v) {
+		switch scope := v.String(); scope {
+		case scopeInstance, scopeVU, scopeIteration:
+			opts.seedScope = scope
+		default:
+			panic(runtime.NewTypeError("invalid seedScope: %s (expected instance, vu or iteration)", scope))
+		}
+	}
+
+	return opts
+}
+
+// parseParallel parses the parallel option, true means one worker per available CPU (GOMAXPROCS).
+func parseParallel(runtime *sobek.Runtime, val sobek.Value) int {
+	if val.ExportType() != nil && val.ExportType().Kind() == reflect.Bool {
+		if val.ToBoolean() {
+			return goruntime.GOMAXPROCS(0)
+		}
+
+		return 0
+	}
+
+	workers := val.ToInteger()
+	if workers < 0 || workers > math.MaxInt32 || val.ToFloat() != float64(workers) {
+		panic(runtime.NewTypeError("invalid parallel: %s (expected boolean or non-negative integer)", val.String()))
+	}
+
+	return int(workers)
+}
+
+// parseDefaults parses the defaults option, an object containing parameter values by parameter name
+// (e.g. {length: 16, "nationalId.country": "DE"}).
+func parseDefaults(runtime *sobek.Runtime, val sobek.Value) map[string]sobek.Value {
+	if !isOptions(val) {
+		panic(runtime.NewTypeError("invalid defaults: %s (expected object)", val.String()))
+	}
+
+	obj := val.ToObject(runtime)
+	defaults := make(map[string]sobek.Value)
+
+	for _, key := range obj.Keys() {
+		if v := obj.Get(key); v != nil && !sobek.IsUndefined(v) {
+			defaults[strings.ToLower(key)] = v
+		}
+	}
+
+	return defaults
+}
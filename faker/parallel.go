@@ -0,0 +1,112 @@
+package faker
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// parallelChunkSize is the number of values generated from the same derived seed by the parallel bulk generation.
+// The values only depend on the chunk size, not on the number of workers, so they are the same on any machine.
+const parallelChunkSize = 4096
+
+// parallelChunk is a chunk of values generated by a worker of the parallel bulk generation.
+type parallelChunk struct {
+	values []any
+	err    error
+}
+
+// generateParallel generates count values of the generator function on the worker goroutines of the parallel option
+// and passes them to the consume function in order, on the calling goroutine.
+//
+// The values are generated in chunks, each chunk with its own random source seeded from a seed drawn
+// from the instance and the index of the chunk, so the result is reproducible regardless of the scheduling.
+// The generator functions only get the parameters, the JavaScript runtime is only used by the consume function.
+func (f *faker) generateParallel(
+	generate generateFunc, params *gofakeit.MapParams, info *gofakeit.Info, count int, consume func(val any),
+) error {
+	base := f.rand.Int63()
+	chunks := (count + parallelChunkSize - 1) / parallelChunkSize
+	results := make([]chan parallelChunk, chunks)
+	jobs := make(chan int, chunks)
+
+	for idx := range chunks {
+		results[idx] = make(chan parallelChunk, 1)
+		jobs <- idx
+	}
+
+	close(jobs)
+
+	var (
+		stopped atomic.Bool
+		workers sync.WaitGroup
+	)
+
+	defer stopped.Store(true)
+
+	for range min(f.opts.parallel, chunks) {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for idx := range jobs {
+				if stopped.Load() {
+					results[idx] <- parallelChunk{}
+
+					continue
+				}
+
+				size := min(parallelChunkSize, count-idx*parallelChunkSize)
+				results[idx] <- f.generateChunk(generate, params, info, chunkSeed(base, idx), size)
+			}
+		}()
+	}
+
+	for _, result := range results {
+		chunk := <-result
+		if chunk.err != nil {
+			return chunk.err
+		}
+
+		for _, val := range chunk.values {
+			consume(val)
+		}
+	}
+
+	workers.Wait()
+
+	return nil
+}
+
+// generateChunk generates a chunk of values using a new random source of the seed.
+func (f *faker) generateChunk(
+	generate generateFunc, params *gofakeit.MapParams, info *gofakeit.Info, seed int64, size int,
+) parallelChunk {
+	r := rand.New(newSource(f.opts.rng, seed)) //#nosec G404
+	values := make([]any, size)
+
+	for idx := range values {
+		val, err := generate(r, params, info)
+		if err != nil {
+			return parallelChunk{err: err}
+		}
+
+		values[idx] = f.output(val)
+	}
+
+	return parallelChunk{values: values}
+}
+
+// chunkSeed returns the seed of the chunk derived from the seed of the bulk generation call.
+func chunkSeed(base int64, chunk int) int64 {
+	hash := fnv.New64a()
+
+	_, _ = hash.Write([]byte(strconv.Itoa(chunk)))
+
+	return base ^ int64(hash.Sum64()) //nolint:gosec
+}
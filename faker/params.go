@@ -0,0 +1,224 @@
+package faker
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+// paramsDescriptor is a pre-compiled descriptor of the generator function parameters.
+type paramsDescriptor struct {
+	// numbers reports for each parameter whether it is a number.
+	numbers []bool
+	// passthrough reports for each parameter whether its type is unknown,
+	// such parameters are passed to the generator function as JSON string.
+	passthrough []bool
+	// options reports for each parameter whether an options object can be passed in its position.
+	options []bool
+	// defaults contains the parameters used when no argument is passed, nil if there are required parameters.
+	defaults *gofakeit.MapParams
+}
+
+//nolint:gochecknoglobals
+var descriptors sync.Map
+
+// describeParams returns the cached parameters descriptor of the generator function.
+func describeParams(info *gofakeit.Info) *paramsDescriptor {
+	if desc, found := descriptors.Load(info); found {
+		return desc.(*paramsDescriptor) //nolint:forcetypeassert
+	}
+
+	desc := &paramsDescriptor{
+		numbers:     make([]bool, len(info.Params)),
+		passthrough: make([]bool, len(info.Params)),
+		options:     make([]bool, len(info.Params)),
+	}
+
+	defaults := gofakeit.NewMapParams()
+
+	for idx, param := range info.Params {
+		desc.numbers[idx] = isNumberType(param.Type)
+		desc.passthrough[idx] = !isKnownType(param.Type)
+		desc.options[idx] = !strings.HasPrefix(param.Type, "map[")
+
+		switch {
+		case defaults == nil:
+		case len(param.Default) != 0:
+			defaults.Add(param.Field, param.Default)
+		case !param.Optional:
+			defaults = nil
+		}
+	}
+
+	desc.defaults = defaults
+
+	actual, _ := descriptors.LoadOrStore(info, desc)
+
+	return actual.(*paramsDescriptor) //nolint:forcetypeassert
+}
+
+// toMapParams converts the call arguments to the parameters of the generator function.
+// Missing arguments are resolved from the defaults option of the instance first, then from the parameter defaults.
+func (f *faker) toMapParams(name string, info *gofakeit.Info, call sobek.FunctionCall) *gofakeit.MapParams {
+	if len(info.Params) == 0 {
+		return nil
+	}
+
+	desc := describeParams(info)
+
+	if len(call.Arguments) == 0 && desc.defaults != nil && len(f.opts.defaults) == 0 {
+		return desc.defaults
+	}
+
+	params := gofakeit.NewMapParams()
+	args := f.arguments(name, info, desc, call)
+
+	for idx, param := range info.Params {
+		// null is an omitted parameter, so the default value applies
+		val := sobek.Undefined()
+		if idx < len(args) && args[idx] != nil && !sobek.IsNull(args[idx]) {
+			val = args[idx]
+		}
+
+		if sobek.IsUndefined(val) {
+			if def, found := f.configDefault(name, param.Field); found && !sobek.IsNull(def) {
+				val = def
+			}
+		}
+
+		if sobek.IsUndefined(val) {
+			if len(param.Default) != 0 {
+				params.Add(param.Field, param.Default)
+
+				continue
+			}
+
+			if param.Optional {
+				continue
+			}
+
+			f.throw(f.argumentError(name, info, &param, "missing parameter"))
+		}
+
+		if desc.passthrough[idx] {
+			params.Add(param.Field, f.passthrough(name, info, &param, val))
+
+			continue
+		}
+
+		var arr []string
+
+		if f.runtime.ExportTo(val, &arr) == nil {
+			(*params)[param.Field] = arr
+
+			continue
+		}
+
+		if desc.numbers[idx] && math.IsNaN(val.ToFloat()) {
+			f.throw(f.argumentError(name, info, &param, "invalid value "+val.String()))
+		}
+
+		params.Add(param.Field, val.String())
+	}
+
+	return params
+}
+
+// configDefault returns the value of the parameter from the defaults option of the instance.
+// The function specific value (e.g. password.length) takes precedence over the generic one (e.g. length).
+func (f *faker) configDefault(name string, field string) (sobek.Value, bool) {
+	if len(f.opts.defaults) == 0 {
+		return nil, false
+	}
+
+	field = strings.ToLower(field)
+
+	if val, found := f.opts.defaults[strings.ToLower(name)+"."+field]; found {
+		return val, true
+	}
+
+	val, found := f.opts.defaults[field]
+
+	return val, found
+}
+
+// passthrough converts the value of a parameter with unknown type to JSON string.
+func (f *faker) passthrough(name string, info *gofakeit.Info, param *gofakeit.Param, val sobek.Value) string {
+	f.warnOnce(name+"."+param.Field,
+		"faker: %s: parameter %s has unsupported type %s, the value is passed as JSON string",
+		name, param.Field, param.Type,
+	)
+
+	if str, ok := val.Export().(string); ok {
+		return str
+	}
+
+	data, err := json.Marshal(val.Export())
+	if err != nil {
+		f.throw(f.argumentError(name, info, param, err.Error()))
+	}
+
+	return string(data)
+}
+
+// arguments returns the call arguments in the order of the function parameters.
+// Besides the positional arguments, an options object is also accepted as the last argument
+// (e.g. nationalId("US", {valid: false})), in which case the option names are validated
+// against the function parameters not passed positionally.
+func (f *faker) arguments(
+	name string,
+	info *gofakeit.Info,
+	desc *paramsDescriptor,
+	call sobek.FunctionCall,
+) []sobek.Value {
+	last := len(call.Arguments) - 1
+	if last < 0 || last >= len(desc.options) || !desc.options[last] || !isOptions(call.Arguments[last]) {
+		return call.Arguments
+	}
+
+	obj := call.Arguments[last].ToObject(f.runtime)
+	args := make([]sobek.Value, len(info.Params))
+
+	copy(args, call.Arguments[:last])
+
+	for _, key := range obj.Keys() {
+		idx := paramIndex(info, key)
+		if idx < 0 {
+			err := &ArgumentError{Function: name, Category: info.Category, Parameter: key, Reason: "unknown option"}
+
+			f.throw(err)
+		}
+
+		if idx < last {
+			err := &ArgumentError{Function: name, Category: info.Category, Parameter: key, Reason: "already passed as argument"}
+
+			f.throw(err)
+		}
+
+		args[idx] = obj.Get(key)
+	}
+
+	return args
+}
+
+// isOptions reports whether the value is a plain object which can be used as an options object.
+func isOptions(val sobek.Value) bool {
+	obj, ok := val.(*sobek.Object)
+
+	return ok && obj.ClassName() == "Object"
+}
+
+// paramIndex returns the index of the parameter with the given (case insensitive) name or -1.
+func paramIndex(info *gofakeit.Info, name string) int {
+	for idx, param := range info.Params {
+		if strings.EqualFold(param.Field, name) {
+			return idx
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,322 @@
+package faker
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// parquetMagic is the magic number at the beginning and at the end of Parquet files.
+const parquetMagic = "PAR1"
+
+// parquetCreatedBy is the name of the application writing the Parquet files.
+const parquetCreatedBy = "xk6-faker"
+
+// Parquet physical types, repetition types, converted types, encodings and page types.
+const (
+	parquetBoolean   = 0
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+	parquetOptional  = 1
+	parquetUTF8      = 0
+	parquetPlain     = 0
+	parquetRLE       = 3
+	parquetDataPage  = 0
+	parquetFormat    = 1
+)
+
+// Thrift compact protocol types, the Parquet metadata is encoded using the compact protocol.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// parquetChunk is the position of a column chunk in the Parquet file.
+type parquetChunk struct {
+	offset int
+	size   int
+}
+
+// encodeParquet encodes the columns in Parquet format. The file contains one row group,
+// every column is an optional column of a primitive type, stored uncompressed in plain encoding
+// in data pages of at most columnarBatchRows values.
+func encodeParquet(columns []*column, rows int) ([]byte, error) {
+	buff := []byte(parquetMagic)
+	chunks := make([]parquetChunk, len(columns))
+
+	for idx, col := range columns {
+		chunks[idx].offset = len(buff)
+
+		for from := 0; from < rows; from += columnarBatchRows {
+			to := min(from+columnarBatchRows, rows)
+
+			page := col.parquetPage(from, to)
+			if len(page) > math.MaxInt32 {
+				return nil, errColumnTooLarge
+			}
+
+			buff = append(buff, parquetPageHeader(to-from, len(page))...)
+			buff = append(buff, page...)
+		}
+
+		chunks[idx].size = len(buff) - chunks[idx].offset
+	}
+
+	meta := parquetMetadata(columns, rows, chunks)
+
+	buff = append(buff, meta...)
+	buff = binary.LittleEndian.AppendUint32(buff, uint32(len(meta))) //nolint:gosec
+
+	return append(buff, parquetMagic...), nil
+}
+
+// parquetType returns the physical type of the column.
+func (c *column) parquetType() int32 {
+	switch c.kind {
+	case columnBoolean:
+		return parquetBoolean
+	case columnInt64:
+		return parquetInt64
+	case columnDouble:
+		return parquetDouble
+	default:
+		return parquetByteArray
+	}
+}
+
+// parquetPage returns the data of the page containing the values of the rows from and to (exclusive):
+// the definition levels (1 for values, 0 for nulls) in RLE encoding prefixed with their length,
+// followed by the values in plain encoding.
+func (c *column) parquetPage(from, to int) []byte {
+	levels := appendLevels(nil, c.valid[from:to])
+
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(levels))) //nolint:gosec
+	page = append(page, levels...)
+
+	switch c.kind {
+	case columnBoolean:
+		values := make([]bool, 0, to-from)
+
+		for idx := from; idx < to; idx++ {
+			if c.valid[idx] {
+				values = append(values, c.bools[idx])
+			}
+		}
+
+		page = appendBitmap(page, values)
+	case columnInt64:
+		for idx := from; idx < to; idx++ {
+			if c.valid[idx] {
+				page = binary.LittleEndian.AppendUint64(page, uint64(c.ints[idx])) //nolint:gosec
+			}
+		}
+	case columnDouble:
+		for idx := from; idx < to; idx++ {
+			if c.valid[idx] {
+				page = binary.LittleEndian.AppendUint64(page, math.Float64bits(c.floats[idx]))
+			}
+		}
+	case columnString:
+		for idx := from; idx < to; idx++ {
+			if c.valid[idx] {
+				page = binary.LittleEndian.AppendUint32(page, uint32(len(c.strings[idx]))) //nolint:gosec
+				page = append(page, c.strings[idx]...)
+			}
+		}
+	}
+
+	return page
+}
+
+// appendLevels appends the definition levels (bit width 1) to the buffer as runs of the RLE encoding.
+func appendLevels(buff []byte, valid []bool) []byte {
+	for idx := 0; idx < len(valid); {
+		run := 1
+
+		for idx+run < len(valid) && valid[idx+run] == valid[idx] {
+			run++
+		}
+
+		buff = binary.AppendUvarint(buff, uint64(run)<<1) //nolint:gosec
+
+		if valid[idx] {
+			buff = append(buff, 1)
+		} else {
+			buff = append(buff, 0)
+		}
+
+		idx += run
+	}
+
+	return buff
+}
+
+// parquetPageHeader returns the header of an uncompressed data page.
+func parquetPageHeader(values int, size int) []byte {
+	var w thriftWriter
+
+	w.open()
+	w.i32(1, parquetDataPage)
+	w.i32(2, int32(size)) //nolint:gosec
+	w.i32(3, int32(size)) //nolint:gosec
+	w.begin(5)
+	w.i32(1, int32(values)) //nolint:gosec
+	w.i32(2, parquetPlain)
+	w.i32(3, parquetRLE)
+	w.i32(4, parquetRLE)
+	w.end()
+	w.end()
+
+	return w.buff
+}
+
+// parquetMetadata returns the file metadata: the schema and the row group containing the column chunks.
+func parquetMetadata(columns []*column, rows int, chunks []parquetChunk) []byte {
+	var w thriftWriter
+
+	w.open()
+	w.i32(1, parquetFormat)
+	w.list(2, thriftStruct, len(columns)+1)
+
+	w.open()
+	w.binary(4, "schema")
+	w.i32(5, int32(len(columns))) //nolint:gosec
+	w.end()
+
+	for _, col := range columns {
+		w.open()
+		w.i32(1, col.parquetType())
+		w.i32(3, parquetOptional)
+		w.binary(4, col.name)
+
+		if col.kind == columnString {
+			w.i32(6, parquetUTF8)
+			w.begin(10) // LogicalType
+			w.begin(1)  // StringType
+			w.end()
+			w.end()
+		}
+
+		w.end()
+	}
+
+	w.i64(3, int64(rows))
+	w.list(4, thriftStruct, 1)
+	w.open()
+	w.list(1, thriftStruct, len(columns))
+
+	var total int
+
+	for idx, col := range columns {
+		total += chunks[idx].size
+
+		w.open()
+		w.i64(2, int64(chunks[idx].offset))
+		w.begin(3)
+		w.i32(1, col.parquetType())
+		w.list(2, thriftI32, 2)
+		w.element(parquetPlain)
+		w.element(parquetRLE)
+		w.list(3, thriftBinary, 1)
+		w.text(col.name)
+		w.i32(4, 0) // uncompressed
+		w.i64(5, int64(rows))
+		w.i64(6, int64(chunks[idx].size))
+		w.i64(7, int64(chunks[idx].size))
+		w.i64(9, int64(chunks[idx].offset))
+		w.end()
+		w.end()
+	}
+
+	w.i64(2, int64(total))
+	w.i64(3, int64(rows))
+	w.end()
+	w.binary(6, parquetCreatedBy)
+	w.end()
+
+	return w.buff
+}
+
+// thriftWriter writes structures in Thrift compact protocol.
+type thriftWriter struct {
+	buff []byte
+	// last contains the last field IDs of the open structures.
+	last []int16
+}
+
+// field writes the header of the field, the field ID is written as delta of the previous one if possible.
+func (w *thriftWriter) field(id int16, typ byte) {
+	const maxDelta = 15
+
+	last := &w.last[len(w.last)-1]
+
+	if delta := id - *last; delta > 0 && delta <= maxDelta {
+		w.buff = append(w.buff, byte(delta)<<4|typ)
+	} else {
+		w.buff = append(w.buff, typ)
+		w.buff = binary.AppendVarint(w.buff, int64(id))
+	}
+
+	*last = id
+}
+
+func (w *thriftWriter) i32(id int16, val int32) {
+	w.field(id, thriftI32)
+	w.element(val)
+}
+
+func (w *thriftWriter) i64(id int16, val int64) {
+	w.field(id, thriftI64)
+	w.buff = binary.AppendVarint(w.buff, val)
+}
+
+func (w *thriftWriter) binary(id int16, val string) {
+	w.field(id, thriftBinary)
+	w.text(val)
+}
+
+// list writes the header of a list field, the elements must be written after it.
+func (w *thriftWriter) list(id int16, elem byte, size int) {
+	const maxShortSize = 15
+
+	w.field(id, thriftList)
+
+	if size < maxShortSize {
+		w.buff = append(w.buff, byte(size)<<4|elem) //nolint:gosec
+
+		return
+	}
+
+	w.buff = binary.AppendUvarint(append(w.buff, 0xf0|elem), uint64(size)) //nolint:gosec
+}
+
+// element writes an i32 list element.
+func (w *thriftWriter) element(val int32) {
+	w.buff = binary.AppendVarint(w.buff, int64(val))
+}
+
+// text writes a binary list element.
+func (w *thriftWriter) text(val string) {
+	w.buff = binary.AppendUvarint(w.buff, uint64(len(val)))
+	w.buff = append(w.buff, val...)
+}
+
+// begin writes the header of a structure field and opens the structure.
+func (w *thriftWriter) begin(id int16) {
+	w.field(id, thriftStruct)
+	w.open()
+}
+
+// open opens a structure (e.g. a list element), its fields must be written after it.
+func (w *thriftWriter) open() {
+	w.last = append(w.last, 0)
+}
+
+// end closes the structure.
+func (w *thriftWriter) end() {
+	w.buff = append(w.buff, 0)
+	w.last = w.last[:len(w.last)-1]
+}
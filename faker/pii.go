@@ -0,0 +1,170 @@
+package faker
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/grafana/sobek"
+)
+
+// Markers of the values generated by redactable generator functions.
+// The marked value looks like [[pii:email]]linda.garcia@example.com[[/pii]].
+const (
+	piiMarkerStart = "[[pii:"
+	piiMarkerEnd   = "[[/pii]]"
+)
+
+// piiClasses contains the personally identifiable information classes of the generator functions.
+//
+//nolint:gochecknoglobals
+var piiClasses = map[string]string{
+	"firstName":                 "name",
+	"middleName":                "name",
+	"lastName":                  "name",
+	"name":                      "name",
+	"demographicFirstName":      "name",
+	"demographicLastName":       "name",
+	"email":                     "email",
+	"disposableEmail":           "email",
+	"emailPlusTag":              "email",
+	"emailWithDomain":           "email",
+	"phone":                     "phone",
+	"phoneFormatted":            "phone",
+	"ssn":                       "ssn",
+	"nationalId":                "nationalId",
+	"gender":                    "gender",
+	"demographicAge":            "age",
+	"address":                   "address",
+	"street":                    "address",
+	"streetName":                "address",
+	"streetNumber":              "address",
+	"zip":                       "address",
+	"latitude":                  "location",
+	"longitude":                 "location",
+	"latitudeRange":             "location",
+	"longitudeRange":            "location",
+	"creditCard":                "creditCard",
+	"creditCardNumber":          "creditCard",
+	"creditCardNumberFormatted": "creditCard",
+	"creditCardCVV":             "creditCard",
+	"creditCardExp":             "creditCard",
+	"achAccountNumber":          "bankAccount",
+	"username":                  "username",
+	"usernamePolicy":            "username",
+	"password":                  "password",
+	"passwordPolicy":            "password",
+	"ipv4Address":               "ip",
+	"ipv6Address":               "ip",
+	"ipInCidr":                  "ip",
+	"bitcoinPrivateKey":         "secret",
+	"sshKeyPair":                "secret",
+	"person":                    "person",
+	"demographicPerson":         "person",
+	"patient":                   "health",
+	"fhirPatient":               "health",
+}
+
+// GetPIIClasses returns the personally identifiable information classes (e.g. name, email, ssn)
+// by function name. Functions not generating personally identifiable information are not included.
+func GetPIIClasses() map[string]string {
+	return piiClasses
+}
+
+// describe returns the metadata of the generator function: name, category, description, example,
+// output type, parameters and personally identifiable information class (pii, null if none).
+func (f *faker) describe(function sobek.Value) *sobek.Object {
+	name, info := f.lookup("describe", function)
+
+	params := make([]any, len(info.Params))
+
+	for idx, param := range info.Params {
+		desc := map[string]any{
+			"name":        param.Field,
+			"type":        jsType(param.Type),
+			"optional":    param.Optional,
+			"description": param.Description,
+		}
+
+		if len(param.Default) != 0 {
+			desc["default"] = param.Default
+		}
+
+		if len(param.Options) != 0 {
+			options := make([]any, len(param.Options))
+
+			for i, option := range param.Options {
+				options[i] = option
+			}
+
+			desc["options"] = options
+		}
+
+		params[idx] = desc
+	}
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("name", name)
+	_ = obj.Set("category", info.Category)
+	_ = obj.Set("description", info.Description)
+	_ = obj.Set("example", info.Example)
+	_ = obj.Set("output", jsType(info.Output))
+	_ = obj.Set("params", params)
+
+	if class, found := piiClasses[resolveFunc(name)]; found {
+		_ = obj.Set("pii", class)
+	} else {
+		_ = obj.Set("pii", sobek.Null())
+	}
+
+	return obj
+}
+
+// redactable returns the generator function producing values with embedded redaction markers
+// ([[pii:class]]value[[/pii]]), so downstream tooling can scrub them from logs and result exports.
+// The items of object and array values are marked one by one. Values of generator functions
+// without personally identifiable information class are returned as is.
+func (f *faker) redactable(function sobek.Value) sobek.Value {
+	name, info := f.lookup("redactable", function)
+	class, found := piiClasses[resolveFunc(name)]
+
+	return f.runtime.ToValue(func(call sobek.FunctionCall) sobek.Value {
+		val := f.invoke(name, info, call)
+		if !found {
+			return val
+		}
+
+		return f.toValue(markPII(val.Export(), class))
+	})
+}
+
+// markPII returns the value with the strings and numbers wrapped in redaction markers of the class.
+// Numbers are converted to marked strings, other values are returned as is.
+func markPII(val any, class string) any {
+	switch typed := val.(type) {
+	case string:
+		return piiMarkerStart + class + "]]" + typed + piiMarkerEnd
+	case map[string]any:
+		marked := make(map[string]any, len(typed))
+
+		for key, item := range typed {
+			marked[key] = markPII(item, class)
+		}
+
+		return marked
+	case []any:
+		marked := make([]any, len(typed))
+
+		for idx, item := range typed {
+			marked[idx] = markPII(item, class)
+		}
+
+		return marked
+	default:
+		if rval := reflect.ValueOf(val); rval.CanInt() || rval.CanUint() || rval.CanFloat() {
+			return markPII(fmt.Sprint(val), class)
+		}
+
+		return val
+	}
+}
@@ -0,0 +1,759 @@
+package faker
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var errInvalidProto = errors.New("invalid proto definition")
+
+// protoWireTypes contains the wire types of the scalar value types.
+var protoWireTypes = map[string]int{ //nolint:gochecknoglobals
+	"int32": 0, "int64": 0, "uint32": 0, "uint64": 0, "sint32": 0, "sint64": 0, "bool": 0,
+	"fixed64": 1, "sfixed64": 1, "double": 1,
+	"string": 2, "bytes": 2,
+	"fixed32": 5, "sfixed32": 5, "float": 5,
+}
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// protoFile is a parsed .proto definition.
+type protoFile struct {
+	messages map[string]*protoMessage
+	enums    map[string]*protoEnum
+	// roots contains the top level messages in order of declaration.
+	roots []*protoMessage
+}
+
+// protoMessage is a message type of the definition.
+type protoMessage struct {
+	// name is the dot separated path of the message, without the package name.
+	name   string
+	fields []*protoField
+	nested []*protoMessage
+	// index is the path of declaration indexes, used by the schema registry wire format.
+	index []int
+}
+
+// protoField is a field of a message type or the key or value of a map field.
+type protoField struct {
+	name     string
+	jsonName string
+	number   int
+	// typ is the name of the scalar type or the referenced message or enum type.
+	typ      string
+	scope    string
+	repeated bool
+	message  *protoMessage
+	enum     *protoEnum
+	// key and value are the fields of the entries of map fields.
+	key   *protoField
+	value *protoField
+}
+
+// protoEnum is an enum type of the definition.
+type protoEnum struct {
+	values map[string]int64
+}
+
+// parseProto parses the proto2/proto3 definition. Services, options and extensions are ignored.
+func parseProto(src string) (*protoFile, error) {
+	parser := &protoParser{
+		tokens: protoTokens(src),
+		file: &protoFile{
+			messages: make(map[string]*protoMessage),
+			enums:    make(map[string]*protoEnum),
+		},
+	}
+
+	if err := parser.parseFile(); err != nil {
+		return nil, err
+	}
+
+	if len(parser.file.roots) == 0 {
+		return nil, fmt.Errorf("%w: no message type", errInvalidProto)
+	}
+
+	for _, field := range parser.fields {
+		if err := parser.file.resolve(field); err != nil {
+			return nil, err
+		}
+	}
+
+	return parser.file, nil
+}
+
+// message returns the message type of the name, the first top level message if the name is empty.
+func (f *protoFile) message(name string) (*protoMessage, error) {
+	if len(name) == 0 {
+		return f.roots[0], nil
+	}
+
+	if msg, found := f.messages[name]; found {
+		return msg, nil
+	}
+
+	for full, msg := range f.messages {
+		if strings.HasSuffix(full, "."+name) {
+			return msg, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown message type %s", errInvalidProto, name)
+}
+
+// resolve sets the referenced message or enum type of the field using the scoping rules of protobuf.
+func (f *protoFile) resolve(field *protoField) error {
+	if _, scalar := protoWireTypes[field.typ]; scalar {
+		return nil
+	}
+
+	// package qualified names are tried without the leading package components too
+	for name := strings.TrimPrefix(field.typ, "."); len(name) != 0; {
+		for scope := field.scope; ; scope = scope[:max(strings.LastIndexByte(scope, '.'), 0)] {
+			full := name
+			if len(scope) != 0 {
+				full = scope + "." + name
+			}
+
+			if msg, found := f.messages[full]; found {
+				field.message = msg
+
+				return nil
+			}
+
+			if enum, found := f.enums[full]; found {
+				field.enum = enum
+
+				return nil
+			}
+
+			if len(scope) == 0 {
+				break
+			}
+		}
+
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	return fmt.Errorf("%w: unknown type %s of field %s", errInvalidProto, field.typ, field.name)
+}
+
+// protoTokens splits the definition into identifiers, numbers, strings and symbols, skipping the comments.
+func protoTokens(src string) []string {
+	var tokens []string
+
+	isWord := func(chr byte) bool {
+		return chr == '_' || chr == '.' ||
+			(chr >= '0' && chr <= '9') || (chr >= 'a' && chr <= 'z') || (chr >= 'A' && chr <= 'Z')
+	}
+
+	for idx := 0; idx < len(src); {
+		start := idx
+
+		switch chr := src[idx]; {
+		case chr == ' ' || chr == '\t' || chr == '\n' || chr == '\r':
+			idx++
+
+			continue
+		case strings.HasPrefix(src[idx:], "//"):
+			if end := strings.IndexByte(src[idx:], '\n'); end >= 0 {
+				idx += end
+			} else {
+				idx = len(src)
+			}
+
+			continue
+		case strings.HasPrefix(src[idx:], "/*"):
+			if end := strings.Index(src[idx+2:], "*/"); end >= 0 {
+				idx += len("/*") + end + len("*/")
+			} else {
+				idx = len(src)
+			}
+
+			continue
+		case chr == '"' || chr == '\'':
+			for idx++; idx < len(src) && src[idx] != chr; idx++ {
+				if src[idx] == '\\' {
+					idx++
+				}
+			}
+
+			idx++
+		case isWord(chr) || (chr == '-' && idx+1 < len(src) && isWord(src[idx+1])):
+			idx++
+
+			for idx < len(src) && isWord(src[idx]) {
+				idx++
+			}
+		default:
+			idx++
+		}
+
+		tokens = append(tokens, src[start:min(idx, len(src))])
+	}
+
+	return tokens
+}
+
+type protoParser struct {
+	tokens []string
+	pos    int
+	file   *protoFile
+	// fields contains the fields to be resolved after parsing.
+	fields []*protoField
+}
+
+func (p *protoParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+
+	p.pos++
+
+	return p.tokens[p.pos-1]
+}
+
+func (p *protoParser) expect(want string) error {
+	if tok := p.next(); tok != want {
+		return p.unexpected(tok, want)
+	}
+
+	return nil
+}
+
+func (p *protoParser) unexpected(tok string, want string) error {
+	if len(tok) == 0 {
+		return fmt.Errorf("%w: unexpected end, expected %s", errInvalidProto, want)
+	}
+
+	return fmt.Errorf("%w: unexpected %s, expected %s", errInvalidProto, tok, want)
+}
+
+// skipStatement skips the tokens until the end of the statement, including blocks.
+func (p *protoParser) skipStatement() error {
+	for {
+		switch tok := p.next(); tok {
+		case ";":
+			return nil
+		case "{":
+			return p.skipBlock()
+		case "":
+			return p.unexpected(tok, ";")
+		}
+	}
+}
+
+// skipBlock skips the tokens until the end of the current block.
+func (p *protoParser) skipBlock() error {
+	for depth := 1; depth > 0; {
+		switch tok := p.next(); tok {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		case "":
+			return p.unexpected(tok, "}")
+		}
+	}
+
+	return nil
+}
+
+func (p *protoParser) parseFile() error {
+	for {
+		switch tok := p.next(); tok {
+		case "":
+			return nil
+		case ";":
+		case "syntax", "edition", "package", "import", "option", "service", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		case "message":
+			msg, err := p.parseMessage("", []int{len(p.file.roots)})
+			if err != nil {
+				return err
+			}
+
+			p.file.roots = append(p.file.roots, msg)
+		case "enum":
+			if err := p.parseEnum(""); err != nil {
+				return err
+			}
+		default:
+			return p.unexpected(tok, "message")
+		}
+	}
+}
+
+func (p *protoParser) parseMessage(scope string, index []int) (*protoMessage, error) { //nolint:cyclop
+	name := p.next()
+	if len(scope) != 0 {
+		name = scope + "." + name
+	}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	msg := &protoMessage{name: name, index: index}
+	p.file.messages[name] = msg
+
+	for {
+		var err error
+
+		switch tok := p.next(); tok {
+		case "}":
+			sort.Slice(msg.fields, func(i, j int) bool { return msg.fields[i].number < msg.fields[j].number })
+
+			return msg, nil
+		case "":
+			return nil, p.unexpected(tok, "}")
+		case ";":
+		case "message":
+			var nested *protoMessage
+
+			if nested, err = p.parseMessage(name, append(slices.Clone(index), len(msg.nested))); err == nil {
+				msg.nested = append(msg.nested, nested)
+			}
+		case "enum":
+			err = p.parseEnum(name)
+		case "option", "reserved", "extensions", "extend":
+			err = p.skipStatement()
+		case "oneof":
+			err = p.parseOneof(msg)
+		case "map":
+			err = p.parseMapField(msg)
+		default:
+			err = p.parseField(msg, tok)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *protoParser) parseOneof(msg *protoMessage) error {
+	p.next() // name of the oneof
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for {
+		var err error
+
+		switch tok := p.next(); tok {
+		case "}":
+			return nil
+		case "":
+			return p.unexpected(tok, "}")
+		case "option":
+			err = p.skipStatement()
+		default:
+			err = p.parseField(msg, tok)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseField parses the field declaration starting with the token: [label] type name = number [options];
+func (p *protoParser) parseField(msg *protoMessage, tok string) error {
+	field := &protoField{scope: msg.name}
+
+	switch tok {
+	case "repeated":
+		field.repeated = true
+		tok = p.next()
+	case "optional", "required":
+		tok = p.next()
+	}
+
+	field.typ = tok
+
+	if err := p.parseFieldTail(field); err != nil {
+		return err
+	}
+
+	msg.fields = append(msg.fields, field)
+	p.fields = append(p.fields, field)
+
+	return nil
+}
+
+// parseMapField parses the map field declaration: map<key, value> name = number [options];
+func (p *protoParser) parseMapField(msg *protoMessage) error {
+	field := &protoField{scope: msg.name, typ: "map"}
+
+	if err := p.expect("<"); err != nil {
+		return err
+	}
+
+	field.key = &protoField{name: "key", number: 1, typ: p.next()}
+
+	if err := p.expect(","); err != nil {
+		return err
+	}
+
+	field.value = &protoField{name: "value", number: 2, typ: p.next(), scope: msg.name}
+
+	if err := p.expect(">"); err != nil {
+		return err
+	}
+
+	if err := p.parseFieldTail(field); err != nil {
+		return err
+	}
+
+	msg.fields = append(msg.fields, field)
+	p.fields = append(p.fields, field.value)
+
+	return nil
+}
+
+// parseFieldTail parses the rest of the field declaration after the type: name = number [options];
+func (p *protoParser) parseFieldTail(field *protoField) error {
+	field.name = p.next()
+	field.jsonName = protoJSONName(field.name)
+
+	if err := p.expect("="); err != nil {
+		return err
+	}
+
+	tok := p.next()
+
+	number, err := strconv.Atoi(tok)
+	if err != nil || number <= 0 {
+		return p.unexpected(tok, "field number")
+	}
+
+	field.number = number
+
+	if tok = p.next(); tok == "[" {
+		for tok != "]" && len(tok) != 0 {
+			tok = p.next()
+		}
+
+		tok = p.next()
+	}
+
+	if tok != ";" {
+		return p.unexpected(tok, ";")
+	}
+
+	return nil
+}
+
+func (p *protoParser) parseEnum(scope string) error {
+	name := p.next()
+	if len(scope) != 0 {
+		name = scope + "." + name
+	}
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	enum := &protoEnum{values: make(map[string]int64)}
+	p.file.enums[name] = enum
+
+	for {
+		switch tok := p.next(); tok {
+		case "}":
+			return nil
+		case "":
+			return p.unexpected(tok, "}")
+		case ";":
+		case "option", "reserved":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			if err := p.expect("="); err != nil {
+				return err
+			}
+
+			num := p.next()
+
+			value, err := strconv.ParseInt(num, 0, 32)
+			if err != nil {
+				return p.unexpected(num, "enum value")
+			}
+
+			enum.values[tok] = value
+
+			for num != ";" && len(num) != 0 {
+				num = p.next()
+			}
+		}
+	}
+}
+
+// protoJSONName returns the JSON name of the field: the lowerCamelCase version of the snake_case name.
+func protoJSONName(name string) string {
+	var buff strings.Builder
+
+	upper := false
+
+	for _, chr := range name {
+		switch {
+		case chr == '_':
+			upper = true
+		case upper:
+			buff.WriteString(strings.ToUpper(string(chr)))
+
+			upper = false
+		default:
+			buff.WriteRune(chr)
+		}
+	}
+
+	return buff.String()
+}
+
+// field returns the field of the message by its name or JSON name.
+func (m *protoMessage) field(name string) *protoField {
+	for _, field := range m.fields {
+		if field.name == name || field.jsonName == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// encode appends the record in protobuf binary encoding to buff.
+// The fields of the record are referenced by name or JSON name, null values are omitted.
+func (m *protoMessage) encode(buff []byte, val any) ([]byte, error) {
+	record, ok := val.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w %v (expected %s message)", errInvalidValue, val, m.name)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(record)) {
+		if m.field(name) == nil {
+			return nil, fmt.Errorf("%s: %w: unknown field of %s message", name, errInvalidValue, m.name)
+		}
+	}
+
+	for _, field := range m.fields {
+		value, found := record[field.name]
+		if !found {
+			value = record[field.jsonName]
+		}
+
+		if value == nil {
+			continue
+		}
+
+		var err error
+
+		if buff, err = field.encode(buff, value); err != nil {
+			return nil, fmt.Errorf("%s: %w", field.name, err)
+		}
+	}
+
+	return buff, nil
+}
+
+// encode appends the field with the value, repeated scalar numeric fields are packed.
+func (f *protoField) encode(buff []byte, val any) ([]byte, error) {
+	if f.key != nil {
+		return f.encodeMap(buff, val)
+	}
+
+	if !f.repeated {
+		return f.encodeSingle(buff, val)
+	}
+
+	items, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w %v (expected array)", errInvalidValue, val)
+	}
+
+	if wire := f.wireType(); wire == wireBytes {
+		for idx, item := range items {
+			var err error
+
+			if buff, err = f.encodeSingle(buff, item); err != nil {
+				return nil, fmt.Errorf("[%d]: %w", idx, err)
+			}
+		}
+
+		return buff, nil
+	}
+
+	var packed []byte
+
+	for idx, item := range items {
+		var err error
+
+		if packed, err = f.appendValue(packed, item); err != nil {
+			return nil, fmt.Errorf("[%d]: %w", idx, err)
+		}
+	}
+
+	if len(packed) == 0 {
+		return buff, nil
+	}
+
+	return appendProtoBytes(appendProtoTag(buff, f.number, wireBytes), packed), nil
+}
+
+func (f *protoField) encodeMap(buff []byte, val any) ([]byte, error) {
+	entries, ok := val.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w %v (expected object)", errInvalidValue, val)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		entry, err := f.key.encodeSingle(nil, key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		if entry, err = f.value.encodeSingle(entry, entries[key]); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		buff = appendProtoBytes(appendProtoTag(buff, f.number, wireBytes), entry)
+	}
+
+	return buff, nil
+}
+
+func (f *protoField) encodeSingle(buff []byte, val any) ([]byte, error) {
+	return f.appendValue(appendProtoTag(buff, f.number, f.wireType()), val)
+}
+
+func (f *protoField) wireType() int {
+	switch {
+	case f.message != nil:
+		return wireBytes
+	case f.enum != nil:
+		return wireVarint
+	default:
+		return protoWireTypes[f.typ]
+	}
+}
+
+// appendValue appends the value of the field without tag.
+func (f *protoField) appendValue(buff []byte, val any) ([]byte, error) { //nolint:cyclop
+	invalid := func() ([]byte, error) {
+		return nil, fmt.Errorf("%w %v (expected %s)", errInvalidValue, val, f.typ)
+	}
+
+	switch {
+	case f.message != nil:
+		data, err := f.message.encode(nil, val)
+		if err != nil {
+			return nil, err
+		}
+
+		return appendProtoBytes(buff, data), nil
+	case f.enum != nil:
+		num, ok := integerOf(val)
+		if name, isName := val.(string); isName {
+			num, ok = f.enum.values[name]
+		}
+
+		if !ok {
+			return invalid()
+		}
+
+		return binary.AppendUvarint(buff, uint64(num)), nil //nolint:gosec
+	}
+
+	switch f.typ {
+	case "string", "bytes":
+		data, ok := bytesOf(val)
+		if !ok || (f.typ == "string" && !isString(val)) {
+			return invalid()
+		}
+
+		return appendProtoBytes(buff, data), nil
+	case "bool":
+		flag, ok := val.(bool)
+		if !ok {
+			flag, ok = map[string]bool{"true": true, "false": false}[fmt.Sprint(val)]
+		}
+
+		if !ok {
+			return invalid()
+		}
+
+		if flag {
+			return append(buff, 1), nil
+		}
+
+		return append(buff, 0), nil
+	case "float", "double":
+		num, ok := floatOf(val)
+		if !ok {
+			return invalid()
+		}
+
+		if f.typ == "float" {
+			return binary.LittleEndian.AppendUint32(buff, math.Float32bits(float32(num))), nil
+		}
+
+		return binary.LittleEndian.AppendUint64(buff, math.Float64bits(num)), nil
+	case "uint32", "uint64", "fixed32", "fixed64":
+		num, ok := unsignedOf(val)
+		if !ok || (strings.HasSuffix(f.typ, "32") && num > math.MaxUint32) {
+			return invalid()
+		}
+
+		return appendProtoInteger(buff, f.typ, num), nil
+	default:
+		num, ok := integerOf(val)
+		if !ok || (strings.HasSuffix(f.typ, "32") && (num < math.MinInt32 || num > math.MaxInt32)) {
+			return invalid()
+		}
+
+		if f.typ == "sint32" || f.typ == "sint64" {
+			return binary.AppendVarint(buff, num), nil
+		}
+
+		return appendProtoInteger(buff, f.typ, uint64(num)), nil //nolint:gosec
+	}
+}
+
+// appendProtoInteger appends the two's complement integer according to the wire type of the scalar type.
+func appendProtoInteger(buff []byte, typ string, num uint64) []byte {
+	switch protoWireTypes[typ] {
+	case wireFixed32:
+		return binary.LittleEndian.AppendUint32(buff, uint32(num)) //nolint:gosec
+	case wireFixed64:
+		return binary.LittleEndian.AppendUint64(buff, num)
+	default:
+		return binary.AppendUvarint(buff, num)
+	}
+}
+
+func appendProtoTag(buff []byte, number int, wire int) []byte {
+	const wireBits = 3
+
+	return binary.AppendUvarint(buff, uint64(number)<<wireBits|uint64(wire)) //nolint:gosec
+}
+
+func appendProtoBytes(buff []byte, data []byte) []byte {
+	return append(binary.AppendUvarint(buff, uint64(len(data))), data...)
+}
@@ -0,0 +1,703 @@
+package faker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+var (
+	errQRContentTooLong  = errors.New("content too long for QR code")
+	errUnknownQRContent  = errors.New("unknown QR content type")
+	errInvalidQRCodeSize = errors.New("QR code image size must be between 1 and 4096 pixels")
+)
+
+// QR code content types.
+const (
+	qrContentAny   = "any"
+	qrContentURL   = "url"
+	qrContentWifi  = "wifi"
+	qrContentVCard = "vcard"
+)
+
+// qrBlocks describes the error correction blocks of a QR code version at error correction level M.
+type qrBlocks struct {
+	// ecc is the number of error correction codewords of a block.
+	ecc int
+	// short is the number of blocks of data codewords, the rest of the blocks have one more data codeword.
+	short     int
+	shortData int
+	long      int
+}
+
+// qrVersions contains the error correction blocks of the QR code versions 1-15 at error correction level M.
+//
+//nolint:gochecknoglobals,mnd
+var qrVersions = []qrBlocks{
+	{10, 1, 16, 0}, {16, 1, 28, 0}, {26, 1, 44, 0}, {18, 2, 32, 0}, {24, 2, 43, 0},
+	{16, 4, 27, 0}, {18, 4, 31, 0}, {22, 2, 38, 2}, {22, 3, 36, 2}, {26, 4, 43, 1},
+	{30, 1, 50, 4}, {22, 6, 36, 2}, {22, 8, 37, 1}, {24, 4, 40, 5}, {24, 5, 41, 5},
+}
+
+// dataCodewords returns the number of data codewords of the version.
+func (b qrBlocks) dataCodewords() int {
+	return b.short*b.shortData + b.long*(b.shortData+1)
+}
+
+func init() {
+	gofakeit.AddFuncLookup("qrcontent", gofakeit.Info{
+		Display:     "QR Content",
+		Category:    "internet",
+		Description: "Payload of a QR code: a URL, a Wi-Fi network configuration or a vCard contact",
+		Example:     "WIFI:T:WPA;S:Cafe Lumen;P:fz8Ko2qV9x;;",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "type",
+				Display:     "Type",
+				Type:        "string",
+				Default:     qrContentAny,
+				Options:     []string{qrContentAny, qrContentURL, qrContentWifi, qrContentVCard},
+				Description: "Type of the content, any for a random type",
+			},
+		},
+		Generate: unsafeOf(qrcontent),
+	})
+}
+
+// qrEscape escapes the special characters of the Wi-Fi network configuration fields.
+func qrEscape(str string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, ":", `\:`, `"`, `\"`).Replace(str)
+}
+
+// qrContentOf returns a random content of the type, with reserved identifiers in safe mode.
+func qrContentOf(r *rand.Rand, typ string, safe bool) (string, error) {
+	fake := &gofakeit.Faker{Rand: r}
+	kinds := []string{qrContentURL, qrContentWifi, qrContentVCard}
+
+	typ = strings.ToLower(typ)
+	if typ == qrContentAny {
+		typ = kinds[r.Intn(len(kinds))]
+	}
+
+	switch typ {
+	case qrContentURL:
+		return urlAddress(r, safe), nil
+	case qrContentWifi:
+		ssid := fake.Company() + " " + fake.RandomString([]string{"Guest", "WiFi", "5G", "Office"})
+
+		return "WIFI:T:WPA;S:" + qrEscape(ssid) + ";P:" + qrEscape(fake.Password(true, true, true, false, false, 12)) + ";;", nil
+	case qrContentVCard:
+		first, last := fake.FirstName(), fake.LastName()
+		lines := []string{
+			"BEGIN:VCARD",
+			"VERSION:3.0",
+			"N:" + last + ";" + first + ";;;",
+			"FN:" + first + " " + last,
+			"ORG:" + fake.Company(),
+			"TITLE:" + fake.JobTitle(),
+			"TEL;TYPE=CELL:" + phoneFormatted(r, safe),
+			"EMAIL:" + emailAddress(r, safe),
+			"END:VCARD",
+		}
+
+		return strings.Join(lines, "\r\n"), nil
+	default:
+		return "", fmt.Errorf("%w: %s", errUnknownQRContent, typ)
+	}
+}
+
+func qrcontent(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	typ, err := info.GetString(m, "type")
+	if err != nil {
+		return nil, err
+	}
+
+	return qrContentOf(r, typ, safe)
+}
+
+// qrCode is the module matrix of a QR code.
+type qrCode struct {
+	size     int
+	modules  [][]bool
+	function [][]bool
+}
+
+// newQRCode returns the QR code of the content in byte mode at error correction level M.
+func newQRCode(content []byte) (*qrCode, error) {
+	code, err := newUnmaskedQRCode(content)
+	if err != nil {
+		return nil, err
+	}
+
+	code.applyBestMask()
+
+	return code, nil
+}
+
+// newUnmaskedQRCode returns the QR code of the content in byte mode at error correction level M
+// of the smallest version, before applying a mask pattern and drawing the format information.
+func newUnmaskedQRCode(content []byte) (*qrCode, error) {
+	const (
+		modeByte    = 0b0100
+		maxShortLen = 9 // the character count of the versions up to 9 is 8 bits long
+		padding     = "\xec\x11"
+	)
+
+	version := 0
+
+	for idx, blocks := range qrVersions {
+		header := 4 + 8 //nolint:mnd
+		if idx+1 > maxShortLen {
+			header += 8 //nolint:mnd
+		}
+
+		if header+len(content)*8 <= blocks.dataCodewords()*8 {
+			version = idx + 1
+
+			break
+		}
+	}
+
+	if version == 0 {
+		last := qrVersions[len(qrVersions)-1].dataCodewords() - 3 //nolint:mnd
+
+		return nil, fmt.Errorf("%w: %d bytes (at most %d)", errQRContentTooLong, len(content), last)
+	}
+
+	blocks := qrVersions[version-1]
+
+	var bits qrBits
+
+	bits.append(modeByte, 4) //nolint:mnd
+
+	if version > maxShortLen {
+		bits.append(len(content), 16) //nolint:mnd
+	} else {
+		bits.append(len(content), 8) //nolint:mnd
+	}
+
+	for _, chr := range content {
+		bits.append(int(chr), 8) //nolint:mnd
+	}
+
+	capacity := blocks.dataCodewords() * 8
+
+	bits.append(0, min(4, capacity-bits.len)) //nolint:mnd
+	bits.append(0, (8-bits.len%8)%8)          //nolint:mnd
+
+	for idx := 0; bits.len < capacity; idx++ {
+		bits.append(int(padding[idx%2]), 8) //nolint:mnd
+	}
+
+	code := newQRMatrix(version)
+
+	code.drawCodewords(qrInterleave(bits.data, blocks))
+
+	return code, nil
+}
+
+// qrBits is a bit buffer.
+type qrBits struct {
+	data []byte
+	len  int
+}
+
+// append appends the low count bits of the value, the most significant first.
+func (b *qrBits) append(val int, count int) {
+	for idx := count - 1; idx >= 0; idx-- {
+		if b.len%8 == 0 {
+			b.data = append(b.data, 0)
+		}
+
+		if val>>idx&1 == 1 {
+			b.data[b.len/8] |= 0x80 >> (b.len % 8)
+		}
+
+		b.len++
+	}
+}
+
+// qrInterleave splits the data codewords into blocks, appends the error correction codewords
+// of the blocks and returns the codewords interleaved.
+func qrInterleave(data []byte, blocks qrBlocks) []byte {
+	count := blocks.short + blocks.long
+	divisor := reedSolomonDivisor(blocks.ecc)
+	dataBlocks := make([][]byte, count)
+	eccBlocks := make([][]byte, count)
+
+	for idx, offset := 0, 0; idx < count; idx++ {
+		size := blocks.shortData
+		if idx >= blocks.short {
+			size++
+		}
+
+		dataBlocks[idx] = data[offset : offset+size]
+		eccBlocks[idx] = reedSolomonRemainder(dataBlocks[idx], divisor)
+		offset += size
+	}
+
+	result := make([]byte, 0, len(data)+count*blocks.ecc)
+
+	for col := range blocks.shortData + 1 {
+		for _, block := range dataBlocks {
+			if col < len(block) {
+				result = append(result, block[col])
+			}
+		}
+	}
+
+	for col := range blocks.ecc {
+		for _, block := range eccBlocks {
+			result = append(result, block[col])
+		}
+	}
+
+	return result
+}
+
+// gfMultiply returns the product of the elements of GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMultiply(x byte, y byte) byte {
+	const reducer = 0x11d
+
+	var product int
+
+	for idx := 7; idx >= 0; idx-- {
+		product = (product << 1) ^ ((product >> 7) * reducer) //nolint:mnd
+		product ^= int((y>>idx)&1) * int(x)
+	}
+
+	return byte(product)
+}
+
+// reedSolomonDivisor returns the coefficients of the generator polynomial of the degree,
+// the product of (x - 2^i) for i from 0 to degree-1, excluding the leading 1.
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+
+	for range degree {
+		for idx := range result {
+			result[idx] = gfMultiply(result[idx], root)
+
+			if idx+1 < len(result) {
+				result[idx] ^= result[idx+1]
+			}
+		}
+
+		root = gfMultiply(root, 2) //nolint:mnd
+	}
+
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords of the data.
+func reedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+
+	for _, chr := range data {
+		factor := chr ^ result[0]
+
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+
+		for idx, coef := range divisor {
+			result[idx] ^= gfMultiply(coef, factor)
+		}
+	}
+
+	return result
+}
+
+// newQRMatrix returns the matrix of the version with the function patterns drawn.
+func newQRMatrix(version int) *qrCode {
+	size := version*4 + 17 //nolint:mnd
+	code := &qrCode{size: size, modules: make([][]bool, size), function: make([][]bool, size)}
+
+	for idx := range size {
+		code.modules[idx] = make([]bool, size)
+		code.function[idx] = make([]bool, size)
+	}
+
+	// timing patterns
+	for idx := range size {
+		code.set(6, idx, idx%2 == 0)
+		code.set(idx, 6, idx%2 == 0)
+	}
+
+	// finder patterns with separators
+	for _, corner := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := corner[0]+dx, corner[1]+dy
+				if x < 0 || x >= size || y < 0 || y >= size {
+					continue
+				}
+
+				dist := max(abs(dx), abs(dy))
+				code.set(x, y, dist != 2 && dist != 4) //nolint:mnd
+			}
+		}
+	}
+
+	// alignment patterns, except the ones overlapping the finder patterns
+	positions := qrAlignmentPositions(version)
+	last := len(positions) - 1
+
+	for i, y := range positions {
+		for j, x := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					code.set(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// the format information is drawn with the mask, the areas are reserved here
+	code.drawFormat(0)
+	code.drawVersion(version)
+
+	return code
+}
+
+func abs(val int) int {
+	return max(val, -val)
+}
+
+// set sets a function module of the column x and the row y.
+func (c *qrCode) set(x int, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+// qrAlignmentPositions returns the centers of the alignment patterns of the version, in both dimensions.
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+
+	count := version/7 + 2                                //nolint:mnd
+	step := (version*8 + count*3 + 5) / (count*4 - 4) * 2 //nolint:mnd
+	result := make([]int, count)
+
+	result[0] = 6
+
+	for idx, pos := count-1, version*4+10; idx > 0; idx, pos = idx-1, pos-step { //nolint:mnd
+		result[idx] = pos
+	}
+
+	return result
+}
+
+// drawFormat draws the format information: error correction level M and the mask, BCH(15,5) coded.
+func (c *qrCode) drawFormat(mask int) {
+	const (
+		generator = 0x537
+		xorMask   = 0x5412
+	)
+
+	rem := mask // the bits of the error correction level M are 00
+
+	for range 10 {
+		rem = (rem << 1) ^ ((rem >> 9) * generator) //nolint:mnd
+	}
+
+	bits := (mask<<10 | rem) ^ xorMask //nolint:mnd
+	bit := func(idx int) bool { return bits>>idx&1 == 1 }
+
+	for idx := range 6 {
+		c.set(8, idx, bit(idx))
+	}
+
+	c.set(8, 7, bit(6))
+	c.set(8, 8, bit(7))
+	c.set(7, 8, bit(8))
+
+	for idx := 9; idx < 15; idx++ {
+		c.set(14-idx, 8, bit(idx))
+	}
+
+	for idx := range 8 {
+		c.set(c.size-1-idx, 8, bit(idx))
+	}
+
+	for idx := 8; idx < 15; idx++ {
+		c.set(8, c.size-15+idx, bit(idx))
+	}
+
+	// the dark module
+	c.set(8, c.size-8, true)
+}
+
+// drawVersion draws the version information of the versions from 7, BCH(18,6) coded.
+func (c *qrCode) drawVersion(version int) {
+	const (
+		generator  = 0x1f25
+		minVersion = 7
+	)
+
+	if version < minVersion {
+		return
+	}
+
+	rem := version
+
+	for range 12 {
+		rem = (rem << 1) ^ ((rem >> 11) * generator) //nolint:mnd
+	}
+
+	bits := version<<12 | rem //nolint:mnd
+
+	for idx := range 18 {
+		dark := bits>>idx&1 == 1
+		a, b := c.size-11+idx%3, idx/3
+
+		c.set(a, b, dark)
+		c.set(b, a, dark)
+	}
+}
+
+// drawCodewords draws the codewords in the zigzag order, from the bottom right corner upwards in 2 wide columns.
+func (c *qrCode) drawCodewords(data []byte) {
+	idx := 0
+
+	for right := c.size - 1; right >= 1; right -= 2 {
+		if right == 6 { // the vertical timing pattern is skipped
+			right = 5
+		}
+
+		upward := (right+1)&2 == 0
+
+		for vert := range c.size {
+			y := vert
+			if upward {
+				y = c.size - 1 - vert
+			}
+
+			for j := range 2 {
+				x := right - j
+
+				if !c.function[y][x] && idx < len(data)*8 {
+					c.modules[y][x] = data[idx/8]>>(7-idx%8)&1 == 1
+					idx++
+				}
+			}
+		}
+	}
+}
+
+// masked reports whether the mask pattern inverts the module of the column x and the row y.
+func masked(mask int, x int, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask inverts the data modules masked by the mask pattern, applying it twice restores the modules.
+func (c *qrCode) applyMask(mask int) {
+	for y := range c.size {
+		for x := range c.size {
+			if !c.function[y][x] && masked(mask, x, y) {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// applyBestMask applies the mask pattern of the lowest penalty score.
+func (c *qrCode) applyBestMask() {
+	const masks = 8
+
+	best, lowest := 0, math.MaxInt
+
+	for mask := range masks {
+		c.applyMask(mask)
+		c.drawFormat(mask)
+
+		if penalty := c.penalty(); penalty < lowest {
+			best, lowest = mask, penalty
+		}
+
+		c.applyMask(mask)
+	}
+
+	c.applyMask(best)
+	c.drawFormat(best)
+}
+
+// penalty returns the penalty score of the modules: long runs, 2x2 blocks,
+// finder like patterns and the imbalance of dark and light modules.
+func (c *qrCode) penalty() int {
+	const (
+		runPenalty    = 3
+		blockPenalty  = 3
+		finderPenalty = 40
+		balancePoints = 10
+		minRun        = 5
+	)
+
+	var score, dark int
+
+	line := make([]byte, c.size)
+
+	for _, vertical := range []bool{false, true} {
+		for i := range c.size {
+			for j := range c.size {
+				module := c.modules[i][j]
+				if vertical {
+					module = c.modules[j][i]
+				}
+
+				line[j] = '0'
+				if module {
+					line[j] = '1'
+				}
+			}
+
+			for run, j := 1, 1; j <= c.size; j++ {
+				if j < c.size && line[j] == line[j-1] {
+					run++
+
+					continue
+				}
+
+				if run >= minRun {
+					score += runPenalty + run - minRun
+				}
+
+				run = 1
+			}
+
+			score += finderPenalty * (strings.Count(string(line), "10111010000") + strings.Count(string(line), "00001011101"))
+		}
+	}
+
+	for y := range c.size {
+		for x := range c.size {
+			if c.modules[y][x] {
+				dark++
+			}
+
+			if x+1 < c.size && y+1 < c.size && c.modules[y][x] == c.modules[y][x+1] &&
+				c.modules[y][x] == c.modules[y+1][x] && c.modules[y][x] == c.modules[y+1][x+1] {
+				score += blockPenalty
+			}
+		}
+	}
+
+	total := c.size * c.size
+
+	return score + balancePoints*((abs(dark*20-total*10)+total-1)/total-1) //nolint:mnd
+}
+
+// png returns the PNG image of the QR code, the code with the quiet zone is centered in the size x size image.
+func (c *qrCode) png(size int) ([]byte, error) {
+	const quietZone = 4
+
+	width := c.size + 2*quietZone
+	scale := max(1, size/width)
+	size = max(size, width)
+	offset := (size-width*scale)/2 + quietZone*scale
+	img := image.NewGray(image.Rect(0, 0, size, size))
+
+	for idx := range img.Pix {
+		img.Pix[idx] = math.MaxUint8
+	}
+
+	for y, row := range c.modules {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+
+			for py := offset + y*scale; py < offset+(y+1)*scale; py++ {
+				for px := offset + x*scale; px < offset+(x+1)*scale; px++ {
+					img.Pix[py*img.Stride+px] = 0
+				}
+			}
+		}
+	}
+
+	var buff bytes.Buffer
+
+	if err := png.Encode(&buff, img); err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// qrPng returns the PNG image of the QR code of the content (error correction level M).
+// If the content is missing, a random URL, Wi-Fi network configuration or vCard is encoded.
+// The size is the width and height of the image in pixels (256 by default).
+func (f *faker) qrPng(content sobek.Value, size sobek.Value) sobek.ArrayBuffer {
+	const (
+		method      = "internet.qrPng"
+		defaultSize = 256
+		maxSize     = 4096
+	)
+
+	var text string
+
+	if content == nil || sobek.IsUndefined(content) || sobek.IsNull(content) {
+		f.rescope()
+
+		text, _ = qrContentOf(f.rand, qrContentAny, f.opts.safe)
+	} else {
+		text = content.String()
+	}
+
+	pixels := int64(defaultSize)
+
+	if size != nil && !sobek.IsUndefined(size) && !sobek.IsNull(size) {
+		pixels = size.ToInteger()
+	}
+
+	if pixels < 1 || pixels > maxSize {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "size", Expected: "number", Reason: fmt.Sprintf("%s: %d", errInvalidQRCodeSize, pixels),
+		})
+	}
+
+	code, err := newQRCode([]byte(text))
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "content", Expected: "string", Reason: err.Error()})
+	}
+
+	data, err := code.png(int(pixels))
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "content", Reason: err.Error()})
+	}
+
+	return f.runtime.NewArrayBuffer(data)
+}
@@ -0,0 +1,54 @@
+package faker_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_qrcontent(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("qrcontent")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	prefixes := map[string]string{"url": "http", "wifi": "WIFI:T:WPA;S:", "vcard": "BEGIN:VCARD\r\nVERSION:3.0\r\n"}
+
+	for typ, prefix := range prefixes {
+		params := gofakeit.NewMapParams()
+		params.Add("type", typ)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(val.(string), prefix), val) //nolint:forcetypeassert
+	}
+
+	types := make(map[string]bool)
+
+	for range 50 {
+		val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+		require.NoError(t, err)
+
+		for typ, prefix := range prefixes {
+			if strings.HasPrefix(val.(string), prefix) { //nolint:forcetypeassert
+				types[typ] = true
+			}
+		}
+	}
+
+	require.Len(t, types, len(prefixes))
+
+	params := gofakeit.NewMapParams()
+	params.Add("type", "sms")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown QR content type")
+}
@@ -0,0 +1,175 @@
+package faker
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/grafana/sobek"
+)
+
+// recipe generates an object based on a compact recipe string.
+//
+// The recipe is a comma separated list of field definitions in "name:function(args)" format,
+// e.g. "name:person.name, email:person.email, amount:finance.amount(10,500)".
+// The parameter list is optional, the arguments are numbers, booleans, arrays in square brackets or strings
+// (quoted with single or double quotes, or unquoted if they contain no special characters).
+// If the name is omitted, the name of the generator function is used as the field name.
+// The compiled recipes are cached, so the same recipe is parsed only once.
+func (f *faker) recipe(recipe string) *sobek.Object {
+	compiled, found := f.recipes[recipe]
+	if !found {
+		compiled = f.compileRecipe(recipe)
+		f.recipes[recipe] = compiled
+	}
+
+	return f.generate(compiled)
+}
+
+func (f *faker) compileRecipe(recipe string) *schema {
+	compiled := new(schema)
+
+	for _, def := range splitRecipe(recipe, ',') {
+		if def = strings.TrimSpace(def); len(def) == 0 {
+			continue
+		}
+
+		compiled.fields = append(compiled.fields, f.compileRecipeField(def))
+	}
+
+	if len(compiled.fields) == 0 {
+		f.throw(&ArgumentError{Function: "recipe", Parameter: "recipe", Expected: "string", Reason: "empty recipe"})
+	}
+
+	return compiled
+}
+
+func (f *faker) compileRecipeField(def string) *schemaField {
+	invalid := func(reason string) {
+		f.throw(&ArgumentError{
+			Function:  "recipe",
+			Parameter: "recipe",
+			Expected:  "name:function(args)",
+			Reason:    reason + " in " + strconv.Quote(def),
+		})
+	}
+
+	field := new(schemaField)
+	function := def
+
+	if idx := strings.IndexByte(def, ':'); idx >= 0 && idx < strings.IndexByte(def+"(", '(') {
+		field.name, function = strings.TrimSpace(def[:idx]), strings.TrimSpace(def[idx+1:])
+	}
+
+	if idx := strings.IndexByte(function, '('); idx >= 0 {
+		if !strings.HasSuffix(function, ")") {
+			invalid("unterminated parameter list")
+		}
+
+		params := strings.TrimSpace(function[idx+1 : len(function)-1])
+
+		for _, arg := range splitRecipe(params, ',') {
+			value, ok := recipeArgument(strings.TrimSpace(arg))
+			if !ok {
+				invalid("invalid argument " + arg)
+			}
+
+			field.args = append(field.args, f.runtime.ToValue(value))
+		}
+
+		function = strings.TrimSpace(function[:idx])
+	}
+
+	if len(function) == 0 {
+		invalid("missing function")
+	}
+
+	field.function, field.info = f.lookup("recipe", f.runtime.ToValue(function))
+
+	if len(field.name) == 0 {
+		field.name = field.function
+	}
+
+	return field
+}
+
+// recipeArgument converts the argument of the recipe to number, boolean, string or array.
+func recipeArgument(arg string) (any, bool) {
+	if len(arg) == 0 {
+		return nil, false
+	}
+
+	if quote := arg[0]; quote == '"' || quote == '\'' {
+		if len(arg) < 2 || arg[len(arg)-1] != quote {
+			return nil, false
+		}
+
+		return arg[1 : len(arg)-1], true
+	}
+
+	if arg[0] == '[' {
+		if arg[len(arg)-1] != ']' {
+			return nil, false
+		}
+
+		items := splitRecipe(strings.TrimSpace(arg[1:len(arg)-1]), ',')
+		array := make([]any, len(items))
+
+		for idx, item := range items {
+			value, ok := recipeArgument(strings.TrimSpace(item))
+			if !ok {
+				return nil, false
+			}
+
+			array[idx] = value
+		}
+
+		return array, true
+	}
+
+	if num, err := strconv.ParseFloat(arg, 64); err == nil {
+		return num, true
+	}
+
+	if arg == "true" || arg == "false" {
+		return arg == "true", true
+	}
+
+	return arg, !strings.ContainsAny(arg, "()[]\"'")
+}
+
+// splitRecipe splits the recipe by the separator, ignoring separators in parentheses, brackets and quotes.
+// An empty recipe results in an empty list.
+func splitRecipe(recipe string, sep byte) []string {
+	if len(recipe) == 0 {
+		return nil
+	}
+
+	var (
+		parts []string
+		depth int
+		quote byte
+		start int
+	)
+
+	for idx := range len(recipe) {
+		chr := recipe[idx]
+
+		switch {
+		case quote != 0:
+			if chr == quote {
+				quote = 0
+			}
+		case chr == '"' || chr == '\'':
+			quote = chr
+		case chr == '(' || chr == '[':
+			depth++
+		case chr == ')' || chr == ']':
+			depth--
+		case chr == sep && depth == 0:
+			parts = append(parts, recipe[start:idx])
+			start = idx + 1
+		}
+	}
+
+	return append(parts, recipe[start:])
+}
@@ -0,0 +1,258 @@
+package faker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/js/modules"
+)
+
+// recordEnv is the environment variable containing the path of the JSON Lines file
+// recording the values generated by the instances.
+const recordEnv = "XK6_FAKER_RECORD"
+
+var (
+	errReplayExhausted = errors.New("no more recorded values")
+	errRecordClosed    = errors.New("the record file is closed at the end of the test run")
+)
+
+// recordEntry is a line of the record file.
+type recordEntry struct {
+	Function string `json:"function"`
+	Args     []any  `json:"args,omitempty"`
+	Output   any    `json:"output"`
+	// VU is the global ID of the VU, 0 in the init context.
+	VU uint64 `json:"vu"`
+	// Iteration is the iteration number of the VU, -1 in the init context.
+	Iteration int64 `json:"iteration"`
+}
+
+// recorder writes the generated values to the record file, it is shared between the VUs.
+type recorder struct {
+	path string
+	// buffered is set if the recorder is closed at the end of the test run,
+	// otherwise every entry is flushed (the global events are not available).
+	buffered bool
+
+	mu     sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	closed bool
+	err    error
+}
+
+// recorders contains the open recorders by record file path.
+//
+//nolint:gochecknoglobals
+var (
+	recorders   = make(map[string]*recorder)
+	recordersMu sync.Mutex
+)
+
+// openRecorder returns the recorder of the file set in the XK6_FAKER_RECORD environment variable,
+// nil if the variable is not set or the environment variables are not available (outside the init context).
+// The file is created (or truncated) on the first write, and flushed and closed when the test run ends.
+func openRecorder(vu modules.VU) *recorder {
+	if vu == nil || vu.InitEnv() == nil || vu.InitEnv().LookupEnv == nil {
+		return nil
+	}
+
+	path, found := vu.InitEnv().LookupEnv(recordEnv)
+	if !found || len(path) == 0 {
+		return nil
+	}
+
+	recordersMu.Lock()
+	defer recordersMu.Unlock()
+
+	if rec, found := recorders[path]; found {
+		return rec
+	}
+
+	rec := &recorder{path: path}
+	rec.buffered = onTestEnd(vu, func() { _ = rec.close() })
+
+	recorders[path] = rec
+
+	return rec
+}
+
+// write appends the entry to the record file as a JSON line.
+func (r *recorder) write(entry *recordEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return errRecordClosed
+	}
+
+	if r.file == nil && r.err == nil {
+		r.file, r.err = os.Create(r.path)
+		if r.err == nil {
+			r.writer = bufio.NewWriter(r.file)
+		}
+	}
+
+	if r.err != nil {
+		return r.err
+	}
+
+	if _, err := r.writer.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	if r.buffered {
+		return nil
+	}
+
+	return r.writer.Flush()
+}
+
+// close flushes and closes the record file and removes the recorder from recorders,
+// so the next test run in the same process starts a new record file.
+func (r *recorder) close() error {
+	recordersMu.Lock()
+
+	if recorders[r.path] == r {
+		delete(recorders, r.path)
+	}
+
+	recordersMu.Unlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	open := !r.closed && r.file != nil
+	r.closed = true
+
+	if !open {
+		return nil
+	}
+
+	return errors.Join(r.writer.Flush(), r.file.Close())
+}
+
+// record writes the generated value of the function to the record file, if recording is enabled.
+func (f *faker) record(name string, args []sobek.Value, output any) {
+	if f.recorder == nil {
+		return
+	}
+
+	entry := &recordEntry{Function: name, Output: output, Iteration: -1}
+
+	if f.vu != nil && f.vu.State() != nil {
+		entry.VU = f.vu.State().VUIDGlobal
+		entry.Iteration = f.vu.State().Iteration
+	}
+
+	for _, arg := range args {
+		entry.Args = append(entry.Args, arg.Export())
+	}
+
+	if err := f.recorder.write(entry); err != nil {
+		f.throw(err)
+	}
+}
+
+// replay contains the recorded values replayed by the instance.
+type replay struct {
+	// values contains the recorded outputs by VU and function name (e.g. 1/firstName).
+	values map[string][]any
+	// next contains the index of the next value to replay by VU and function name.
+	next map[string]int
+}
+
+// replayFiles contains the parsed record files (by absolute path) as maps of recorded outputs.
+var replayFiles sync.Map //nolint:gochecknoglobals
+
+// replayFrom makes the generator functions of the instance return the values recorded in the file
+// (written using the XK6_FAKER_RECORD environment variable) instead of generating new ones.
+// The values are replayed per VU and function in the recorded order. The file is loaded in the init context,
+// the path is relative to the script.
+func (f *faker) replayFrom(filePath sobek.Value) {
+	const method = "replayFrom"
+
+	abs := f.initFilePath(method, filePath)
+
+	values, found := replayFiles.Load(abs)
+	if !found {
+		parsed, reason := parseRecordFile(f.readInitFile(method, abs))
+		if len(reason) != 0 {
+			f.throw(&ArgumentError{Function: method, Parameter: "path", Expected: "record file", Reason: reason})
+		}
+
+		values, _ = replayFiles.LoadOrStore(abs, parsed)
+	}
+
+	f.replay = &replay{values: values.(map[string][]any), next: make(map[string]int)} //nolint:forcetypeassert
+}
+
+// parseRecordFile returns the recorded outputs by VU and function name, or the reason of the failure.
+func parseRecordFile(data []byte) (map[string][]any, string) {
+	values := make(map[string][]any)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	scanner.Buffer(nil, len(data)+1)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var entry recordEntry
+
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || len(entry.Function) == 0 {
+			return nil, "invalid entry in line " + strconv.Itoa(line)
+		}
+
+		key := replayKey(entry.VU, entry.Function)
+		values[key] = append(values[key], entry.Output)
+	}
+
+	if len(values) == 0 {
+		return nil, "empty record file"
+	}
+
+	return values, ""
+}
+
+func replayKey(vu uint64, function string) string {
+	return strconv.FormatUint(vu, 10) + "/" + function
+}
+
+// replayed returns the next recorded value of the function, false if the instance doesn't replay.
+// Throws an error if there are no more recorded values of the function in the current VU.
+func (f *faker) replayed(name string) (any, bool) {
+	if f.replay == nil {
+		return nil, false
+	}
+
+	var vu uint64
+
+	if f.vu != nil && f.vu.State() != nil {
+		vu = f.vu.State().VUIDGlobal
+	}
+
+	key := replayKey(vu, name)
+	values, next := f.replay.values[key], f.replay.next[key]
+
+	if next >= len(values) {
+		f.throw(fmt.Errorf("%w: %s (VU %d)", errReplayExhausted, name, vu))
+	}
+
+	f.replay.next[key] = next + 1
+
+	return copyValue(values[next]), true
+}
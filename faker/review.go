@@ -0,0 +1,286 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidStars      = errors.New("stars must be between 0 and 5")
+	errUnknownSentiment  = errors.New("unknown sentiment")
+	errSentimentMismatch = errors.New("stars do not match the sentiment")
+	errUnknownLanguage   = errors.New("unknown language")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("productreview", gofakeit.Info{
+		Display:     "Review",
+		Category:    "product",
+		Description: "Product review with star rating, matching title and text, reviewer and timestamp",
+		Example: `{
+	"rating": 2,
+	"sentiment": "negative",
+	"title": "Disappointed",
+	"text": "It stopped working after a few days. The quality is much lower than expected.",
+	"language": "en",
+	"reviewer": "Linda Garcia",
+	"verifiedPurchase": true,
+	"helpfulVotes": 3,
+	"createdAt": "2024-03-02T11:25:41Z"
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "sentiment",
+				Display:     "Sentiment",
+				Type:        "string",
+				Default:     "any",
+				Options:     []string{"any", "positive", "neutral", "negative"},
+				Description: "Sentiment of the review, any for the sentiment matching the stars",
+			},
+			{
+				Field:       "stars",
+				Display:     "Stars",
+				Type:        "int",
+				Default:     "0",
+				Description: "Star rating (1-5), 0 for a rating matching the sentiment",
+			},
+			{
+				Field:       "language",
+				Display:     "Language",
+				Type:        "string",
+				Default:     "en",
+				Options:     reviewLanguages,
+				Description: "Language of the review text",
+			},
+		},
+		Generate: productreview,
+	})
+}
+
+// Sentiments of the reviews.
+const (
+	sentimentPositive = "positive"
+	sentimentNeutral  = "neutral"
+	sentimentNegative = "negative"
+)
+
+// reviewTexts contains the titles and the sentences of the reviews of a language and sentiment.
+type reviewTexts struct {
+	titles    []string
+	sentences []string
+}
+
+// reviewLanguages contains the supported languages of the reviews.
+var reviewLanguages = []string{"en", "de", "fr", "es"} //nolint:gochecknoglobals
+
+// reviewPhrases contains the review texts by language and sentiment.
+//
+//nolint:gochecknoglobals,lll
+var reviewPhrases = map[string]map[string]reviewTexts{
+	"en": {
+		sentimentPositive: {
+			titles: []string{"Absolutely love it", "Great value for money", "Exceeded my expectations", "Highly recommended", "Works perfectly", "Best purchase this year"},
+			sentences: []string{
+				"The quality is excellent and it feels very solid.", "Setup took only a few minutes.", "It works exactly as described.",
+				"Delivery was fast and the packaging was perfect.", "I use it every day and it still looks new.", "The price is more than fair for what you get.",
+				"My whole family loves it.", "I would definitely buy it again.",
+			},
+		},
+		sentimentNeutral: {
+			titles: []string{"It's okay", "Does the job", "Decent but not perfect", "Average product", "Mixed feelings"},
+			sentences: []string{
+				"It does what it should, nothing more.", "The quality is fine for the price.", "Delivery took a bit longer than expected.",
+				"The instructions could be clearer.", "It is a little smaller than it looks in the pictures.", "Some features work well, others feel unfinished.",
+				"Not bad, but I expected a bit more.",
+			},
+		},
+		sentimentNegative: {
+			titles: []string{"Disappointed", "Not worth the money", "Stopped working", "Would not recommend", "Poor quality"},
+			sentences: []string{
+				"It stopped working after a few days.", "The quality is much lower than expected.", "Customer service never answered my emails.",
+				"The item arrived damaged.", "It looks nothing like the pictures.", "I returned it and asked for a refund.",
+				"Save your money and buy something else.",
+			},
+		},
+	},
+	"de": {
+		sentimentPositive: {
+			titles: []string{"Absolut begeistert", "Top Preis-Leistung", "Besser als erwartet", "Klare Kaufempfehlung", "Funktioniert einwandfrei"},
+			sentences: []string{
+				"Die Qualität ist hervorragend und wirkt sehr stabil.", "Die Einrichtung hat nur wenige Minuten gedauert.", "Es funktioniert genau wie beschrieben.",
+				"Die Lieferung war schnell und die Verpackung perfekt.", "Ich benutze es jeden Tag und es sieht noch wie neu aus.", "Der Preis ist mehr als fair.",
+				"Ich würde es jederzeit wieder kaufen.",
+			},
+		},
+		sentimentNeutral: {
+			titles: []string{"Ganz okay", "Erfüllt seinen Zweck", "Solide, aber nicht perfekt", "Durchschnittlich"},
+			sentences: []string{
+				"Es macht, was es soll, mehr aber auch nicht.", "Die Qualität ist für den Preis in Ordnung.", "Die Lieferung hat etwas länger gedauert.",
+				"Die Anleitung könnte verständlicher sein.", "Es ist etwas kleiner als auf den Bildern.", "Nicht schlecht, aber ich hatte mehr erwartet.",
+			},
+		},
+		sentimentNegative: {
+			titles: []string{"Enttäuscht", "Das Geld nicht wert", "Nach kurzer Zeit kaputt", "Keine Empfehlung"},
+			sentences: []string{
+				"Nach wenigen Tagen funktionierte es nicht mehr.", "Die Qualität ist deutlich schlechter als erwartet.", "Der Kundenservice hat nie geantwortet.",
+				"Der Artikel kam beschädigt an.", "Es sieht ganz anders aus als auf den Bildern.", "Ich habe es zurückgeschickt.",
+			},
+		},
+	},
+	"fr": {
+		sentimentPositive: {
+			titles: []string{"Je l'adore", "Excellent rapport qualité-prix", "Au-delà de mes attentes", "Je recommande vivement", "Fonctionne parfaitement"},
+			sentences: []string{
+				"La qualité est excellente et le produit est très solide.", "L'installation n'a pris que quelques minutes.", "Il fonctionne exactement comme décrit.",
+				"La livraison a été rapide et l'emballage parfait.", "Je l'utilise tous les jours et il est encore comme neuf.", "Le prix est plus que correct.",
+				"Je l'achèterais de nouveau sans hésiter.",
+			},
+		},
+		sentimentNeutral: {
+			titles: []string{"Correct", "Fait le travail", "Bien mais pas parfait", "Produit moyen"},
+			sentences: []string{
+				"Il fait ce qu'il doit faire, sans plus.", "La qualité est correcte pour le prix.", "La livraison a pris un peu plus de temps que prévu.",
+				"Les instructions pourraient être plus claires.", "Il est un peu plus petit que sur les photos.", "Pas mal, mais je m'attendais à mieux.",
+			},
+		},
+		sentimentNegative: {
+			titles: []string{"Déçu", "Ne vaut pas son prix", "Tombé en panne", "Je ne recommande pas"},
+			sentences: []string{
+				"Il a cessé de fonctionner après quelques jours.", "La qualité est bien inférieure à mes attentes.", "Le service client n'a jamais répondu.",
+				"L'article est arrivé endommagé.", "Il ne ressemble pas du tout aux photos.", "Je l'ai renvoyé et demandé un remboursement.",
+			},
+		},
+	},
+	"es": {
+		sentimentPositive: {
+			titles: []string{"Me encanta", "Excelente relación calidad-precio", "Superó mis expectativas", "Muy recomendable", "Funciona perfectamente"},
+			sentences: []string{
+				"La calidad es excelente y se siente muy resistente.", "La instalación solo tomó unos minutos.", "Funciona exactamente como se describe.",
+				"El envío fue rápido y el embalaje perfecto.", "Lo uso todos los días y sigue como nuevo.", "El precio es más que justo.",
+				"Sin duda lo volvería a comprar.",
+			},
+		},
+		sentimentNeutral: {
+			titles: []string{"Está bien", "Cumple su función", "Correcto pero no perfecto", "Producto normal"},
+			sentences: []string{
+				"Hace lo que tiene que hacer, nada más.", "La calidad está bien para el precio.", "El envío tardó un poco más de lo esperado.",
+				"Las instrucciones podrían ser más claras.", "Es un poco más pequeño de lo que parece en las fotos.", "No está mal, pero esperaba un poco más.",
+			},
+		},
+		sentimentNegative: {
+			titles: []string{"Decepcionado", "No vale lo que cuesta", "Dejó de funcionar", "No lo recomiendo"},
+			sentences: []string{
+				"Dejó de funcionar a los pocos días.", "La calidad es mucho peor de lo esperado.", "El servicio al cliente nunca respondió.",
+				"El artículo llegó dañado.", "No se parece en nada a las fotos.", "Lo devolví y pedí un reembolso.",
+			},
+		},
+	},
+}
+
+// sentimentOf returns the sentiment matching the star rating.
+func sentimentOf(stars int) string {
+	const positiveStars = 4
+
+	switch {
+	case stars >= positiveStars:
+		return sentimentPositive
+	case stars == positiveStars-1:
+		return sentimentNeutral
+	default:
+		return sentimentNegative
+	}
+}
+
+// starsOf returns a random star rating matching the sentiment.
+func starsOf(r *rand.Rand, sentiment string) int {
+	const (
+		negativeStars = 1
+		neutralStars  = 3
+		positiveStars = 4
+	)
+
+	switch sentiment {
+	case sentimentPositive:
+		return positiveStars + r.Intn(2)
+	case sentimentNeutral:
+		return neutralStars
+	default:
+		return negativeStars + r.Intn(2)
+	}
+}
+
+func productreview(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		maxStars     = 5
+		minSentences = 1
+		maxSentences = 3
+		maxReviewAge = 365 * 24 * time.Hour
+		maxVotes     = 50
+		verifiedRate = 5
+	)
+
+	sentiment, err := info.GetString(m, "sentiment")
+	if err != nil {
+		return nil, err
+	}
+
+	stars, err := info.GetInt(m, "stars")
+	if err != nil {
+		return nil, err
+	}
+
+	language, err := info.GetString(m, "language")
+	if err != nil {
+		return nil, err
+	}
+
+	if stars < 0 || stars > maxStars {
+		return nil, fmt.Errorf("%w: %d", errInvalidStars, stars)
+	}
+
+	texts, found := reviewPhrases[strings.ToLower(language)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownLanguage, language)
+	}
+
+	switch sentiment = strings.ToLower(sentiment); {
+	case sentiment == "any" && stars == 0:
+		stars = 1 + r.Intn(maxStars)
+		sentiment = sentimentOf(stars)
+	case sentiment == "any":
+		sentiment = sentimentOf(stars)
+	case !slices.Contains([]string{sentimentPositive, sentimentNeutral, sentimentNegative}, sentiment):
+		return nil, fmt.Errorf("%w: %s", errUnknownSentiment, sentiment)
+	case stars == 0:
+		stars = starsOf(r, sentiment)
+	case sentimentOf(stars) != sentiment:
+		return nil, fmt.Errorf("%w: %d (%s)", errSentimentMismatch, stars, sentiment)
+	}
+
+	text := texts[sentiment]
+	fake := &gofakeit.Faker{Rand: r}
+	sentences := make([]string, 0, maxSentences)
+
+	for _, idx := range r.Perm(len(text.sentences))[:minSentences+r.Intn(maxSentences)] {
+		sentences = append(sentences, text.sentences[idx])
+	}
+
+	return object{
+		{"rating", stars},
+		{"sentiment", sentiment},
+		{"title", text.titles[r.Intn(len(text.titles))]},
+		{"text", strings.Join(sentences, " ")},
+		{"language", strings.ToLower(language)},
+		{"reviewer", fake.FirstName() + " " + fake.LastName()},
+		{"verifiedPurchase", r.Intn(verifiedRate) != 0},
+		{"helpfulVotes", r.Intn(1 + r.Intn(maxVotes))},
+		{"createdAt", time.Now().Add(-time.Duration(r.Int63n(int64(maxReviewAge)))).UTC().Format(time.RFC3339)},
+	}, nil
+}
@@ -0,0 +1,81 @@
+package faker_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_productreview(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("productreview")
+
+	require.NotNil(t, info)
+
+	sentiments := map[int]string{1: "negative", 2: "negative", 3: "neutral", 4: "positive", 5: "positive"}
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+		require.NoError(t, err)
+
+		review, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+		require.Equal(t, sentiments[review["rating"].(int)], review["sentiment"]) //nolint:forcetypeassert
+		require.NotEmpty(t, review["title"])
+		require.NotEmpty(t, review["text"])
+		require.NotEmpty(t, review["reviewer"])
+		require.Equal(t, "en", review["language"])
+	}
+
+	for _, sentiment := range []string{"positive", "neutral", "negative"} {
+		params := gofakeit.NewMapParams()
+		params.Add("sentiment", sentiment)
+		params.Add("language", "de")
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		review, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+		require.Equal(t, sentiment, sentiments[review["rating"].(int)]) //nolint:forcetypeassert
+		require.Equal(t, "de", review["language"])
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("stars", "5")
+
+	val, err := info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Equal(t, "positive", faker.PlainMaps(val).(map[string]any)["sentiment"]) //nolint:forcetypeassert
+
+	for value, msg := range map[[2]string]string{
+		{"stars", "6"}:         "stars must be between 0 and 5: 6",
+		{"sentiment", "angry"}: "unknown sentiment: angry",
+		{"language", "hu"}:     "unknown language: hu",
+	} {
+		params := gofakeit.NewMapParams()
+		params.Add(value[0], value[1])
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, msg)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("stars", "1")
+	params.Add("sentiment", "positive")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "stars do not match the sentiment: 1 (positive)")
+}
@@ -0,0 +1,316 @@
+package faker
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand"
+	randv2 "math/rand/v2"
+
+	"github.com/aead/chacha20/chacha"
+)
+
+var (
+	errSourceState   = errors.New("invalid random source state")
+	errCryptoState   = errors.New("the state of the crypto random source can not be exported")
+	errUnknownSource = errors.New("unknown random source")
+)
+
+// Random sources of the Faker instance.
+const (
+	// rngFrand is the fast ChaCha12 based random source of frand (default).
+	rngFrand = "frand"
+	// rngPCG64 is the PCG-DXSM generator with 128 bits of state of math/rand/v2.
+	rngPCG64 = "pcg64"
+	// rngXoshiro is the xoshiro256** generator seeded by splitmix64.
+	rngXoshiro = "xoshiro256"
+	// rngCrypto is the cryptographically secure random source of the operating system, it can't be seeded.
+	rngCrypto = "crypto"
+)
+
+// newSource returns the random source of the kind seeded with the seed.
+func newSource(kind string, seed int64) rand.Source64 {
+	var src rand.Source64
+
+	switch kind {
+	case rngPCG64:
+		src = &pcgSource{pcg: new(randv2.PCG)}
+	case rngXoshiro:
+		src = new(xoshiroSource)
+	case rngCrypto:
+		src = cryptoSource{}
+	default:
+		src = new(chachaSource)
+	}
+
+	src.Seed(seed)
+
+	return src
+}
+
+// marshalSource returns the internal state of the random source, so the source can continue
+// the same sequence after restoring the state by unmarshalSource.
+// The state of the crypto random source can not be exported.
+func marshalSource(src rand.Source64) ([]byte, error) {
+	switch src := src.(type) {
+	case *lockedSource:
+		return src.MarshalBinary()
+	case *chachaSource:
+		return src.MarshalBinary()
+	case *pcgSource:
+		return src.pcg.MarshalBinary()
+	case *xoshiroSource:
+		return src.MarshalBinary()
+	case cryptoSource:
+		return nil, errCryptoState
+	default:
+		return nil, errUnknownSource
+	}
+}
+
+// unmarshalSource restores the internal state of the random source returned by marshalSource.
+func unmarshalSource(src rand.Source64, data []byte) error {
+	switch src := src.(type) {
+	case *lockedSource:
+		return src.UnmarshalBinary(data)
+	case *chachaSource:
+		return src.UnmarshalBinary(data)
+	case *pcgSource:
+		if err := src.pcg.UnmarshalBinary(data); err != nil {
+			return errSourceState
+		}
+
+		return nil
+	case *xoshiroSource:
+		return src.UnmarshalBinary(data)
+	case cryptoSource:
+		return errCryptoState
+	default:
+		return errUnknownSource
+	}
+}
+
+// chachaSource is the ChaCha12 based random source of frand. It generates the same sequence
+// as frand.Source seeded with the same seed, but unlike frand.Source its state can be exported.
+type chachaSource struct {
+	// buff contains the key of the next block and the unread random bytes of the current block,
+	// the read bytes are erased.
+	buff [chacha.KeySize + chachaBlock]byte
+	// next is the position of the next unread byte in buff.
+	next int
+}
+
+const (
+	// chachaBlock is the number of random bytes generated at once (the buffer size of frand).
+	chachaBlock = 1024
+	// chachaRounds is the number of ChaCha rounds of frand.
+	chachaRounds = 12
+)
+
+var _ rand.Source64 = (*chachaSource)(nil)
+
+// read returns the next 8 random bytes of the stream as a number.
+// A new block is generated, keyed by the first bytes of the buffer, when all bytes have been read.
+func (s *chachaSource) read() uint64 {
+	if s.next == len(s.buff) {
+		var nonce [chacha.NonceSize]byte
+
+		chacha.XORKeyStream(s.buff[:], s.buff[:], nonce[:], s.buff[:chacha.KeySize], chachaRounds)
+		s.next = chacha.KeySize
+	}
+
+	chunk := s.buff[s.next : s.next+8]
+	val := binary.LittleEndian.Uint64(chunk)
+
+	clear(chunk)
+	s.next += len(chunk)
+
+	return val
+}
+
+// Int63 implements rand.Source.
+func (s *chachaSource) Int63() int64 {
+	// the values out of range are rejected (as in frand), instead of masking the highest bit
+	for {
+		if val := s.read(); val <= math.MaxInt64 {
+			return int64(val)
+		}
+	}
+}
+
+// Uint64 implements rand.Source64.
+func (s *chachaSource) Uint64() uint64 {
+	for {
+		if val := s.read(); val != math.MaxUint64 {
+			return val
+		}
+	}
+}
+
+// Seed implements rand.Source, the key of the first block is the little endian seed padded with zeros.
+func (s *chachaSource) Seed(seed int64) {
+	var (
+		key   [chacha.KeySize]byte
+		nonce [chacha.NonceSize]byte
+	)
+
+	binary.LittleEndian.PutUint64(key[:], uint64(seed)) //nolint:gosec
+
+	clear(s.buff[:])
+	chacha.XORKeyStream(s.buff[:], s.buff[:], nonce[:], key[:], chachaRounds)
+	s.next = chacha.KeySize
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, the state is the key of the next block
+// followed by the unread bytes of the current block.
+func (s *chachaSource) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, chacha.KeySize+len(s.buff)-s.next)
+
+	data = append(data, s.buff[:chacha.KeySize]...)
+
+	return append(data, s.buff[s.next:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *chachaSource) UnmarshalBinary(data []byte) error {
+	unread := len(data) - chacha.KeySize
+	if unread < 0 || unread > chachaBlock || unread%8 != 0 {
+		return errSourceState
+	}
+
+	clear(s.buff[:])
+	copy(s.buff[:], data[:chacha.KeySize])
+
+	s.next = len(s.buff) - unread
+	copy(s.buff[s.next:], data[chacha.KeySize:])
+
+	return nil
+}
+
+// pcgSource adapts the PCG generator of math/rand/v2 to rand.Source64.
+type pcgSource struct {
+	pcg *randv2.PCG
+}
+
+var _ rand.Source64 = (*pcgSource)(nil)
+
+// Int63 implements rand.Source.
+func (s *pcgSource) Int63() int64 {
+	return int64(s.pcg.Uint64() & math.MaxInt64) //nolint:gosec
+}
+
+// Uint64 implements rand.Source64.
+func (s *pcgSource) Uint64() uint64 {
+	return s.pcg.Uint64()
+}
+
+// Seed implements rand.Source, the state is the same as of rand.NewPCG(0, seed).
+func (s *pcgSource) Seed(seed int64) {
+	s.pcg.Seed(0, uint64(seed)) //nolint:gosec
+}
+
+// xoshiroSource is the xoshiro256** generator (https://prng.di.unimi.it/).
+type xoshiroSource struct {
+	state [4]uint64
+}
+
+var _ rand.Source64 = (*xoshiroSource)(nil)
+
+// Int63 implements rand.Source.
+func (s *xoshiroSource) Int63() int64 {
+	return int64(s.Uint64() & math.MaxInt64) //nolint:gosec
+}
+
+// Uint64 implements rand.Source64.
+func (s *xoshiroSource) Uint64() uint64 {
+	const (
+		rotate1 = 7
+		rotate2 = 45
+		shift   = 17
+		mul1    = 5
+		mul2    = 9
+	)
+
+	result := bits.RotateLeft64(s.state[1]*mul1, rotate1) * mul2
+	tmp := s.state[1] << shift
+
+	s.state[2] ^= s.state[0]
+	s.state[3] ^= s.state[1]
+	s.state[1] ^= s.state[2]
+	s.state[0] ^= s.state[3]
+	s.state[2] ^= tmp
+	s.state[3] = bits.RotateLeft64(s.state[3], rotate2)
+
+	return result
+}
+
+// Seed implements rand.Source, the state is initialized by the splitmix64 generator seeded with the seed,
+// as recommended by the authors of xoshiro.
+func (s *xoshiroSource) Seed(seed int64) {
+	const (
+		gamma  = 0x9e3779b97f4a7c15
+		mul1   = 0xbf58476d1ce4e5b9
+		mul2   = 0x94d049bb133111eb
+		shift1 = 30
+		shift2 = 27
+		shift3 = 31
+	)
+
+	x := uint64(seed) //nolint:gosec
+
+	for idx := range s.state {
+		x += gamma
+		z := x
+		z = (z ^ (z >> shift1)) * mul1
+		z = (z ^ (z >> shift2)) * mul2
+		s.state[idx] = z ^ (z >> shift3)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, the state is the four words in little endian order.
+func (s *xoshiroSource) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(s.state)*8)
+
+	for _, word := range s.state {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *xoshiroSource) UnmarshalBinary(data []byte) error {
+	if len(data) != len(s.state)*8 {
+		return errSourceState
+	}
+
+	for idx := range s.state {
+		s.state[idx] = binary.LittleEndian.Uint64(data[idx*8:])
+	}
+
+	return nil
+}
+
+// cryptoSource is the cryptographically secure random source of the operating system.
+type cryptoSource struct{}
+
+var _ rand.Source64 = cryptoSource{}
+
+// Int63 implements rand.Source.
+func (s cryptoSource) Int63() int64 {
+	return int64(s.Uint64() & math.MaxInt64) //nolint:gosec
+}
+
+// Uint64 implements rand.Source64.
+func (cryptoSource) Uint64() uint64 {
+	var buf [8]byte
+
+	_, _ = crand.Read(buf[:])
+
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// Seed implements rand.Source, the cryptographically secure random source can't be seeded.
+func (cryptoSource) Seed(_ int64) {}
@@ -0,0 +1,96 @@
+package faker
+
+import (
+	"math/rand"
+	randv2 "math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"lukechampine.com/frand"
+)
+
+func Test_newSource(t *testing.T) {
+	t.Parallel()
+
+	xoshiro := newSource(rngXoshiro, 42)
+
+	require.Equal(t, uint64(1546998764402558742), xoshiro.Uint64())
+	require.Equal(t, uint64(6990951692964543102), xoshiro.Uint64())
+	require.Equal(t, uint64(12544586762248559009), xoshiro.Uint64())
+
+	pcg := newSource(rngPCG64, 42)
+	ref := randv2.NewPCG(0, 42)
+
+	for range 10 {
+		require.Equal(t, ref.Uint64(), pcg.Uint64())
+	}
+
+	for _, kind := range []string{rngFrand, rngPCG64, rngXoshiro, rngCrypto} {
+		src := newSource(kind, 42)
+
+		for range 100 {
+			require.GreaterOrEqual(t, src.Int63(), int64(0), kind)
+		}
+	}
+
+	for _, kind := range []string{rngFrand, rngPCG64, rngXoshiro} {
+		first, second := newSource(kind, 42), newSource(kind, 42)
+
+		require.Equal(t, first.Uint64(), second.Uint64(), kind)
+
+		first.Seed(7)
+		second.Seed(7)
+
+		require.Equal(t, first.Int63(), second.Int63(), kind)
+	}
+
+	require.NotEqual(t, newSource(rngCrypto, 42).Uint64(), newSource(rngCrypto, 42).Uint64())
+}
+
+func Test_chachaSource(t *testing.T) {
+	t.Parallel()
+
+	src, ref := newSource(rngFrand, 42), frand.NewSource()
+
+	ref.Seed(42)
+
+	// more than one block, with rejected draws of Int63
+	for idx := range 1000 {
+		if idx%3 == 0 {
+			require.Equal(t, ref.Uint64(), src.Uint64())
+		} else {
+			require.Equal(t, ref.Int63(), src.Int63())
+		}
+	}
+}
+
+func Test_marshalSource(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range []string{rngFrand, rngPCG64, rngXoshiro} {
+		for _, src := range []rand.Source64{newSource(kind, 42), &lockedSource{src: newSource(kind, 42)}} {
+			for range 300 {
+				src.Uint64()
+			}
+
+			data, err := marshalSource(src)
+
+			require.NoError(t, err, kind)
+
+			restored := newSource(kind, 7)
+
+			require.NoError(t, unmarshalSource(restored, data), kind)
+
+			for range 300 {
+				require.Equal(t, src.Uint64(), restored.Uint64(), kind)
+			}
+
+			require.Error(t, unmarshalSource(restored, data[:len(data)-1]), kind)
+		}
+	}
+
+	_, err := marshalSource(newSource(rngCrypto, 42))
+
+	require.ErrorIs(t, err, errCryptoState)
+	require.ErrorIs(t, unmarshalSource(newSource(rngCrypto, 42), nil), errCryptoState)
+}
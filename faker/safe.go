@@ -0,0 +1,480 @@
+package faker
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/brianvoe/gofakeit/v6/data"
+)
+
+// generateFunc is the signature of the generator functions.
+type generateFunc func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error)
+
+// safeGenerators contains the replacements of the generator functions (by function name) used in safe mode.
+// The replacements only generate reserved or documentation identifiers: RFC 2606 domains and email addresses,
+// RFC 5737 and RFC 3849 IP addresses, 555-01xx phone numbers, well-known test credit card numbers and
+// never issued national identification, EIN and VAT numbers.
+// The info parameter of the replacements is the original generator function.
+//
+//nolint:gochecknoglobals
+var safeGenerators = map[string]generateFunc{
+	"email":           safeEmail,
+	"disposableEmail": safeEmail,
+	"domainName": func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+		return safeDomain(r), nil
+	},
+	"domainSuffix": func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+		return pickOf("test", "example", "invalid", "localhost")(r), nil
+	},
+	"url":         safeURL,
+	"ipv4Address": safeIPv4,
+	"ipv6Address": safeIPv6,
+	"phone": func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+		return safePhone(r), nil
+	},
+	"phoneFormatted":            safePhoneFormatted,
+	"creditCard":                safeCreditCard,
+	"creditCardNumber":          safeCreditCardNumber,
+	"creditCardNumberFormatted": safeCreditCardNumberFormatted,
+	"person":                    safePerson,
+	"imageUrl":                  safeURL,
+	"ssn": func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+		return safeSSN(r), nil
+	},
+	"nationalId": safeNationalID,
+	"ein":        safeOf(ein),
+	"vatNumber":  safeOf(vatnumber),
+}
+
+// safeAwareFunc is the signature of the composite generators calling identifier helpers (e.g. domainName),
+// the helpers generate reserved identifiers if safe is set.
+type safeAwareFunc func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error)
+
+// unsafeOf returns the generator function of the composite generator used outside of safe mode.
+func unsafeOf(generate safeAwareFunc) generateFunc {
+	return func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+		return generate(r, m, info, false)
+	}
+}
+
+// safeOf returns the generator function of the composite generator used in safe mode.
+func safeOf(generate safeAwareFunc) generateFunc {
+	return func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+		return generate(r, m, info, true)
+	}
+}
+
+func init() {
+	// the composite generators call generatorOf, so they can not be part of the initializer of safeGenerators
+	for name, generate := range map[string]safeAwareFunc{
+		"amqpMessage":        amqpmessage,
+		"cidr":               cidr,
+		"cloudEvent":         cloudevent,
+		"cookie":             cookie,
+		"dnsRecord":          dnsrecord,
+		"ipInCidr":           ipincidr,
+		"kafkaRecord":        kafkarecord,
+		"oauthTokenResponse": oauthtokenresponse,
+		"order":              order,
+		"organization":       organization,
+		"qrContent":          qrcontent,
+		"selfSignedCert":     selfsignedcert,
+		"zoneFile":           zonefile,
+	} {
+		safeGenerators[name] = safeOf(generate)
+	}
+}
+
+// testCards contains the well-known test credit card numbers of the payment providers by card type.
+//
+//nolint:gochecknoglobals
+var testCards = map[string][]string{
+	"visa":             {"4111111111111111", "4242424242424242", "4012888888881881"},
+	"mastercard":       {"5555555555554444", "5105105105105100", "2223003122003222"},
+	"american-express": {"378282246310005", "371449635398431"},
+	"diners-club":      {"30569309025904", "38520000023237"},
+	"discover":         {"6011111111111117", "6011000990139424"},
+	"jcb":              {"3530111333300000", "3566002020360505"},
+	"unionpay":         {"6200000000000005"},
+}
+
+// generatorOf returns the generator function of the function called by a composite generator,
+// in safe mode the safe replacement if any.
+func generatorOf(safe bool, name string, info *gofakeit.Info) generateFunc {
+	if safe {
+		if generate, found := safeGenerators[name[strings.LastIndexByte(name, '.')+1:]]; found {
+			return generate
+		}
+	}
+
+	return info.Generate
+}
+
+// generator returns the generator function of the function, in safe mode the safe replacement if any.
+func (f *faker) generator(name string, info *gofakeit.Info) generateFunc {
+	if f.opts.safe {
+		if generate, found := safeGenerators[name]; found {
+			return generate
+		}
+	}
+
+	return info.Generate
+}
+
+// domainName returns a random domain name, a reserved one in safe mode.
+func domainName(r *rand.Rand, safe bool) string {
+	if safe {
+		return safeDomain(r)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).DomainName()
+}
+
+// domainSuffix returns a random top level domain, a reserved one in safe mode.
+func domainSuffix(r *rand.Rand, safe bool) string {
+	if safe {
+		return pickOf("test", "example", "invalid", "localhost")(r)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).DomainSuffix()
+}
+
+// mailDomain returns a random domain name of email addresses, a reserved one in safe mode.
+func mailDomain(r *rand.Rand, safe bool) string {
+	if safe {
+		return pickOf("example.com", "example.net", "example.org")(r)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).DomainName()
+}
+
+// emailAddress returns a random email address, a reserved one in safe mode.
+func emailAddress(r *rand.Rand, safe bool) string {
+	if safe {
+		return randomLocalPart(r) + "@" + pickOf("example.com", "example.net", "example.org")(r)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).Email()
+}
+
+// phoneNumber returns a random 10 digit phone number, a fictional one in safe mode.
+func phoneNumber(r *rand.Rand, safe bool) string {
+	if safe {
+		return safePhone(r)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).Phone()
+}
+
+// phoneFormatted returns a random formatted phone number, a fictional one in safe mode.
+func phoneFormatted(r *rand.Rand, safe bool) string {
+	formatted := (&gofakeit.Faker{Rand: r}).PhoneFormatted()
+
+	if safe {
+		return replaceDigits(formatted, safePhone(r))
+	}
+
+	return formatted
+}
+
+// urlAddress returns a random URL, one with reserved host in safe mode.
+func urlAddress(r *rand.Rand, safe bool) string {
+	val := (&gofakeit.Faker{Rand: r}).URL()
+
+	if !safe {
+		return val
+	}
+
+	loc, err := url.Parse(val)
+	if err != nil {
+		return "https://www." + safeDomain(r) + "/"
+	}
+
+	loc.Host = "www." + safeDomain(r)
+
+	return loc.String()
+}
+
+// ipv4Address returns a random IPv4 address, one of the documentation range in safe mode.
+func ipv4Address(r *rand.Rand, safe bool) string {
+	if safe {
+		val, _ := safeIPv4(r, nil, nil)
+
+		return fmt.Sprint(val)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).IPv4Address()
+}
+
+// ipv6Address returns a random IPv6 address, one of the documentation range in safe mode.
+func ipv6Address(r *rand.Rand, safe bool) string {
+	if safe {
+		val, _ := safeIPv6(r, nil, nil)
+
+		return fmt.Sprint(val)
+	}
+
+	return (&gofakeit.Faker{Rand: r}).IPv6Address()
+}
+
+// documentationIPv4 contains the IPv4 address blocks reserved for documentation (RFC 5737).
+//
+//nolint:gochecknoglobals
+var documentationIPv4 = []netip.Prefix{
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+}
+
+// documentationIPv6 is the IPv6 address block reserved for documentation (RFC 3849).
+var documentationIPv6 = netip.MustParsePrefix("2001:db8::/32") //nolint:gochecknoglobals
+
+// documentationPrefix returns the documentation address block of the address family of the address,
+// the block containing the address if there is such.
+func documentationPrefix(addr netip.Addr) netip.Prefix {
+	if addr.Is6() {
+		return documentationIPv6
+	}
+
+	for _, prefix := range documentationIPv4 {
+		if prefix.Contains(addr) {
+			return prefix
+		}
+	}
+
+	return documentationIPv4[0]
+}
+
+// safePrefix returns the part of the network within the documentation ranges: the network itself
+// if it is within a documentation block, the documentation block if the network contains it,
+// otherwise the documentation block of the address family.
+func safePrefix(prefix netip.Prefix) netip.Prefix {
+	reserved := documentationPrefix(prefix.Addr())
+
+	switch {
+	case !reserved.Overlaps(prefix):
+		return reserved
+	case prefix.Bits() >= reserved.Bits():
+		return prefix
+	default:
+		return reserved
+	}
+}
+
+// safeDomain returns a domain name under a reserved top level domain or second level domain (RFC 2606).
+func safeDomain(r *rand.Rand) string {
+	name, _, _ := strings.Cut(strings.ToLower((&gofakeit.Faker{Rand: r}).DomainName()), ".")
+
+	return name + "." + pickOf("test", "example", "example.com", "example.net", "example.org")(r)
+}
+
+func safeEmail(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	return randomLocalPart(r) + "@" + pickOf("example.com", "example.net", "example.org")(r), nil
+}
+
+func safeURL(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	val, err := info.Generate(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := url.Parse(fmt.Sprint(val))
+	if err != nil {
+		return nil, err
+	}
+
+	loc.Host = "www." + safeDomain(r)
+
+	return loc.String(), nil
+}
+
+func safeIPv4(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const hosts = 254
+
+	return "192.0.2." + strconv.Itoa(1+r.Intn(hosts)), nil
+}
+
+func safeIPv6(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const groups = 6
+
+	parts := []string{"2001", "db8"}
+
+	for range groups {
+		parts = append(parts, strconv.FormatInt(int64(r.Intn(0x10000)), 16))
+	}
+
+	return strings.Join(parts, ":"), nil
+}
+
+// safePhone returns a 10 digit North American phone number of the 555-0100 - 555-0199 range reserved for fiction.
+func safePhone(r *rand.Rand) string {
+	const (
+		minAreaCode = 200
+		areaCodes   = 800
+		fictional   = 100
+	)
+
+	return fmt.Sprintf("%d555%04d", minAreaCode+r.Intn(areaCodes), fictional+r.Intn(fictional))
+}
+
+func safePhoneFormatted(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	val, err := info.Generate(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return replaceDigits(fmt.Sprint(val), safePhone(r)), nil
+}
+
+// replaceDigits replaces the digits of the formatted string from the end with the digits,
+// so the format (and the leading country code) is kept.
+func replaceDigits(formatted string, digits string) string {
+	chars := []rune(formatted)
+
+	for idx, pos := len(chars)-1, len(digits)-1; idx >= 0 && pos >= 0; idx-- {
+		if chars[idx] >= '0' && chars[idx] <= '9' {
+			chars[idx] = rune(digits[pos])
+			pos--
+		}
+	}
+
+	return string(chars)
+}
+
+// testCard returns a random test card number and its type, one of the types if the types are given.
+func testCard(r *rand.Rand, types []string) (string, string) {
+	types = slices.DeleteFunc(slices.Clone(types), func(typ string) bool { return len(testCards[typ]) == 0 })
+
+	if len(types) == 0 {
+		types = slices.Sorted(maps.Keys(testCards))
+	}
+
+	typ := types[r.Intn(len(types))]
+
+	return testCards[typ][r.Intn(len(testCards[typ]))], typ
+}
+
+// groupCard returns the card number grouped by the separator according to the gaps of the card type.
+func groupCard(number string, typ string, separator string) string {
+	var buf strings.Builder
+
+	buf.Grow(len(number) + len(data.CreditCards[typ].Gaps)*len(separator))
+
+	for idx, chr := range number {
+		if slices.Contains(data.CreditCards[typ].Gaps, uint(idx)) {
+			buf.WriteString(separator)
+		}
+
+		buf.WriteRune(chr)
+	}
+
+	return buf.String()
+}
+
+func safeCreditCard(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	val, err := info.Generate(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	card, ok := val.(*gofakeit.CreditCardInfo)
+	if !ok {
+		return val, nil
+	}
+
+	number, typ := testCard(r, nil)
+
+	card.Number = number
+	card.Type = data.CreditCards[typ].Display
+
+	return card, nil
+}
+
+func safeCreditCardNumber(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	types, err := info.GetStringArray(m, "types")
+	if err != nil {
+		return nil, err
+	}
+
+	gaps, err := info.GetBool(m, "gaps")
+	if err != nil {
+		return nil, err
+	}
+
+	number, typ := testCard(r, types)
+
+	if gaps {
+		return groupCard(number, typ, " "), nil
+	}
+
+	return number, nil
+}
+
+func safeCreditCardNumberFormatted(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	number, typ := testCard(r, nil)
+
+	return groupCard(number, typ, "-"), nil
+}
+
+func safePerson(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	val, err := info.Generate(r, m, info)
+	if err != nil {
+		return nil, err
+	}
+
+	person, ok := val.(*gofakeit.PersonInfo)
+	if !ok {
+		return val, nil
+	}
+
+	if person.Contact != nil {
+		person.Contact.Phone = safePhone(r)
+		person.Contact.Email = localPartOf(r, emailPart(person.FirstName), emailPart(person.LastName)) +
+			"@" + pickOf("example.com", "example.net", "example.org")(r)
+	}
+
+	if len(person.Image) != 0 {
+		if loc, err := url.Parse(person.Image); err == nil {
+			loc.Host = "images." + safeDomain(r)
+			person.Image = loc.String()
+		}
+	}
+
+	if len(person.SSN) != 0 {
+		person.SSN = safeSSN(r)
+	}
+
+	if person.CreditCard != nil {
+		number, typ := testCard(r, nil)
+
+		person.CreditCard.Number = number
+		person.CreditCard.Type = data.CreditCards[typ].Display
+	}
+
+	return person, nil
+}
+
+// safeSSN returns a US Social Security Number of the never issued area 666, without separators like gofakeit.
+func safeSSN(r *rand.Rand) string {
+	return strings.ReplaceAll(ssnOf(r, true, true), "-", "")
+}
+
+func safeNationalID(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	params := gofakeit.NewMapParams()
+
+	if m != nil {
+		maps.Copy(*params, *m)
+	}
+
+	// the fake numbers are never issued
+	(*params)["fake"] = []string{"true"}
+
+	return info.Generate(r, params, info)
+}
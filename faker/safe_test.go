@@ -0,0 +1,220 @@
+package faker_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals
+var (
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+-]+@([A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)+)`)
+	urlPattern    = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://(?:[^@/\s]+@)?(\[[0-9a-fA-F:]+\]|[^/\s:?#"'\\]+)`)
+	domainPattern = regexp.MustCompile(`(?i)(?:^|[^\w.@-])((?:[a-z0-9-]+\.)+(?:com|net|org|biz|info|name|io))(?:$|[^\w-])`)
+	ipv4Pattern   = regexp.MustCompile(`(?:^|[^\w./:])(\d{1,3}(?:\.\d{1,3}){3}(?:/\d{1,2})?)(?:$|[^\w.])`)
+	ipv6Pattern   = regexp.MustCompile(`(?:^|[^\w:./])([0-9a-fA-F]{0,4}(?::[0-9a-fA-F]{0,4}){2,7}(?:/\d{1,3})?)(?:$|[^\w:.])`)
+	phonePattern  = regexp.MustCompile(`(?m)^TEL(?:;[^:\r\n]*)?:([^\r\n]+)`)
+
+	// publicHosts are the hosts of public services and registries (e.g. container image registries),
+	// they are not identifiers of the generated organizations or persons.
+	publicHosts = []string{"googleapis.com", "gserviceaccount.com", "registry.k8s.io", "ghcr.io", "kubernetes.io"}
+
+	// reservedPrefixes are the documentation address blocks (RFC 5737 and RFC 3849).
+	reservedPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("192.0.2.0/24"),
+		netip.MustParsePrefix("198.51.100.0/24"),
+		netip.MustParsePrefix("203.0.113.0/24"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	}
+)
+
+func reservedDomain(domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	suffixes := []string{"test", "example", "invalid", "localhost", "example.com", "example.net", "example.org"}
+
+	for _, suffix := range append(suffixes, publicHosts...) {
+		if domain == suffix || strings.HasSuffix(domain, "."+suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reservedAddress(str string) bool {
+	if prefix, err := netip.ParsePrefix(str); err == nil {
+		return slices.ContainsFunc(reservedPrefixes, func(reserved netip.Prefix) bool {
+			return reserved.Bits() <= prefix.Bits() && reserved.Contains(prefix.Addr())
+		})
+	}
+
+	addr, err := netip.ParseAddr(strings.Trim(str, "[]"))
+	if err != nil {
+		return true // not an address
+	}
+
+	return slices.ContainsFunc(reservedPrefixes, func(reserved netip.Prefix) bool { return reserved.Contains(addr) })
+}
+
+func reservedPhone(str string) bool {
+	return strings.Contains(strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+
+		return -1
+	}, str), "55501")
+}
+
+// unsafeIdentifiers returns the identifiers of the value (emails, domains, IP addresses and phone numbers)
+// which are not reserved for documentation.
+func unsafeIdentifiers(key string, val any) []string {
+	var found []string
+
+	switch val := val.(type) {
+	case map[string]any:
+		for k, v := range val {
+			found = append(found, unsafeIdentifiers(k, v)...)
+		}
+	case []any:
+		for _, v := range val {
+			found = append(found, unsafeIdentifiers(key, v)...)
+		}
+	case []map[string]any:
+		for _, v := range val {
+			found = append(found, unsafeIdentifiers(key, v)...)
+		}
+	case string:
+		if strings.Count(val, ".") == 2 && strings.HasPrefix(val, "eyJ") {
+			// the claims of JSON Web Tokens
+			_, payload, _ := strings.Cut(val, ".")
+			payload, _, _ = strings.Cut(payload, ".")
+
+			var claims any
+
+			if data, err := base64.RawURLEncoding.DecodeString(payload); err == nil && json.Unmarshal(data, &claims) == nil {
+				found = append(found, unsafeIdentifiers(key, claims)...)
+			}
+		}
+
+		if strings.Contains(strings.ToLower(key), "phone") && !reservedPhone(val) {
+			found = append(found, val)
+		}
+
+		for _, match := range phonePattern.FindAllStringSubmatch(val, -1) {
+			if !reservedPhone(match[1]) {
+				found = append(found, match[0])
+			}
+		}
+
+		for _, match := range emailPattern.FindAllStringSubmatch(val, -1) {
+			if !reservedDomain(match[1]) {
+				found = append(found, match[0])
+			}
+		}
+
+		for _, match := range urlPattern.FindAllStringSubmatch(val, -1) {
+			if host, err := url.PathUnescape(match[1]); err == nil && !reservedAddress(host) {
+				found = append(found, match[0])
+			} else if _, err := netip.ParseAddr(strings.Trim(host, "[]")); err != nil && strings.Contains(host, ".") &&
+				!reservedDomain(host) {
+				found = append(found, match[0])
+			}
+		}
+
+		// company names may look like domain names (e.g. PlaceILive.com)
+		for _, match := range domainPattern.FindAllStringSubmatch(val, -1) {
+			if key != "name" && key != "company" && !reservedDomain(match[1]) {
+				found = append(found, match[1])
+			}
+		}
+
+		for _, pattern := range []*regexp.Regexp{ipv4Pattern, ipv6Pattern} {
+			for _, match := range pattern.FindAllStringSubmatch(val, -1) {
+				if !reservedAddress(match[1]) {
+					found = append(found, match[1])
+				}
+			}
+		}
+	}
+
+	return found
+}
+
+func Test_Faker_safe_functions(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	_, err := vm.RunString(`const faker = new Faker({ seed: 11, safe: true })`)
+
+	require.NoError(t, err)
+
+	leaks := make(map[string][]string)
+
+	for _, name := range slices.Sorted(maps.Keys(faker.GetFuncLookups())) {
+		for range 10 {
+			val, err := vm.RunString(`JSON.parse(JSON.stringify(faker.call("` + name + `")) ?? "null")`)
+			if err != nil {
+				break // the function requires parameters
+			}
+
+			if found := unsafeIdentifiers("", val.Export()); len(found) != 0 {
+				leaks[name] = found
+
+				break
+			}
+		}
+	}
+
+	require.Empty(t, leaks)
+}
+
+func Test_Faker_safe_serve(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	val, err := vm.RunString(`
+	const faker = new Faker({ seed: 11, safe: true })
+	const server = faker.serve("localhost:0", {
+	  "/organizations": { schema: { organization: "organization", order: "order", contact: "person" }, count: 10 },
+	})
+
+	server.url
+	`)
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _, _ = vm.RunString(`server.close()`) })
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, val.String()+"/organizations", nil)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	var body any
+
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body, 10)
+	require.Empty(t, unsafeIdentifiers("", body))
+}
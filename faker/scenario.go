@@ -0,0 +1,104 @@
+package faker
+
+import (
+	"hash/fnv"
+
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/lib"
+)
+
+// scenarioData pre-generates rows of data for the named scenario.
+//
+// The rows are generated by a Faker derived from the instance (see derive) and the scenario name,
+// so each scenario gets its own isolated, reproducible data set.
+// The next() method of the returned object returns the row of the current scenario iteration
+// (so VUs of the scenario get distinct rows), outside of the scenario it cycles through the rows.
+func (f *faker) scenarioData(name string, options sobek.Value) *sobek.Object {
+	if len(name) == 0 {
+		f.throw(&ArgumentError{Function: "scenarioData", Parameter: "scenario", Expected: "string", Reason: "missing parameter"})
+	}
+
+	opts := f.objectArgument("scenarioData", "options", options)
+
+	rows := f.countArgument("scenarioData", "", "rows", opts.Get("rows"), 1)
+
+	compiled := f.compileSchema("scenarioData", opts.Get("fields"))
+
+	budget := f.budget(func(reason string) {
+		f.throw(&ArgumentError{Function: "scenarioData", Parameter: "rows", Reason: reason})
+	})
+
+	budget.batch(rows)
+
+	gen := f.derive(name)
+	data := make([]sobek.Value, rows)
+
+	for idx := range data {
+		data[idx] = gen.generate(compiled)
+
+		budget.add(data[idx])
+	}
+
+	var counter int
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("length", len(data))
+	_ = obj.Set("next", func() sobek.Value {
+		if iter, ok := f.scenarioIteration(name); ok {
+			return data[iter%uint64(len(data))]
+		}
+
+		row := data[counter%len(data)]
+		counter++
+
+		return row
+	})
+
+	return obj
+}
+
+// scenarioIteration returns the iteration number of the named scenario in the test,
+// if the VU is currently executing the scenario.
+func (f *faker) scenarioIteration(name string) (uint64, bool) {
+	if f.vu == nil || f.vu.State() == nil || f.vu.State().GetScenarioGlobalVUIter == nil {
+		return 0, false
+	}
+
+	scenario := lib.GetScenarioState(f.vu.Context())
+	if scenario == nil || scenario.Name != name {
+		return 0, false
+	}
+
+	return f.vu.State().GetScenarioGlobalVUIter(), true
+}
+
+// derive returns a Faker seeded from the seed of the instance and the given name,
+// with the options, loaded datasets and stored entities of the instance.
+func (f *faker) derive(name string) *faker {
+	gen := newFakerWithOptions(f.deriveSeed(name), f.opts, f.runtime)
+	gen.vu = f.vu
+	gen.datasets = f.datasets
+	gen.entities = f.entities
+	gen.corpusKeys = f.corpusKeys
+
+	return gen
+}
+
+// deriveSeed returns a seed derived from the seed of the instance and the given name.
+func (f *faker) deriveSeed(name string) int64 {
+	hash := fnv.New64a()
+
+	_, _ = hash.Write([]byte(name))
+
+	return f.seed ^ int64(hash.Sum64()) //nolint:gosec
+}
+
+// objectArgument returns the value as an object, throws FakerArgumentError if it is not an object.
+func (f *faker) objectArgument(function, param string, val sobek.Value) *sobek.Object {
+	if !isOptions(val) {
+		f.throw(&ArgumentError{Function: function, Parameter: param, Expected: "object", Reason: "invalid value"})
+	}
+
+	return val.ToObject(f.runtime)
+}
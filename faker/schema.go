@@ -0,0 +1,334 @@
+package faker
+
+import (
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+// schema is a compiled data generation schema.
+//
+// The schema is a JavaScript object, the property values are field specifications:
+//   - string: the name of the generator function (e.g. "email" or "person.firstName")
+//   - array: the name of the generator function followed by its parameters (e.g. ["intRange", 1, 10])
+//   - object with func property: the name of the generator function and the args array
+//   - object with ref property: a random stored entity of the kind (see adapt) or a field of a random record
+//     generated by the collections method, the optional func and args properties are used as fallback
+//     if there is no stored entity of the kind
+//   - object with oneOf property: the array of alternative field specifications, picked randomly
+//     (proportionally to the optional weights array)
+//   - any other object: nested schema
+//
+// The objects with func, ref or oneOf property may contain modifiers: the nullable property is the probability
+// of a null value, the missing property is the probability of an absent field (both 0 by default).
+type schema struct {
+	fields []*schemaField
+}
+
+// schemaField is a compiled field specification of the schema.
+type schemaField struct {
+	name     string
+	function string
+	info     *gofakeit.Info
+	args     []sobek.Value
+	ref      string
+	nested   *schema
+	// nullable and missing are the probabilities of a null value and of an absent field.
+	nullable float64
+	missing  float64
+	// variants are the alternative field specifications of the oneOf modifier, picked by the weights.
+	variants []*schemaField
+	weights  []float64
+	// params are the converted arguments of the generator function if prepared is set (see compile).
+	params   *gofakeit.MapParams
+	prepared bool
+	// constraints are the checks of the value derived from the arguments (see expectations).
+	constraints []constraint
+}
+
+// maxSchemaNesting is the maximum nesting depth of the schema objects.
+const maxSchemaNesting = 32
+
+// compileSchema compiles the schema JavaScript object.
+// A schema containing itself (directly or in a nested schema) or nested deeper than maxSchemaNesting is rejected.
+func (f *faker) compileSchema(method string, val sobek.Value) *schema {
+	obj, ok := val.(*sobek.Object)
+	if !ok || obj.ClassName() != "Object" {
+		f.throw(&ArgumentError{Function: method, Parameter: "schema", Expected: "object", Reason: "invalid value " + val.String()})
+	}
+
+	if slices.Contains(f.compiling, obj) {
+		f.throw(&ArgumentError{Function: method, Parameter: "schema", Expected: "object", Reason: "the schema contains itself"})
+	}
+
+	if len(f.compiling) >= maxSchemaNesting {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "schema", Expected: "object",
+			Reason: "nested deeper than " + strconv.Itoa(maxSchemaNesting) + " levels",
+		})
+	}
+
+	f.compiling = append(f.compiling, obj)
+	defer func() { f.compiling = f.compiling[:len(f.compiling)-1] }()
+
+	keys := obj.Keys()
+	compiled := &schema{fields: make([]*schemaField, 0, len(keys))}
+
+	for _, key := range keys {
+		compiled.fields = append(compiled.fields, f.compileField(method, key, obj.Get(key)))
+	}
+
+	return compiled
+}
+
+func (f *faker) compileField(method string, name string, spec sobek.Value) *schemaField {
+	field := &schemaField{name: name}
+
+	var function sobek.Value
+
+	obj, isObject := spec.(*sobek.Object)
+
+	switch {
+	case !isObject:
+		function = spec
+	case obj.ClassName() == "Array":
+		var items []sobek.Value
+
+		_ = f.runtime.ExportTo(obj, &items)
+
+		if len(items) == 0 {
+			f.throw(&ArgumentError{Function: method, Parameter: name, Expected: "[func, ...args]", Reason: "empty field specification"})
+		}
+
+		function, field.args = items[0], items[1:]
+	case obj.Get("oneOf") != nil:
+		f.compileModifiers(method, field, obj)
+		f.compileVariants(method, field, obj)
+
+		return field
+	case obj.Get("ref") != nil:
+		f.compileModifiers(method, field, obj)
+
+		field.ref = obj.Get("ref").String()
+
+		if function = obj.Get("func"); function == nil {
+			return field
+		}
+
+		if args := obj.Get("args"); args != nil && !sobek.IsUndefined(args) {
+			_ = f.runtime.ExportTo(args, &field.args)
+		}
+	case obj.Get("func") != nil:
+		f.compileModifiers(method, field, obj)
+
+		function = obj.Get("func")
+
+		if args := obj.Get("args"); args != nil && !sobek.IsUndefined(args) {
+			_ = f.runtime.ExportTo(args, &field.args)
+		}
+	default:
+		field.nested = f.compileSchema(method, obj)
+
+		return field
+	}
+
+	field.function, field.info = f.lookup(method, function)
+
+	return field
+}
+
+// compileModifiers compiles the nullable and missing probabilities of the field specification object.
+func (f *faker) compileModifiers(method string, field *schemaField, obj *sobek.Object) {
+	for name, target := range map[string]*float64{"nullable": &field.nullable, "missing": &field.missing} {
+		val := obj.Get(name)
+		if val == nil || sobek.IsUndefined(val) {
+			continue
+		}
+
+		if *target = val.ToFloat(); !(*target >= 0 && *target <= 1) {
+			f.throw(&ArgumentError{
+				Function: method, Parameter: field.name + "." + name, Expected: "number between 0 and 1",
+				Reason: "invalid value " + val.String(),
+			})
+		}
+	}
+}
+
+// compileVariants compiles the alternative field specifications of the oneOf modifier and their weights.
+func (f *faker) compileVariants(method string, field *schemaField, obj *sobek.Object) {
+	var items []sobek.Value
+
+	if err := f.runtime.ExportTo(obj.Get("oneOf"), &items); err != nil || len(items) == 0 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: field.name + ".oneOf", Expected: "non-empty array of field specifications",
+			Reason: "invalid value " + obj.Get("oneOf").String(),
+		})
+	}
+
+	for _, item := range items {
+		field.variants = append(field.variants, f.compileField(method, field.name, item))
+	}
+
+	weights := obj.Get("weights")
+	if weights == nil || sobek.IsUndefined(weights) {
+		return
+	}
+
+	invalid := func(reason string) {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: field.name + ".weights", Expected: "array of non-negative numbers",
+			Reason: reason,
+		})
+	}
+
+	if err := f.runtime.ExportTo(weights, &field.weights); err != nil {
+		invalid("invalid value " + weights.String())
+	}
+
+	if len(field.weights) != len(field.variants) {
+		invalid("the length differs from the length of oneOf")
+	}
+
+	var total float64
+
+	for _, weight := range field.weights {
+		if !(weight >= 0) || math.IsInf(weight, 0) {
+			invalid("invalid weight " + formatNumber(weight))
+		}
+
+		total += weight
+	}
+
+	if total == 0 {
+		invalid("zero total weight")
+	}
+}
+
+// required returns a copy of the schema without the nullable and missing modifiers,
+// so all fields of the generated objects are present and not null.
+func (s *schema) required() *schema {
+	copied := &schema{fields: make([]*schemaField, len(s.fields))}
+
+	for idx, field := range s.fields {
+		dup := *field
+		dup.nullable, dup.missing = 0, 0
+
+		if dup.nested != nil {
+			dup.nested = dup.nested.required()
+		}
+
+		if len(dup.variants) != 0 {
+			dup.variants = (&schema{fields: dup.variants}).required().fields
+		}
+
+		copied.fields[idx] = &dup
+	}
+
+	return copied
+}
+
+// chance reports whether an event of the probability happens.
+// No random value is consumed for zero probability, so the modifiers don't change the values of other fields.
+func (f *faker) chance(probability float64) bool {
+	if probability <= 0 {
+		return false
+	}
+
+	f.rescope()
+
+	return f.rand.Float64() < probability
+}
+
+// generate generates a new JavaScript object based on the schema.
+func (f *faker) generate(s *schema) *sobek.Object {
+	obj := f.runtime.NewObject()
+
+	for _, field := range s.fields {
+		if f.chance(field.missing) {
+			continue
+		}
+
+		_ = obj.Set(field.name, f.generateField(field))
+	}
+
+	return obj
+}
+
+func (f *faker) generateField(field *schemaField) sobek.Value {
+	if f.chance(field.nullable) {
+		return sobek.Null()
+	}
+
+	if len(field.variants) != 0 {
+		return f.generateField(f.pickVariant(field))
+	}
+
+	if field.nested != nil {
+		return f.generate(field.nested)
+	}
+
+	if len(field.ref) != 0 {
+		if val, found := f.pickRelated(field.ref); found {
+			return val
+		}
+
+		if val, found := f.pickEntity(field.ref); found {
+			return val
+		}
+
+		if field.info == nil {
+			return sobek.Undefined()
+		}
+	}
+
+	return f.invokeParams(
+		field.function, field.info, sobek.FunctionCall{This: sobek.Undefined(), Arguments: field.args},
+		field.params, field.prepared,
+	)
+}
+
+// pickVariant returns a random variant of the oneOf field, proportionally to the weights if given.
+func (f *faker) pickVariant(field *schemaField) *schemaField {
+	f.rescope()
+
+	if len(field.weights) == 0 {
+		return field.variants[f.rand.Intn(len(field.variants))]
+	}
+
+	return field.variants[weightedIndex(f.rand, field.weights)]
+}
+
+// fromSchema generates a new object based on the schema.
+// If the invalid option is set, the object contains exactly one violation of the kind
+// (see fromSchemaInvalid), and it is returned together with the description of the violation.
+func (f *faker) fromSchema(schema sobek.Value, options sobek.Value) *sobek.Object {
+	const method = "fromSchema"
+
+	compiled := f.compileSchema(method, schema)
+
+	if options == nil || sobek.IsUndefined(options) {
+		return f.generate(compiled)
+	}
+
+	opts := f.objectArgument(method, "options", options)
+
+	val := opts.Get("invalid")
+	if val == nil || sobek.IsUndefined(val) {
+		return f.generate(compiled)
+	}
+
+	switch kind := val.String(); kind {
+	case invalidMissingRequired, invalidWrongType, invalidOutOfRange:
+		return f.fromSchemaInvalid(compiled, kind)
+	default:
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "invalid", Expected: "missingRequired, wrongType or outOfRange",
+			Reason: "invalid value " + kind,
+		})
+	}
+
+	return nil
+}
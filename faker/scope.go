@@ -0,0 +1,48 @@
+package faker
+
+import (
+	"strconv"
+
+	"go.k6.io/k6/v2/lib"
+)
+
+// rescope reseeds the random source if the seed scope of the instance has changed
+// since the last call (e.g. a new iteration has started).
+// The new seed is derived from the seed of the instance and the scope key,
+// so the generated data is reproducible but distinct per scope.
+func (f *faker) rescope() {
+	key, ok := f.scopeKey()
+	if !ok || key == f.scope {
+		return
+	}
+
+	f.scope = key
+	f.rand.Seed(f.deriveSeed(key))
+}
+
+// scopeKey returns the key of the current seed scope, false if the instance is not scoped
+// or it is not used in the VU context.
+func (f *faker) scopeKey() (string, bool) {
+	if f.opts.seedScope != scopeVU && f.opts.seedScope != scopeIteration {
+		return "", false
+	}
+
+	if f.vu == nil || f.vu.State() == nil {
+		return "", false
+	}
+
+	state := f.vu.State()
+	key := "vu/" + strconv.FormatUint(state.VUIDGlobal, 10)
+
+	if f.opts.seedScope == scopeVU {
+		return key, true
+	}
+
+	var scenario string
+
+	if s := lib.GetScenarioState(f.vu.Context()); s != nil {
+		scenario = s.Name
+	}
+
+	return scenario + "/" + key + "/" + strconv.FormatInt(state.Iteration, 10), true
+}
@@ -0,0 +1,226 @@
+package faker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	errInvalidSenML      = errors.New("invalid SenML record")
+	errUnknownPackFormat = errors.New("unknown SenML format")
+)
+
+// SenML pack formats.
+const (
+	senmlCBOR = "cbor"
+	senmlJSON = "json"
+)
+
+// senmlLabel is a field of a SenML record (RFC 8428).
+type senmlLabel struct {
+	name string
+	// key is the integer label of the field in CBOR representation.
+	key int64
+	// kind is the type of the value: string, number, boolean or data.
+	kind string
+}
+
+// senmlLabels contains the SenML record fields in the order they are encoded.
+//
+//nolint:gochecknoglobals,mnd
+var senmlLabels = []senmlLabel{
+	{"bver", -1, "number"}, {"bn", -2, "string"}, {"bt", -3, "number"}, {"bu", -4, "string"},
+	{"bv", -5, "number"}, {"bs", -6, "number"}, {"n", 0, "string"}, {"u", 1, "string"}, {"v", 2, "number"},
+	{"vs", 3, "string"}, {"vb", 4, "boolean"}, {"vd", 8, "data"}, {"s", 5, "number"}, {"t", 6, "number"},
+	{"ut", 7, "number"},
+}
+
+// senmlAliases contains the SenML fields of the sensor reading properties (e.g. of iot.sensorReading).
+//
+//nolint:gochecknoglobals
+var senmlAliases = map[string]string{"type": "n", "unit": "u", "timestamp": "t"}
+
+// senmlRecord returns the SenML record of the object. Besides the SenML fields, the properties of
+// the sensor readings are accepted: type, unit, value and timestamp (RFC 3339 or seconds),
+// the id or deviceId property is prepended to the name.
+func senmlRecord(obj map[string]any) (map[string]any, error) { //nolint:cyclop
+	record := make(map[string]any, len(obj))
+
+	var device string
+
+	for name, val := range obj {
+		switch name {
+		case "id", "deviceId":
+			device = fmt.Sprint(val)
+
+			continue
+		case "value":
+			switch val.(type) {
+			case string:
+				name = "vs"
+			case bool:
+				name = "vb"
+			default:
+				name = "v"
+			}
+		case "timestamp":
+			if str, ok := val.(string); ok {
+				when, err := time.Parse(time.RFC3339Nano, str)
+				if err != nil {
+					return nil, fmt.Errorf("%w: %s: %s", errInvalidSenML, name, err.Error())
+				}
+
+				val = float64(when.UnixMilli()) / float64(time.Second/time.Millisecond)
+			}
+		}
+
+		if alias, found := senmlAliases[name]; found {
+			name = alias
+		}
+
+		label, found := senmlLabelOf(name)
+		if !found {
+			return nil, fmt.Errorf("%w: unknown field %s", errInvalidSenML, name)
+		}
+
+		if _, found := record[name]; found {
+			return nil, fmt.Errorf("%w: duplicate field %s", errInvalidSenML, name)
+		}
+
+		if !label.valid(val) {
+			return nil, fmt.Errorf("%w: %s: %v (expected %s)", errInvalidSenML, name, val, label.kind)
+		}
+
+		record[name] = val
+	}
+
+	if len(device) != 0 {
+		name, _ := record["n"].(string)
+		record["n"] = device + ":" + name
+	}
+
+	return record, nil
+}
+
+// senmlLabelOf returns the SenML field of the name.
+func senmlLabelOf(name string) (senmlLabel, bool) {
+	for _, label := range senmlLabels {
+		if label.name == name {
+			return label, true
+		}
+	}
+
+	return senmlLabel{}, false
+}
+
+// valid reports whether the value is of the type of the field.
+func (l senmlLabel) valid(val any) bool {
+	switch l.kind {
+	case "number":
+		_, ok := floatOf(val)
+
+		return ok
+	case "boolean":
+		_, ok := val.(bool)
+
+		return ok
+	case "data":
+		_, ok := bytesOf(val)
+
+		return ok
+	default:
+		return isString(val)
+	}
+}
+
+// senmlPack returns the SenML pack of the records in JSON or CBOR format (application/senml+json
+// and application/senml+cbor content formats, used by LwM2M too). The base name is added to the first record.
+func senmlPack(records []map[string]any, format string, baseName string) ([]byte, error) {
+	if len(baseName) != 0 && len(records) != 0 {
+		if _, found := records[0]["bn"]; !found {
+			records[0]["bn"] = baseName
+		}
+	}
+
+	switch strings.ToLower(format) {
+	case senmlJSON:
+		return senmlJSONPack(records)
+	case senmlCBOR:
+		return senmlCBORPack(records)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownPackFormat, format)
+	}
+}
+
+func senmlJSONPack(records []map[string]any) ([]byte, error) {
+	buff := []byte{'['}
+
+	for idx, record := range records {
+		if idx > 0 {
+			buff = append(buff, ',')
+		}
+
+		buff = append(buff, '{')
+		sep := false
+
+		for _, label := range senmlLabels {
+			val, found := record[label.name]
+			if !found {
+				continue
+			}
+
+			if label.kind == "data" {
+				data, _ := bytesOf(val)
+				val = base64.RawURLEncoding.EncodeToString(data)
+			}
+
+			data, err := json.Marshal(val)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %s", errInvalidSenML, label.name, err.Error())
+			}
+
+			if sep {
+				buff = append(buff, ',')
+			}
+
+			buff = append(append(append(buff, '"'), label.name...), '"', ':')
+			buff = append(buff, data...)
+			sep = true
+		}
+
+		buff = append(buff, '}')
+	}
+
+	return append(buff, ']'), nil
+}
+
+func senmlCBORPack(records []map[string]any) ([]byte, error) {
+	buff := appendCBORHead(nil, cborArray, uint64(len(records)))
+
+	for _, record := range records {
+		buff = appendCBORHead(buff, cborMap, uint64(len(record)))
+
+		for _, label := range senmlLabels {
+			val, found := record[label.name]
+			if !found {
+				continue
+			}
+
+			if label.kind == "data" {
+				val, _ = bytesOf(val)
+			}
+
+			var err error
+
+			if buff, err = appendCBOR(appendCBORInt(buff, label.key), val); err != nil {
+				return nil, fmt.Errorf("%w: %s: %s", errInvalidSenML, label.name, err.Error())
+			}
+		}
+	}
+
+	return buff, nil
+}
@@ -0,0 +1,144 @@
+package faker
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+)
+
+// sequenceVersion is the current version of the deterministic sequence algorithm.
+//
+// Version 1 of the algorithm computes the SHA-256 hash of:
+//
+//  1. the text "xk6-faker/sequence/v1\n"
+//  2. count 64-bit values (little-endian) drawn from a new random source of the instance kind seeded with the seed
+//  3. for each function of sequenceFunctions (in order), the text name=JSON\n, where JSON is the JSON encoded value
+//     of the function called with default parameters, using the same random source
+//
+// The hash is returned as v1: followed by the lowercase hex digits. The primitive draws only depend on the
+// random source, the function values also depend on the order in which the embedded gofakeit generators
+// consume random numbers, so a change of that order changes the hash.
+const sequenceVersion = 1
+
+// sequenceCount is the default number of primitive draws of the sequence hash.
+const sequenceCount = 100
+
+// sequenceFunctions contains the generator functions included in the sequence hash.
+// Functions depending on the current time (e.g. date) must not be included.
+//
+//nolint:gochecknoglobals
+var sequenceFunctions = []string{
+	"firstName", "lastName", "email", "uuid", "int64", "float64", "boolean", "sentence", "price", "city",
+}
+
+// sequenceReferences contains the sequence hashes of the reference instance (frand source, seed 1,
+// 16 primitive draws) by algorithm version. A version can only be pinned if this build reproduces it.
+//
+//nolint:gochecknoglobals
+var sequenceReferences = map[int]string{
+	1: "v1:35617622e4cd6cc6e41656f38968446b52bc6dac00052954f561b57d4b8e7641",
+}
+
+var (
+	errNotReproducible     = errors.New("the crypto random source has no reproducible sequence")
+	errUnsupportedSequence = errors.New("unsupported sequence version")
+	errChangedSequence     = errors.New("the sequence of this build differs from the pinned sequence version")
+)
+
+// sequenceHash returns the hash of the deterministic sequence of the instance seed (see sequenceVersion).
+// The random source of the instance is not used, so calling it doesn't change the generated data.
+func (f *faker) sequenceHash(count sobek.Value) string {
+	draws := sequenceCount
+
+	if count != nil && !sobek.IsUndefined(count) {
+		if draws = int(count.ToInteger()); draws < 1 {
+			f.throw(&ArgumentError{
+				Function: "sequenceHash", Parameter: "count", Expected: "positive integer",
+				Reason: "invalid value " + count.String(),
+			})
+		}
+	}
+
+	if f.opts.rng == rngCrypto {
+		f.throw(errNotReproducible)
+	}
+
+	hash, err := sequenceDigest(f.opts.rng, f.seed, draws)
+	if err != nil {
+		f.throw(err)
+	}
+
+	return hash
+}
+
+// sequenceDigest returns the sequence hash of the random source kind seeded with the seed.
+func sequenceDigest(kind string, seed int64, count int) (string, error) {
+	rnd := rand.New(newSource(kind, seed)) //#nosec G404
+	hash := sha256.New()
+
+	_, _ = fmt.Fprintf(hash, "xk6-faker/sequence/v%d\n", sequenceVersion)
+
+	var buf [8]byte
+
+	for range count {
+		binary.LittleEndian.PutUint64(buf[:], rnd.Uint64())
+		_, _ = hash.Write(buf[:])
+	}
+
+	lookups := GetFuncLookups()
+
+	for _, name := range sequenceFunctions {
+		info, found := lookups[name]
+		if !found {
+			return "", &LookupError{Function: name}
+		}
+
+		val, err := info.Generate(rnd, gofakeit.NewMapParams(), info)
+		if err != nil {
+			return "", err
+		}
+
+		data, err := json.Marshal(val)
+		if err != nil {
+			return "", err
+		}
+
+		_, _ = fmt.Fprintf(hash, "%s=%s\n", name, data)
+	}
+
+	return fmt.Sprintf("v%d:%s", sequenceVersion, hex.EncodeToString(hash.Sum(nil))), nil
+}
+
+// assertSequenceVersion returns an error if the pinned version of the sequence algorithm
+// can't be reproduced by this build (e.g. after a change of the embedded gofakeit generators).
+// It is only an assertion: the builds implement a single version of the algorithm (see sequenceVersion),
+// so pinning a version doesn't change the generated values or the sequence hash.
+func assertSequenceVersion(version int) error {
+	reference, found := sequenceReferences[version]
+	if !found {
+		return fmt.Errorf("%w: %d", errUnsupportedSequence, version)
+	}
+
+	const (
+		referenceSeed  = 1
+		referenceCount = 16
+	)
+
+	hash, err := sequenceDigest(rngFrand, referenceSeed, referenceCount)
+	if err != nil {
+		return err
+	}
+
+	if hash != reference {
+		return fmt.Errorf("%w: %d", errChangedSequence, version)
+	}
+
+	return nil
+}
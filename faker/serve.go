@@ -0,0 +1,357 @@
+package faker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/js/modules"
+)
+
+var errInvalidRoute = errors.New("invalid route")
+
+// mockServer is an embedded HTTP server serving fake JSON responses.
+type mockServer struct {
+	server *http.Server
+	url    string
+}
+
+// The types of the global k6 events ending the test run (TestEnd and Exit). The event package of k6 is internal,
+// so the values are mirrored here and passed as untyped constants to the event subscriber.
+// Test_eventTypes checks them against the event types of the k6 version in go.mod.
+const (
+	eventTestEnd = 3
+	eventExit    = 6
+)
+
+// mockServers contains the running mock servers of a VU by listen address.
+// The servers are shut down by their close method or at the end of the test run.
+type mockServers struct {
+	mu      sync.Mutex
+	running map[string]*mockServer
+}
+
+// newMockServers returns an empty mock server registry.
+func newMockServers() *mockServers {
+	return &mockServers{running: make(map[string]*mockServer)}
+}
+
+// mockRoute is a compiled route of the mock server.
+type mockRoute struct {
+	schema *mockSchema
+	status int
+	// count is the number of generated objects in the response array, 0 for a single object response.
+	count int
+}
+
+// mockSchema is a schema compiled to generate values outside the JavaScript runtime.
+type mockSchema struct {
+	fields []*mockField
+}
+
+// mockField is a compiled field of mockSchema, the parameters are converted in advance.
+type mockField struct {
+	name     string
+	info     *gofakeit.Info
+	generate generateFunc
+	params   *gofakeit.MapParams
+	nested   *mockSchema
+	nullable float64
+	missing  float64
+	variants []*mockField
+	weights  []float64
+}
+
+// serve starts an embedded HTTP server listening on the address, serving fake JSON responses
+// generated from the schemas of the routes. The route keys are net/http ServeMux patterns
+// (e.g. "GET /users/{id}"), the values are schemas (see fromSchema) or route objects
+// with schema, status and count properties.
+//
+// The responses are generated on the goroutines of the server, using a random source
+// seeded from the instance, so stored entities (ref fields) and loaded datasets can't be used.
+// The servers are not shared between the VUs. If a server of the VU is already running on the address,
+// it is returned. The server is shut down at the end of the test run, unless it is closed before.
+func (f *faker) serve(addr string, routes sobek.Value) *sobek.Object {
+	const method = "serve"
+
+	if f.servers == nil {
+		f.servers = newMockServers()
+	}
+
+	f.servers.mu.Lock()
+	defer f.servers.mu.Unlock()
+
+	server, found := f.servers.running[addr]
+	if !found {
+		mux := http.NewServeMux()
+		obj := f.objectArgument(method, "routes", routes)
+
+		f.rescope()
+
+		rnd := rand.New(&lockedSource{src: newSource(f.opts.rng, f.rand.Int63())}) //#nosec G404
+
+		for _, pattern := range obj.Keys() {
+			route := f.compileRoute(method, pattern, obj.Get(pattern))
+
+			if err := registerRoute(mux, pattern, f.mockHandler(route, rnd)); err != nil {
+				f.throw(&ArgumentError{Function: method, Parameter: pattern, Expected: "route pattern", Reason: err.Error()})
+			}
+		}
+
+		server = f.startServer(method, addr, mux)
+	}
+
+	return f.serverObject(server)
+}
+
+// registerRoute registers the handler, converting the panic of ServeMux on invalid or conflicting pattern to error.
+func registerRoute(mux *http.ServeMux, pattern string, handler http.Handler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", errInvalidRoute, r)
+		}
+	}()
+
+	mux.Handle(pattern, handler)
+
+	return nil
+}
+
+// startServer starts the server listening on the address and stores it in f.servers (locked by the caller).
+func (f *faker) startServer(method string, addr string, handler http.Handler) *mockServer {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		f.throw(&ArgumentError{Function: method, Parameter: "addr", Expected: "listen address", Reason: err.Error()})
+	}
+
+	const readHeaderTimeout = 10 * time.Second
+
+	server := &mockServer{
+		server: &http.Server{Handler: handler, ReadHeaderTimeout: readHeaderTimeout},
+		url:    "http://" + listener.Addr().String(),
+	}
+
+	go func() { _ = server.server.Serve(listener) }()
+
+	f.servers.running[addr] = server
+
+	f.shutdownOnEnd(server)
+
+	return server
+}
+
+// shutdownOnEnd subscribes to the global events of the VU, so the server is closed when the test run ends.
+func (f *faker) shutdownOnEnd(server *mockServer) {
+	servers := f.servers
+
+	onTestEnd(f.vu, func() { servers.close(server) })
+}
+
+// onTestEnd calls fn when the test run ends (on the TestEnd or Exit global event of the VU).
+// Returns false if the global events are not available, fn is never called in this case.
+func onTestEnd(vu modules.VU, fn func()) bool {
+	if vu == nil || vu.Events().Global == nil {
+		return false
+	}
+
+	events := vu.Events().Global
+	subID, eventsCh := events.Subscribe(eventTestEnd, eventExit)
+
+	go func() {
+		evt, ok := <-eventsCh
+		if !ok {
+			return
+		}
+
+		fn()
+		evt.Done()
+		events.Unsubscribe(subID)
+
+		// the remaining events must be read, the emitter waits for the buffered channel
+		for evt := range eventsCh {
+			evt.Done()
+		}
+	}()
+
+	return true
+}
+
+// close removes the server from the running servers and shuts it down.
+func (s *mockServers) close(server *mockServer) {
+	s.mu.Lock()
+
+	for addr, running := range s.running {
+		if running == server {
+			delete(s.running, addr)
+		}
+	}
+
+	s.mu.Unlock()
+
+	_ = server.server.Shutdown(context.Background())
+}
+
+// serverObject returns the JavaScript object of the server with url property and close method.
+func (f *faker) serverObject(server *mockServer) *sobek.Object {
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("url", server.url)
+	servers := f.servers
+
+	_ = obj.Set("close", func() { servers.close(server) })
+
+	return obj
+}
+
+// compileRoute compiles the route specification, a schema or an object with schema, status and count properties.
+func (f *faker) compileRoute(method string, pattern string, spec sobek.Value) *mockRoute {
+	route := &mockRoute{status: http.StatusOK}
+	obj := f.objectArgument(method, pattern, spec)
+
+	if val := obj.Get("schema"); isOptions(val) {
+		if status := obj.Get("status"); status != nil && !sobek.IsUndefined(status) {
+			route.status = int(status.ToInteger())
+		}
+
+		if count := obj.Get("count"); count != nil && !sobek.IsUndefined(count) {
+			if route.count = int(count.ToInteger()); route.count < 1 {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: pattern + ".count", Expected: "positive integer",
+					Reason: "invalid value " + count.String(),
+				})
+			}
+		}
+
+		spec = val
+	}
+
+	route.schema = f.compileMockSchema(method, f.compileSchema(method, spec))
+
+	return route
+}
+
+// compileMockSchema converts the parameters of the schema fields in advance,
+// so the values can be generated outside the JavaScript runtime.
+func (f *faker) compileMockSchema(method string, s *schema) *mockSchema {
+	compiled := &mockSchema{fields: make([]*mockField, len(s.fields))}
+
+	for idx, field := range s.fields {
+		compiled.fields[idx] = f.compileMockField(method, field)
+	}
+
+	return compiled
+}
+
+func (f *faker) compileMockField(method string, field *schemaField) *mockField {
+	if len(field.ref) != 0 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: field.name, Expected: "field specification",
+			Reason: "ref fields are not supported",
+		})
+	}
+
+	compiled := &mockField{name: field.name, nullable: field.nullable, missing: field.missing, weights: field.weights}
+
+	switch {
+	case len(field.variants) != 0:
+		for _, variant := range field.variants {
+			compiled.variants = append(compiled.variants, f.compileMockField(method, variant))
+		}
+	case field.nested != nil:
+		compiled.nested = f.compileMockSchema(method, field.nested)
+	default:
+		compiled.info = field.info
+		compiled.generate = f.generator(field.function, field.info)
+		compiled.params = f.toMapParams(field.function, field.info, sobek.FunctionCall{
+			This: sobek.Undefined(), Arguments: field.args,
+		})
+	}
+
+	return compiled
+}
+
+// generateMock generates a plain object based on the compiled schema, with the properties in field order.
+func (f *faker) generateMock(s *mockSchema, rnd *rand.Rand) (object, error) {
+	obj := make(object, 0, len(s.fields))
+
+	for _, field := range s.fields {
+		if field.missing > 0 && rnd.Float64() < field.missing {
+			continue
+		}
+
+		val, err := f.generateMockField(field, rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		obj = append(obj, property{name: field.name, value: val})
+	}
+
+	return obj, nil
+}
+
+// generateMockField generates the value of the compiled field, applying the nullable and oneOf modifiers.
+func (f *faker) generateMockField(field *mockField, rnd *rand.Rand) (any, error) {
+	if field.nullable > 0 && rnd.Float64() < field.nullable {
+		return nil, nil //nolint:nilnil
+	}
+
+	switch {
+	case len(field.variants) != 0 && len(field.weights) != 0:
+		return f.generateMockField(field.variants[weightedIndex(rnd, field.weights)], rnd)
+	case len(field.variants) != 0:
+		return f.generateMockField(field.variants[rnd.Intn(len(field.variants))], rnd)
+	case field.nested != nil:
+		return f.generateMock(field.nested, rnd)
+	}
+
+	val, err := field.generate(rnd, field.params, field.info)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.output(val), nil
+}
+
+// mockHandler returns the HTTP handler responding with the JSON values generated by the route.
+func (f *faker) mockHandler(route *mockRoute, rnd *rand.Rand) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		var (
+			body any
+			err  error
+		)
+
+		if route.count == 0 {
+			body, err = f.generateMock(route.schema, rnd)
+		} else {
+			items := make([]any, route.count)
+
+			for idx := range items {
+				if items[idx], err = f.generateMock(route.schema, rnd); err != nil {
+					break
+				}
+			}
+
+			body = items
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(route.status)
+
+		_ = json.NewEncoder(w).Encode(body)
+	})
+}
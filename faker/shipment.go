@@ -0,0 +1,192 @@
+package faker
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// serviceLevel is a shipping service with its transit time in days.
+type serviceLevel struct {
+	name    string
+	minDays int
+	maxDays int
+}
+
+// serviceLevels contains the shipping services of the carriers.
+//
+//nolint:gochecknoglobals,mnd
+var serviceLevels = []serviceLevel{
+	{"overnight", 1, 1},
+	{"express", 2, 3},
+	{"ground", 3, 7},
+	{"economy", 5, 10},
+}
+
+func init() {
+	gofakeit.AddFuncLookup("shipment", gofakeit.Info{
+		Display:     "Shipment",
+		Category:    "commerce",
+		Description: "Parcel shipment with its tracking number and the ordered tracking events from label creation up to delivery",
+		Example: `{
+	"trackingNumber": "1Z4X7P2M0381927456",
+	"carrier": "UPS",
+	"serviceLevel": "ground",
+	"status": "delivered",
+	"weightKg": 2.4,
+	"dimensions": {"lengthCm": 40, "widthCm": 30, "heightCm": 15},
+	"fromAddress": {"name": "Markus Moen", "street": "369 North Cornerbury", "city": "Miami", "state": "Florida", "zip": "58129", "country": "United States of America"},
+	"toAddress": {"name": "Jeffery Glover", "street": "4757 Port Heightsside", "city": "Denver", "state": "Colorado", "zip": "80202", "country": "United States of America"},
+	"events": [
+		{"status": "label_created", "description": "Shipping label created", "location": "Miami, Florida", "timestamp": "2024-05-02T14:05:00Z"},
+		{"status": "picked_up", "description": "Picked up by carrier", "location": "Miami, Florida", "timestamp": "2024-05-03T09:40:00Z"},
+		{"status": "in_transit", "description": "Departed facility", "location": "Atlanta, Georgia", "timestamp": "2024-05-04T02:15:00Z"},
+		{"status": "out_for_delivery", "description": "Out for delivery", "location": "Denver, Colorado", "timestamp": "2024-05-07T07:20:00Z"},
+		{"status": "delivered", "description": "Delivered, left at front door", "location": "Denver, Colorado", "timestamp": "2024-05-07T13:55:00Z"}
+	]
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{
+				Field:       "carrier",
+				Display:     "Carrier",
+				Type:        "string",
+				Default:     "any",
+				Options:     []string{"any", "ups", "fedex", "usps", "dhl"},
+				Description: "Shipping carrier",
+			},
+		},
+		Generate: shipment,
+	})
+}
+
+// deliveryNotes contains the descriptions of the delivered events.
+//
+//nolint:gochecknoglobals
+var deliveryNotes = []string{
+	"Delivered, left at front door", "Delivered, handed to resident", "Delivered to mailroom",
+	"Delivered, left at back door", "Delivered to parcel locker", "Delivered, signed by recipient",
+}
+
+func shipment(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		maxAge     = 14 * 24 * time.Hour
+		maxWeight  = 30
+		minSide    = 10
+		maxSide    = 120
+		maxHubs    = 3
+		slot       = 5 * time.Minute
+		tenth      = 10
+		pickupHour = 8
+		hoursOfDay = 10
+	)
+
+	carrier, err := info.GetString(m, "carrier")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(carrier, "any") {
+		carrier = carriers[r.Intn(len(carriers))]
+	}
+
+	tracking, err := newTrackingNumber(r, carrier)
+	if err != nil {
+		return nil, err
+	}
+
+	// the display name of the carrier
+	for _, name := range carriers {
+		if strings.EqualFold(name, carrier) {
+			carrier = name
+		}
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+	service := serviceLevels[r.Intn(len(serviceLevels))]
+	from, to := shipmentAddressOf(fake), shipmentAddressOf(fake)
+
+	// the label is created in the past, the events are on 5 minute slots of the working hours of the next days
+	created := time.Now().UTC().Add(-time.Duration(r.Int63n(int64(maxAge)))).Truncate(slot)
+	during := func(day time.Time) time.Time {
+		day = day.Truncate(24 * time.Hour) //nolint:mnd
+
+		return day.Add(pickupHour*time.Hour + time.Duration(r.Int63n(int64(hoursOfDay*time.Hour/slot)))*slot)
+	}
+
+	transit := service.minDays + r.Intn(service.maxDays-service.minDays+1)
+	pickup := during(created.AddDate(0, 0, 1))
+	delivery := during(pickup.AddDate(0, 0, transit))
+	out := delivery.Add(-time.Duration(1+r.Intn(hoursOfDay/2)) * time.Hour) //nolint:mnd
+
+	// the shipment is on its way while the latest events are in the future
+	now := time.Now().UTC()
+	events := make([]object, 0, maxHubs+4) //nolint:mnd
+	track := func(status string, description string, addr object, when time.Time) {
+		if !when.After(now) {
+			events = append(events, shipmentEventOf(status, description, addr, when))
+		}
+	}
+
+	track("label_created", "Shipping label created", from, created)
+	track("picked_up", "Picked up by carrier", from, pickup)
+
+	// the hubs are visited evenly between the pick up and the out for delivery events
+	hubs := 1 + r.Intn(maxHubs)
+	step := out.Sub(pickup) / time.Duration(hubs+1)
+
+	for idx := range hubs {
+		desc := "Departed facility"
+		if idx == 0 {
+			desc = "Arrived at facility"
+		}
+
+		track("in_transit", desc, shipmentAddressOf(fake), pickup.Add(step*time.Duration(idx+1)).Truncate(slot))
+	}
+
+	track("out_for_delivery", "Out for delivery", to, out)
+	track("delivered", deliveryNotes[r.Intn(len(deliveryNotes))], to, delivery)
+
+	return object{
+		{"trackingNumber", tracking},
+		{"carrier", carrier},
+		{"serviceLevel", service.name},
+		{"status", events[len(events)-1].get("status")},
+		{"weightKg", math.Round(fake.Float64Range(0.1, maxWeight)*tenth) / tenth},
+		{"dimensions", object{
+			{"lengthCm", minSide + r.Intn(maxSide-minSide+1)},
+			{"widthCm", minSide + r.Intn(maxSide/2-minSide+1)},
+			{"heightCm", minSide + r.Intn(maxSide/2-minSide+1)},
+		}},
+		{"fromAddress", from},
+		{"toAddress", to},
+		{"events", events},
+	}, nil
+}
+
+// shipmentAddressOf returns a random postal address with the name of the addressee.
+func shipmentAddressOf(fake *gofakeit.Faker) object {
+	addr := fake.Address()
+
+	return object{
+		{"name", fake.FirstName() + " " + fake.LastName()},
+		{"street", addr.Street},
+		{"city", addr.City},
+		{"state", addr.State},
+		{"zip", addr.Zip},
+		{"country", addr.Country},
+	}
+}
+
+// shipmentEventOf returns a tracking event at the city of the address.
+func shipmentEventOf(status string, description string, addr object, when time.Time) object {
+	return object{
+		{"status", status},
+		{"description", description},
+		{"location", addr.get("city").(string) + ", " + addr.get("state").(string)}, //nolint:forcetypeassert
+		{"timestamp", when.Format(time.RFC3339)},
+	}
+}
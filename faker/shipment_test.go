@@ -0,0 +1,86 @@
+package faker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_shipment(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("shipment")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+	statuses := []string{"label_created", "picked_up", "in_transit", "out_for_delivery", "delivered"}
+
+	for range 50 {
+		val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+		require.NoError(t, err)
+
+		shipment, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+		require.NotEmpty(t, shipment["trackingNumber"])
+		require.Contains(t, []string{"UPS", "FedEx", "USPS", "DHL"}, shipment["carrier"])
+		require.Contains(t, []string{"overnight", "express", "ground", "economy"}, shipment["serviceLevel"])
+
+		events, ok := shipment["events"].([]map[string]any)
+
+		require.True(t, ok)
+		require.NotEmpty(t, events)
+		require.Equal(t, "label_created", events[0]["status"])
+		require.Equal(t, events[len(events)-1]["status"], shipment["status"])
+
+		var (
+			last  time.Time
+			stage int
+		)
+
+		for _, event := range events {
+			when, err := time.Parse(time.RFC3339, event["timestamp"].(string)) //nolint:forcetypeassert
+
+			require.NoError(t, err)
+			require.False(t, when.Before(last))
+			require.False(t, when.After(time.Now()))
+
+			next := stage
+			for statuses[next] != event["status"] {
+				next++
+			}
+
+			require.GreaterOrEqual(t, next, stage)
+
+			last, stage = when, next
+		}
+	}
+}
+
+func Test_shipment_carrier(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("shipment")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("carrier", "fedex")
+
+	val, err := info.Generate(testRand(t), params, info)
+
+	require.NoError(t, err)
+	require.Equal(t, "FedEx", faker.PlainMaps(val).(map[string]any)["carrier"]) //nolint:forcetypeassert
+
+	params = gofakeit.NewMapParams()
+	params.Add("carrier", "pigeon")
+
+	_, err = info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "unknown carrier: pigeon")
+}
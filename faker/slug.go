@@ -0,0 +1,137 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/iancoleman/strcase"
+)
+
+var (
+	errInvalidWordCount = errors.New("word count must be positive")
+	errEmptyAlphabet    = errors.New("empty alphabet")
+)
+
+func init() {
+	addCaseLookup("slug", "Slug", "URL path segment of lowercase words separated by hyphens", "summer-sale-shoes",
+		strcase.ToKebab)
+	addCaseLookup("snakecase", "Snake Case", "Identifier of lowercase words separated by underscores", "order_line_item",
+		strcase.ToSnake)
+	addCaseLookup("camelcase", "Camel Case", "Identifier of words in lower camel case", "orderLineItem",
+		strcase.ToLowerCamel)
+	addCaseLookup("kebabcase", "Kebab Case", "Identifier of lowercase words separated by hyphens", "order-line-item",
+		strcase.ToKebab)
+
+	gofakeit.AddFuncLookup("identifier", gofakeit.Info{
+		Display:     "Identifier",
+		Category:    "string",
+		Description: "Random resource identifier of the alphabet with optional prefix (e.g. cus_, ord-)",
+		Example:     "cus_N3k8QfZ2aLx7",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "prefix",
+				Display:     "Prefix",
+				Type:        "string",
+				Optional:    true,
+				Description: "Prefix of the identifier, used as is",
+			},
+			{
+				Field:       "length",
+				Display:     "Length",
+				Type:        "int",
+				Default:     "12",
+				Description: "Number of random characters after the prefix",
+			},
+			{
+				Field:       "alphabet",
+				Display:     "Alphabet",
+				Type:        "string",
+				Default:     "alphanumeric",
+				Options:     cloudTypes(identifierAlphabets)[1:],
+				Description: "Name of the alphabet or the characters to be used",
+			},
+		},
+		Generate: identifier,
+	})
+}
+
+// identifierAlphabets contains the named alphabets of identifiers.
+var identifierAlphabets = map[string]string{ //nolint:gochecknoglobals
+	"alphanumeric": mixedAlphanum,
+	"lowercase":    alphanum,
+	"uppercase":    "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789",
+	"hex":          "0123456789abcdef",
+	"numeric":      "0123456789",
+	"base58":       "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+	"crockford":    "0123456789ABCDEFGHJKMNPQRSTVWXYZ",
+}
+
+// addCaseLookup registers a generator in the strings category joining random words in the format.
+func addCaseLookup(key string, display string, description string, example string, join func(string) string) {
+	gofakeit.AddFuncLookup(key, gofakeit.Info{
+		Display:     display,
+		Category:    "string",
+		Description: description,
+		Example:     example,
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{Field: "words", Display: "Words", Type: "int", Default: "3", Description: "Number of words"},
+		},
+		Generate: func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+			count, err := info.GetInt(m, "words")
+			if err != nil {
+				return nil, err
+			}
+
+			if count < 1 {
+				return nil, fmt.Errorf("%w: %d", errInvalidWordCount, count)
+			}
+
+			if err := checkCount(count); err != nil {
+				return nil, err
+			}
+
+			fake := &gofakeit.Faker{Rand: r}
+			words := make([]string, count)
+
+			for idx := range words {
+				words[idx] = wordOf(fake)
+			}
+
+			return join(strings.Join(words, " ")), nil
+		},
+	})
+}
+
+func identifier(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	length, err := info.GetInt(m, "length")
+	if err != nil {
+		return nil, err
+	}
+
+	if length < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeLength, length)
+	}
+
+	alphabet, err := info.GetString(m, "alphabet")
+	if err != nil {
+		return nil, err
+	}
+
+	if named, found := identifierAlphabets[strings.ToLower(alphabet)]; found {
+		alphabet = named
+	}
+
+	if len(alphabet) == 0 {
+		return nil, errEmptyAlphabet
+	}
+
+	// the prefix is optional
+	prefix, _ := info.GetString(m, "prefix")
+
+	return prefix + runesOf(r, alphabet, length), nil
+}
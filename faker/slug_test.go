@@ -0,0 +1,86 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_caseLookups(t *testing.T) {
+	t.Parallel()
+
+	formats := map[string]*regexp.Regexp{
+		"slug":      regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+){3}$`),
+		"snakecase": regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+){3}$`),
+		"camelcase": regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z][a-z0-9]*){3}$`),
+		"kebabcase": regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+){3}$`),
+	}
+
+	r := testRand(t)
+
+	for key, format := range formats {
+		info := gofakeit.GetFuncLookup(key)
+
+		require.NotNil(t, info)
+
+		params := gofakeit.NewMapParams()
+		params.Add("words", "4")
+
+		for range 20 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Regexp(t, format, val, key)
+		}
+
+		params = gofakeit.NewMapParams()
+		params.Add("words", "0")
+
+		_, err := info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, "word count must be positive")
+
+		params = gofakeit.NewMapParams()
+		params.Add("words", "100001")
+
+		_, err = info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, "too many items: 100001")
+	}
+}
+
+func Test_identifier(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("identifier")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^[a-zA-Z0-9]{12}$`), val)
+
+	params := gofakeit.NewMapParams()
+	params.Add("prefix", "cus_")
+	params.Add("length", "8")
+	params.Add("alphabet", "hex")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^cus_[0-9a-f]{8}$`), val)
+
+	params = gofakeit.NewMapParams()
+	params.Add("alphabet", "αβγ")
+	params.Add("length", "5")
+
+	val, err = info.Generate(r, params, info)
+
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^[αβγ]{5}$`), val)
+}
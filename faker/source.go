@@ -0,0 +1,54 @@
+package faker
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// lockedSource is a random source safe for concurrent use.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+var _ rand.Source64 = (*lockedSource)(nil)
+
+// Int63 implements rand.Source.
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Int63()
+}
+
+// Uint64 implements rand.Source64.
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Uint64()
+}
+
+// Seed implements rand.Source.
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.src.Seed(seed)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, the state of the wrapped source is exported.
+func (s *lockedSource) MarshalBinary() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return marshalSource(s.src)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, the state of the wrapped source is restored.
+func (s *lockedSource) UnmarshalBinary(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return unmarshalSource(s.src, data)
+}
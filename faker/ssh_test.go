@@ -0,0 +1,69 @@
+package faker_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sshkeypair(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("sshkeypair")
+
+	require.NotNil(t, info)
+
+	for alg, keyType := range map[string]string{"ed25519": "ssh-ed25519", "RSA": "ssh-rsa"} {
+		params := gofakeit.NewMapParams()
+		params.Add("algorithm", alg)
+
+		val, err := info.Generate(testRand(t), params, info)
+
+		require.NoError(t, err)
+
+		pair := faker.PlainMaps(val).(map[string]any) //nolint:forcetypeassert
+
+		fields := strings.Fields(pair["publicKey"].(string)) //nolint:forcetypeassert
+
+		require.Len(t, fields, 3)
+		require.Equal(t, keyType, fields[0])
+		require.Equal(t, pair["comment"], fields[2])
+
+		blob, err := base64.StdEncoding.DecodeString(fields[1])
+
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(blob[4:], []byte(keyType)))
+
+		sum := sha256.Sum256(blob)
+
+		require.Equal(t, "SHA256:"+base64.RawStdEncoding.EncodeToString(sum[:]), pair["fingerprint"])
+		require.Len(t, pair["fingerprintMD5"], 47)
+
+		block, rest := pem.Decode([]byte(pair["privateKey"].(string))) //nolint:forcetypeassert
+
+		require.NotNil(t, block)
+		require.Empty(t, rest)
+		require.Equal(t, "OPENSSH PRIVATE KEY", block.Type)
+		require.True(t, bytes.HasPrefix(block.Bytes, []byte("openssh-key-v1\x00")))
+		require.True(t, bytes.Contains(block.Bytes, blob))
+
+		again, err := info.Generate(testRand(t), params, info)
+
+		require.NoError(t, err)
+		require.Equal(t, pair, faker.PlainMaps(again), alg)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("algorithm", "dsa")
+
+	_, err := info.Generate(testRand(t), params, info)
+
+	require.ErrorContains(t, err, "unknown SSH key algorithm")
+}
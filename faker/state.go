@@ -0,0 +1,287 @@
+package faker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/grafana/sobek"
+)
+
+// stateVersion is the version of the exported state format.
+// Version 2 contains the internal state of the random source instead of the recorded draws of version 1.
+const stateVersion = 2
+
+var (
+	errInvalidState   = errors.New("invalid Faker state")
+	errReplaySnapshot = errors.New("the snapshot of an instance replaying recorded values is not supported")
+)
+
+// fakerState is the exported state of a Faker instance.
+type fakerState struct {
+	Version         int            `json:"v"`
+	Seed            int64          `json:"seed"`
+	SeedScope       string         `json:"seedScope,omitempty"`
+	RFC3339         bool           `json:"rfc3339,omitempty"`
+	Defaults        map[string]any `json:"defaults,omitempty"`
+	Metrics         bool           `json:"metrics,omitempty"`
+	Safe            bool           `json:"safe,omitempty"`
+	RNG             string         `json:"rng,omitempty"`
+	SequenceVersion int            `json:"sequenceVersion,omitempty"`
+	MaxTotalBytes   int64          `json:"maxTotalBytes,omitempty"`
+	Parallel        int            `json:"parallel,omitempty"`
+	Scope           string         `json:"scope,omitempty"`
+	// Source contains the internal state of the random source, it is missing from the snapshots
+	// of instances using the crypto random source.
+	Source []byte `json:"source,omitempty"`
+	// Entities contains the entity store, only in snapshots.
+	Entities map[string]entitiesState `json:"entities,omitempty"`
+	// Datasets contains the datasets loaded by loadData, only in snapshots.
+	Datasets map[string]datasetState `json:"datasets,omitempty"`
+	// Recipes contains the compiled recipes, only in snapshots.
+	Recipes []string `json:"recipes,omitempty"`
+	// Codecs contains the cache keys (method and source) of the compiled Avro and protobuf schemas,
+	// only in snapshots.
+	Codecs []string `json:"codecs,omitempty"`
+	// Corpora contains the cache keys of the markov corpus files by path, only in snapshots.
+	// The chains trained from the files are shared by the instances of the k6 process.
+	Corpora map[string]string `json:"corpora,omitempty"`
+	// Sensors contains the last values of the simulated sensors, only in snapshots.
+	Sensors map[string]float64 `json:"sensors,omitempty"`
+}
+
+// datasetState is the exported state of a loaded dataset.
+type datasetState struct {
+	Values   []string `json:"values"`
+	Embedded int      `json:"embedded,omitempty"`
+}
+
+// entitiesState is the exported state of the stored entities of a kind.
+type entitiesState struct {
+	Values []any `json:"values"`
+	Next   int   `json:"next,omitempty"`
+}
+
+// state returns the opaque string representation of the random source position.
+// The state of the crypto random source can not be exported.
+func (f *faker) state() string {
+	return f.export(false)
+}
+
+// snapshot returns the opaque string representation of the instance, including the options,
+// the random source position, the entity store, the loaded datasets, the compiled recipes and codecs,
+// the markov corpus files and the sensor values, so it can be passed from the setup function to the VUs.
+// The instances replaying recorded values cannot be exported. The snapshots of instances using
+// the crypto random source contain no random source state, the restored instance uses a new crypto source.
+func (f *faker) snapshot() string {
+	if f.replay != nil {
+		f.throw(errReplaySnapshot)
+	}
+
+	return f.export(true)
+}
+
+func (f *faker) export(withEntities bool) string {
+	state := fakerState{
+		Version:         stateVersion,
+		Seed:            f.seed,
+		SeedScope:       f.opts.seedScope,
+		RFC3339:         f.opts.rfc3339,
+		Scope:           f.scope,
+		Defaults:        exportDefaults(f.opts.defaults),
+		Metrics:         f.opts.metrics,
+		Safe:            f.opts.safe,
+		RNG:             f.opts.rng,
+		SequenceVersion: f.opts.sequenceVersion,
+		MaxTotalBytes:   f.opts.maxTotalBytes,
+		Parallel:        f.opts.parallel,
+	}
+
+	source, err := marshalSource(f.source)
+	if err != nil && (!withEntities || !errors.Is(err, errCryptoState)) {
+		f.throw(err)
+	}
+
+	state.Source = source
+
+	if withEntities {
+		f.exportSnapshot(&state)
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		f.throw(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// exportSnapshot adds the state carried only by snapshots to the exported state.
+func (f *faker) exportSnapshot(state *fakerState) {
+	if len(f.entities) != 0 {
+		state.Entities = make(map[string]entitiesState, len(f.entities))
+
+		for kind, store := range f.entities {
+			values := make([]any, len(store.values))
+
+			for idx, val := range store.values {
+				values[idx] = val.Export()
+			}
+
+			state.Entities[kind] = entitiesState{Values: values, Next: store.next}
+		}
+	}
+
+	if len(f.datasets) != 0 {
+		state.Datasets = make(map[string]datasetState, len(f.datasets))
+
+		for name, loaded := range f.datasets {
+			state.Datasets[name] = datasetState{Values: loaded.values, Embedded: loaded.embedded}
+		}
+	}
+
+	state.Recipes = slices.Sorted(maps.Keys(f.recipes))
+	state.Codecs = slices.Sorted(maps.Keys(f.codecs))
+	state.Corpora = f.corpusKeys
+	state.Sensors = f.sensors
+}
+
+// restoreSnapshot restores the state carried only by snapshots.
+func (f *faker) restoreSnapshot(state *fakerState) error {
+	for kind, store := range state.Entities {
+		if store.Next < 0 || store.Next >= max(len(store.Values), 1) {
+			return errInvalidState
+		}
+
+		restored := &entities{values: make([]sobek.Value, len(store.Values)), next: store.Next}
+
+		for idx, val := range store.Values {
+			restored.values[idx] = f.runtime.ToValue(val)
+		}
+
+		f.entities[kind] = restored
+	}
+
+	for name, loaded := range state.Datasets {
+		if _, found := datasets[name]; !found || len(loaded.Values) == 0 || loaded.Embedded < 0 {
+			return errInvalidState
+		}
+
+		if f.datasets == nil {
+			f.datasets = make(map[string]*loadedDataset, len(state.Datasets))
+		}
+
+		f.datasets[name] = &loadedDataset{values: loaded.Values, embedded: loaded.Embedded}
+	}
+
+	for _, recipe := range state.Recipes {
+		f.recipes[recipe] = f.compileRecipe(recipe)
+	}
+
+	for _, key := range state.Codecs {
+		codec, err := compileCodec(key)
+		if err != nil {
+			return errInvalidState
+		}
+
+		f.codecs[key] = codec
+	}
+
+	f.corpusKeys = state.Corpora
+	f.sensors = state.Sensors
+
+	return nil
+}
+
+// compileCodec returns the compiled schema of the codec cache key (method and source).
+func compileCodec(key string) (any, error) {
+	method, src, _ := strings.Cut(key, ":")
+
+	switch method {
+	case "avro":
+		return parseAvroSchema(src)
+	case "protobuf":
+		return parseProto(src)
+	default:
+		return nil, errInvalidState
+	}
+}
+
+// restoreFaker creates a new Faker instance from the state returned by state() or snapshot() method.
+// The internal state of the random source is restored, so restoring takes the same time
+// regardless of the number of values generated by the original instance.
+func restoreFaker(str string, runtime *sobek.Runtime) (*faker, error) {
+	data, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return nil, errInvalidState
+	}
+
+	var state fakerState
+
+	if err := json.Unmarshal(data, &state); err != nil || state.Version != stateVersion || state.Seed == 0 {
+		return nil, errInvalidState
+	}
+
+	opts := &options{
+		seedScope:       state.SeedScope,
+		rfc3339:         state.RFC3339,
+		defaults:        importDefaults(runtime, state.Defaults),
+		metrics:         state.Metrics,
+		safe:            state.Safe,
+		rng:             state.RNG,
+		sequenceVersion: state.SequenceVersion,
+		maxTotalBytes:   state.MaxTotalBytes,
+		parallel:        state.Parallel,
+	}
+	faker := newFakerWithOptions(state.Seed, opts, runtime)
+
+	if len(state.Scope) != 0 {
+		faker.scope = state.Scope
+		faker.rand.Seed(faker.deriveSeed(state.Scope))
+	}
+
+	if state.Source != nil || state.RNG != rngCrypto {
+		if err := unmarshalSource(faker.source, state.Source); err != nil {
+			return nil, errInvalidState
+		}
+	}
+
+	if err := faker.restoreSnapshot(&state); err != nil {
+		return nil, err
+	}
+
+	return faker, nil
+}
+
+// exportDefaults returns the defaults option as plain values.
+func exportDefaults(defaults map[string]sobek.Value) map[string]any {
+	if len(defaults) == 0 {
+		return nil
+	}
+
+	exported := make(map[string]any, len(defaults))
+
+	for key, val := range defaults {
+		exported[key] = val.Export()
+	}
+
+	return exported
+}
+
+// importDefaults returns the defaults option from the plain values.
+func importDefaults(runtime *sobek.Runtime, exported map[string]any) map[string]sobek.Value {
+	if len(exported) == 0 {
+		return nil
+	}
+
+	defaults := make(map[string]sobek.Value, len(exported))
+
+	for key, val := range exported {
+		defaults[key] = runtime.ToValue(val)
+	}
+
+	return defaults
+}
@@ -0,0 +1,113 @@
+package faker
+
+import (
+	"errors"
+	"math"
+
+	"github.com/grafana/sobek"
+)
+
+var errNoTimers = errors.New("setTimeout is not available, the stream can only be started in k6 scripts")
+
+// stream returns a source of timed messages generated from the schema, e.g. for simulating the clients
+// of a WebSocket or SSE service. The messages follow each other at the ratePerSecond option (1 by default),
+// the jitter option (0 by default, at most 1) randomizes the delays by the given fraction of the interval.
+//
+// The returned object is an iterator of {delay, message} objects (delay in milliseconds), its start method
+// calls the callback with the messages at the generated delays using setTimeout, until the stop method is called.
+func (f *faker) stream(schema sobek.Value, options sobek.Value) *sobek.Object {
+	const method = "stream"
+
+	compiled := f.compileSchema(method, schema)
+	rate, jitter := 1.0, 0.0
+
+	if options != nil && !sobek.IsUndefined(options) {
+		opts := f.objectArgument(method, "options", options)
+
+		if val := opts.Get("ratePerSecond"); val != nil && !sobek.IsUndefined(val) {
+			if rate = val.ToFloat(); rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "ratePerSecond", Expected: "positive number",
+					Reason: "invalid value " + val.String(),
+				})
+			}
+		}
+
+		if val := opts.Get("jitter"); val != nil && !sobek.IsUndefined(val) {
+			if jitter = val.ToFloat(); !(jitter >= 0 && jitter <= 1) {
+				f.throw(&ArgumentError{
+					Function: method, Parameter: "jitter", Expected: "number between 0 and 1",
+					Reason: "invalid value " + val.String(),
+				})
+			}
+		}
+	}
+
+	const millis = 1000
+
+	interval := millis / rate
+
+	delay := func() float64 {
+		f.rescope()
+
+		return interval * (1 + jitter*(2*f.rand.Float64()-1))
+	}
+
+	obj := f.runtime.NewObject()
+
+	_ = obj.Set("next", func() *sobek.Object {
+		item := f.runtime.NewObject()
+
+		_ = item.Set("delay", delay())
+		_ = item.Set("message", f.generate(compiled))
+
+		result := f.runtime.NewObject()
+
+		_ = result.Set("value", item)
+		_ = result.Set("done", false)
+
+		return result
+	})
+
+	_ = obj.SetSymbol(sobek.SymIterator, func(call sobek.FunctionCall) sobek.Value { return call.This })
+
+	// run identifies the current run of the stream, so a stopped (or restarted) run doesn't schedule more messages.
+	var run int
+
+	_ = obj.Set("start", func(callback sobek.Callable) {
+		setTimeout, isFunction := sobek.AssertFunction(f.runtime.GlobalObject().Get("setTimeout"))
+		if !isFunction {
+			f.throw(errNoTimers)
+		}
+
+		run++
+
+		current := run
+
+		var schedule func()
+
+		tick := func() {
+			if run != current {
+				return
+			}
+
+			if _, err := callback(sobek.Undefined(), f.generate(compiled)); err != nil {
+				panic(err)
+			}
+
+			schedule()
+		}
+
+		schedule = func() {
+			if _, err := setTimeout(sobek.Undefined(), f.runtime.ToValue(tick), f.runtime.ToValue(delay())); err != nil {
+				panic(err)
+			}
+		}
+
+		schedule()
+	})
+
+	_ = obj.Set("stop", func() { run++ })
+
+	return obj
+}
@@ -0,0 +1,52 @@
+package faker
+
+import (
+	"errors"
+
+	"github.com/grafana/sobek"
+	"go.k6.io/k6/v2/metrics"
+)
+
+var errNoVUState = errors.New("tags can only be set in the VU context")
+
+// tag generates a value using the generator function and sets it as a tag of the VU.
+// The tag is attached to all subsequent metrics (e.g. HTTP requests) emitted by the VU.
+func (f *faker) tag(name string, function sobek.Value, args ...sobek.Value) sobek.Value {
+	return f.tagWith("tag", name, function, args, func(tm *metrics.TagsAndMeta, value string) {
+		tm.SetTag(name, value)
+	})
+}
+
+// correlate generates a value using the generator function and sets it as a metadata of the VU.
+// Unlike tags, metadata is not indexed, so it is suitable for high-cardinality correlation IDs.
+func (f *faker) correlate(name string, function sobek.Value, args ...sobek.Value) sobek.Value {
+	return f.tagWith("correlate", name, function, args, func(tm *metrics.TagsAndMeta, value string) {
+		tm.SetMetadata(name, value)
+	})
+}
+
+func (f *faker) tagWith(
+	method string,
+	name string,
+	function sobek.Value,
+	args []sobek.Value,
+	setter func(*metrics.TagsAndMeta, string),
+) sobek.Value {
+	if len(name) == 0 {
+		f.throw(&ArgumentError{Function: method, Parameter: "name", Expected: "string", Reason: "missing parameter"})
+	}
+
+	if f.vu == nil || f.vu.State() == nil {
+		f.throw(errNoVUState)
+	}
+
+	fname, info := f.lookup(method, function)
+
+	value := f.invoke(fname, info, sobek.FunctionCall{This: sobek.Undefined(), Arguments: args})
+
+	f.vu.State().Tags.Modify(func(tm *metrics.TagsAndMeta) {
+		setter(tm, value.String())
+	})
+
+	return value
+}
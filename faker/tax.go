@@ -0,0 +1,343 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownVATCountry = errors.New("no VAT number format for country")
+
+func init() {
+	gofakeit.AddFuncLookup("vatnumber", gofakeit.Info{
+		Display:     "VAT Number",
+		Category:    "company",
+		Description: "EU value added tax identification number with country prefix and valid check digits",
+		Example:     "DE136695976",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "country",
+				Display:     "Country",
+				Type:        "string",
+				Default:     "any",
+				Options:     append([]string{"any"}, vatCountries()...),
+				Description: "ISO 3166-1 alpha-2 country code of an EU member state",
+			},
+		},
+		Generate: unsafeOf(vatnumber),
+	})
+
+	gofakeit.AddFuncLookup("ein", gofakeit.Info{
+		Display:     "EIN",
+		Category:    "company",
+		Description: "US Employer Identification Number with valid IRS campus prefix",
+		Example:     "27-5413589",
+		Output:      "string",
+		Params:      nil,
+		Generate:    unsafeOf(ein),
+	})
+}
+
+// cifTypes contains the entity type letters of Spanish companies (e.g. A for corporation, B for limited company).
+const cifTypes = "ABCDEFGHJ"
+
+// vatScheme describes the VAT number format of a country.
+type vatScheme struct {
+	// layout is the format of the number, the first argument is the body, the second is the check digits.
+	layout string
+	// body returns the random part of the number.
+	body func(r *rand.Rand) string
+	// check returns the check digits of the body, false if there is no valid check digit for the body.
+	check func(body string) (string, bool)
+}
+
+//nolint:gochecknoglobals,mnd
+var vatSchemes = map[string]vatScheme{
+	"AT": {
+		layout: "ATU%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return digits(r, 7) },
+		check:  atVATCheck,
+	},
+	"BE": {
+		layout: "BE%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return "0" + nonZeroDigits(r, 7) },
+		check:  beVATCheck,
+	},
+	"DE": {
+		layout: "DE%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return nonZeroDigits(r, 8) },
+		check:  func(body string) (string, bool) { return strconv.Itoa(mod1110CheckDigit(body)), true },
+	},
+	"DK": {
+		layout: "DK%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return nonZeroDigits(r, 7) },
+		check:  dkVATCheck,
+	},
+	"ES": {
+		layout: "ES%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return string(cifTypes[r.Intn(len(cifTypes))]) + digits(r, 7) },
+		check:  esVATCheck,
+	},
+	"FI": {
+		layout: "FI%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return digits(r, 7) },
+		check:  fiVATCheck,
+	},
+	"FR": {
+		layout: "FR%[2]s%[1]s",
+		body: func(r *rand.Rand) string {
+			siren := nonZeroDigits(r, 8)
+
+			return siren + strconv.Itoa(luhnCheckDigit(siren))
+		},
+		check: frVATCheck,
+	},
+	"IT": {
+		layout: "IT%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return digits(r, 7) + fmt.Sprintf("%03d", 1+r.Intn(100)) },
+		check:  func(body string) (string, bool) { return strconv.Itoa(luhnCheckDigit(body)), true },
+	},
+	"NL": {
+		layout: "NL%[1]s%[2]sB01",
+		body:   func(r *rand.Rand) string { return nonZeroDigits(r, 8) },
+		check:  func(body string) (string, bool) { return mod11CheckDigit(body, 9, 8, 7, 6, 5, 4, 3, 2) },
+	},
+	"PL": {
+		layout: "PL%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return nonZeroDigits(r, 9) },
+		check:  func(body string) (string, bool) { return mod11CheckDigit(body, 6, 5, 7, 2, 3, 4, 5, 6, 7) },
+	},
+	"PT": {
+		layout: "PT%[1]s%[2]s",
+		body:   func(r *rand.Rand) string { return "5" + digits(r, 7) },
+		check:  ptVATCheck,
+	},
+	"SE": {
+		layout: "SE%[1]s%[2]s01",
+		body:   func(r *rand.Rand) string { return "55" + digits(r, 7) },
+		check:  func(body string) (string, bool) { return strconv.Itoa(luhnCheckDigit(body)), true },
+	},
+}
+
+func vatCountries() []string {
+	countries := make([]string, 0, len(vatSchemes))
+
+	for country := range vatSchemes {
+		countries = append(countries, country)
+	}
+
+	sort.Strings(countries)
+
+	return countries
+}
+
+func vatnumber(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info, safe bool) (any, error) {
+	country, err := info.GetString(m, "country")
+	if err != nil {
+		return nil, err
+	}
+
+	country = strings.ToUpper(country)
+
+	if country == "ANY" {
+		countries := vatCountries()
+		country = countries[r.Intn(len(countries))]
+	}
+
+	scheme, found := vatSchemes[country]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownVATCountry, country)
+	}
+
+	for {
+		body := scheme.body(r)
+
+		if check, ok := scheme.check(body); ok {
+			if safe {
+				// a number with wrong check digit is never issued
+				check = wrongCheck(r, check)
+			}
+
+			return fmt.Sprintf(scheme.layout, body, check), nil
+		}
+	}
+}
+
+func ein(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info, safe bool) (any, error) {
+	return newEIN(r, safe), nil
+}
+
+// wrongCheck returns the check characters with the last one replaced by a different character of the same kind.
+// The letters are the control letters A-J of the Spanish CIF.
+func wrongCheck(r *rand.Rand, check string) string {
+	const (
+		digitCount  = 10
+		letterCount = 10
+	)
+
+	last := check[len(check)-1]
+
+	if last >= '0' && last <= '9' {
+		last = '0' + (last-'0'+byte(1+r.Intn(digitCount-1)))%digitCount
+	} else {
+		last = 'A' + (last-'A'+byte(1+r.Intn(letterCount-1)))%letterCount
+	}
+
+	return check[:len(check)-1] + string(last)
+}
+
+// nonZeroDigits returns a random string of n decimal digits, the first digit is not zero.
+func nonZeroDigits(r *rand.Rand, n int) string {
+	const maxDigit = 9
+
+	return strconv.Itoa(1+r.Intn(maxDigit)) + digits(r, n-1)
+}
+
+// weightedSum returns the sum of the digits multiplied by the weights.
+func weightedSum(body string, weights ...int) int {
+	var sum int
+
+	for idx, weight := range weights {
+		sum += int(body[idx]-'0') * weight
+	}
+
+	return sum
+}
+
+// mod11CheckDigit returns the weighted sum modulo 11 as check digit, false if it is 10.
+func mod11CheckDigit(body string, weights ...int) (string, bool) {
+	const (
+		modulus = 11
+		base    = 10
+	)
+
+	check := weightedSum(body, weights...) % modulus
+
+	return strconv.Itoa(check), check != base
+}
+
+// atVATCheck returns the check digit of the Austrian UID, digits on even positions
+// are doubled and their digit sums are added.
+func atVATCheck(body string) (string, bool) {
+	const (
+		base   = 10
+		offset = 4
+	)
+
+	var sum int
+
+	for idx := range len(body) {
+		digit := int(body[idx] - '0')
+
+		if idx%2 == 1 {
+			digit *= 2
+			digit = digit/base + digit%base
+		}
+
+		sum += digit
+	}
+
+	return strconv.Itoa((base - (sum+offset)%base) % base), true
+}
+
+// beVATCheck returns the two check digits of the Belgian enterprise number: 97 - body mod 97.
+func beVATCheck(body string) (string, bool) {
+	const modulus = 97
+
+	num, _ := strconv.Atoi(body)
+
+	return fmt.Sprintf("%02d", modulus-num%modulus), true
+}
+
+// dkVATCheck returns the check digit of the Danish CVR number, which makes the weighted sum divisible by 11.
+func dkVATCheck(body string) (string, bool) {
+	const (
+		modulus = 11
+		base    = 10
+	)
+
+	check := (modulus - weightedSum(body, 2, 7, 6, 5, 4, 3, 2)%modulus) % modulus
+
+	return strconv.Itoa(check), check != base
+}
+
+// esVATCheck returns the control character of the Spanish CIF: the first letter is the type of the entity,
+// digits on odd positions are doubled and their digit sums are added. Entities of type
+// A, B, E and H use a control digit, the others use the control letter.
+func esVATCheck(body string) (string, bool) {
+	const (
+		base     = 10
+		controls = "JABCDEFGHI"
+	)
+
+	var sum int
+
+	for idx := 1; idx < len(body); idx++ {
+		digit := int(body[idx] - '0')
+
+		if idx%2 == 1 {
+			digit *= 2
+			digit = digit/base + digit%base
+		}
+
+		sum += digit
+	}
+
+	check := (base - sum%base) % base
+
+	if strings.ContainsRune("ABEH", rune(body[0])) {
+		return strconv.Itoa(check), true
+	}
+
+	return string(controls[check]), true
+}
+
+// fiVATCheck returns the check digit of the Finnish business ID (Y-tunnus).
+func fiVATCheck(body string) (string, bool) {
+	const modulus = 11
+
+	rem := weightedSum(body, 7, 9, 10, 5, 8, 4, 2) % modulus
+
+	switch rem {
+	case 0:
+		return "0", true
+	case 1:
+		return "", false
+	default:
+		return strconv.Itoa(modulus - rem), true
+	}
+}
+
+// frVATCheck returns the two digit key of the French VAT number calculated from the SIREN.
+func frVATCheck(body string) (string, bool) {
+	const (
+		modulus = 97
+		offset  = 12
+		factor  = 3
+	)
+
+	siren, _ := strconv.Atoi(body)
+
+	return fmt.Sprintf("%02d", (offset+factor*(siren%modulus))%modulus), true
+}
+
+// ptVATCheck returns the check digit of the Portuguese NIF, 11 - weighted sum modulo 11 (0 if greater than 9).
+func ptVATCheck(body string) (string, bool) {
+	const (
+		modulus = 11
+		base    = 10
+	)
+
+	check := modulus - weightedSum(body, 9, 8, 7, 6, 5, 4, 3, 2)%modulus
+	if check >= base {
+		check = 0
+	}
+
+	return strconv.Itoa(check), true
+}
@@ -0,0 +1,232 @@
+package faker_test
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/sobek"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_vatnumber(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("vatnumber")
+
+	require.NotNil(t, info)
+
+	formats := map[string]*regexp.Regexp{
+		"AT":  regexp.MustCompile(`^ATU[0-9]{8}$`),
+		"be":  regexp.MustCompile(`^BE0[1-9][0-9]{8}$`),
+		"DE":  regexp.MustCompile(`^DE[1-9][0-9]{8}$`),
+		"DK":  regexp.MustCompile(`^DK[1-9][0-9]{7}$`),
+		"ES":  regexp.MustCompile(`^ES[A-HJ][0-9]{7}[0-9A-J]$`),
+		"FI":  regexp.MustCompile(`^FI[0-9]{8}$`),
+		"FR":  regexp.MustCompile(`^FR[0-9]{2}[1-9][0-9]{8}$`),
+		"IT":  regexp.MustCompile(`^IT[0-9]{11}$`),
+		"NL":  regexp.MustCompile(`^NL[1-9][0-9]{8}B01$`),
+		"PL":  regexp.MustCompile(`^PL[1-9][0-9]{9}$`),
+		"PT":  regexp.MustCompile(`^PT5[0-9]{8}$`),
+		"SE":  regexp.MustCompile(`^SE55[0-9]{8}01$`),
+		"any": regexp.MustCompile(`^(AT|BE|DE|DK|ES|FI|FR|IT|NL|PL|PT|SE)[0-9A-Z]{8,12}$`),
+	}
+
+	r := testRand(t)
+
+	for country, format := range formats {
+		params := gofakeit.NewMapParams()
+		params.Add("country", country)
+
+		for range 20 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Regexp(t, format, val, country)
+
+			code := val.(string)
+			valid, found := vatValidators[code[:2]]
+
+			require.True(t, found, code)
+			require.True(t, valid(code[2:]), code)
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("country", "US")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "no VAT number format for country: US")
+}
+
+func Test_vatnumber_safe(t *testing.T) {
+	t.Parallel()
+
+	vm := sobek.New()
+
+	require.NoError(t, vm.Set("Faker", faker.Constructor))
+
+	_, err := vm.RunString(`const faker = new Faker({ seed: 11, safe: true })`)
+
+	require.NoError(t, err)
+
+	for country := range vatValidators {
+		for range 20 {
+			val, err := vm.RunString(`faker.company.vatNumber("` + country + `")`)
+
+			require.NoError(t, err)
+
+			code := val.String()
+
+			require.Equal(t, country, code[:2])
+			require.False(t, vatValidators[country](code[2:]), code)
+		}
+	}
+}
+
+//nolint:gochecknoglobals
+var vatValidators = map[string]func(code string) bool{
+	"AT": func(code string) bool {
+		sum := 0
+
+		for idx, digit := range toDigits(code[1:8]) {
+			if idx%2 == 1 {
+				digit = 2*digit/10 + 2*digit%10
+			}
+
+			sum += digit
+		}
+
+		return (10-(sum+4)%10)%10 == toDigits(code[8:])[0]
+	},
+	"BE": func(code string) bool {
+		num, _ := strconv.Atoi(code[:8])
+		check, _ := strconv.Atoi(code[8:])
+
+		return check == 97-num%97
+	},
+	"DE": func(code string) bool { // ISO 7064 MOD 11,10
+		product := 10
+
+		for _, digit := range toDigits(code[:8]) {
+			sum := (digit + product) % 10
+			if sum == 0 {
+				sum = 10
+			}
+
+			product = 2 * sum % 11
+		}
+
+		return (11-product)%10 == toDigits(code[8:])[0]
+	},
+	"DK": func(code string) bool {
+		return weighted(code, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+	},
+	"ES": func(code string) bool {
+		sum := 0
+
+		for idx, digit := range toDigits(code[1:8]) {
+			if idx%2 == 0 {
+				digit = 2*digit/10 + 2*digit%10
+			}
+
+			sum += digit
+		}
+
+		check := (10 - sum%10) % 10
+
+		if strings.ContainsRune("ABEH", rune(code[0])) {
+			return code[8:] == strconv.Itoa(check)
+		}
+
+		return code[8] == "JABCDEFGHI"[check]
+	},
+	"FI": func(code string) bool {
+		rem := weighted(code, 7, 9, 10, 5, 8, 4, 2) % 11
+
+		return rem != 1 && (11-rem)%11 == toDigits(code[7:])[0]
+	},
+	"FR": func(code string) bool {
+		siren, _ := strconv.Atoi(code[2:])
+		key, _ := strconv.Atoi(code[:2])
+
+		return luhn(code[2:]) && key == (12+3*(siren%97))%97
+	},
+	"IT": luhn,
+	"NL": func(code string) bool {
+		return weighted(code, 9, 8, 7, 6, 5, 4, 3, 2, -1)%11 == 0
+	},
+	"PL": func(code string) bool {
+		return weighted(code, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == toDigits(code[9:])[0]
+	},
+	"PT": func(code string) bool {
+		check := 11 - weighted(code, 9, 8, 7, 6, 5, 4, 3, 2)%11
+		if check >= 10 {
+			check = 0
+		}
+
+		return check == toDigits(code[8:])[0]
+	},
+	"SE": func(code string) bool {
+		return luhn(code[:10])
+	},
+}
+
+func toDigits(code string) []int {
+	digits := make([]int, 0, len(code))
+
+	for _, chr := range code {
+		digits = append(digits, int(chr-'0'))
+	}
+
+	return digits
+}
+
+func weighted(code string, weights ...int) int {
+	sum := 0
+
+	for idx, digit := range toDigits(code[:len(weights)]) {
+		sum += digit * weights[idx]
+	}
+
+	return sum
+}
+
+func luhn(code string) bool {
+	sum := 0
+
+	for idx, digit := range toDigits(code) {
+		if (len(code)-idx)%2 == 0 {
+			digit = 2*digit/10 + 2*digit%10
+		}
+
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
+
+func Test_ein(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("ein")
+
+	require.NotNil(t, info)
+
+	// prefixes not assigned to any IRS campus or to online applications
+	unassigned := regexp.MustCompile(`^(00|07|08|09|17|18|19|28|29|49|69|70|78|79|89|96|97)-`)
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^[0-9]{2}-[0-9]{7}$`), val)
+		require.NotRegexp(t, unassigned, val)
+	}
+}
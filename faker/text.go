@@ -0,0 +1,172 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errNegativeLength = errors.New("negative length")
+
+func init() {
+	gofakeit.AddFuncLookup("textoflength", gofakeit.Info{
+		Display:     "Text Of Length",
+		Category:    "word",
+		Description: "Text of sentences with exactly the given length in bytes, for payloads of exact size",
+		Example:     "Interpret context record river mind. Press self should compare property outcome divide.",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{Field: "bytes", Display: "Bytes", Type: "int", Default: "100", Description: "Length of the text in bytes"},
+		},
+		Generate: textoflength,
+	})
+
+	addMaxBytes("sentence")
+	addMaxBytes("paragraph")
+}
+
+// addMaxBytes adds the maxBytes parameter to the text generator function of the key.
+// Longer texts are truncated at word boundary, paragraphs are kept whole if possible.
+func addMaxBytes(key string) {
+	info := *gofakeit.GetFuncLookup(key)
+	generate := info.Generate
+	paragraphs := paramIndex(&info, "paragraphseparator") >= 0
+
+	info.Params = append(slices.Clone(info.Params), gofakeit.Param{
+		Field:       "maxBytes",
+		Display:     "Max Bytes",
+		Type:        "int",
+		Default:     "0",
+		Description: "Maximum length of the text in bytes, 0 for no limit",
+	})
+
+	info.Generate = func(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+		limit, err := info.GetInt(m, "maxBytes")
+		if err != nil {
+			return nil, err
+		}
+
+		if limit < 0 {
+			return nil, fmt.Errorf("%w: %d", errNegativeLength, limit)
+		}
+
+		val, err := generate(r, m, info)
+		if err != nil || limit == 0 {
+			return val, err
+		}
+
+		var separator string
+
+		if paragraphs {
+			if separator, err = info.GetString(m, "paragraphseparator"); err != nil {
+				return nil, err
+			}
+		}
+
+		return truncateParagraphs(val.(string), separator, limit), nil //nolint:forcetypeassert
+	}
+
+	gofakeit.AddFuncLookup(key, info)
+}
+
+// truncateParagraphs returns the whole paragraphs of the text fitting into limit bytes,
+// followed by the truncated next paragraph.
+func truncateParagraphs(text string, separator string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+
+	if len(separator) == 0 {
+		return truncateText(text, limit)
+	}
+
+	var buff strings.Builder
+
+	for paragraph := range strings.SplitSeq(text, separator) {
+		if buff.Len() == 0 {
+			if len(paragraph) > limit {
+				return truncateText(paragraph, limit)
+			}
+
+			buff.WriteString(paragraph)
+
+			continue
+		}
+
+		remaining := limit - buff.Len() - len(separator)
+
+		if len(paragraph) > remaining {
+			if rest := truncateText(paragraph, remaining); len(rest) != 0 {
+				buff.WriteString(separator + rest)
+			}
+
+			break
+		}
+
+		buff.WriteString(separator + paragraph)
+	}
+
+	return buff.String()
+}
+
+// truncateText truncates the text to at most limit bytes at word boundary, ending with a period.
+// A single word longer than limit is cut.
+func truncateText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+
+	if limit <= 0 {
+		return ""
+	}
+
+	cut := strings.LastIndexByte(text[:limit], ' ')
+	if cut <= 0 {
+		return strings.ToValidUTF8(text[:limit], "")
+	}
+
+	return strings.TrimRight(text[:cut], ",;:.!?") + "."
+}
+
+func textoflength(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		minWords  = 4
+		wordsSpan = 12
+	)
+
+	length, err := info.GetInt(m, "bytes")
+	if err != nil {
+		return nil, err
+	}
+
+	if length < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeLength, length)
+	}
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	var buff strings.Builder
+
+	buff.Grow(length + 1)
+
+	for buff.Len() < length {
+		if buff.Len() != 0 {
+			buff.WriteByte(' ')
+		}
+
+		buff.WriteString(fake.Sentence(minWords + r.Intn(wordsSpan)))
+	}
+
+	text := buff.String()[:length]
+
+	// the text is cut at the exact length, which must not end with a space
+	if strings.HasSuffix(text, " ") {
+		text = text[:length-1] + "."
+	}
+
+	return text, nil
+}
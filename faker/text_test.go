@@ -0,0 +1,101 @@
+package faker_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_textoflength(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("textoflength")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, length := range []int{0, 1, 2, 17, 100, 1000, 65536} {
+		params := gofakeit.NewMapParams()
+		params.Add("bytes", strconv.Itoa(length))
+
+		for range 10 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Len(t, val, length)
+			require.False(t, strings.HasSuffix(val.(string), " ")) //nolint:forcetypeassert
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("bytes", "-1")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "negative length")
+}
+
+func Test_sentence_maxBytes(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("sentence")
+
+	require.NotNil(t, info)
+
+	params := gofakeit.NewMapParams()
+	params.Add("wordcount", "30")
+	params.Add("maxBytes", "40")
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		text, ok := val.(string)
+
+		require.True(t, ok)
+		require.LessOrEqual(t, len(text), 40)
+		require.True(t, strings.HasSuffix(text, "."), text)
+	}
+
+	val, err := info.Generate(r, nil, info)
+
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(val.(string)), 5) //nolint:forcetypeassert
+}
+
+func Test_paragraph_maxBytes(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("paragraph")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, limit := range []int{10, 100, 250} {
+		params := gofakeit.NewMapParams()
+		params.Add("paragraphcount", "5")
+		params.Add("paragraphseparator", "\n\n")
+		params.Add("maxBytes", strconv.Itoa(limit))
+
+		for range 50 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+
+			text, ok := val.(string)
+
+			require.True(t, ok)
+			require.LessOrEqual(t, len(text), limit)
+			require.NotContains(t, text, "\n\n\n")
+			require.False(t, strings.HasSuffix(text, "\n"), text)
+		}
+	}
+}
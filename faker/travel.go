@@ -0,0 +1,296 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errUnknownPassportCountry = errors.New("no passport number format for country")
+	errInvalidLegs            = errors.New("itinerary legs must be between 1 and 10")
+)
+
+const (
+	// cruiseSpeed is the average ground speed of an airliner in km/h.
+	cruiseSpeed = 800
+	// taxiMinutes is the time spent on the ground between the gate and the runway in minutes.
+	taxiMinutes = 30
+	// earthRadius is the mean radius of the Earth in km.
+	earthRadius = 6371
+	// maxLegs is the maximum number of flights of an itinerary.
+	maxLegs = 10
+)
+
+// airport is an airport with its IATA code and location.
+type airport struct {
+	code string
+	lat  float64
+	lon  float64
+}
+
+// airports contains major international airports.
+//
+//nolint:gochecknoglobals,mnd
+var airports = []airport{
+	{"ATL", 33.64, -84.43},
+	{"LAX", 33.94, -118.41},
+	{"ORD", 41.97, -87.91},
+	{"JFK", 40.64, -73.78},
+	{"SFO", 37.62, -122.38},
+	{"YYZ", 43.68, -79.63},
+	{"MEX", 19.44, -99.07},
+	{"GRU", -23.43, -46.47},
+	{"LHR", 51.47, -0.45},
+	{"CDG", 49.01, 2.55},
+	{"AMS", 52.31, 4.76},
+	{"FRA", 50.03, 8.56},
+	{"MUC", 48.35, 11.79},
+	{"MAD", 40.49, -3.57},
+	{"FCO", 41.80, 12.25},
+	{"ZRH", 47.46, 8.55},
+	{"IST", 41.26, 28.74},
+	{"DXB", 25.25, 55.36},
+	{"DOH", 25.27, 51.61},
+	{"DEL", 28.56, 77.10},
+	{"SIN", 1.36, 103.99},
+	{"HKG", 22.31, 113.91},
+	{"PEK", 40.08, 116.58},
+	{"HND", 35.55, 139.78},
+	{"ICN", 37.46, 126.44},
+	{"SYD", -33.95, 151.18},
+	{"JNB", -26.14, 28.24},
+}
+
+// airlines contains the IATA codes and names of airlines.
+//
+//nolint:gochecknoglobals
+var airlines = [][2]string{
+	{"AA", "American Airlines"}, {"DL", "Delta Air Lines"}, {"UA", "United Airlines"}, {"AC", "Air Canada"},
+	{"BA", "British Airways"}, {"AF", "Air France"}, {"KL", "KLM"}, {"LH", "Lufthansa"}, {"IB", "Iberia"},
+	{"LX", "Swiss"}, {"TK", "Turkish Airlines"}, {"EK", "Emirates"}, {"QR", "Qatar Airways"},
+	{"SQ", "Singapore Airlines"}, {"NH", "All Nippon Airways"}, {"QF", "Qantas"}, {"LA", "LATAM Airlines"},
+}
+
+// passportFormats contains the passport number generators of the countries.
+//
+//nolint:gochecknoglobals
+var passportFormats = map[string]func(r *rand.Rand) string{
+	"US": func(r *rand.Rand) string { return skuOf(r, "#########") },
+	"GB": func(r *rand.Rand) string { return skuOf(r, "#########") },
+	"CA": func(r *rand.Rand) string { return skuOf(r, "??######") },
+	"DE": func(r *rand.Rand) string {
+		// German passport numbers use digits and consonants except the easily confused ones
+		const alphabet = "0123456789CFGHJKLMNPRTVWXYZ"
+
+		buff := []byte{'C'}
+
+		for range 8 {
+			buff = append(buff, alphabet[r.Intn(len(alphabet))])
+		}
+
+		return string(buff)
+	},
+	"FR": func(r *rand.Rand) string { return skuOf(r, "##??#####") },
+	"IN": func(r *rand.Rand) string { return skuOf(r, "?#######") },
+	"JP": func(r *rand.Rand) string { return skuOf(r, "??#######") },
+	"BR": func(r *rand.Rand) string { return skuOf(r, "??######") },
+	"AU": func(r *rand.Rand) string { return skuOf(r, "?#######") },
+}
+
+func init() {
+	gofakeit.AddFuncLookup("flightnumber", gofakeit.Info{
+		Display:     "Flight Number",
+		Category:    "travel",
+		Description: "Flight designator: the IATA code of the airline followed by the number of the flight",
+		Example:     "LH438",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return flightNumberOf(r, airlines[r.Intn(len(airlines))][0]), nil
+		},
+	})
+
+	gofakeit.AddFuncLookup("pnr", gofakeit.Info{
+		Display:     "PNR",
+		Category:    "travel",
+		Description: "Passenger name record locator, the six character booking reference of an airline reservation",
+		Example:     "K7QX2M",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return pnrOf(r), nil
+		},
+	})
+
+	gofakeit.AddFuncLookup("iataairport", gofakeit.Info{
+		Display:     "IATA Airport",
+		Category:    "travel",
+		Description: "Three letter IATA code of a major international airport",
+		Example:     "FRA",
+		Output:      "string",
+		Generate: func(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+			return airports[r.Intn(len(airports))].code, nil
+		},
+	})
+
+	gofakeit.AddFuncLookup("bookingitinerary", gofakeit.Info{
+		Display:     "Booking Itinerary",
+		Category:    "travel",
+		Description: "Flight booking of connecting legs, each leg departs from the destination of the previous one after a layover",
+		Example: `{
+	"pnr": "K7QX2M",
+	"airline": "Lufthansa",
+	"legs": [
+		{"flightNumber": "LH438", "origin": "FRA", "destination": "ORD", "departure": "2024-05-03T09:35:00Z", "arrival": "2024-05-03T19:05:00Z", "durationMinutes": 570},
+		{"flightNumber": "LH9052", "origin": "ORD", "destination": "SFO", "departure": "2024-05-03T21:00:00Z", "arrival": "2024-05-04T01:55:00Z", "durationMinutes": 295}
+	],
+	"origin": "FRA",
+	"destination": "SFO"
+}`,
+		Output: "map[string]any",
+		Params: []gofakeit.Param{
+			{Field: "legs", Display: "Legs", Type: "int", Default: "2", Description: "Number of flights"},
+		},
+		Generate: bookingitinerary,
+	})
+
+	gofakeit.AddFuncLookup("passportnumber", gofakeit.Info{
+		Display:     "Passport Number",
+		Category:    "travel",
+		Description: "Passport number in the format of the issuing country",
+		Example:     "C01X00T47",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "country",
+				Display:     "Country",
+				Type:        "string",
+				Default:     "US",
+				Options:     slices.Sorted(maps.Keys(passportFormats)),
+				Description: "ISO 3166-1 alpha-2 code of the issuing country",
+			},
+		},
+		Generate: passportnumber,
+	})
+}
+
+// flightNumberOf returns a random flight number of the airline.
+func flightNumberOf(r *rand.Rand, airline string) string {
+	const maxFlight = 9999
+
+	return airline + strconv.Itoa(1+r.Intn(maxFlight))
+}
+
+// pnrOf returns a random booking reference, without the easily confused characters (0, 1, I and O).
+func pnrOf(r *rand.Rand) string {
+	const (
+		alphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+		length   = 6
+	)
+
+	buff := make([]byte, length)
+
+	for idx := range buff {
+		buff[idx] = alphabet[r.Intn(len(alphabet))]
+	}
+
+	return string(buff)
+}
+
+// flightMinutes returns the scheduled duration of the flight between the airports in minutes,
+// rounded to 5 minutes: the great circle distance at cruise speed plus the taxi time.
+func flightMinutes(from airport, to airport) int {
+	const (
+		radians = math.Pi / 180
+		round   = 5
+	)
+
+	dlat, dlon := (to.lat-from.lat)*radians, (to.lon-from.lon)*radians
+	hav := math.Pow(math.Sin(dlat/2), 2) + math.Cos(from.lat*radians)*math.Cos(to.lat*radians)*math.Pow(math.Sin(dlon/2), 2)
+	distance := 2 * earthRadius * math.Asin(math.Sqrt(hav))
+	minutes := distance/cruiseSpeed*float64(time.Hour/time.Minute) + taxiMinutes
+
+	return int(math.Round(minutes/round)) * round
+}
+
+func bookingitinerary(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	const (
+		daysAhead   = 90
+		minLayover  = 45
+		maxLayover  = 240
+		slotMinutes = 5
+	)
+
+	count, err := info.GetInt(m, "legs")
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 1 || count > maxLegs {
+		return nil, fmt.Errorf("%w: %d", errInvalidLegs, count)
+	}
+
+	carrier := airlines[r.Intn(len(airlines))]
+	from := r.Intn(len(airports))
+	visited := []int{from}
+
+	// departures are on 5 minute slots between 6:00 and 22:00 in the next days
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1+r.Intn(daysAhead)) //nolint:mnd
+	departure := day.Add(6*time.Hour + time.Duration(r.Intn(16*60/slotMinutes)*slotMinutes)*time.Minute)
+
+	legs := make([]object, count)
+
+	for idx := range legs {
+		// the next airport is not visited yet, unless all of them are
+		to := r.Intn(len(airports))
+		for slices.Contains(visited, to) && len(visited) < len(airports) {
+			to = r.Intn(len(airports))
+		}
+
+		duration := flightMinutes(airports[from], airports[to])
+		arrival := departure.Add(time.Duration(duration) * time.Minute)
+
+		legs[idx] = object{
+			{"flightNumber", flightNumberOf(r, carrier[0])},
+			{"origin", airports[from].code},
+			{"destination", airports[to].code},
+			{"departure", departure.Format(time.RFC3339)},
+			{"arrival", arrival.Format(time.RFC3339)},
+			{"durationMinutes", duration},
+		}
+
+		layover := minLayover + r.Intn((maxLayover-minLayover)/slotMinutes+1)*slotMinutes
+		departure = arrival.Add(time.Duration(layover) * time.Minute)
+		from = to
+		visited = append(visited, to)
+	}
+
+	return object{
+		{"pnr", pnrOf(r)},
+		{"airline", carrier[1]},
+		{"legs", legs},
+		{"origin", legs[0].get("origin")},
+		{"destination", legs[count-1].get("destination")},
+	}, nil
+}
+
+func passportnumber(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	country, err := info.GetString(m, "country")
+	if err != nil {
+		return nil, err
+	}
+
+	generate, found := passportFormats[strings.ToUpper(country)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownPassportCountry, country)
+	}
+
+	return generate(r), nil
+}
@@ -0,0 +1,132 @@
+package faker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_travel_codes(t *testing.T) {
+	t.Parallel()
+
+	r := testRand(t)
+
+	patterns := map[string]string{
+		"flightnumber": `^[A-Z]{2}[1-9]\d{0,3}$`,
+		"pnr":          `^[2-9A-HJ-NP-Z]{6}$`,
+		"iataairport":  `^[A-Z]{3}$`,
+	}
+
+	for name, pattern := range patterns {
+		info := gofakeit.GetFuncLookup(name)
+
+		require.NotNil(t, info, name)
+
+		for range 20 {
+			val, err := info.Generate(r, gofakeit.NewMapParams(), info)
+
+			require.NoError(t, err)
+			require.Regexp(t, pattern, val, name)
+		}
+	}
+}
+
+func Test_bookingitinerary(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("bookingitinerary")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 20 {
+		params := gofakeit.NewMapParams()
+		params.Add("legs", "4")
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		booking, ok := faker.PlainMaps(val).(map[string]any)
+
+		require.True(t, ok)
+
+		legs, ok := booking["legs"].([]map[string]any)
+
+		require.True(t, ok)
+		require.Len(t, legs, 4)
+		require.Equal(t, legs[0]["origin"], booking["origin"])
+		require.Equal(t, legs[3]["destination"], booking["destination"])
+
+		for idx, leg := range legs {
+			require.NotEqual(t, leg["origin"], leg["destination"])
+			require.Equal(t, legs[0]["flightNumber"].(string)[:2], leg["flightNumber"].(string)[:2]) //nolint:forcetypeassert
+
+			departure, err := time.Parse(time.RFC3339, leg["departure"].(string)) //nolint:forcetypeassert
+
+			require.NoError(t, err)
+
+			arrival, err := time.Parse(time.RFC3339, leg["arrival"].(string)) //nolint:forcetypeassert
+
+			require.NoError(t, err)
+			require.Equal(t, time.Duration(leg["durationMinutes"].(int))*time.Minute, arrival.Sub(departure)) //nolint:forcetypeassert
+			require.True(t, departure.After(time.Now()))
+
+			if idx == 0 {
+				continue
+			}
+
+			require.Equal(t, legs[idx-1]["destination"], leg["origin"])
+
+			previous, _ := time.Parse(time.RFC3339, legs[idx-1]["arrival"].(string)) //nolint:forcetypeassert
+
+			require.GreaterOrEqual(t, departure.Sub(previous), 45*time.Minute)
+			require.LessOrEqual(t, departure.Sub(previous), 4*time.Hour)
+		}
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("legs", "0")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "itinerary legs must be between 1 and 10")
+}
+
+func Test_passportnumber(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("passportnumber")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	patterns := map[string]string{
+		"US": `^\d{9}$`,
+		"de": `^C[0-9CFGHJ-NPRTV-Z]{8}$`,
+		"FR": `^\d{2}[A-Z]{2}\d{5}$`,
+		"JP": `^[A-Z]{2}\d{7}$`,
+	}
+
+	for country, pattern := range patterns {
+		params := gofakeit.NewMapParams()
+		params.Add("country", country)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, pattern, val, country)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("country", "XX")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "no passport number format for country")
+}
@@ -0,0 +1,113 @@
+package faker
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/grafana/sobek"
+)
+
+// float32Array returns a Float32Array of count random numbers between min (0 by default, inclusive)
+// and max (1 by default, exclusive). The numbers are written into a Go allocated buffer
+// backing the typed array, so large numeric payloads (e.g. ML feature vectors) don't need JavaScript arrays.
+func (f *faker) float32Array(count sobek.Value, minVal sobek.Value, maxVal sobek.Value) *sobek.Object {
+	const method = "numbers.float32Array"
+
+	size := f.typedArrayCount(method, count)
+	low, high := f.rangeArgument(method, minVal, maxVal, 0, 1)
+
+	if math.IsInf(low, 0) || math.IsInf(high, 0) || low < -math.MaxFloat32 || high > math.MaxFloat32 {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "max", Expected: "finite float32 range", Reason: "invalid range",
+		})
+	}
+
+	f.rescope()
+
+	data := make([]byte, 4*size)
+
+	for idx := range size {
+		val := float32(low + f.rand.Float64()*(high-low))
+
+		binary.NativeEndian.PutUint32(data[4*idx:], math.Float32bits(val))
+	}
+
+	return f.typedArray("Float32Array", data)
+}
+
+// intArray returns an Int32Array of count random integers between min and max (both inclusive),
+// by default the whole int32 range. Like float32Array, the integers are written into a Go allocated buffer.
+func (f *faker) intArray(count sobek.Value, minVal sobek.Value, maxVal sobek.Value) *sobek.Object {
+	const method = "numbers.intArray"
+
+	size := f.typedArrayCount(method, count)
+	low, high := f.rangeArgument(method, minVal, maxVal, math.MinInt32, math.MaxInt32)
+
+	if low < math.MinInt32 || high > math.MaxInt32 || low != math.Trunc(low) || high != math.Trunc(high) {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "max", Expected: "int32 range", Reason: "invalid range",
+		})
+	}
+
+	f.rescope()
+
+	data := make([]byte, 4*size)
+	span := int64(high) - int64(low) + 1
+
+	for idx := range size {
+		val := int32(int64(low) + f.rand.Int63n(span)) //nolint:gosec
+
+		binary.NativeEndian.PutUint32(data[4*idx:], uint32(val)) //nolint:gosec
+	}
+
+	return f.typedArray("Int32Array", data)
+}
+
+// typedArrayCount returns the count parameter of the typed array methods.
+// The count is bounded and the maxTotalBytes option limits it like the count of the many methods.
+func (f *faker) typedArrayCount(method string, count sobek.Value) int {
+	size := f.countArgument(method, "", "count", count, 0)
+
+	budget := f.budget(func(reason string) {
+		f.throw(&ArgumentError{Function: method, Parameter: "count", Reason: reason})
+	})
+
+	budget.batch(size)
+
+	return size
+}
+
+// rangeArgument returns the min and max parameters of the typed array methods, or their defaults.
+func (f *faker) rangeArgument(method string, minVal sobek.Value, maxVal sobek.Value, low, high float64) (float64, float64) {
+	if minVal != nil && !sobek.IsUndefined(minVal) {
+		low = minVal.ToFloat()
+	}
+
+	if maxVal != nil && !sobek.IsUndefined(maxVal) {
+		high = maxVal.ToFloat()
+	}
+
+	if !(low <= high) {
+		f.throw(&ArgumentError{
+			Function: method, Parameter: "max", Expected: "number not less than min",
+			Reason: "invalid range " + formatNumber(low) + "-" + formatNumber(high),
+		})
+	}
+
+	return low, high
+}
+
+// typedArray returns a new typed array of the constructor name (e.g. Float32Array) backed by the data.
+func (f *faker) typedArray(constructor string, data []byte) *sobek.Object {
+	ctor, ok := sobek.AssertConstructor(f.runtime.Get(constructor))
+	if !ok {
+		panic(f.runtime.NewTypeError("%s is not a constructor", constructor))
+	}
+
+	obj, err := ctor(nil, f.runtime.ToValue(f.runtime.NewArrayBuffer(data)))
+	if err != nil {
+		panic(err)
+	}
+
+	return obj
+}
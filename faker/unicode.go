@@ -0,0 +1,241 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errUnknownScript          = errors.New("unknown script")
+	errUnknownAdversarialKind = errors.New("unknown adversarial kind")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("unicode", gofakeit.Info{
+		Display:     "Unicode",
+		Category:    "string",
+		Description: "Random string of non-ASCII characters of the script, emoji sequences or a mix of scripts",
+		Example:     "Жюрщдпоя",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "script",
+				Display:     "Script",
+				Type:        "string",
+				Default:     "mixed",
+				Options:     cloudTypes(unicodeScripts)[1:],
+				Description: "Script of the characters (e.g. cyrillic, cjk, emoji), mixed for all of them",
+			},
+			{
+				Field:       "length",
+				Display:     "Length",
+				Type:        "int",
+				Default:     "10",
+				Description: "Number of characters, an emoji sequence counts as one",
+			},
+		},
+		Generate: unicodestring,
+	})
+
+	gofakeit.AddFuncLookup("adversarial", gofakeit.Info{
+		Display:  "Adversarial",
+		Category: "string",
+		Description: "Hostile input string: bidirectional overrides, invisible characters, normalization hazards, " +
+			"homoglyphs, SQL, script or path injection",
+		Example: "' OR '1'='1",
+		Output:  "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "kind",
+				Display:     "Kind",
+				Type:        "string",
+				Default:     "any",
+				Options:     cloudTypes(adversarialValues),
+				Description: "Kind of the hostile input, any for a random one",
+			},
+		},
+		Generate: adversarial,
+	})
+}
+
+// runeRange is an inclusive range of code points.
+type runeRange struct {
+	low  rune
+	high rune
+}
+
+// unicodeScripts contains the letter ranges of the scripts, emoji and mixed are handled separately.
+//
+//nolint:gochecknoglobals
+var unicodeScripts = map[string][]runeRange{
+	"latin":      {{0x00C0, 0x00D6}, {0x00D8, 0x00F6}, {0x00F8, 0x017F}},
+	"greek":      {{0x0391, 0x03A1}, {0x03A3, 0x03A9}, {0x03B1, 0x03C9}},
+	"cyrillic":   {{0x0410, 0x044F}, {0x0401, 0x0401}, {0x0451, 0x0451}},
+	"hebrew":     {{0x05D0, 0x05EA}},
+	"arabic":     {{0x0621, 0x063A}, {0x0641, 0x064A}},
+	"devanagari": {{0x0905, 0x0939}},
+	"cjk":        {{0x4E00, 0x9FA5}, {0x3041, 0x3096}, {0x30A1, 0x30FA}, {0xAC00, 0xD7A3}},
+	"emoji":      nil,
+	"mixed":      nil,
+}
+
+// unicodeScriptNames contains the names of the scripts having letter ranges.
+var unicodeScriptNames = []string{ //nolint:gochecknoglobals
+	"arabic", "cjk", "cyrillic", "devanagari", "greek", "hebrew", "latin",
+}
+
+// emojiSequences contains emoji consisting of multiple code points (modifiers, ZWJ sequences, flags).
+var emojiSequences = []string{ //nolint:gochecknoglobals
+	"👍🏽", "👋🏿", "👩‍💻", "👨‍👩‍👧‍👦", "🧑‍🚀", "🏳️‍🌈", "❤️", "❤️‍🔥", "🇯🇵", "🇧🇷", "🇺🇦", "1️⃣", "🫶🏼",
+}
+
+// emojiRanges contains single code point emoji ranges.
+var emojiRanges = []runeRange{ //nolint:gochecknoglobals
+	{0x1F600, 0x1F64F}, {0x1F300, 0x1F5FF}, {0x1F680, 0x1F6C5}, {0x1F90C, 0x1F9FF},
+}
+
+// runeOf returns a random code point of the ranges, weighted by the size of the ranges.
+func runeOf(r *rand.Rand, ranges []runeRange) rune {
+	var total rune
+
+	for _, rng := range ranges {
+		total += rng.high - rng.low + 1
+	}
+
+	idx := rune(r.Int63n(int64(total)))
+
+	for _, rng := range ranges {
+		if size := rng.high - rng.low + 1; idx >= size {
+			idx -= size
+
+			continue
+		}
+
+		return rng.low + idx
+	}
+
+	return ranges[0].low
+}
+
+// emojiOf returns a random emoji, which is a multi code point sequence in a quarter of cases.
+func emojiOf(r *rand.Rand) string {
+	const sequenceRate = 4
+
+	if r.Intn(sequenceRate) == 0 {
+		return emojiSequences[r.Intn(len(emojiSequences))]
+	}
+
+	return string(runeOf(r, emojiRanges))
+}
+
+func unicodestring(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	script, err := info.GetString(m, "script")
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := info.GetInt(m, "length")
+	if err != nil {
+		return nil, err
+	}
+
+	if length < 0 {
+		return nil, fmt.Errorf("%w: %d", errNegativeLength, length)
+	}
+
+	script = strings.ToLower(script)
+
+	if _, found := unicodeScripts[script]; !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownScript, script)
+	}
+
+	var buff strings.Builder
+
+	for range length {
+		name := script
+
+		if name == "mixed" {
+			// emoji are as likely as any of the scripts
+			if idx := r.Intn(len(unicodeScriptNames) + 1); idx < len(unicodeScriptNames) {
+				name = unicodeScriptNames[idx]
+			} else {
+				name = "emoji"
+			}
+		}
+
+		if name == "emoji" {
+			buff.WriteString(emojiOf(r))
+		} else {
+			buff.WriteRune(runeOf(r, unicodeScripts[name]))
+		}
+	}
+
+	return buff.String(), nil
+}
+
+// adversarialValues contains hostile input strings by kind.
+//
+//nolint:gochecknoglobals
+var adversarialValues = map[string][]string{
+	"bidi": {
+		"invoice\u202efdp.exe", "\u202eevil", "abc\u202adef\u202c", "\u2066isolated\u2069 text",
+		"\u200fright-to-left\u200f", "\u05e9\u05dc\u05d5\u05dd hello 123", "\u0645\u0631\u062d\u0628\u0627, world!",
+		"user\u061c@example.com",
+	},
+	"invisible": {
+		"zero\u200bwidth", "admin\u200b", "joiner\u200dtext", "non\u200cjoiner", "\ufeffbom",
+		"word\u2060joiner", "soft\u00adhyphen", "\u00a0non-breaking\u00a0", "tab\u3164filler",
+	},
+	// composed and decomposed forms, compatibility characters and case mapping hazards
+	"normalization": {
+		"\u00e9", "e\u0301", "\u212bngstr\u00f6m", "A\u030angstro\u0308m", "\ufb01le", "\uff26\uff55\uff4c\uff4c",
+		"Stra\u00dfe", "\u0130stanbul", "\u01c6", "\u2460\u2461\u2462", "Z\u0351\u0361a\u0352l\u0353g\u0354o\u0355",
+	},
+	// Latin looking words containing Cyrillic or Greek letters
+	"homoglyph": {
+		"раураl", "аdmin", "gооgle.com", "micrоsoft",
+		"Αpple", "paypaӏ", "ссс.com",
+	},
+	"sql": {
+		"' OR '1'='1", "'; DROP TABLE users; --", "\" OR \"\"=\"", "admin'--", "1' UNION SELECT NULL, version()--",
+		"1; SELECT pg_sleep(10)--", "' AND 1=CONVERT(int, @@version)--", "%' OR 1=1 #",
+	},
+	"script": {
+		"<script>alert(1)</script>", "\"><img src=x onerror=alert(1)>", "javascript:alert(1)",
+		"<svg/onload=alert(1)>", "{{7*7}}", "${7*7}", "';alert(String.fromCharCode(88,83,83))//",
+		"<iframe src=\"javascript:alert(1)\">",
+	},
+	"path": {
+		"../../../../etc/passwd", "..\\..\\..\\windows\\win.ini", "%2e%2e%2f%2e%2e%2fetc%2fpasswd",
+		"....//....//etc/passwd", "file:///etc/passwd", "CON", "NUL.txt", "/dev/null",
+	},
+	"control": {
+		"nul\x00byte", "line\r\nbreak", "\r\nSet-Cookie: injected=1", "\x1b[31mred\x1b[0m", "bell\x07",
+		"%s%s%s%n", "%x%x%x%x", "back\bspace",
+	},
+}
+
+// adversarialKinds contains the sorted kinds of hostile inputs.
+var adversarialKinds = cloudTypes(adversarialValues)[1:] //nolint:gochecknoglobals
+
+func adversarial(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	kind, err := info.GetString(m, "kind")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(kind, "any") {
+		kind = adversarialKinds[r.Intn(len(adversarialKinds))]
+	}
+
+	values, found := adversarialValues[strings.ToLower(kind)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownAdversarialKind, kind)
+	}
+
+	return values[r.Intn(len(values))], nil
+}
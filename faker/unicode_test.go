@@ -0,0 +1,94 @@
+package faker_test
+
+import (
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_unicode(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("unicode")
+
+	require.NotNil(t, info)
+
+	scripts := map[string]*unicode.RangeTable{
+		"cyrillic":   unicode.Cyrillic,
+		"greek":      unicode.Greek,
+		"hebrew":     unicode.Hebrew,
+		"arabic":     unicode.Arabic,
+		"devanagari": unicode.Devanagari,
+		"latin":      unicode.Latin,
+	}
+
+	r := testRand(t)
+
+	for script, table := range scripts {
+		params := gofakeit.NewMapParams()
+		params.Add("script", script)
+		params.Add("length", "20")
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		str, ok := val.(string)
+
+		require.True(t, ok)
+		require.Equal(t, 20, utf8.RuneCountInString(str))
+
+		for _, chr := range str {
+			require.True(t, unicode.Is(table, chr), script)
+			require.Greater(t, chr, rune(unicode.MaxASCII))
+		}
+	}
+
+	for _, script := range []string{"cjk", "emoji", "mixed"} {
+		params := gofakeit.NewMapParams()
+		params.Add("script", script)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.True(t, utf8.ValidString(val.(string)))                     //nolint:forcetypeassert
+		require.GreaterOrEqual(t, utf8.RuneCountInString(val.(string)), 10) //nolint:forcetypeassert
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("script", "klingon")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown script")
+}
+
+func Test_adversarial(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("adversarial")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for _, kind := range info.Params[0].Options {
+		params := gofakeit.NewMapParams()
+		params.Add("kind", kind)
+
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, val)
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("kind", "friendly")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown adversarial kind")
+}
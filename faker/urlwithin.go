@@ -0,0 +1,193 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	errInvalidBaseURL   = errors.New("invalid base URL, expected absolute URL (e.g. https://test.example.com)")
+	errUnknownExtension = errors.New("unknown extension")
+)
+
+func init() {
+	gofakeit.AddFuncLookup("urlwithin", gofakeit.Info{
+		Display:     "URL Within",
+		Category:    "internet",
+		Description: "Realistic URL under the base URL with random path and query string, for crawling-style tests",
+		Example:     "https://test.example.com/products/summer-sale/4821.html?page=3&sort=price",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "base",
+				Display:     "Base",
+				Type:        "string",
+				Default:     "https://test.example.com",
+				Description: "Absolute base URL, its path and query string are kept",
+			},
+			{
+				Field:       "depth",
+				Display:     "Depth",
+				Type:        "int",
+				Default:     "3",
+				Description: "Maximum number of path segments added to the base URL",
+			},
+			{
+				Field:       "extension",
+				Display:     "Extension",
+				Type:        "string",
+				Default:     "none",
+				Options:     append([]string{"none", "any"}, urlExtensions...),
+				Description: "Extension of the last path segment, none for no extension, any for a random one",
+			},
+			{
+				Field:       "queryParams",
+				Display:     "Query Params",
+				Type:        "int",
+				Default:     "2",
+				Description: "Maximum number of query parameters added to the base URL",
+			},
+		},
+		Generate: urlwithin,
+	})
+}
+
+// urlExtensions contains the accepted extensions of the last path segment.
+var urlExtensions = []string{ //nolint:gochecknoglobals
+	"html", "htm", "php", "asp", "aspx", "jsp", "json", "xml", "js", "css", "png", "jpg", "pdf",
+}
+
+// maxResourceID is the maximum of numeric resource IDs in paths and query strings.
+const maxResourceID = 99_999
+
+// urlQueryParams contains realistic query parameters with their value generators.
+var urlQueryParams = map[string]func(r *rand.Rand) string{ //nolint:gochecknoglobals
+	"page":       pickOf("1", "2", "3", "4", "5", "8", "12"),
+	"limit":      pickOf("10", "20", "25", "50", "100"),
+	"offset":     pickOf("0", "20", "50", "100", "200"),
+	"sort":       pickOf("price", "name", "date", "rating", "relevance", "-price", "-date"),
+	"order":      pickOf("asc", "desc"),
+	"q":          func(r *rand.Rand) string { return wordOf(&gofakeit.Faker{Rand: r}) },
+	"id":         func(r *rand.Rand) string { return strconv.Itoa(1 + r.Intn(maxResourceID)) },
+	"category":   func(r *rand.Rand) string { return wordOf(&gofakeit.Faker{Rand: r}) },
+	"lang":       pickOf("en", "de", "fr", "es", "it", "ja", "pt-BR"),
+	"ref":        pickOf("home", "search", "newsletter", "footer", "banner"),
+	"utm_source": pickOf("google", "facebook", "twitter", "newsletter", "linkedin"),
+	"utm_medium": pickOf("cpc", "email", "social", "organic", "referral"),
+	"filter":     func(r *rand.Rand) string { return wordOf(&gofakeit.Faker{Rand: r}) },
+}
+
+// urlQueryNames contains the sorted names of the query parameters.
+var urlQueryNames = cloudTypes(urlQueryParams)[1:] //nolint:gochecknoglobals
+
+// wordOf returns a random lowercase word, words containing punctuation (e.g. i.e.) are skipped.
+func wordOf(fake *gofakeit.Faker) string {
+	for {
+		if word := strings.ToLower(fake.Word()); strings.Trim(word, alphanum) == "" {
+			return word
+		}
+	}
+}
+
+// pathSegment returns a random path segment: a word, a slug of words or a numeric resource ID.
+func pathSegment(r *rand.Rand) string {
+	const (
+		slugRate = 4
+		idRate   = 5
+	)
+
+	fake := &gofakeit.Faker{Rand: r}
+
+	switch {
+	case r.Intn(idRate) == 0:
+		return strconv.Itoa(1 + r.Intn(maxResourceID))
+	case r.Intn(slugRate) == 0:
+		return wordOf(fake) + "-" + wordOf(fake)
+	default:
+		return wordOf(fake)
+	}
+}
+
+func urlwithin(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	base, err := info.GetString(m, "base")
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := info.GetInt(m, "depth")
+	if err != nil {
+		return nil, err
+	}
+
+	extension, err := info.GetString(m, "extension")
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := info.GetInt(m, "queryParams")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCount(depth); err != nil {
+		return nil, fmt.Errorf("depth: %w", err)
+	}
+
+	if err := checkCount(params); err != nil {
+		return nil, fmt.Errorf("queryParams: %w", err)
+	}
+
+	loc, err := url.Parse(base)
+	if err != nil || !loc.IsAbs() || len(loc.Host) == 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidBaseURL, base)
+	}
+
+	extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+
+	switch extension {
+	case "none":
+		extension = ""
+	case "any":
+		extension = urlExtensions[r.Intn(len(urlExtensions))]
+	default:
+		if !slices.Contains(urlExtensions, extension) {
+			return nil, fmt.Errorf("%w: %s", errUnknownExtension, extension)
+		}
+	}
+
+	segments := make([]string, 0, depth)
+
+	if depth > 0 {
+		for range 1 + r.Intn(depth) {
+			segments = append(segments, pathSegment(r))
+		}
+	}
+
+	if len(segments) != 0 && len(extension) != 0 {
+		segments[len(segments)-1] += "." + extension
+	}
+
+	loc.Path = strings.TrimSuffix(loc.Path, "/") + "/" + strings.Join(segments, "/")
+	loc.RawPath = ""
+
+	query := loc.RawQuery
+
+	for _, name := range pickSome(r, urlQueryNames, min(params, len(urlQueryNames))) {
+		if len(query) != 0 {
+			query += "&"
+		}
+
+		query += name + "=" + url.QueryEscape(urlQueryParams[name](r))
+	}
+
+	loc.RawQuery = query
+
+	return loc.String(), nil
+}
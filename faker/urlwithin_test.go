@@ -0,0 +1,86 @@
+package faker_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_urlwithin(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("urlwithin")
+
+	require.NotNil(t, info)
+
+	r := testRand(t)
+
+	for range 50 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+
+		loc, err := url.Parse(val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.Equal(t, "https", loc.Scheme)
+		require.Equal(t, "test.example.com", loc.Host)
+		require.LessOrEqual(t, len(loc.Query()), 2)
+
+		segments := strings.Split(strings.TrimPrefix(loc.Path, "/"), "/")
+
+		require.NotEmpty(t, segments)
+		require.LessOrEqual(t, len(segments), 3)
+		require.NotContains(t, loc.Path, ".")
+	}
+
+	params := gofakeit.NewMapParams()
+	params.Add("base", "http://localhost:8080/api/v1/?tenant=acme")
+	params.Add("depth", "1")
+	params.Add("extension", "json")
+	params.Add("queryParams", "3")
+
+	for range 20 {
+		val, err := info.Generate(r, params, info)
+
+		require.NoError(t, err)
+
+		loc, err := url.Parse(val.(string)) //nolint:forcetypeassert
+
+		require.NoError(t, err)
+		require.Equal(t, "localhost:8080", loc.Host)
+		require.Regexp(t, `^/api/v1/[a-z0-9-]+\.json$`, loc.Path)
+		require.Equal(t, "acme", loc.Query().Get("tenant"))
+		require.LessOrEqual(t, len(loc.Query()), 4)
+	}
+
+	params = gofakeit.NewMapParams()
+	params.Add("base", "/relative")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "invalid base URL")
+
+	params = gofakeit.NewMapParams()
+	params.Add("extension", "exe")
+
+	_, err = info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "unknown extension")
+
+	for value, msg := range map[[2]string]string{
+		{"depth", "-1"}:           "depth: negative count: -1",
+		{"depth", "100001"}:       "depth: too many items: 100001",
+		{"queryParams", "100001"}: "queryParams: too many items: 100001",
+	} {
+		params = gofakeit.NewMapParams()
+		params.Add(value[0], value[1])
+
+		_, err = info.Generate(r, params, info)
+
+		require.ErrorContains(t, err, msg)
+	}
+}
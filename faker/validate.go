@@ -0,0 +1,355 @@
+package faker
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana/sobek"
+)
+
+// rules is a compiled set of validation rules.
+//
+// The rules JavaScript object can be:
+//   - rule specification: validates the value itself
+//   - object: the property values are rule specifications (or nested rules) of the object properties
+//
+// The rule specification can be:
+//   - string: the name of a built-in rule (e.g. "email")
+//   - object with rule property: the name of a built-in rule and its options (e.g. {rule: "date", min: "2000-01-01"})
+//   - function: custom rule, returns true if the value is valid, false or an error message otherwise
+//   - array: multiple rule specifications
+type rules struct {
+	checks []*rule
+	fields []*fieldRules
+}
+
+// fieldRules contains the compiled rules of an object property.
+type fieldRules struct {
+	name  string
+	rules *rules
+}
+
+// rule is a compiled rule specification.
+type rule struct {
+	name     string
+	min      time.Time
+	max      time.Time
+	callable sobek.Callable
+}
+
+// violation describes a value which does not satisfy a rule.
+type violation struct {
+	path    string
+	rule    string
+	value   sobek.Value
+	message string
+}
+
+//nolint:gochecknoglobals
+var (
+	emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s.]+$`)
+	e164Regexp  = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+)
+
+// dateLayouts contains the accepted layouts of date strings.
+//
+//nolint:gochecknoglobals
+var dateLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// validate validates a value or a dataset against the rules and returns the violations.
+// If the value is an array and the rules describe object properties, every item of the array is validated.
+func (f *faker) validate(data sobek.Value, rulesVal sobek.Value) sobek.Value {
+	compiled := f.compileRules("rules", rulesVal)
+
+	var violations []*violation
+
+	if obj, ok := data.(*sobek.Object); ok && obj.ClassName() == "Array" && len(compiled.fields) != 0 {
+		var items []sobek.Value
+
+		_ = f.runtime.ExportTo(obj, &items)
+
+		for idx, item := range items {
+			violations = f.check(compiled, fmt.Sprintf("[%d]", idx), item, violations)
+		}
+	} else {
+		violations = f.check(compiled, "", data, violations)
+	}
+
+	values := make([]any, 0, len(violations))
+
+	for _, v := range violations {
+		obj := f.runtime.NewObject()
+
+		_ = obj.Set("path", v.path)
+		_ = obj.Set("rule", v.rule)
+		_ = obj.Set("value", v.value)
+		_ = obj.Set("message", v.message)
+
+		values = append(values, obj)
+	}
+
+	return f.runtime.NewArray(values...)
+}
+
+// compileRules compiles the rules JavaScript object.
+func (f *faker) compileRules(path string, val sobek.Value) *rules {
+	compiled := new(rules)
+
+	obj, isObject := val.(*sobek.Object)
+
+	switch {
+	case !isObject:
+		compiled.checks = append(compiled.checks, f.compileRule(path, val))
+	case obj.ClassName() == "Array":
+		var items []sobek.Value
+
+		_ = f.runtime.ExportTo(obj, &items)
+
+		for _, item := range items {
+			compiled.checks = append(compiled.checks, f.compileRule(path, item))
+		}
+	case obj.ClassName() == "Function", obj.Get("rule") != nil:
+		compiled.checks = append(compiled.checks, f.compileRule(path, val))
+	default:
+		for _, key := range obj.Keys() {
+			compiled.fields = append(compiled.fields, &fieldRules{name: key, rules: f.compileRules(key, obj.Get(key))})
+		}
+	}
+
+	return compiled
+}
+
+func (f *faker) compileRule(path string, val sobek.Value) *rule {
+	if callable, ok := sobek.AssertFunction(val); ok {
+		return &rule{name: "custom", callable: callable}
+	}
+
+	compiled := new(rule)
+
+	var opts *sobek.Object
+
+	if obj, ok := val.(*sobek.Object); ok && obj.ClassName() == "Object" {
+		opts = obj
+		val = obj.Get("rule")
+	}
+
+	if val == nil || sobek.IsUndefined(val) {
+		f.throw(&ArgumentError{Function: "validate", Parameter: path, Expected: "rule", Reason: "missing rule"})
+	}
+
+	compiled.name = val.String()
+
+	switch compiled.name {
+	case "required", "email", "e164", "luhn":
+	case "phone":
+		compiled.name = "e164"
+	case "date":
+		if opts != nil {
+			compiled.min = f.ruleDate(path, "min", opts.Get("min"))
+			compiled.max = f.ruleDate(path, "max", opts.Get("max"))
+		}
+	default:
+		f.throw(&ArgumentError{
+			Function:  "validate",
+			Parameter: path,
+			Expected:  "required, email, e164, phone, luhn or date",
+			Reason:    "unknown rule " + compiled.name,
+		})
+	}
+
+	return compiled
+}
+
+func (f *faker) ruleDate(path, option string, val sobek.Value) time.Time {
+	if val == nil || sobek.IsUndefined(val) {
+		return time.Time{}
+	}
+
+	date, ok := toTime(val)
+	if !ok {
+		f.throw(&ArgumentError{
+			Function:  "validate",
+			Parameter: path,
+			Expected:  "date",
+			Reason:    fmt.Sprintf("invalid %s value %s", option, val.String()),
+		})
+	}
+
+	return date
+}
+
+// check appends the violations of the value to the list.
+func (f *faker) check(compiled *rules, path string, val sobek.Value, list []*violation) []*violation {
+	for _, rule := range compiled.checks {
+		if msg := f.checkRule(rule, val); len(msg) != 0 {
+			list = append(list, &violation{path: path, rule: rule.name, value: val, message: msg})
+		}
+	}
+
+	if len(compiled.fields) == 0 {
+		return list
+	}
+
+	obj, ok := val.(*sobek.Object)
+	if !ok {
+		return append(list, &violation{path: path, rule: "object", value: val, message: "not an object"})
+	}
+
+	for _, field := range compiled.fields {
+		fieldPath := field.name
+		if len(path) != 0 {
+			fieldPath = path + "." + field.name
+		}
+
+		list = f.check(field.rules, fieldPath, obj.Get(field.name), list)
+	}
+
+	return list
+}
+
+// checkRule returns the violation message, empty if the value satisfies the rule.
+func (f *faker) checkRule(r *rule, val sobek.Value) string {
+	if r.callable != nil {
+		return f.checkCustom(r, val)
+	}
+
+	if val == nil || sobek.IsUndefined(val) || sobek.IsNull(val) {
+		return "missing value"
+	}
+
+	if r.name == "date" {
+		return checkDate(r, val)
+	}
+
+	str, ok := val.Export().(string)
+	if !ok {
+		return "not a string"
+	}
+
+	switch r.name {
+	case "email":
+		if !emailRegexp.MatchString(str) {
+			return "invalid email address"
+		}
+	case "e164":
+		if !e164Regexp.MatchString(str) {
+			return "invalid E.164 phone number"
+		}
+	case "luhn":
+		if !luhnValid(str) {
+			return "invalid Luhn checksum"
+		}
+	default:
+		if len(str) == 0 {
+			return "missing value"
+		}
+	}
+
+	return ""
+}
+
+func (f *faker) checkCustom(r *rule, val sobek.Value) string {
+	if val == nil {
+		val = sobek.Undefined()
+	}
+
+	res, err := r.callable(sobek.Undefined(), val)
+	if err != nil {
+		panic(err)
+	}
+
+	if str, ok := res.Export().(string); ok {
+		return str
+	}
+
+	if !res.ToBoolean() {
+		return "custom rule failed"
+	}
+
+	return ""
+}
+
+func checkDate(r *rule, val sobek.Value) string {
+	date, ok := toTime(val)
+	if !ok {
+		return "invalid date"
+	}
+
+	if !r.min.IsZero() && date.Before(r.min) {
+		return "date before " + r.min.Format(time.RFC3339)
+	}
+
+	if !r.max.IsZero() && date.After(r.max) {
+		return "date after " + r.max.Format(time.RFC3339)
+	}
+
+	return ""
+}
+
+// toTime converts a JavaScript Date or date string to time.
+func toTime(val sobek.Value) (time.Time, bool) {
+	switch exported := val.Export().(type) {
+	case time.Time:
+		return exported, true
+	case string:
+		for _, layout := range dateLayouts {
+			if date, err := time.Parse(layout, exported); err == nil {
+				return date, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// luhnValid reports whether the number (spaces and dashes are ignored) has a valid Luhn checksum.
+func luhnValid(number string) bool {
+	number = strings.NewReplacer(" ", "", "-", "").Replace(number)
+
+	const minLength = 2
+
+	if len(number) < minLength {
+		return false
+	}
+
+	var sum int
+
+	for idx := range len(number) {
+		chr := number[len(number)-1-idx]
+		if chr < '0' || chr > '9' {
+			return false
+		}
+
+		digit := int(chr - '0')
+
+		if idx%2 == 1 {
+			const maxDigit = 9
+
+			if digit *= 2; digit > maxDigit {
+				digit -= maxDigit
+			}
+		}
+
+		sum += digit
+	}
+
+	const base = 10
+
+	return sum%base == 0
+}
+
+// luhnCheckDigit returns the digit which makes the Luhn checksum of the number valid.
+func luhnCheckDigit(number string) int {
+	const base = 10
+
+	for digit := range base {
+		if luhnValid(number + strconv.Itoa(digit)) {
+			return digit
+		}
+	}
+
+	return 0
+}
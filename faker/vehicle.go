@@ -0,0 +1,213 @@
+package faker
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var errUnknownPlateRegion = errors.New("no license plate format for country or state")
+
+func init() {
+	gofakeit.AddFuncLookup("vin", gofakeit.Info{
+		Display:     "VIN",
+		Category:    "car",
+		Description: "Vehicle Identification Number with valid check digit",
+		Example:     "1M8GDM9AXKP042788",
+		Output:      "string",
+		Params:      nil,
+		Generate:    vin,
+	})
+
+	gofakeit.AddFuncLookup("licenseplate", gofakeit.Info{
+		Display:     "License Plate",
+		Category:    "car",
+		Description: "Vehicle registration plate in the format of the country or US state",
+		Example:     "7KDF392",
+		Output:      "string",
+		Params: []gofakeit.Param{
+			{
+				Field:       "region",
+				Display:     "Region",
+				Type:        "string",
+				Default:     "US",
+				Options:     plateRegions(),
+				Description: "ISO 3166-1 alpha-2 country code or ISO 3166-2 code of a US state (e.g. US-CA)",
+			},
+		},
+		Generate: licenseplate,
+	})
+}
+
+const (
+	// vinChars contains the characters allowed in VIN (I, O and Q are not allowed).
+	vinChars = "ABCDEFGHJKLMNPRSTUVWXYZ0123456789"
+	// vinYears contains the model year codes (I, O, Q, U, Z and 0 are not allowed).
+	vinYears = "ABCDEFGHJKLMNPRSTVWXY123456789"
+)
+
+// vinWMIs contains World Manufacturer Identifiers of common manufacturers.
+var vinWMIs = []string{ //nolint:gochecknoglobals
+	"1FA", "1FT", "1G1", "1GC", "1HG", "1N4", "2HG", "2T1", "3FA", "3VW", "4T1", "5YJ", "JHM", "JN1",
+	"JTD", "KMH", "KNA", "SAJ", "SAL", "VF1", "VF3", "W0L", "WAU", "WBA", "WDD", "WVW", "YV1", "ZFA",
+}
+
+// vinWeights contains the weights of the VIN positions used for the check digit calculation.
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2} //nolint:gochecknoglobals
+
+func vin(r *rand.Rand, _ *gofakeit.MapParams, _ *gofakeit.Info) (any, error) {
+	const (
+		length       = 17
+		vdsLength    = 5
+		serialLength = 6
+		checkPos     = 8
+	)
+
+	var buff strings.Builder
+
+	buff.Grow(length)
+	buff.WriteString(vinWMIs[r.Intn(len(vinWMIs))])
+
+	for range vdsLength {
+		buff.WriteByte(vinChars[r.Intn(len(vinChars))])
+	}
+
+	buff.WriteByte('0') // check digit placeholder
+	buff.WriteByte(vinYears[r.Intn(len(vinYears))])
+	buff.WriteByte(vinChars[r.Intn(len(vinChars))])
+	buff.WriteString(digits(r, serialLength))
+
+	code := []byte(buff.String())
+	code[checkPos] = vinCheckDigit(string(code))
+
+	return string(code), nil
+}
+
+// vinCheckDigit returns the check digit of the VIN (the value of the check digit position is ignored).
+func vinCheckDigit(code string) byte {
+	const modulus = 11
+
+	var sum int
+
+	for idx := range len(code) {
+		sum += vinValue(code[idx]) * vinWeights[idx]
+	}
+
+	if check := sum % modulus; check != modulus-1 {
+		return byte('0' + check)
+	}
+
+	return 'X'
+}
+
+// vinValue returns the transliterated value of the VIN character.
+func vinValue(chr byte) int {
+	const values = "12345678_12345_7_923456789" // A..Z, "_" for characters not allowed
+
+	if chr >= '0' && chr <= '9' {
+		return int(chr - '0')
+	}
+
+	if chr >= 'A' && chr <= 'Z' && values[chr-'A'] != '_' {
+		return int(values[chr-'A'] - '0')
+	}
+
+	return 0
+}
+
+// plateFormats contains the license plate formats of countries and US states.
+// In the formats # is replaced by a digit, ? by a letter (except I, O and Q),
+// ^ by a consonant and D by a district code.
+var plateFormats = map[string][]string{ //nolint:gochecknoglobals
+	"BR":    {"???#?##"},
+	"DE":    {"D ?? ####", "D ? ###", "D ?? ##"},
+	"ES":    {"#### ^^^"},
+	"FR":    {"??-###-??"},
+	"GB":    {"??## ???"},
+	"IT":    {"?? ###??"},
+	"NL":    {"##-???-#", "#-???-##", "??-###-?"},
+	"PL":    {"?? #####", "??? ####"},
+	"US-CA": {"#???###"},
+	"US-FL": {"??? ?##", "###-???"},
+	"US-IL": {"?? #####"},
+	"US-NY": {"???-####"},
+	"US-TX": {"???-####"},
+	"US-WA": {"???####"},
+}
+
+// plateDistricts contains German district codes.
+var plateDistricts = []string{"B", "M", "HH", "K", "F", "S", "D", "DO", "HB", "L", "N", "BN"} //nolint:gochecknoglobals
+
+func plateRegions() []string {
+	regions := []string{"US"}
+
+	for region := range plateFormats {
+		regions = append(regions, region)
+	}
+
+	sort.Strings(regions)
+
+	return regions
+}
+
+func licenseplate(r *rand.Rand, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+	region, err := info.GetString(m, "region")
+	if err != nil {
+		return nil, err
+	}
+
+	region = strings.ToUpper(region)
+
+	if region == "US" {
+		var states []string
+
+		for _, name := range plateRegions() {
+			if strings.HasPrefix(name, "US-") {
+				states = append(states, name)
+			}
+		}
+
+		region = states[r.Intn(len(states))]
+	}
+
+	formats, found := plateFormats[region]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownPlateRegion, region)
+	}
+
+	return plateOf(r, formats[r.Intn(len(formats))]), nil
+}
+
+// plateOf returns a random license plate of the format.
+func plateOf(r *rand.Rand, format string) string {
+	const (
+		letters    = "ABCDEFGHJKLMNPRSTUVWXYZ"
+		consonants = "BCDFGHJKLMNPRSTVWXYZ"
+		base       = 10
+	)
+
+	var buff strings.Builder
+
+	buff.Grow(len(format))
+
+	for idx := range len(format) {
+		switch chr := format[idx]; chr {
+		case '#':
+			buff.WriteByte(byte('0' + r.Intn(base)))
+		case '?':
+			buff.WriteByte(letters[r.Intn(len(letters))])
+		case '^':
+			buff.WriteByte(consonants[r.Intn(len(consonants))])
+		case 'D':
+			buff.WriteString(plateDistricts[r.Intn(len(plateDistricts))])
+		default:
+			buff.WriteByte(chr)
+		}
+	}
+
+	return buff.String()
+}
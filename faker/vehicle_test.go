@@ -0,0 +1,99 @@
+package faker_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_vin(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("vin")
+
+	require.NotNil(t, info)
+
+	// ISO 3779 transliteration of the letters (I, O and Q are not allowed) and position weights
+	values := map[rune]int{
+		'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+		'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+		'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+	}
+	weights := []int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+	r := testRand(t)
+
+	for range 100 {
+		val, err := info.Generate(r, nil, info)
+
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{8}[0-9X][A-HJ-NPR-Z0-9]{2}[0-9]{6}$`), val)
+
+		sum := 0
+
+		for idx, chr := range val.(string) {
+			value, found := values[chr]
+			if !found {
+				value = int(chr - '0')
+			}
+
+			sum += value * weights[idx]
+		}
+
+		check := "0123456789X"[sum%11]
+
+		require.Equal(t, check, val.(string)[8], val)
+	}
+}
+
+func Test_licenseplate(t *testing.T) {
+	t.Parallel()
+
+	info := gofakeit.GetFuncLookup("licenseplate")
+
+	require.NotNil(t, info)
+
+	formats := map[string]*regexp.Regexp{
+		"BR":    regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`),
+		"DE":    regexp.MustCompile(`^[A-Z]{1,2} [A-Z]{1,2} [0-9]{2,4}$`),
+		"ES":    regexp.MustCompile(`^[0-9]{4} [B-DF-HJ-NP-TV-Z]{3}$`),
+		"FR":    regexp.MustCompile(`^[A-Z]{2}-[0-9]{3}-[A-Z]{2}$`),
+		"gb":    regexp.MustCompile(`^[A-Z]{2}[0-9]{2} [A-Z]{3}$`),
+		"IT":    regexp.MustCompile(`^[A-Z]{2} [0-9]{3}[A-Z]{2}$`),
+		"NL":    regexp.MustCompile(`^([0-9]{2}-[A-Z]{3}-[0-9]|[0-9]-[A-Z]{3}-[0-9]{2}|[A-Z]{2}-[0-9]{3}-[A-Z])$`),
+		"PL":    regexp.MustCompile(`^([A-Z]{2} [0-9]{5}|[A-Z]{3} [0-9]{4})$`),
+		"US-CA": regexp.MustCompile(`^[0-9][A-Z]{3}[0-9]{3}$`),
+		"US-FL": regexp.MustCompile(`^([A-Z]{3} [A-Z][0-9]{2}|[0-9]{3}-[A-Z]{3})$`),
+		"US-IL": regexp.MustCompile(`^[A-Z]{2} [0-9]{5}$`),
+		"US-NY": regexp.MustCompile(`^[A-Z]{3}-[0-9]{4}$`),
+		"US-TX": regexp.MustCompile(`^[A-Z]{3}-[0-9]{4}$`),
+		"US-WA": regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`),
+		"US":    regexp.MustCompile(`^[A-Z0-9 -]{7,8}$`),
+	}
+
+	r := testRand(t)
+
+	for region, format := range formats {
+		params := gofakeit.NewMapParams()
+		params.Add("region", region)
+
+		for range 20 {
+			val, err := info.Generate(r, params, info)
+
+			require.NoError(t, err)
+			require.Regexp(t, format, val, region)
+
+			if region != "DE" { // district codes are not restricted
+				require.NotRegexp(t, regexp.MustCompile(`[IOQ]`), val, region)
+			}
+		}
+	}
+	params := gofakeit.NewMapParams()
+	params.Add("region", "XX")
+
+	_, err := info.Generate(r, params, info)
+
+	require.ErrorContains(t, err, "no license plate format for country or state: XX")
+}
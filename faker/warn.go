@@ -0,0 +1,39 @@
+package faker
+
+import "sync"
+
+//nolint:gochecknoglobals
+var warned sync.Map
+
+// warnOnce logs a warning message via the k6 logger, but only once per key during the test run.
+// The key is only used up when the warning is actually logged, so a call without logger
+// (e.g. without VU) doesn't suppress the warning of the later calls.
+func (f *faker) warnOnce(key string, format string, args ...any) {
+	warnf := f.warnFunc()
+	if warnf == nil {
+		return
+	}
+
+	if _, done := warned.LoadOrStore(key, struct{}{}); done {
+		return
+	}
+
+	warnf(format, args...)
+}
+
+// warnFunc returns the Warnf function of the k6 logger of the VU (or of the init context), nil if there is none.
+func (f *faker) warnFunc() func(format string, args ...any) {
+	if f.vu == nil {
+		return nil
+	}
+
+	if state := f.vu.State(); state != nil && state.Logger != nil {
+		return state.Logger.Warnf
+	}
+
+	if env := f.vu.InitEnv(); env != nil && env.Logger != nil {
+		return env.Logger.Warnf
+	}
+
+	return nil
+}
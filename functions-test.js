@@ -9,6 +9,7 @@ exists(faker.address.address(), 'address.address()');
 exists(faker.address.city(), 'address.city()');
 exists(faker.address.country(), 'address.country()');
 exists(faker.address.countryAbbreviation(), 'address.countryAbbreviation()');
+exists(faker.address.gpsTrace([],[],20,50,5), 'address.gpsTrace([],[],20,50,5)');
 exists(faker.address.latitude(), 'address.latitude()');
 exists(faker.address.latitudeRange(0,90), 'address.latitudeRange(0,90)');
 exists(faker.address.longitude(), 'address.longitude()');
@@ -43,30 +44,50 @@ exists(faker.book.book(), 'book.book()');
 exists(faker.book.bookAuthor(), 'book.bookAuthor()');
 exists(faker.book.bookGenre(), 'book.bookGenre()');
 exists(faker.book.bookTitle(), 'book.bookTitle()');
+exists(faker.book.doi(), 'book.doi()');
+exists(faker.book.isbn10(), 'book.isbn10()');
+exists(faker.book.isbn13(), 'book.isbn13()');
+exists(faker.book.issn(), 'book.issn()');
 exists(faker.car.car(), 'car.car()');
 exists(faker.car.carFuelType(), 'car.carFuelType()');
 exists(faker.car.carMaker(), 'car.carMaker()');
 exists(faker.car.carModel(), 'car.carModel()');
 exists(faker.car.carTransmissionType(), 'car.carTransmissionType()');
 exists(faker.car.carType(), 'car.carType()');
+exists(faker.car.licensePlate("US"), 'car.licensePlate("US")');
+exists(faker.car.vin(), 'car.vin()');
 exists(faker.celebrity.celebrityActor(), 'celebrity.celebrityActor()');
 exists(faker.celebrity.celebrityBusiness(), 'celebrity.celebrityBusiness()');
 exists(faker.celebrity.celebritySport(), 'celebrity.celebritySport()');
+exists(faker.chaos.pathSegment(0.1), 'chaos.pathSegment(0.1)');
+exists(faker.chaos.queryString(0.1), 'chaos.queryString(0.1)');
+exists(faker.cloud.awsArn("any"), 'cloud.awsArn("any")');
+exists(faker.cloud.azureResourceId("any"), 'cloud.azureResourceId("any")');
+exists(faker.cloud.gcpResourceName("any"), 'cloud.gcpResourceName("any")');
 exists(faker.color.color(), 'color.color()');
 exists(faker.color.hexColor(), 'color.hexColor()');
 exists(faker.color.niceColors(), 'color.niceColors()');
 exists(faker.color.rgbColor(), 'color.rgbColor()');
 exists(faker.color.safeColor(), 'color.safeColor()');
+exists(faker.commerce.cartItems(3), 'commerce.cartItems(3)');
+exists(faker.commerce.discountCode(), 'commerce.discountCode()');
+exists(faker.commerce.order(), 'commerce.order()');
+exists(faker.commerce.shipment("any"), 'commerce.shipment("any")');
+exists(faker.commerce.sku("???-#####"), 'commerce.sku("???-#####")');
+exists(faker.commerce.trackingNumber("any"), 'commerce.trackingNumber("any")');
 exists(faker.company.blurb(), 'company.blurb()');
 exists(faker.company.bs(), 'company.bs()');
 exists(faker.company.buzzword(), 'company.buzzword()');
 exists(faker.company.company(), 'company.company()');
 exists(faker.company.companySuffix(), 'company.companySuffix()');
+exists(faker.company.ein(), 'company.ein()');
 exists(faker.company.job(), 'company.job()');
 exists(faker.company.jobDescriptor(), 'company.jobDescriptor()');
 exists(faker.company.jobLevel(), 'company.jobLevel()');
 exists(faker.company.jobTitle(), 'company.jobTitle()');
+exists(faker.company.organization(), 'company.organization()');
 exists(faker.company.slogan(), 'company.slogan()');
+exists(faker.company.vatNumber("any"), 'company.vatNumber("any")');
 exists(faker.emoji.emoji(), 'emoji.emoji()');
 exists(faker.emoji.emojiAlias(), 'emoji.emojiAlias()');
 exists(faker.emoji.emojiCategory(), 'emoji.emojiCategory()');
@@ -83,8 +104,12 @@ exists(faker.error.runtimeError(), 'error.runtimeError()');
 exists(faker.error.validationError(), 'error.validationError()');
 exists(faker.file.fileExtension(), 'file.fileExtension()');
 exists(faker.file.fileMimeType(), 'file.fileMimeType()');
+exists(faker.finance.amount(0,1000), 'finance.amount(0,1000)');
 exists(faker.finance.cusip(), 'finance.cusip()');
 exists(faker.finance.isin(), 'finance.isin()');
+exists(faker.finance.ohlcCandle(100,0.01), 'finance.ohlcCandle(100,0.01)');
+exists(faker.finance.orderBookSnapshot(5), 'finance.orderBookSnapshot(5)');
+exists(faker.finance.tickerQuote("any"), 'finance.tickerQuote("any")');
 exists(faker.food.breakfast(), 'food.breakfast()');
 exists(faker.food.dessert(), 'food.dessert()');
 exists(faker.food.dinner(), 'food.dinner()');
@@ -93,20 +118,40 @@ exists(faker.food.fruit(), 'food.fruit()');
 exists(faker.food.lunch(), 'food.lunch()');
 exists(faker.food.snack(), 'food.snack()');
 exists(faker.food.vegetable(), 'food.vegetable()');
+exists(faker.fuzz.boundaryNumbers("all"), 'fuzz.boundaryNumbers("all")');
+exists(faker.fuzz.injectionStrings("all"), 'fuzz.injectionStrings("all")');
 exists(faker.game.dice(1,[5,4,13]), 'game.dice(1,[5,4,13])');
 exists(faker.game.gamertag(), 'game.gamertag()');
+exists(faker.game.leaderboardEntry(), 'game.leaderboardEntry()');
+exists(faker.game.lobbyName(), 'game.lobbyName()');
+exists(faker.game.matchResult(10), 'game.matchResult(10)');
+exists(faker.game.playerTelemetryBatch(10), 'game.playerTelemetryBatch(10)');
 exists(faker.hacker.hackerAbbreviation(), 'hacker.hackerAbbreviation()');
 exists(faker.hacker.hackerAdjective(), 'hacker.hackerAdjective()');
 exists(faker.hacker.hackerNoun(), 'hacker.hackerNoun()');
 exists(faker.hacker.hackerPhrase(), 'hacker.hackerPhrase()');
 exists(faker.hacker.hackerVerb(), 'hacker.hackerVerb()');
 exists(faker.hacker.hackeringVerb(), 'hacker.hackeringVerb()');
+exists(faker.health.allergy(), 'health.allergy()');
+exists(faker.health.bloodType(), 'health.bloodType()');
+exists(faker.health.fhirPatient(), 'health.fhirPatient()');
+exists(faker.health.icd10Code(), 'health.icd10Code()');
+exists(faker.health.npi(), 'health.npi()');
+exists(faker.health.patient(), 'health.patient()');
 exists(faker.hipster.hipsterParagraph(2,2,5,"\u003cbr /\u003e"), 'hipster.hipsterParagraph(2,2,5,"\u003cbr /\u003e")');
 exists(faker.hipster.hipsterSentence(5), 'hipster.hipsterSentence(5)');
 exists(faker.hipster.hipsterWord(), 'hipster.hipsterWord()');
+exists(faker.internet.asn(), 'internet.asn()');
 exists(faker.internet.chromeUserAgent(), 'internet.chromeUserAgent()');
+exists(faker.internet.cidr("v4",0), 'internet.cidr("v4",0)');
+exists(faker.internet.cookie("any","any",["secure"]), 'internet.cookie("any","any",["secure"])');
+exists(faker.internet.cookieHeader(3), 'internet.cookieHeader(3)');
+exists(faker.internet.disposableEmail(), 'internet.disposableEmail()');
+exists(faker.internet.dnsRecord("any"), 'internet.dnsRecord("any")');
 exists(faker.internet.domainName(), 'internet.domainName()');
 exists(faker.internet.domainSuffix(), 'internet.domainSuffix()');
+exists(faker.internet.emailPlusTag("qa@example.com"), 'internet.emailPlusTag("qa@example.com")');
+exists(faker.internet.emailWithDomain("example.com"), 'internet.emailWithDomain("example.com")');
 exists(faker.internet.firefoxUserAgent(), 'internet.firefoxUserAgent()');
 exists(faker.internet.httpMethod(), 'internet.httpMethod()');
 exists(faker.internet.httpStatusCode(), 'internet.httpStatusCode()');
@@ -114,20 +159,44 @@ exists(faker.internet.httpStatusCodeSimple(), 'internet.httpStatusCodeSimple()')
 exists(faker.internet.httpVersion(), 'internet.httpVersion()');
 exists(faker.internet.imageUrl(500,500), 'internet.imageUrl(500,500)');
 exists(faker.internet.inputName(), 'internet.inputName()');
+exists(faker.internet.ipInCidr("10.0.0.0/8"), 'internet.ipInCidr("10.0.0.0/8")');
 exists(faker.internet.ipv4Address(), 'internet.ipv4Address()');
 exists(faker.internet.ipv6Address(), 'internet.ipv6Address()');
 exists(faker.internet.logLevel(), 'internet.logLevel()');
 exists(faker.internet.macAddress(), 'internet.macAddress()');
+exists(faker.internet.macAddressVendor("any"), 'internet.macAddressVendor("any")');
+exists(faker.internet.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600), 'internet.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600)');
 exists(faker.internet.operaUserAgent(), 'internet.operaUserAgent()');
 exists(faker.internet.password(true,false,true,true,false,12), 'internet.password(true,false,true,true,false,12)');
+exists(faker.internet.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true), 'internet.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true)');
+exists(faker.internet.portEphemeral(), 'internet.portEphemeral()');
+exists(faker.internet.portWellKnown(), 'internet.portWellKnown()');
+exists(faker.internet.qrContent("any"), 'internet.qrContent("any")');
 exists(faker.internet.safariUserAgent(), 'internet.safariUserAgent()');
+exists(faker.internet.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa"), 'internet.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa")');
+exists(faker.internet.sshKeyPair("ed25519"), 'internet.sshKeyPair("ed25519")');
 exists(faker.internet.url(), 'internet.url()');
+exists(faker.internet.urlWithin("https://test.example.com",3,"none",2), 'internet.urlWithin("https://test.example.com",3,"none",2)');
 exists(faker.internet.userAgent(), 'internet.userAgent()');
 exists(faker.internet.username(), 'internet.username()');
+exists(faker.internet.usernamePolicy("a-z0-9._",3,16), 'internet.usernamePolicy("a-z0-9._",3,16)');
+exists(faker.internet.zoneFile(10), 'internet.zoneFile(10)');
+exists(faker.iot.deviceId("uuid"), 'iot.deviceId("uuid")');
+exists(faker.iot.mqttTopic(5,"ourselves"), 'iot.mqttTopic(5,"ourselves")');
+exists(faker.iot.telemetryBatch(10,"temperature","meanReverting"), 'iot.telemetryBatch(10,"temperature","meanReverting")');
+exists(faker.k8s.labelSet(3), 'k8s.labelSet(3)');
+exists(faker.k8s.namespace(), 'k8s.namespace()');
+exists(faker.k8s.podManifest(1), 'k8s.podManifest(1)');
+exists(faker.k8s.resourceName(), 'k8s.resourceName()');
 exists(faker.language.language(), 'language.language()');
 exists(faker.language.languageAbbreviation(), 'language.languageAbbreviation()');
 exists(faker.language.languageBcp(), 'language.languageBcp()');
 exists(faker.language.programmingLanguage(), 'language.programmingLanguage()');
+exists(faker.messaging.amqpMessage("any"), 'messaging.amqpMessage("any")');
+exists(faker.messaging.cloudEvent("any","any","any"), 'messaging.cloudEvent("any","any","any")');
+exists(faker.messaging.kafkaRecord("uuid","any"), 'messaging.kafkaRecord("uuid","any")');
+exists(faker.minecraft.chunkCoordinates(), 'minecraft.chunkCoordinates()');
+exists(faker.minecraft.inventory(36), 'minecraft.inventory(36)');
 exists(faker.minecraft.minecraftAnimal(), 'minecraft.minecraftAnimal()');
 exists(faker.minecraft.minecraftArmorPart(), 'minecraft.minecraftArmorPart()');
 exists(faker.minecraft.minecraftArmorTier(), 'minecraft.minecraftArmorTier()');
@@ -146,14 +215,16 @@ exists(faker.minecraft.minecraftVillagerStation(), 'minecraft.minecraftVillagerS
 exists(faker.minecraft.minecraftWeapon(), 'minecraft.minecraftWeapon()');
 exists(faker.minecraft.minecraftWeather(), 'minecraft.minecraftWeather()');
 exists(faker.minecraft.minecraftWood(), 'minecraft.minecraftWood()');
+exists(faker.minecraft.serverStatusJson(), 'minecraft.serverStatusJson()');
 exists(faker.movie.movie(), 'movie.movie()');
 exists(faker.movie.movieGenre(), 'movie.movieGenre()');
 exists(faker.movie.movieName(), 'movie.movieName()');
+exists(faker.numbers.bigInt(64), 'numbers.bigInt(64)');
 exists(faker.numbers.boolean(), 'numbers.boolean()');
 exists(faker.numbers.float32(), 'numbers.float32()');
 exists(faker.numbers.float32Range(3,5), 'numbers.float32Range(3,5)');
 exists(faker.numbers.float64(), 'numbers.float64()');
-exists(faker.numbers.float64Range(3,5), 'numbers.float64Range(3,5)');
+exists(faker.numbers.float64Range(3,5,-1), 'numbers.float64Range(3,5,-1)');
 exists(faker.numbers.hexUint128(), 'numbers.hexUint128()');
 exists(faker.numbers.hexUint16(), 'numbers.hexUint16()');
 exists(faker.numbers.hexUint256(), 'numbers.hexUint256()');
@@ -162,6 +233,7 @@ exists(faker.numbers.hexUint64(), 'numbers.hexUint64()');
 exists(faker.numbers.hexUint8(), 'numbers.hexUint8()');
 exists(faker.numbers.int16(), 'numbers.int16()');
 exists(faker.numbers.int32(), 'numbers.int32()');
+exists(faker.numbers.int53(), 'numbers.int53()');
 exists(faker.numbers.int64(), 'numbers.int64()');
 exists(faker.numbers.int8(), 'numbers.int8()');
 exists(faker.numbers.intRange(3,5), 'numbers.intRange(3,5)');
@@ -180,6 +252,7 @@ exists(faker.payment.bitcoinAddress(), 'payment.bitcoinAddress()');
 exists(faker.payment.bitcoinPrivateKey(), 'payment.bitcoinPrivateKey()');
 exists(faker.payment.creditCard(), 'payment.creditCard()');
 exists(faker.payment.creditCardCVV(), 'payment.creditCardCVV()');
+exists(faker.payment.creditCardCvv(), 'payment.creditCardCvv()');
 exists(faker.payment.creditCardExp(), 'payment.creditCardExp()');
 exists(faker.payment.creditCardExpMonth(), 'payment.creditCardExpMonth()');
 exists(faker.payment.creditCardExpYear(), 'payment.creditCardExpYear()');
@@ -189,21 +262,32 @@ exists(faker.payment.currency(), 'payment.currency()');
 exists(faker.payment.currencyLong(), 'payment.currencyLong()');
 exists(faker.payment.currencyShort(), 'payment.currencyShort()');
 exists(faker.payment.price(0,1000), 'payment.price(0,1000)');
+exists(faker.person.birthdate(18,80), 'person.birthdate(18,80)');
+exists(faker.person.demographicAge("US"), 'person.demographicAge("US")');
+exists(faker.person.demographicFirstName("US","any"), 'person.demographicFirstName("US","any")');
+exists(faker.person.demographicLastName("US"), 'person.demographicLastName("US")');
+exists(faker.person.demographicPerson("US"), 'person.demographicPerson("US")');
 exists(faker.person.email(), 'person.email()');
-exists(faker.person.firstName(), 'person.firstName()');
+exists(faker.person.firstName("any","any"), 'person.firstName("any","any")');
+exists(faker.person.fullName("{firstName} {lastName}","any","any"), 'person.fullName("{firstName} {lastName}","any","any")');
 exists(faker.person.gender(), 'person.gender()');
+exists(faker.person.genderPronouns("any"), 'person.genderPronouns("any")');
 exists(faker.person.hobby(), 'person.hobby()');
+exists(faker.person.honorific("any","any"), 'person.honorific("any","any")');
 exists(faker.person.lastName(), 'person.lastName()');
 exists(faker.person.middleName(), 'person.middleName()');
 exists(faker.person.name(), 'person.name()');
 exists(faker.person.namePrefix(), 'person.namePrefix()');
 exists(faker.person.nameSuffix(), 'person.nameSuffix()');
+exists(faker.person.nationalId("US",true,true), 'person.nationalId("US",true,true)');
 exists(faker.person.person(), 'person.person()');
 exists(faker.person.phone(), 'person.phone()');
 exists(faker.person.phoneFormatted(), 'person.phoneFormatted()');
 exists(faker.person.school(), 'person.school()');
 exists(faker.person.ssn(), 'person.ssn()');
 exists(faker.person.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"]), 'person.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"])');
+exists(faker.product.ean13(), 'product.ean13()');
+exists(faker.product.gs1128(["00"]), 'product.gs1128(["00"])');
 exists(faker.product.product(), 'product.product()');
 exists(faker.product.productCategory(), 'product.productCategory()');
 exists(faker.product.productDescription(), 'product.productDescription()');
@@ -211,33 +295,63 @@ exists(faker.product.productFeature(), 'product.productFeature()');
 exists(faker.product.productMaterial(), 'product.productMaterial()');
 exists(faker.product.productName(), 'product.productName()');
 exists(faker.product.productUpc(), 'product.productUpc()');
+exists(faker.product.review("any",0,"en"), 'product.review("any",0,"en")');
+exists(faker.product.upcA(), 'product.upcA()');
+exists(faker.strings.adversarial("any"), 'strings.adversarial("any")');
+exists(faker.strings.camelCase(3), 'strings.camelCase(3)');
+exists(faker.strings.crc32("none"), 'strings.crc32("none")');
 exists(faker.strings.digit(), 'strings.digit()');
 exists(faker.strings.digitN(3), 'strings.digitN(3)');
+exists(faker.strings.identifier("none",12,"alphanumeric"), 'strings.identifier("none",12,"alphanumeric")');
+exists(faker.strings.kebabCase(3), 'strings.kebabCase(3)');
+exists(faker.strings.ksuid(), 'strings.ksuid()');
 exists(faker.strings.letter(), 'strings.letter()');
 exists(faker.strings.letterN(3), 'strings.letterN(3)');
 exists(faker.strings.lexify("none"), 'strings.lexify("none")');
+exists(faker.strings.md5("none"), 'strings.md5("none")');
+exists(faker.strings.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"), 'strings.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")');
 exists(faker.strings.numerify("none"), 'strings.numerify("none")');
 exists(faker.strings.randomString(["none","how","these","keep","trip","congolese","choir","computer","still","far"]), 'strings.randomString(["none","how","these","keep","trip","congolese","choir","computer","still","far"])');
+exists(faker.strings.sha1("none"), 'strings.sha1("none")');
+exists(faker.strings.sha256("none"), 'strings.sha256("none")');
 exists(faker.strings.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"]), 'strings.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"])');
+exists(faker.strings.slug(3), 'strings.slug(3)');
+exists(faker.strings.snakeCase(3), 'strings.snakeCase(3)');
+exists(faker.strings.ulid(), 'strings.ulid()');
+exists(faker.strings.unicode("mixed",10), 'strings.unicode("mixed",10)');
 exists(faker.strings.uuid(), 'strings.uuid()');
+exists(faker.strings.uuidV7(), 'strings.uuidV7()');
+exists(faker.time.businessDay("US"), 'time.businessDay("US")');
+exists(faker.time.cron(), 'time.cron()');
 exists(faker.time.date("RFC3339"), 'time.date("RFC3339")');
 exists(faker.time.dateRange("1970-01-01","2024-03-13","yyyy-MM-dd"), 'time.dateRange("1970-01-01","2024-03-13","yyyy-MM-dd")');
 exists(faker.time.day(), 'time.day()');
+exists(faker.time.duration("1s","1h","s"), 'time.duration("1s","1h","s")');
 exists(faker.time.futureTime(), 'time.futureTime()');
 exists(faker.time.hour(), 'time.hour()');
+exists(faker.time.interarrival("poisson",1), 'time.interarrival("poisson",1)');
 exists(faker.time.minute(), 'time.minute()');
 exists(faker.time.month(), 'time.month()');
 exists(faker.time.monthString(), 'time.monthString()');
 exists(faker.time.nanosecond(), 'time.nanosecond()');
 exists(faker.time.pastTime(), 'time.pastTime()');
+exists(faker.time.recentTimestamp("24h"), 'time.recentTimestamp("24h")');
 exists(faker.time.second(), 'time.second()');
+exists(faker.time.soonTimestamp("24h"), 'time.soonTimestamp("24h")');
+exists(faker.time.timeRange("now","30d","1h","7d"), 'time.timeRange("now","30d","1h","7d")');
 exists(faker.time.timezone(), 'time.timezone()');
 exists(faker.time.timezoneAbbreviation(), 'time.timezoneAbbreviation()');
 exists(faker.time.timezoneFull(), 'time.timezoneFull()');
 exists(faker.time.timezoneOffset(), 'time.timezoneOffset()');
 exists(faker.time.timezoneRegion(), 'time.timezoneRegion()');
 exists(faker.time.weekday(), 'time.weekday()');
+exists(faker.time.withinBusinessHours("UTC"), 'time.withinBusinessHours("UTC")');
 exists(faker.time.year(), 'time.year()');
+exists(faker.travel.bookingItinerary(2), 'travel.bookingItinerary(2)');
+exists(faker.travel.flightNumber(), 'travel.flightNumber()');
+exists(faker.travel.iataAirport(), 'travel.iataAirport()');
+exists(faker.travel.passportNumber("US"), 'travel.passportNumber("US")');
+exists(faker.travel.pnr(), 'travel.pnr()');
 exists(faker.word.actionVerb(), 'word.actionVerb()');
 exists(faker.word.adjective(), 'word.adjective()');
 exists(faker.word.adverb(), 'word.adverb()');
@@ -280,7 +394,7 @@ exists(faker.word.nounDeterminer(), 'word.nounDeterminer()');
 exists(faker.word.nounPhrase(), 'word.nounPhrase()');
 exists(faker.word.nounProper(), 'word.nounProper()');
 exists(faker.word.nounUncountable(), 'word.nounUncountable()');
-exists(faker.word.paragraph(2,2,5,"\u003cbr /\u003e"), 'word.paragraph(2,2,5,"\u003cbr /\u003e")');
+exists(faker.word.paragraph(2,2,5,"\u003cbr /\u003e",0), 'word.paragraph(2,2,5,"\u003cbr /\u003e",0)');
 exists(faker.word.phrase(), 'word.phrase()');
 exists(faker.word.possessiveAdjective(), 'word.possessiveAdjective()');
 exists(faker.word.preposition(), 'word.preposition()');
@@ -301,8 +415,9 @@ exists(faker.word.properAdjective(), 'word.properAdjective()');
 exists(faker.word.quantitativeAdjective(), 'word.quantitativeAdjective()');
 exists(faker.word.question(), 'word.question()');
 exists(faker.word.quote(), 'word.quote()');
-exists(faker.word.sentence(5), 'word.sentence(5)');
+exists(faker.word.sentence(5,0), 'word.sentence(5,0)');
 exists(faker.word.simpleSentence(), 'word.simpleSentence()');
+exists(faker.word.textOfLength(100), 'word.textOfLength(100)');
 exists(faker.word.transitiveVerb(), 'word.transitiveVerb()');
 exists(faker.word.verb(), 'word.verb()');
 exists(faker.word.verbPhrase(), 'word.verbPhrase()');
@@ -335,6 +450,14 @@ exists(faker.zen.adverbTimeDefinite(), 'zen.adverbTimeDefinite()');
 exists(faker.call("adverbTimeDefinite"), 'call("adverbTimeDefinite")');
 exists(faker.zen.adverbTimeIndefinite(), 'zen.adverbTimeIndefinite()');
 exists(faker.call("adverbTimeIndefinite"), 'call("adverbTimeIndefinite")');
+exists(faker.zen.adversarial("any"), 'zen.adversarial("any")');
+exists(faker.call("adversarial","any"), 'call("adversarial","any")');
+exists(faker.zen.allergy(), 'zen.allergy()');
+exists(faker.call("allergy"), 'call("allergy")');
+exists(faker.zen.amount(0,1000), 'zen.amount(0,1000)');
+exists(faker.call("amount",0,1000), 'call("amount",0,1000)');
+exists(faker.zen.amqpMessage("any"), 'zen.amqpMessage("any")');
+exists(faker.call("amqpMessage","any"), 'call("amqpMessage","any")');
 exists(faker.zen.animal(), 'zen.animal()');
 exists(faker.call("animal"), 'call("animal")');
 exists(faker.zen.animalType(), 'zen.animalType()');
@@ -345,6 +468,12 @@ exists(faker.zen.appName(), 'zen.appName()');
 exists(faker.call("appName"), 'call("appName")');
 exists(faker.zen.appVersion(), 'zen.appVersion()');
 exists(faker.call("appVersion"), 'call("appVersion")');
+exists(faker.zen.asn(), 'zen.asn()');
+exists(faker.call("asn"), 'call("asn")');
+exists(faker.zen.awsArn("any"), 'zen.awsArn("any")');
+exists(faker.call("awsArn","any"), 'call("awsArn","any")');
+exists(faker.zen.azureResourceId("any"), 'zen.azureResourceId("any")');
+exists(faker.call("azureResourceId","any"), 'call("azureResourceId","any")');
 exists(faker.zen.beerAlcohol(), 'zen.beerAlcohol()');
 exists(faker.call("beerAlcohol"), 'call("beerAlcohol")');
 exists(faker.zen.beerBlg(), 'zen.beerBlg()');
@@ -361,12 +490,18 @@ exists(faker.zen.beerStyle(), 'zen.beerStyle()');
 exists(faker.call("beerStyle"), 'call("beerStyle")');
 exists(faker.zen.beerYeast(), 'zen.beerYeast()');
 exists(faker.call("beerYeast"), 'call("beerYeast")');
+exists(faker.zen.bigInt(64), 'zen.bigInt(64)');
+exists(faker.call("bigInt",64), 'call("bigInt",64)');
 exists(faker.zen.bird(), 'zen.bird()');
 exists(faker.call("bird"), 'call("bird")');
+exists(faker.zen.birthdate(18,80), 'zen.birthdate(18,80)');
+exists(faker.call("birthdate",18,80), 'call("birthdate",18,80)');
 exists(faker.zen.bitcoinAddress(), 'zen.bitcoinAddress()');
 exists(faker.call("bitcoinAddress"), 'call("bitcoinAddress")');
 exists(faker.zen.bitcoinPrivateKey(), 'zen.bitcoinPrivateKey()');
 exists(faker.call("bitcoinPrivateKey"), 'call("bitcoinPrivateKey")');
+exists(faker.zen.bloodType(), 'zen.bloodType()');
+exists(faker.call("bloodType"), 'call("bloodType")');
 exists(faker.zen.blurb(), 'zen.blurb()');
 exists(faker.call("blurb"), 'call("blurb")');
 exists(faker.zen.book(), 'zen.book()');
@@ -377,14 +512,22 @@ exists(faker.zen.bookGenre(), 'zen.bookGenre()');
 exists(faker.call("bookGenre"), 'call("bookGenre")');
 exists(faker.zen.bookTitle(), 'zen.bookTitle()');
 exists(faker.call("bookTitle"), 'call("bookTitle")');
+exists(faker.zen.bookingItinerary(2), 'zen.bookingItinerary(2)');
+exists(faker.call("bookingItinerary",2), 'call("bookingItinerary",2)');
 exists(faker.zen.boolean(), 'zen.boolean()');
 exists(faker.call("boolean"), 'call("boolean")');
+exists(faker.zen.boundaryNumbers("all"), 'zen.boundaryNumbers("all")');
+exists(faker.call("boundaryNumbers","all"), 'call("boundaryNumbers","all")');
 exists(faker.zen.breakfast(), 'zen.breakfast()');
 exists(faker.call("breakfast"), 'call("breakfast")');
 exists(faker.zen.bs(), 'zen.bs()');
 exists(faker.call("bs"), 'call("bs")');
+exists(faker.zen.businessDay("US"), 'zen.businessDay("US")');
+exists(faker.call("businessDay","US"), 'call("businessDay","US")');
 exists(faker.zen.buzzword(), 'zen.buzzword()');
 exists(faker.call("buzzword"), 'call("buzzword")');
+exists(faker.zen.camelCase(3), 'zen.camelCase(3)');
+exists(faker.call("camelCase",3), 'call("camelCase",3)');
 exists(faker.zen.car(), 'zen.car()');
 exists(faker.call("car"), 'call("car")');
 exists(faker.zen.carFuelType(), 'zen.carFuelType()');
@@ -397,6 +540,8 @@ exists(faker.zen.carTransmissionType(), 'zen.carTransmissionType()');
 exists(faker.call("carTransmissionType"), 'call("carTransmissionType")');
 exists(faker.zen.carType(), 'zen.carType()');
 exists(faker.call("carType"), 'call("carType")');
+exists(faker.zen.cartItems(3), 'zen.cartItems(3)');
+exists(faker.call("cartItems",3), 'call("cartItems",3)');
 exists(faker.zen.cat(), 'zen.cat()');
 exists(faker.call("cat"), 'call("cat")');
 exists(faker.zen.celebrityActor(), 'zen.celebrityActor()');
@@ -407,8 +552,14 @@ exists(faker.zen.celebritySport(), 'zen.celebritySport()');
 exists(faker.call("celebritySport"), 'call("celebritySport")');
 exists(faker.zen.chromeUserAgent(), 'zen.chromeUserAgent()');
 exists(faker.call("chromeUserAgent"), 'call("chromeUserAgent")');
+exists(faker.zen.chunkCoordinates(), 'zen.chunkCoordinates()');
+exists(faker.call("chunkCoordinates"), 'call("chunkCoordinates")');
+exists(faker.zen.cidr("v4",0), 'zen.cidr("v4",0)');
+exists(faker.call("cidr","v4",0), 'call("cidr","v4",0)');
 exists(faker.zen.city(), 'zen.city()');
 exists(faker.call("city"), 'call("city")');
+exists(faker.zen.cloudEvent("any","any","any"), 'zen.cloudEvent("any","any","any")');
+exists(faker.call("cloudEvent","any","any","any"), 'call("cloudEvent","any","any","any")');
 exists(faker.zen.color(), 'zen.color()');
 exists(faker.call("color"), 'call("color")');
 exists(faker.zen.comment(), 'zen.comment()');
@@ -431,14 +582,22 @@ exists(faker.zen.connectiveListing(), 'zen.connectiveListing()');
 exists(faker.call("connectiveListing"), 'call("connectiveListing")');
 exists(faker.zen.connectiveTime(), 'zen.connectiveTime()');
 exists(faker.call("connectiveTime"), 'call("connectiveTime")');
+exists(faker.zen.cookie("any","any",["secure"]), 'zen.cookie("any","any",["secure"])');
+exists(faker.call("cookie","any","any",["secure"]), 'call("cookie","any","any",["secure"])');
+exists(faker.zen.cookieHeader(3), 'zen.cookieHeader(3)');
+exists(faker.call("cookieHeader",3), 'call("cookieHeader",3)');
 exists(faker.zen.country(), 'zen.country()');
 exists(faker.call("country"), 'call("country")');
 exists(faker.zen.countryAbbreviation(), 'zen.countryAbbreviation()');
 exists(faker.call("countryAbbreviation"), 'call("countryAbbreviation")');
+exists(faker.zen.crc32("none"), 'zen.crc32("none")');
+exists(faker.call("crc32","none"), 'call("crc32","none")');
 exists(faker.zen.creditCard(), 'zen.creditCard()');
 exists(faker.call("creditCard"), 'call("creditCard")');
 exists(faker.zen.creditCardCVV(), 'zen.creditCardCVV()');
 exists(faker.call("creditCardCVV"), 'call("creditCardCVV")');
+exists(faker.zen.creditCardCvv(), 'zen.creditCardCvv()');
+exists(faker.call("creditCardCvv"), 'call("creditCardCvv")');
 exists(faker.zen.creditCardExp(), 'zen.creditCardExp()');
 exists(faker.call("creditCardExp"), 'call("creditCardExp")');
 exists(faker.zen.creditCardExpMonth(), 'zen.creditCardExpMonth()');
@@ -449,6 +608,8 @@ exists(faker.zen.creditCardNumberFormatted(), 'zen.creditCardNumberFormatted()')
 exists(faker.call("creditCardNumberFormatted"), 'call("creditCardNumberFormatted")');
 exists(faker.zen.creditCardType(), 'zen.creditCardType()');
 exists(faker.call("creditCardType"), 'call("creditCardType")');
+exists(faker.zen.cron(), 'zen.cron()');
+exists(faker.call("cron"), 'call("cron")');
 exists(faker.zen.currency(), 'zen.currency()');
 exists(faker.call("currency"), 'call("currency")');
 exists(faker.zen.currencyLong(), 'zen.currencyLong()');
@@ -465,12 +626,22 @@ exists(faker.zen.dateRange("1970-01-01","2024-03-13","yyyy-MM-dd"), 'zen.dateRan
 exists(faker.call("dateRange","1970-01-01","2024-03-13","yyyy-MM-dd"), 'call("dateRange","1970-01-01","2024-03-13","yyyy-MM-dd")');
 exists(faker.zen.day(), 'zen.day()');
 exists(faker.call("day"), 'call("day")');
+exists(faker.zen.demographicAge("US"), 'zen.demographicAge("US")');
+exists(faker.call("demographicAge","US"), 'call("demographicAge","US")');
+exists(faker.zen.demographicFirstName("US","any"), 'zen.demographicFirstName("US","any")');
+exists(faker.call("demographicFirstName","US","any"), 'call("demographicFirstName","US","any")');
+exists(faker.zen.demographicLastName("US"), 'zen.demographicLastName("US")');
+exists(faker.call("demographicLastName","US"), 'call("demographicLastName","US")');
+exists(faker.zen.demographicPerson("US"), 'zen.demographicPerson("US")');
+exists(faker.call("demographicPerson","US"), 'call("demographicPerson","US")');
 exists(faker.zen.demonstrativeAdjective(), 'zen.demonstrativeAdjective()');
 exists(faker.call("demonstrativeAdjective"), 'call("demonstrativeAdjective")');
 exists(faker.zen.descriptiveAdjective(), 'zen.descriptiveAdjective()');
 exists(faker.call("descriptiveAdjective"), 'call("descriptiveAdjective")');
 exists(faker.zen.dessert(), 'zen.dessert()');
 exists(faker.call("dessert"), 'call("dessert")');
+exists(faker.zen.deviceId("uuid"), 'zen.deviceId("uuid")');
+exists(faker.call("deviceId","uuid"), 'call("deviceId","uuid")');
 exists(faker.zen.dice(1,[5,4,13]), 'zen.dice(1,[5,4,13])');
 exists(faker.call("dice",1,[5,4,13]), 'call("dice",1,[5,4,13])');
 exists(faker.zen.digit(), 'zen.digit()');
@@ -479,16 +650,34 @@ exists(faker.zen.digitN(3), 'zen.digitN(3)');
 exists(faker.call("digitN",3), 'call("digitN",3)');
 exists(faker.zen.dinner(), 'zen.dinner()');
 exists(faker.call("dinner"), 'call("dinner")');
+exists(faker.zen.discountCode(), 'zen.discountCode()');
+exists(faker.call("discountCode"), 'call("discountCode")');
+exists(faker.zen.disposableEmail(), 'zen.disposableEmail()');
+exists(faker.call("disposableEmail"), 'call("disposableEmail")');
+exists(faker.zen.dnsRecord("any"), 'zen.dnsRecord("any")');
+exists(faker.call("dnsRecord","any"), 'call("dnsRecord","any")');
 exists(faker.zen.dog(), 'zen.dog()');
 exists(faker.call("dog"), 'call("dog")');
+exists(faker.zen.doi(), 'zen.doi()');
+exists(faker.call("doi"), 'call("doi")');
 exists(faker.zen.domainName(), 'zen.domainName()');
 exists(faker.call("domainName"), 'call("domainName")');
 exists(faker.zen.domainSuffix(), 'zen.domainSuffix()');
 exists(faker.call("domainSuffix"), 'call("domainSuffix")');
 exists(faker.zen.drink(), 'zen.drink()');
 exists(faker.call("drink"), 'call("drink")');
+exists(faker.zen.duration("1s","1h","s"), 'zen.duration("1s","1h","s")');
+exists(faker.call("duration","1s","1h","s"), 'call("duration","1s","1h","s")');
+exists(faker.zen.ean13(), 'zen.ean13()');
+exists(faker.call("ean13"), 'call("ean13")');
+exists(faker.zen.ein(), 'zen.ein()');
+exists(faker.call("ein"), 'call("ein")');
 exists(faker.zen.email(), 'zen.email()');
 exists(faker.call("email"), 'call("email")');
+exists(faker.zen.emailPlusTag("qa@example.com"), 'zen.emailPlusTag("qa@example.com")');
+exists(faker.call("emailPlusTag","qa@example.com"), 'call("emailPlusTag","qa@example.com")');
+exists(faker.zen.emailWithDomain("example.com"), 'zen.emailWithDomain("example.com")');
+exists(faker.call("emailWithDomain","example.com"), 'call("emailWithDomain","example.com")');
 exists(faker.zen.emoji(), 'zen.emoji()');
 exists(faker.call("emoji"), 'call("emoji")');
 exists(faker.zen.emojiAlias(), 'zen.emojiAlias()');
@@ -505,32 +694,46 @@ exists(faker.zen.errorObjectWord(), 'zen.errorObjectWord()');
 exists(faker.call("errorObjectWord"), 'call("errorObjectWord")');
 exists(faker.zen.farmAnimal(), 'zen.farmAnimal()');
 exists(faker.call("farmAnimal"), 'call("farmAnimal")');
+exists(faker.zen.fhirPatient(), 'zen.fhirPatient()');
+exists(faker.call("fhirPatient"), 'call("fhirPatient")');
 exists(faker.zen.fileExtension(), 'zen.fileExtension()');
 exists(faker.call("fileExtension"), 'call("fileExtension")');
 exists(faker.zen.fileMimeType(), 'zen.fileMimeType()');
 exists(faker.call("fileMimeType"), 'call("fileMimeType")');
 exists(faker.zen.firefoxUserAgent(), 'zen.firefoxUserAgent()');
 exists(faker.call("firefoxUserAgent"), 'call("firefoxUserAgent")');
-exists(faker.zen.firstName(), 'zen.firstName()');
-exists(faker.call("firstName"), 'call("firstName")');
+exists(faker.zen.firstName("any","any"), 'zen.firstName("any","any")');
+exists(faker.call("firstName","any","any"), 'call("firstName","any","any")');
+exists(faker.zen.flightNumber(), 'zen.flightNumber()');
+exists(faker.call("flightNumber"), 'call("flightNumber")');
 exists(faker.zen.float32(), 'zen.float32()');
 exists(faker.call("float32"), 'call("float32")');
 exists(faker.zen.float32Range(3,5), 'zen.float32Range(3,5)');
 exists(faker.call("float32Range",3,5), 'call("float32Range",3,5)');
 exists(faker.zen.float64(), 'zen.float64()');
 exists(faker.call("float64"), 'call("float64")');
-exists(faker.zen.float64Range(3,5), 'zen.float64Range(3,5)');
-exists(faker.call("float64Range",3,5), 'call("float64Range",3,5)');
+exists(faker.zen.float64Range(3,5,-1), 'zen.float64Range(3,5,-1)');
+exists(faker.call("float64Range",3,5,-1), 'call("float64Range",3,5,-1)');
 exists(faker.zen.fruit(), 'zen.fruit()');
 exists(faker.call("fruit"), 'call("fruit")');
+exists(faker.zen.fullName("{firstName} {lastName}","any","any"), 'zen.fullName("{firstName} {lastName}","any","any")');
+exists(faker.call("fullName","{firstName} {lastName}","any","any"), 'call("fullName","{firstName} {lastName}","any","any")');
 exists(faker.zen.futureTime(), 'zen.futureTime()');
 exists(faker.call("futureTime"), 'call("futureTime")');
 exists(faker.zen.gRPCError(), 'zen.gRPCError()');
 exists(faker.call("gRPCError"), 'call("gRPCError")');
 exists(faker.zen.gamertag(), 'zen.gamertag()');
 exists(faker.call("gamertag"), 'call("gamertag")');
+exists(faker.zen.gcpResourceName("any"), 'zen.gcpResourceName("any")');
+exists(faker.call("gcpResourceName","any"), 'call("gcpResourceName","any")');
 exists(faker.zen.gender(), 'zen.gender()');
 exists(faker.call("gender"), 'call("gender")');
+exists(faker.zen.genderPronouns("any"), 'zen.genderPronouns("any")');
+exists(faker.call("genderPronouns","any"), 'call("genderPronouns","any")');
+exists(faker.zen.gpsTrace([],[],20,50,5), 'zen.gpsTrace([],[],20,50,5)');
+exists(faker.call("gpsTrace",[],[],20,50,5), 'call("gpsTrace",[],[],20,50,5)');
+exists(faker.zen.gs1128(["00"]), 'zen.gs1128(["00"])');
+exists(faker.call("gs1128",["00"]), 'call("gs1128",["00"])');
 exists(faker.zen.hackerAbbreviation(), 'zen.hackerAbbreviation()');
 exists(faker.call("hackerAbbreviation"), 'call("hackerAbbreviation")');
 exists(faker.zen.hackerAdjective(), 'zen.hackerAdjective()');
@@ -567,6 +770,8 @@ exists(faker.zen.hipsterWord(), 'zen.hipsterWord()');
 exists(faker.call("hipsterWord"), 'call("hipsterWord")');
 exists(faker.zen.hobby(), 'zen.hobby()');
 exists(faker.call("hobby"), 'call("hobby")');
+exists(faker.zen.honorific("any","any"), 'zen.honorific("any","any")');
+exists(faker.call("honorific","any","any"), 'call("honorific","any","any")');
 exists(faker.zen.hour(), 'zen.hour()');
 exists(faker.call("hour"), 'call("hour")');
 exists(faker.zen.httpClientError(), 'zen.httpClientError()');
@@ -583,34 +788,56 @@ exists(faker.zen.httpStatusCodeSimple(), 'zen.httpStatusCodeSimple()');
 exists(faker.call("httpStatusCodeSimple"), 'call("httpStatusCodeSimple")');
 exists(faker.zen.httpVersion(), 'zen.httpVersion()');
 exists(faker.call("httpVersion"), 'call("httpVersion")');
+exists(faker.zen.iataAirport(), 'zen.iataAirport()');
+exists(faker.call("iataAirport"), 'call("iataAirport")');
+exists(faker.zen.icd10Code(), 'zen.icd10Code()');
+exists(faker.call("icd10Code"), 'call("icd10Code")');
+exists(faker.zen.identifier("none",12,"alphanumeric"), 'zen.identifier("none",12,"alphanumeric")');
+exists(faker.call("identifier","none",12,"alphanumeric"), 'call("identifier","none",12,"alphanumeric")');
 exists(faker.zen.imageUrl(500,500), 'zen.imageUrl(500,500)');
 exists(faker.call("imageUrl",500,500), 'call("imageUrl",500,500)');
 exists(faker.zen.indefiniteAdjective(), 'zen.indefiniteAdjective()');
 exists(faker.call("indefiniteAdjective"), 'call("indefiniteAdjective")');
+exists(faker.zen.injectionStrings("all"), 'zen.injectionStrings("all")');
+exists(faker.call("injectionStrings","all"), 'call("injectionStrings","all")');
 exists(faker.zen.inputName(), 'zen.inputName()');
 exists(faker.call("inputName"), 'call("inputName")');
 exists(faker.zen.int16(), 'zen.int16()');
 exists(faker.call("int16"), 'call("int16")');
 exists(faker.zen.int32(), 'zen.int32()');
 exists(faker.call("int32"), 'call("int32")');
+exists(faker.zen.int53(), 'zen.int53()');
+exists(faker.call("int53"), 'call("int53")');
 exists(faker.zen.int64(), 'zen.int64()');
 exists(faker.call("int64"), 'call("int64")');
 exists(faker.zen.int8(), 'zen.int8()');
 exists(faker.call("int8"), 'call("int8")');
 exists(faker.zen.intRange(3,5), 'zen.intRange(3,5)');
 exists(faker.call("intRange",3,5), 'call("intRange",3,5)');
+exists(faker.zen.interarrival("poisson",1), 'zen.interarrival("poisson",1)');
+exists(faker.call("interarrival","poisson",1), 'call("interarrival","poisson",1)');
 exists(faker.zen.interjection(), 'zen.interjection()');
 exists(faker.call("interjection"), 'call("interjection")');
 exists(faker.zen.interrogativeAdjective(), 'zen.interrogativeAdjective()');
 exists(faker.call("interrogativeAdjective"), 'call("interrogativeAdjective")');
 exists(faker.zen.intransitiveVerb(), 'zen.intransitiveVerb()');
 exists(faker.call("intransitiveVerb"), 'call("intransitiveVerb")');
+exists(faker.zen.inventory(36), 'zen.inventory(36)');
+exists(faker.call("inventory",36), 'call("inventory",36)');
+exists(faker.zen.ipInCidr("10.0.0.0/8"), 'zen.ipInCidr("10.0.0.0/8")');
+exists(faker.call("ipInCidr","10.0.0.0/8"), 'call("ipInCidr","10.0.0.0/8")');
 exists(faker.zen.ipv4Address(), 'zen.ipv4Address()');
 exists(faker.call("ipv4Address"), 'call("ipv4Address")');
 exists(faker.zen.ipv6Address(), 'zen.ipv6Address()');
 exists(faker.call("ipv6Address"), 'call("ipv6Address")');
+exists(faker.zen.isbn10(), 'zen.isbn10()');
+exists(faker.call("isbn10"), 'call("isbn10")');
+exists(faker.zen.isbn13(), 'zen.isbn13()');
+exists(faker.call("isbn13"), 'call("isbn13")');
 exists(faker.zen.isin(), 'zen.isin()');
 exists(faker.call("isin"), 'call("isin")');
+exists(faker.zen.issn(), 'zen.issn()');
+exists(faker.call("issn"), 'call("issn")');
 exists(faker.zen.job(), 'zen.job()');
 exists(faker.call("job"), 'call("job")');
 exists(faker.zen.jobDescriptor(), 'zen.jobDescriptor()');
@@ -619,6 +846,14 @@ exists(faker.zen.jobLevel(), 'zen.jobLevel()');
 exists(faker.call("jobLevel"), 'call("jobLevel")');
 exists(faker.zen.jobTitle(), 'zen.jobTitle()');
 exists(faker.call("jobTitle"), 'call("jobTitle")');
+exists(faker.zen.kafkaRecord("uuid","any"), 'zen.kafkaRecord("uuid","any")');
+exists(faker.call("kafkaRecord","uuid","any"), 'call("kafkaRecord","uuid","any")');
+exists(faker.zen.kebabCase(3), 'zen.kebabCase(3)');
+exists(faker.call("kebabCase",3), 'call("kebabCase",3)');
+exists(faker.zen.ksuid(), 'zen.ksuid()');
+exists(faker.call("ksuid"), 'call("ksuid")');
+exists(faker.zen.labelSet(3), 'zen.labelSet(3)');
+exists(faker.call("labelSet",3), 'call("labelSet",3)');
 exists(faker.zen.language(), 'zen.language()');
 exists(faker.call("language"), 'call("language")');
 exists(faker.zen.languageAbbreviation(), 'zen.languageAbbreviation()');
@@ -631,14 +866,20 @@ exists(faker.zen.latitude(), 'zen.latitude()');
 exists(faker.call("latitude"), 'call("latitude")');
 exists(faker.zen.latitudeRange(0,90), 'zen.latitudeRange(0,90)');
 exists(faker.call("latitudeRange",0,90), 'call("latitudeRange",0,90)');
+exists(faker.zen.leaderboardEntry(), 'zen.leaderboardEntry()');
+exists(faker.call("leaderboardEntry"), 'call("leaderboardEntry")');
 exists(faker.zen.letter(), 'zen.letter()');
 exists(faker.call("letter"), 'call("letter")');
 exists(faker.zen.letterN(3), 'zen.letterN(3)');
 exists(faker.call("letterN",3), 'call("letterN",3)');
 exists(faker.zen.lexify("none"), 'zen.lexify("none")');
 exists(faker.call("lexify","none"), 'call("lexify","none")');
+exists(faker.zen.licensePlate("US"), 'zen.licensePlate("US")');
+exists(faker.call("licensePlate","US"), 'call("licensePlate","US")');
 exists(faker.zen.linkingVerb(), 'zen.linkingVerb()');
 exists(faker.call("linkingVerb"), 'call("linkingVerb")');
+exists(faker.zen.lobbyName(), 'zen.lobbyName()');
+exists(faker.call("lobbyName"), 'call("lobbyName")');
 exists(faker.zen.logLevel(), 'zen.logLevel()');
 exists(faker.call("logLevel"), 'call("logLevel")');
 exists(faker.zen.longitude(), 'zen.longitude()');
@@ -655,6 +896,12 @@ exists(faker.zen.lunch(), 'zen.lunch()');
 exists(faker.call("lunch"), 'call("lunch")');
 exists(faker.zen.macAddress(), 'zen.macAddress()');
 exists(faker.call("macAddress"), 'call("macAddress")');
+exists(faker.zen.macAddressVendor("any"), 'zen.macAddressVendor("any")');
+exists(faker.call("macAddressVendor","any"), 'call("macAddressVendor","any")');
+exists(faker.zen.matchResult(10), 'zen.matchResult(10)');
+exists(faker.call("matchResult",10), 'call("matchResult",10)');
+exists(faker.zen.md5("none"), 'zen.md5("none")');
+exists(faker.call("md5","none"), 'call("md5","none")');
 exists(faker.zen.middleName(), 'zen.middleName()');
 exists(faker.call("middleName"), 'call("middleName")');
 exists(faker.zen.minecraftAnimal(), 'zen.minecraftAnimal()');
@@ -705,14 +952,22 @@ exists(faker.zen.movieGenre(), 'zen.movieGenre()');
 exists(faker.call("movieGenre"), 'call("movieGenre")');
 exists(faker.zen.movieName(), 'zen.movieName()');
 exists(faker.call("movieName"), 'call("movieName")');
+exists(faker.zen.mqttTopic(5,"ourselves"), 'zen.mqttTopic(5,"ourselves")');
+exists(faker.call("mqttTopic",5,"ourselves"), 'call("mqttTopic",5,"ourselves")');
 exists(faker.zen.name(), 'zen.name()');
 exists(faker.call("name"), 'call("name")');
 exists(faker.zen.namePrefix(), 'zen.namePrefix()');
 exists(faker.call("namePrefix"), 'call("namePrefix")');
 exists(faker.zen.nameSuffix(), 'zen.nameSuffix()');
 exists(faker.call("nameSuffix"), 'call("nameSuffix")');
+exists(faker.zen.namespace(), 'zen.namespace()');
+exists(faker.call("namespace"), 'call("namespace")');
+exists(faker.zen.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"), 'zen.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")');
+exists(faker.call("nanoid",21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"), 'call("nanoid",21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")');
 exists(faker.zen.nanosecond(), 'zen.nanosecond()');
 exists(faker.call("nanosecond"), 'call("nanosecond")');
+exists(faker.zen.nationalId("US",true,true), 'zen.nationalId("US",true,true)');
+exists(faker.call("nationalId","US",true,true), 'call("nationalId","US",true,true)');
 exists(faker.zen.niceColors(), 'zen.niceColors()');
 exists(faker.call("niceColors"), 'call("niceColors")');
 exists(faker.zen.noun(), 'zen.noun()');
@@ -739,18 +994,38 @@ exists(faker.zen.nounProper(), 'zen.nounProper()');
 exists(faker.call("nounProper"), 'call("nounProper")');
 exists(faker.zen.nounUncountable(), 'zen.nounUncountable()');
 exists(faker.call("nounUncountable"), 'call("nounUncountable")');
+exists(faker.zen.npi(), 'zen.npi()');
+exists(faker.call("npi"), 'call("npi")');
 exists(faker.zen.number(-2147483648,2147483647), 'zen.number(-2147483648,2147483647)');
 exists(faker.call("number",-2147483648,2147483647), 'call("number",-2147483648,2147483647)');
 exists(faker.zen.numerify("none"), 'zen.numerify("none")');
 exists(faker.call("numerify","none"), 'call("numerify","none")');
+exists(faker.zen.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600), 'zen.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600)');
+exists(faker.call("oauthTokenResponse",["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600), 'call("oauthTokenResponse",["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600)');
+exists(faker.zen.ohlcCandle(100,0.01), 'zen.ohlcCandle(100,0.01)');
+exists(faker.call("ohlcCandle",100,0.01), 'call("ohlcCandle",100,0.01)');
 exists(faker.zen.operaUserAgent(), 'zen.operaUserAgent()');
 exists(faker.call("operaUserAgent"), 'call("operaUserAgent")');
-exists(faker.zen.paragraph(2,2,5,"\u003cbr /\u003e"), 'zen.paragraph(2,2,5,"\u003cbr /\u003e")');
-exists(faker.call("paragraph",2,2,5,"\u003cbr /\u003e"), 'call("paragraph",2,2,5,"\u003cbr /\u003e")');
+exists(faker.zen.order(), 'zen.order()');
+exists(faker.call("order"), 'call("order")');
+exists(faker.zen.orderBookSnapshot(5), 'zen.orderBookSnapshot(5)');
+exists(faker.call("orderBookSnapshot",5), 'call("orderBookSnapshot",5)');
+exists(faker.zen.organization(), 'zen.organization()');
+exists(faker.call("organization"), 'call("organization")');
+exists(faker.zen.paragraph(2,2,5,"\u003cbr /\u003e",0), 'zen.paragraph(2,2,5,"\u003cbr /\u003e",0)');
+exists(faker.call("paragraph",2,2,5,"\u003cbr /\u003e",0), 'call("paragraph",2,2,5,"\u003cbr /\u003e",0)');
+exists(faker.zen.passportNumber("US"), 'zen.passportNumber("US")');
+exists(faker.call("passportNumber","US"), 'call("passportNumber","US")');
 exists(faker.zen.password(true,false,true,true,false,12), 'zen.password(true,false,true,true,false,12)');
 exists(faker.call("password",true,false,true,true,false,12), 'call("password",true,false,true,true,false,12)');
+exists(faker.zen.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true), 'zen.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true)');
+exists(faker.call("passwordPolicy",12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true), 'call("passwordPolicy",12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true)');
 exists(faker.zen.pastTime(), 'zen.pastTime()');
 exists(faker.call("pastTime"), 'call("pastTime")');
+exists(faker.zen.pathSegment(0.1), 'zen.pathSegment(0.1)');
+exists(faker.call("pathSegment",0.1), 'call("pathSegment",0.1)');
+exists(faker.zen.patient(), 'zen.patient()');
+exists(faker.call("patient"), 'call("patient")');
 exists(faker.zen.person(), 'zen.person()');
 exists(faker.call("person"), 'call("person")');
 exists(faker.zen.petName(), 'zen.petName()');
@@ -761,6 +1036,16 @@ exists(faker.zen.phoneFormatted(), 'zen.phoneFormatted()');
 exists(faker.call("phoneFormatted"), 'call("phoneFormatted")');
 exists(faker.zen.phrase(), 'zen.phrase()');
 exists(faker.call("phrase"), 'call("phrase")');
+exists(faker.zen.playerTelemetryBatch(10), 'zen.playerTelemetryBatch(10)');
+exists(faker.call("playerTelemetryBatch",10), 'call("playerTelemetryBatch",10)');
+exists(faker.zen.pnr(), 'zen.pnr()');
+exists(faker.call("pnr"), 'call("pnr")');
+exists(faker.zen.podManifest(1), 'zen.podManifest(1)');
+exists(faker.call("podManifest",1), 'call("podManifest",1)');
+exists(faker.zen.portEphemeral(), 'zen.portEphemeral()');
+exists(faker.call("portEphemeral"), 'call("portEphemeral")');
+exists(faker.zen.portWellKnown(), 'zen.portWellKnown()');
+exists(faker.call("portWellKnown"), 'call("portWellKnown")');
 exists(faker.zen.possessiveAdjective(), 'zen.possessiveAdjective()');
 exists(faker.call("possessiveAdjective"), 'call("possessiveAdjective")');
 exists(faker.zen.preposition(), 'zen.preposition()');
@@ -811,8 +1096,12 @@ exists(faker.zen.pronounRelative(), 'zen.pronounRelative()');
 exists(faker.call("pronounRelative"), 'call("pronounRelative")');
 exists(faker.zen.properAdjective(), 'zen.properAdjective()');
 exists(faker.call("properAdjective"), 'call("properAdjective")');
+exists(faker.zen.qrContent("any"), 'zen.qrContent("any")');
+exists(faker.call("qrContent","any"), 'call("qrContent","any")');
 exists(faker.zen.quantitativeAdjective(), 'zen.quantitativeAdjective()');
 exists(faker.call("quantitativeAdjective"), 'call("quantitativeAdjective")');
+exists(faker.zen.queryString(0.1), 'zen.queryString(0.1)');
+exists(faker.call("queryString",0.1), 'call("queryString",0.1)');
 exists(faker.zen.question(), 'zen.question()');
 exists(faker.call("question"), 'call("question")');
 exists(faker.zen.quote(), 'zen.quote()');
@@ -823,6 +1112,12 @@ exists(faker.zen.randomString(["none","how","these","keep","trip","congolese","c
 exists(faker.call("randomString",["none","how","these","keep","trip","congolese","choir","computer","still","far"]), 'call("randomString",["none","how","these","keep","trip","congolese","choir","computer","still","far"])');
 exists(faker.zen.randomUint([14,8,13]), 'zen.randomUint([14,8,13])');
 exists(faker.call("randomUint",[14,8,13]), 'call("randomUint",[14,8,13])');
+exists(faker.zen.recentTimestamp("24h"), 'zen.recentTimestamp("24h")');
+exists(faker.call("recentTimestamp","24h"), 'call("recentTimestamp","24h")');
+exists(faker.zen.resourceName(), 'zen.resourceName()');
+exists(faker.call("resourceName"), 'call("resourceName")');
+exists(faker.zen.review("any",0,"en"), 'zen.review("any",0,"en")');
+exists(faker.call("review","any",0,"en"), 'call("review","any",0,"en")');
 exists(faker.zen.rgbColor(), 'zen.rgbColor()');
 exists(faker.call("rgbColor"), 'call("rgbColor")');
 exists(faker.zen.runtimeError(), 'zen.runtimeError()');
@@ -835,18 +1130,38 @@ exists(faker.zen.school(), 'zen.school()');
 exists(faker.call("school"), 'call("school")');
 exists(faker.zen.second(), 'zen.second()');
 exists(faker.call("second"), 'call("second")');
-exists(faker.zen.sentence(5), 'zen.sentence(5)');
-exists(faker.call("sentence",5), 'call("sentence",5)');
+exists(faker.zen.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa"), 'zen.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa")');
+exists(faker.call("selfSignedCert","any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa"), 'call("selfSignedCert","any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa")');
+exists(faker.zen.sentence(5,0), 'zen.sentence(5,0)');
+exists(faker.call("sentence",5,0), 'call("sentence",5,0)');
+exists(faker.zen.serverStatusJson(), 'zen.serverStatusJson()');
+exists(faker.call("serverStatusJson"), 'call("serverStatusJson")');
+exists(faker.zen.sha1("none"), 'zen.sha1("none")');
+exists(faker.call("sha1","none"), 'call("sha1","none")');
+exists(faker.zen.sha256("none"), 'zen.sha256("none")');
+exists(faker.call("sha256","none"), 'call("sha256","none")');
+exists(faker.zen.shipment("any"), 'zen.shipment("any")');
+exists(faker.call("shipment","any"), 'call("shipment","any")');
 exists(faker.zen.shuffleInts([14,8,13]), 'zen.shuffleInts([14,8,13])');
 exists(faker.call("shuffleInts",[14,8,13]), 'call("shuffleInts",[14,8,13])');
 exists(faker.zen.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"]), 'zen.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"])');
 exists(faker.call("shuffleStrings",["none","how","these","keep","trip","congolese","choir","computer","still","far"]), 'call("shuffleStrings",["none","how","these","keep","trip","congolese","choir","computer","still","far"])');
 exists(faker.zen.simpleSentence(), 'zen.simpleSentence()');
 exists(faker.call("simpleSentence"), 'call("simpleSentence")');
+exists(faker.zen.sku("???-#####"), 'zen.sku("???-#####")');
+exists(faker.call("sku","???-#####"), 'call("sku","???-#####")');
 exists(faker.zen.slogan(), 'zen.slogan()');
 exists(faker.call("slogan"), 'call("slogan")');
+exists(faker.zen.slug(3), 'zen.slug(3)');
+exists(faker.call("slug",3), 'call("slug",3)');
 exists(faker.zen.snack(), 'zen.snack()');
 exists(faker.call("snack"), 'call("snack")');
+exists(faker.zen.snakeCase(3), 'zen.snakeCase(3)');
+exists(faker.call("snakeCase",3), 'call("snakeCase",3)');
+exists(faker.zen.soonTimestamp("24h"), 'zen.soonTimestamp("24h")');
+exists(faker.call("soonTimestamp","24h"), 'call("soonTimestamp","24h")');
+exists(faker.zen.sshKeyPair("ed25519"), 'zen.sshKeyPair("ed25519")');
+exists(faker.call("sshKeyPair","ed25519"), 'call("sshKeyPair","ed25519")');
 exists(faker.zen.ssn(), 'zen.ssn()');
 exists(faker.call("ssn"), 'call("ssn")');
 exists(faker.zen.state(), 'zen.state()');
@@ -865,6 +1180,14 @@ exists(faker.zen.streetSuffix(), 'zen.streetSuffix()');
 exists(faker.call("streetSuffix"), 'call("streetSuffix")');
 exists(faker.zen.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"]), 'zen.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"])');
 exists(faker.call("teams",["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"]), 'call("teams",["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"])');
+exists(faker.zen.telemetryBatch(10,"temperature","meanReverting"), 'zen.telemetryBatch(10,"temperature","meanReverting")');
+exists(faker.call("telemetryBatch",10,"temperature","meanReverting"), 'call("telemetryBatch",10,"temperature","meanReverting")');
+exists(faker.zen.textOfLength(100), 'zen.textOfLength(100)');
+exists(faker.call("textOfLength",100), 'call("textOfLength",100)');
+exists(faker.zen.tickerQuote("any"), 'zen.tickerQuote("any")');
+exists(faker.call("tickerQuote","any"), 'call("tickerQuote","any")');
+exists(faker.zen.timeRange("now","30d","1h","7d"), 'zen.timeRange("now","30d","1h","7d")');
+exists(faker.call("timeRange","now","30d","1h","7d"), 'call("timeRange","now","30d","1h","7d")');
 exists(faker.zen.timezone(), 'zen.timezone()');
 exists(faker.call("timezone"), 'call("timezone")');
 exists(faker.zen.timezoneAbbreviation(), 'zen.timezoneAbbreviation()');
@@ -875,6 +1198,8 @@ exists(faker.zen.timezoneOffset(), 'zen.timezoneOffset()');
 exists(faker.call("timezoneOffset"), 'call("timezoneOffset")');
 exists(faker.zen.timezoneRegion(), 'zen.timezoneRegion()');
 exists(faker.call("timezoneRegion"), 'call("timezoneRegion")');
+exists(faker.zen.trackingNumber("any"), 'zen.trackingNumber("any")');
+exists(faker.call("trackingNumber","any"), 'call("trackingNumber","any")');
 exists(faker.zen.transitiveVerb(), 'zen.transitiveVerb()');
 exists(faker.call("transitiveVerb"), 'call("transitiveVerb")');
 exists(faker.zen.uint16(), 'zen.uint16()');
@@ -887,27 +1212,47 @@ exists(faker.zen.uint8(), 'zen.uint8()');
 exists(faker.call("uint8"), 'call("uint8")');
 exists(faker.zen.uintRange(0,4294967295), 'zen.uintRange(0,4294967295)');
 exists(faker.call("uintRange",0,4294967295), 'call("uintRange",0,4294967295)');
+exists(faker.zen.ulid(), 'zen.ulid()');
+exists(faker.call("ulid"), 'call("ulid")');
+exists(faker.zen.unicode("mixed",10), 'zen.unicode("mixed",10)');
+exists(faker.call("unicode","mixed",10), 'call("unicode","mixed",10)');
+exists(faker.zen.upcA(), 'zen.upcA()');
+exists(faker.call("upcA"), 'call("upcA")');
 exists(faker.zen.url(), 'zen.url()');
 exists(faker.call("url"), 'call("url")');
+exists(faker.zen.urlWithin("https://test.example.com",3,"none",2), 'zen.urlWithin("https://test.example.com",3,"none",2)');
+exists(faker.call("urlWithin","https://test.example.com",3,"none",2), 'call("urlWithin","https://test.example.com",3,"none",2)');
 exists(faker.zen.userAgent(), 'zen.userAgent()');
 exists(faker.call("userAgent"), 'call("userAgent")');
 exists(faker.zen.username(), 'zen.username()');
 exists(faker.call("username"), 'call("username")');
+exists(faker.zen.usernamePolicy("a-z0-9._",3,16), 'zen.usernamePolicy("a-z0-9._",3,16)');
+exists(faker.call("usernamePolicy","a-z0-9._",3,16), 'call("usernamePolicy","a-z0-9._",3,16)');
 exists(faker.zen.uuid(), 'zen.uuid()');
 exists(faker.call("uuid"), 'call("uuid")');
+exists(faker.zen.uuidV7(), 'zen.uuidV7()');
+exists(faker.call("uuidV7"), 'call("uuidV7")');
 exists(faker.zen.validationError(), 'zen.validationError()');
 exists(faker.call("validationError"), 'call("validationError")');
+exists(faker.zen.vatNumber("any"), 'zen.vatNumber("any")');
+exists(faker.call("vatNumber","any"), 'call("vatNumber","any")');
 exists(faker.zen.vegetable(), 'zen.vegetable()');
 exists(faker.call("vegetable"), 'call("vegetable")');
 exists(faker.zen.verb(), 'zen.verb()');
 exists(faker.call("verb"), 'call("verb")');
 exists(faker.zen.verbPhrase(), 'zen.verbPhrase()');
 exists(faker.call("verbPhrase"), 'call("verbPhrase")');
+exists(faker.zen.vin(), 'zen.vin()');
+exists(faker.call("vin"), 'call("vin")');
 exists(faker.zen.weekday(), 'zen.weekday()');
 exists(faker.call("weekday"), 'call("weekday")');
+exists(faker.zen.withinBusinessHours("UTC"), 'zen.withinBusinessHours("UTC")');
+exists(faker.call("withinBusinessHours","UTC"), 'call("withinBusinessHours","UTC")');
 exists(faker.zen.word(), 'zen.word()');
 exists(faker.call("word"), 'call("word")');
 exists(faker.zen.year(), 'zen.year()');
 exists(faker.call("year"), 'call("year")');
 exists(faker.zen.zip(), 'zen.zip()');
 exists(faker.call("zip"), 'call("zip")');
+exists(faker.zen.zoneFile(10), 'zen.zoneFile(10)');
+exists(faker.call("zoneFile",10), 'call("zoneFile",10)');
@@ -23,7 +23,7 @@ func Test_functions_json(t *testing.T) {
 	var functions map[string]*gofakeit.Info
 
 	require.NoError(t, json.Unmarshal(functionsJSON, &functions))
-	require.Len(t, functions, len(faker.GetFuncLookups()))
+	require.Len(t, functions, len(faker.GetFuncLookups())+len(faker.GetDeprecations()))
 
 	runtime := modulestest.NewRuntime(t)
 	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
@@ -40,7 +40,9 @@ func Test_functions_json(t *testing.T) {
 	lookups := faker.GetFuncLookups()
 
 	for name, info := range functions {
-		require.Contains(t, lookups, name)
+		if _, deprecated := faker.GetDeprecations()[name]; !deprecated {
+			require.Contains(t, lookups, name)
+		}
 
 		val, err := runtime.RunOnEventLoop("typeof faker.zen." + name)
 		require.NoError(t, err)
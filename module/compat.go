@@ -0,0 +1,30 @@
+package module
+
+import (
+	"github.com/grafana/xk6-faker/faker"
+	"go.k6.io/k6/v2/js/modules"
+)
+
+// CompatImportPath contains the JavaScript import path of the faker-js compatible module.
+const CompatImportPath = ImportPath + "/compat"
+
+// compatModule is k6 JavaScript module exposing the faker-js API.
+type compatModule struct{}
+
+// NewCompat creates new faker-js compatible root module.
+func NewCompat() modules.Module {
+	return &compatModule{}
+}
+
+// NewModuleInstance creates new module instance.
+// The faker object is exported both as default and as named export (import { faker } from ...).
+func (root *compatModule) NewModuleInstance(vu modules.VU) modules.Instance {
+	obj := faker.NewCompatForVU(getseed(vu), vu.Runtime(), vu)
+
+	return &module{exports: modules.Exports{
+		Named:   map[string]interface{}{"faker": obj},
+		Default: obj,
+	}}
+}
+
+var _ modules.Module = (*compatModule)(nil)
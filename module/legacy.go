@@ -0,0 +1,30 @@
+package module
+
+import (
+	"github.com/grafana/xk6-faker/faker"
+	"go.k6.io/k6/v2/js/modules"
+)
+
+// LegacyImportPath contains the JavaScript import path of the module exposing the legacy flat API.
+const LegacyImportPath = ImportPath + "/legacy"
+
+// legacyModule is k6 JavaScript module exposing the legacy flat API (e.g. faker.Uuid()).
+type legacyModule struct{}
+
+// NewLegacy creates new legacy root module.
+func NewLegacy() modules.Module {
+	return &legacyModule{}
+}
+
+// NewModuleInstance creates new module instance.
+// The faker object is exported both as default and as named export (import { faker } from ...).
+func (root *legacyModule) NewModuleInstance(vu modules.VU) modules.Instance {
+	obj := faker.NewLegacyForVU(getseed(vu), vu.Runtime(), vu)
+
+	return &module{exports: modules.Exports{
+		Named:   map[string]interface{}{"faker": obj},
+		Default: obj,
+	}}
+}
+
+var _ modules.Module = (*legacyModule)(nil)
@@ -41,10 +41,10 @@ func getseed(vu modules.VU) int64 {
 func (root *rootModule) NewModuleInstance(vu modules.VU) modules.Instance {
 	mod := &module{exports: modules.Exports{
 		Named:   make(map[string]interface{}),
-		Default: faker.New(getseed(vu), vu.Runtime()),
+		Default: faker.NewForVU(getseed(vu), vu),
 	}}
 
-	mod.exports.Named["Faker"] = faker.Constructor
+	mod.exports.Named["Faker"] = faker.NewClass(vu.Runtime(), vu)
 
 	return mod
 }
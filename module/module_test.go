@@ -1,11 +1,18 @@
 package module_test
 
 import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/grafana/xk6-faker/module"
 	"github.com/stretchr/testify/require"
 	"go.k6.io/k6/v2/js/modulestest"
+	"go.k6.io/k6/v2/lib"
+	"go.k6.io/k6/v2/lib/fsext"
+	"go.k6.io/k6/v2/metrics"
 )
 
 func Test_Default_Faker(t *testing.T) {
@@ -49,3 +56,715 @@ func Test_New_Faker(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "Abshire5538", val.String())
 }
+
+func Test_Faker_tag(t *testing.T) {
+	t.Parallel()
+
+	runtime := modulestest.NewRuntime(t)
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker(11)
+	`)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`f.tag("persona", "username")`)
+
+	require.ErrorContains(t, err, "VU context")
+
+	state := &lib.State{Tags: lib.NewVUStateTags(metrics.NewRegistry().RootTagSet())}
+
+	runtime.MoveToVUContext(state)
+
+	val, err := runtime.RunOnEventLoop(`f.tag("persona", "username")`)
+
+	require.NoError(t, err)
+	require.Equal(t, "Abshire5538", val.String())
+
+	val, err = runtime.RunOnEventLoop(`f.correlate("order", "intRange", 1, 1)`)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1), val.ToInteger())
+
+	current := state.Tags.GetCurrentValues()
+
+	tag, ok := current.Tags.Get("persona")
+
+	require.True(t, ok)
+	require.Equal(t, "Abshire5538", tag)
+	require.Equal(t, "1", current.Metadata["order"])
+}
+
+func Test_Faker_seedScope(t *testing.T) {
+	t.Parallel()
+
+	runtime := modulestest.NewRuntime(t)
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker({ seed: 11, seedScope: "iteration" })
+	let g = new faker.Faker({ seed: 11, seedScope: "iteration" })
+	`)
+
+	require.NoError(t, err)
+
+	state := &lib.State{Tags: lib.NewVUStateTags(metrics.NewRegistry().RootTagSet()), VUIDGlobal: 1}
+
+	runtime.MoveToVUContext(state)
+
+	first, err := runtime.RunOnEventLoop(`[f.call("username"), f.call("username")].join()`)
+
+	require.NoError(t, err)
+
+	state.Iteration++
+
+	second, err := runtime.RunOnEventLoop(`[f.call("username"), f.call("username")].join()`)
+
+	require.NoError(t, err)
+	require.NotEqual(t, first.String(), second.String())
+
+	// same iteration of the same VU gives the same data, regardless of the previous calls
+	again, err := runtime.RunOnEventLoop(`[g.call("username"), g.call("username")].join()`)
+
+	require.NoError(t, err)
+	require.Equal(t, second.String(), again.String())
+
+	_, err = runtime.RunOnEventLoop(`new faker.Faker({ seedScope: "test" })`)
+
+	require.ErrorContains(t, err, "invalid seedScope: test")
+}
+
+func Test_Faker_metrics(t *testing.T) {
+	t.Parallel()
+
+	runtime := modulestest.NewRuntime(t)
+	registry := runtime.VU.InitEnvField.Registry
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker({ seed: 11, metrics: true })
+	let snapshot = f.snapshot()
+	`)
+
+	require.NoError(t, err)
+	require.NotNil(t, registry.Get("faker_calls"))
+	require.NotNil(t, registry.Get("faker_generation_time"))
+
+	samples := make(chan metrics.SampleContainer, 10)
+	state := &lib.State{Tags: lib.NewVUStateTags(registry.RootTagSet()), Samples: samples}
+
+	runtime.MoveToVUContext(state)
+
+	_, err = runtime.RunOnEventLoop(`
+	f.zen.username()
+	faker.Faker.restore(snapshot).many("username", 3)
+	new faker.Faker(11).zen.username()
+	`)
+
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+
+	var calls []float64
+
+	for range 2 {
+		for _, sample := range (<-samples).GetSamples() {
+			function, _ := sample.Tags.Get("function")
+
+			require.Equal(t, "username", function)
+
+			if sample.Metric.Name == "faker_calls" {
+				calls = append(calls, sample.Value)
+			} else {
+				require.Equal(t, "faker_generation_time", sample.Metric.Name)
+			}
+		}
+	}
+
+	require.Equal(t, []float64{1, 3}, calls)
+}
+
+func Test_Faker_markov_corpusPath(t *testing.T) {
+	t.Parallel()
+
+	fs := fsext.NewMemMapFs()
+
+	require.NoError(t, fsext.WriteFile(fs, "/data/corpus.txt", []byte("Red fish swim. Blue fish swim."), 0o600))
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/data/"}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker(11)
+	f.word.markov({ corpusPath: "corpus.txt", words: 3 })
+	`)
+
+	require.NoError(t, err)
+
+	runtime.MoveToVUContext(&lib.State{Tags: lib.NewVUStateTags(metrics.NewRegistry().RootTagSet())})
+
+	val, err := runtime.RunOnEventLoop(`f.word.markov({ corpusPath: "corpus.txt", words: 3 })`)
+
+	require.NoError(t, err)
+	require.Regexp(t, `^(Red|Blue) fish swim\.$`, val.String())
+
+	_, err = runtime.RunOnEventLoop(`f.word.markov({ corpusPath: "other.txt" })`)
+
+	require.ErrorContains(t, err, "can only be loaded in the init context")
+}
+
+func Test_Faker_fromFile(t *testing.T) {
+	t.Parallel()
+
+	fs := fsext.NewMemMapFs()
+
+	csvData := "drug,weight\nAspirin,1\nIbuprofen,0\nParacetamol,3\n"
+	jsonData := `[{"sku": "PN-1", "tags": ["a"]}, {"sku": "PN-2", "tags": ["b"]}]`
+
+	require.NoError(t, fsext.WriteFile(fs, "/samples/drugs.csv", []byte(csvData), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/samples/parts.json", []byte(jsonData), 0o600))
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/samples/"}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker(11)
+	let drugs = f.fromFile("drugs.csv", { column: "drug", weighted: "weight" })
+	let rows = f.fromFile("drugs.csv")
+	let parts = f.fromFile("parts.json")
+	`)
+
+	require.NoError(t, err)
+
+	runtime.MoveToVUContext(&lib.State{Tags: lib.NewVUStateTags(metrics.NewRegistry().RootTagSet())})
+
+	val, err := runtime.RunOnEventLoop(`
+	const counts = {}
+
+	for (let i = 0; i < 400; i++) {
+	  const drug = drugs.next()
+
+	  counts[drug] = (counts[drug] || 0) + 1
+	}
+
+	const part = parts.next()
+
+	part.tags.push("modified")
+
+	;[
+	  drugs.length, counts.Ibuprofen === undefined, counts.Paracetamol > counts.Aspirin,
+	  Object.keys(rows.next()).sort().join(","),
+	  parts.length, parts.next().tags.length,
+	  new faker.Faker(11).fromFile === undefined,
+	]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(3), true, true, "drug,weight", int64(2), int64(1), false}, val.Export())
+
+	_, err = runtime.RunOnEventLoop(`f.fromFile("drugs.csv")`)
+
+	require.ErrorContains(t, err, "the file can only be loaded in the init context")
+}
+
+func Test_Faker_record_replay(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "record.jsonl")
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.LookupEnv = func(key string) (string, bool) {
+		if key == "XK6_FAKER_RECORD" {
+			return path, true
+		}
+
+		return "", false
+	}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker(11)
+	`)
+
+	require.NoError(t, err)
+
+	runtime.MoveToVUContext(&lib.State{
+		Tags: lib.NewVUStateTags(metrics.NewRegistry().RootTagSet()), VUIDGlobal: 3, Iteration: 7,
+	})
+
+	recorded, err := runtime.RunOnEventLoop(`
+	;[f.person.firstName(), f.numbers.intRange({ min: 1, max: 100 }), f.person.firstName.many(2), f.company.job()]
+	`)
+
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+
+	require.Len(t, lines, 5)
+	require.Contains(t, lines[1], `"function":"intRange","args":[{"max":100,"min":1}]`)
+	require.Contains(t, lines[1], `"vu":3,"iteration":7`)
+
+	fs := fsext.NewMemMapFs()
+
+	require.NoError(t, fsext.WriteFile(fs, "/replay/record.jsonl", data, 0o600))
+
+	runtime = modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/replay/"}
+
+	err = runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	_, err = runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let f = new faker.Faker(12)
+
+	f.replayFrom("record.jsonl")
+	`)
+
+	require.NoError(t, err)
+
+	runtime.MoveToVUContext(&lib.State{Tags: lib.NewVUStateTags(metrics.NewRegistry().RootTagSet()), VUIDGlobal: 3})
+
+	replayed, err := runtime.RunOnEventLoop(`
+	;[f.person.firstName(), f.numbers.intRange({ min: 1, max: 100 }), f.person.firstName.many(2), f.company.job()]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, recorded.Export(), replayed.Export())
+
+	_, err = runtime.RunOnEventLoop(`f.numbers.intRange({ min: 1, max: 100 })`)
+
+	require.ErrorContains(t, err, "no more recorded values: intRange (VU 3)")
+}
+
+func Test_Faker_openapi(t *testing.T) {
+	t.Parallel()
+
+	spec := `
+{
+  "openapi": "3.0.3",
+  "info": {"title": "Users", "version": "1.0"},
+  "paths": {
+    "/users": {
+      "get": {"responses": {"200": {"description": "OK"}}},
+      "post": {
+        "requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/NewUser"}}}},
+        "responses": {"201": {"description": "Created"}}
+      }
+    },
+    "/users/{id}/tags": {
+      "put": {
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "array",
+                "minItems": 2,
+                "maxItems": 4,
+                "items": {"type": "string", "enum": ["admin", "beta", "staff"]}
+              }
+            }
+          }
+        },
+        "responses": {"204": {"description": "Updated"}}
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Base": {
+        "type": "object",
+        "required": ["id"],
+        "properties": {
+          "id": {"type": "string", "format": "uuid", "readOnly": true},
+          "createdAt": {"type": "string", "format": "date-time"}
+        }
+      },
+      "NewUser": {
+        "allOf": [
+          {"$ref": "#/components/schemas/Base"},
+          {
+            "type": "object",
+            "required": ["email", "firstName", "age", "plan", "code", "score", "nickname"],
+            "properties": {
+              "email": {"type": "string", "format": "email"},
+              "firstName": {"type": "string", "maxLength": 5},
+              "nickname": {"type": "string", "minLength": 20},
+              "age": {"type": "integer", "minimum": 18, "maximum": 30, "multipleOf": 3},
+              "score": {"type": "number", "minimum": 0, "exclusiveMaximum": true, "maximum": 1},
+              "plan": {"type": "string", "enum": ["free", "pro"]},
+              "code": {"type": "string", "pattern": "^[A-Z]{3}-[0-9]{4}$"},
+              "verified": {"type": "boolean"}
+            }
+          }
+        ]
+      }
+    }
+  }
+}
+`
+
+	orders := `
+openapi: 3.0.3
+info: { title: Orders, version: "1.0" }
+paths:
+  /orders:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [sku, quantity]
+              properties:
+                sku: { type: string, pattern: "^[A-Z]{3}-[0-9]{4}$" }
+                quantity: { type: integer, minimum: 2, maximum: 4 }
+      responses: { "201": { description: Created } }
+`
+
+	fs := fsext.NewMemMapFs()
+
+	require.NoError(t, fsext.WriteFile(fs, "/openapi/users.json", []byte(spec), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/openapi/swagger.json", []byte(`{"swagger": "2.0"}`), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/openapi/orders.yaml", []byte(orders), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/openapi/broken.yaml", []byte("openapi: [3.0.3\n"), 0o600))
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/openapi/"}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	val, err := runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let api = new faker.Faker(11).openapi("users.json")
+	let checks = {}
+
+	checks.operations = api.operations.join() === "GET /users,POST /users,PUT /users/{id}/tags"
+
+	for (let i = 0; i < 200; i++) {
+	  const user = api.requestBody("POST /users")
+	  const tags = api.requestBody("PUT /users/{id}/tags")
+
+	  checks.readOnly = (checks.readOnly ?? true) && user.id === undefined
+	  checks.email = (checks.email ?? true) && /^[^@]+@[^@]+$/.test(user.email)
+	  checks.maxLength = (checks.maxLength ?? true) && user.firstName.length <= 5
+	  checks.minLength = (checks.minLength ?? true) && user.nickname.length >= 20
+	  checks.age = (checks.age ?? true) && [18, 21, 24, 27, 30].includes(user.age)
+	  checks.score = (checks.score ?? true) && user.score >= 0 && user.score < 1
+	  checks.plan = (checks.plan ?? true) && ["free", "pro"].includes(user.plan)
+	  checks.code = (checks.code ?? true) && /^[A-Z]{3}-[0-9]{4}$/.test(user.code)
+	  checks.date = (checks.date ?? true) && (user.createdAt === undefined || !isNaN(Date.parse(user.createdAt)))
+	  checks.tags = (checks.tags ?? true) && tags.length >= 2 && tags.length <= 4
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("DELETE /users")`)
+
+	require.ErrorContains(t, err, "unknown operation DELETE /users")
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("GET /users")`)
+
+	require.ErrorContains(t, err, "no JSON request body of GET /users")
+
+	_, err = runtime.RunOnEventLoop(`new faker.Faker(11).openapi("swagger.json")`)
+
+	require.ErrorContains(t, err, "unsupported OpenAPI version")
+
+	val, err = runtime.RunOnEventLoop(`
+	let orders = new faker.Faker(11).openapi("orders.yaml")
+	let order = orders.requestBody("POST /orders")
+
+	;[orders.operations.join(), /^[A-Z]{3}-[0-9]{4}$/.test(order.sku), order.quantity >= 2 && order.quantity <= 4]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "POST /orders,true,true", val.String())
+
+	_, err = runtime.RunOnEventLoop(`new faker.Faker(11).openapi("broken.yaml")`)
+
+	require.ErrorContains(t, err, "invalid specification")
+}
+
+func Test_Faker_openapi_uniqueItems(t *testing.T) {
+	t.Parallel()
+
+	spec := `
+openapi: 3.0.3
+info: { title: Teams, version: "1.0" }
+paths:
+  /flags:
+    put:
+      requestBody:
+        content:
+          application/json:
+            schema: { type: array, uniqueItems: true, minItems: 2, items: { type: boolean } }
+      responses: { "204": { description: Updated } }
+  /members:
+    put:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: array
+              uniqueItems: true
+              minItems: 3
+              maxItems: 5
+              items:
+                type: object
+                required: [role, level]
+                properties:
+                  role: { type: string, enum: [owner, admin, member] }
+                  level: { type: integer, minimum: 1, maximum: 2 }
+      responses: { "204": { description: Updated } }
+  /switches:
+    put:
+      requestBody:
+        content:
+          application/json:
+            schema: { type: array, uniqueItems: true, minItems: 3, items: { type: boolean } }
+      responses: { "204": { description: Updated } }
+`
+
+	fs := fsext.NewMemMapFs()
+
+	require.NoError(t, fsext.WriteFile(fs, "/openapi/teams.yaml", []byte(spec), 0o600))
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/openapi/"}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	val, err := runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let api = new faker.Faker(11).openapi("teams.yaml")
+	let checks = {}
+
+	for (let i = 0; i < 100; i++) {
+	  const flags = api.requestBody("PUT /flags")
+	  const members = api.requestBody("PUT /members").map((member) => JSON.stringify(member))
+
+	  checks.flags = (checks.flags ?? true) && flags.length === 2 && flags[0] !== flags[1]
+	  checks.members = (checks.members ?? true) && members.length >= 3 && members.length <= 5
+	  checks.unique = (checks.unique ?? true) && new Set(members).size === members.length
+	}
+
+	Object.entries(checks).filter(([, ok]) => !ok).map(([name]) => name)
+	`)
+
+	require.NoError(t, err)
+	require.Empty(t, val.Export())
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("PUT /switches")`)
+
+	require.ErrorContains(t, err, "not enough distinct items: body requires 3 unique items, generated 2")
+}
+
+func Test_Faker_openapi_recursive(t *testing.T) {
+	t.Parallel()
+
+	spec := `
+openapi: 3.0.3
+info: { title: Trees, version: "1.0" }
+paths:
+  /trees:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: { $ref: "#/components/schemas/Tree" }
+      responses: { "201": { description: Created } }
+  /nodes:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: { $ref: "#/components/schemas/Node" }
+      responses: { "201": { description: Created } }
+  /choices:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: { $ref: "#/components/schemas/Choice" }
+      responses: { "201": { description: Created } }
+  /chains:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: { $ref: "#/components/schemas/Chain" }
+      responses: { "201": { description: Created } }
+  /lists:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: { $ref: "#/components/schemas/List" }
+      responses: { "201": { description: Created } }
+  /huge:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: { type: array, minItems: 1000000000, items: { type: integer } }
+      responses: { "201": { description: Created } }
+components:
+  schemas:
+    Tree: { type: array, items: { $ref: "#/components/schemas/Tree" } }
+    Node:
+      type: object
+      required: [children]
+      properties:
+        name: { type: string }
+        children: { type: array, items: { $ref: "#/components/schemas/Node" } }
+    Choice: { oneOf: [{ $ref: "#/components/schemas/Choice" }] }
+    Chain: { allOf: [{ $ref: "#/components/schemas/Chain" }] }
+    List: { type: array, minItems: 1, items: { $ref: "#/components/schemas/List" } }
+`
+
+	fs := fsext.NewMemMapFs()
+
+	require.NoError(t, fsext.WriteFile(fs, "/openapi/trees.yaml", []byte(spec), 0o600))
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/openapi/"}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	val, err := runtime.RunOnEventLoop(`
+	let faker = require("` + module.ImportPath + `")
+	let api = new faker.Faker(11).openapi("trees.yaml")
+	let depth = (val) => Array.isArray(val) ? 1 + Math.max(0, ...val.map(depth)) : 0
+	let nodeDepth = (node) => 1 + Math.max(0, ...(node.children ?? []).map(nodeDepth))
+
+	;[depth(api.requestBody("POST /trees")) <= 7, nodeDepth(api.requestBody("POST /nodes")) <= 7]
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, []any{true, true}, val.Export())
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("POST /choices")`)
+
+	require.ErrorContains(t, err, "schema nesting too deep: body exceeds depth 6")
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("POST /chains")`)
+
+	require.ErrorContains(t, err, "schema nesting too deep: allOf of body exceeds depth 6")
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("POST /lists")`)
+
+	require.ErrorContains(t, err, "schema nesting too deep: body requires items at depth 6")
+
+	_, err = runtime.RunOnEventLoop(`api.requestBody("POST /huge")`)
+
+	require.ErrorContains(t, err, "schema limit too large: minItems of body 1000000000 is above 1000")
+}
+
+func Test_Faker_fromFile_errors(t *testing.T) {
+	t.Parallel()
+
+	fs := fsext.NewMemMapFs()
+
+	require.NoError(t, fsext.WriteFile(fs, "/errors/drugs.csv", []byte("drug,weight\nAspirin,1\n"), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/errors/bad.csv", []byte("drug,weight\nAspirin,heavy\n"), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/errors/bad.txt", []byte("Aspirin"), 0o600))
+	require.NoError(t, fsext.WriteFile(fs, "/errors/empty.json", []byte("[]"), 0o600))
+
+	runtime := modulestest.NewRuntime(t)
+	runtime.VU.InitEnvField.FileSystems = map[string]fsext.Fs{"file": fs}
+	runtime.VU.InitEnvField.CWD = &url.URL{Scheme: "file", Path: "/errors/"}
+
+	err := runtime.SetupModuleSystem(map[string]any{module.ImportPath: module.New()}, nil, nil)
+
+	require.NoError(t, err)
+
+	for script, msg := range map[string]string{
+		`fromFile("missing.csv")`:                     "file does not exist",
+		`fromFile("bad.txt")`:                         "unsupported file format .txt",
+		`fromFile("empty.json")`:                      "empty dataset",
+		`fromFile("bad.csv", { weighted: "weight" })`: "invalid weight in row 1",
+		`fromFile("drugs.csv", { column: "name" })`:   "missing column name",
+	} {
+		_, err = runtime.RunOnEventLoop(`new (require("` + module.ImportPath + `").Faker)(11).` + script)
+
+		require.ErrorContains(t, err, msg, script)
+	}
+}
+
+func Test_Compat_Faker(t *testing.T) {
+	t.Parallel()
+
+	runtime := modulestest.NewRuntime(t)
+	err := runtime.SetupModuleSystem(map[string]any{module.CompatImportPath: module.NewCompat()}, nil, nil)
+
+	require.NoError(t, err)
+
+	val, err := runtime.RunOnEventLoop(`
+	let compat = require("` + module.CompatImportPath + `")
+	compat.faker === compat.default && typeof compat.faker.person.firstName()
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "string", val.String())
+}
+
+func Test_Legacy_Faker(t *testing.T) {
+	t.Parallel()
+
+	runtime := modulestest.NewRuntime(t)
+	err := runtime.SetupModuleSystem(map[string]any{module.LegacyImportPath: module.NewLegacy()}, nil, nil)
+
+	require.NoError(t, err)
+
+	val, err := runtime.RunOnEventLoop(`
+	let legacy = require("` + module.LegacyImportPath + `")
+	legacy.faker === legacy.default && typeof legacy.faker.FirstName()
+	`)
+
+	require.NoError(t, err)
+	require.Equal(t, "string", val.String())
+}
@@ -8,6 +8,8 @@ import (
 
 func register() {
 	modules.Register(module.ImportPath, module.New())
+	modules.Register(module.CompatImportPath, module.NewCompat())
+	modules.Register(module.LegacyImportPath, module.NewLegacy())
 }
 
 func init() { //nolint:gochecknoinits
@@ -15,6 +15,7 @@ export default function () {
     check(faker.address.city(), { 'address.city()': checker });
     check(faker.address.country(), { 'address.country()': checker });
     check(faker.address.countryAbbreviation(), { 'address.countryAbbreviation()': checker });
+    check(faker.address.gpsTrace([],[],20,50,5), { 'address.gpsTrace([],[],20,50,5)': checker });
     check(faker.address.latitude(), { 'address.latitude()': checker });
     check(faker.address.latitudeRange(0,90), { 'address.latitudeRange(0,90)': checker });
     check(faker.address.longitude(), { 'address.longitude()': checker });
@@ -57,6 +58,10 @@ export default function () {
     check(faker.book.bookAuthor(), { 'book.bookAuthor()': checker });
     check(faker.book.bookGenre(), { 'book.bookGenre()': checker });
     check(faker.book.bookTitle(), { 'book.bookTitle()': checker });
+    check(faker.book.doi(), { 'book.doi()': checker });
+    check(faker.book.isbn10(), { 'book.isbn10()': checker });
+    check(faker.book.isbn13(), { 'book.isbn13()': checker });
+    check(faker.book.issn(), { 'book.issn()': checker });
   });
   group('car', ()=> {
     check(faker.car.car(), { 'car.car()': checker });
@@ -65,12 +70,23 @@ export default function () {
     check(faker.car.carModel(), { 'car.carModel()': checker });
     check(faker.car.carTransmissionType(), { 'car.carTransmissionType()': checker });
     check(faker.car.carType(), { 'car.carType()': checker });
+    check(faker.car.licensePlate("US"), { 'car.licensePlate("US")': checker });
+    check(faker.car.vin(), { 'car.vin()': checker });
   });
   group('celebrity', ()=> {
     check(faker.celebrity.celebrityActor(), { 'celebrity.celebrityActor()': checker });
     check(faker.celebrity.celebrityBusiness(), { 'celebrity.celebrityBusiness()': checker });
     check(faker.celebrity.celebritySport(), { 'celebrity.celebritySport()': checker });
   });
+  group('chaos', ()=> {
+    check(faker.chaos.pathSegment(0.1), { 'chaos.pathSegment(0.1)': checker });
+    check(faker.chaos.queryString(0.1), { 'chaos.queryString(0.1)': checker });
+  });
+  group('cloud', ()=> {
+    check(faker.cloud.awsArn("any"), { 'cloud.awsArn("any")': checker });
+    check(faker.cloud.azureResourceId("any"), { 'cloud.azureResourceId("any")': checker });
+    check(faker.cloud.gcpResourceName("any"), { 'cloud.gcpResourceName("any")': checker });
+  });
   group('color', ()=> {
     check(faker.color.color(), { 'color.color()': checker });
     check(faker.color.hexColor(), { 'color.hexColor()': checker });
@@ -78,17 +94,28 @@ export default function () {
     check(faker.color.rgbColor(), { 'color.rgbColor()': checker });
     check(faker.color.safeColor(), { 'color.safeColor()': checker });
   });
+  group('commerce', ()=> {
+    check(faker.commerce.cartItems(3), { 'commerce.cartItems(3)': checker });
+    check(faker.commerce.discountCode(), { 'commerce.discountCode()': checker });
+    check(faker.commerce.order(), { 'commerce.order()': checker });
+    check(faker.commerce.shipment("any"), { 'commerce.shipment("any")': checker });
+    check(faker.commerce.sku("???-#####"), { 'commerce.sku("???-#####")': checker });
+    check(faker.commerce.trackingNumber("any"), { 'commerce.trackingNumber("any")': checker });
+  });
   group('company', ()=> {
     check(faker.company.blurb(), { 'company.blurb()': checker });
     check(faker.company.bs(), { 'company.bs()': checker });
     check(faker.company.buzzword(), { 'company.buzzword()': checker });
     check(faker.company.company(), { 'company.company()': checker });
     check(faker.company.companySuffix(), { 'company.companySuffix()': checker });
+    check(faker.company.ein(), { 'company.ein()': checker });
     check(faker.company.job(), { 'company.job()': checker });
     check(faker.company.jobDescriptor(), { 'company.jobDescriptor()': checker });
     check(faker.company.jobLevel(), { 'company.jobLevel()': checker });
     check(faker.company.jobTitle(), { 'company.jobTitle()': checker });
+    check(faker.company.organization(), { 'company.organization()': checker });
     check(faker.company.slogan(), { 'company.slogan()': checker });
+    check(faker.company.vatNumber("any"), { 'company.vatNumber("any")': checker });
   });
   group('emoji', ()=> {
     check(faker.emoji.emoji(), { 'emoji.emoji()': checker });
@@ -113,8 +140,12 @@ export default function () {
     check(faker.file.fileMimeType(), { 'file.fileMimeType()': checker });
   });
   group('finance', ()=> {
+    check(faker.finance.amount(0,1000), { 'finance.amount(0,1000)': checker });
     check(faker.finance.cusip(), { 'finance.cusip()': checker });
     check(faker.finance.isin(), { 'finance.isin()': checker });
+    check(faker.finance.ohlcCandle(100,0.01), { 'finance.ohlcCandle(100,0.01)': checker });
+    check(faker.finance.orderBookSnapshot(5), { 'finance.orderBookSnapshot(5)': checker });
+    check(faker.finance.tickerQuote("any"), { 'finance.tickerQuote("any")': checker });
   });
   group('food', ()=> {
     check(faker.food.breakfast(), { 'food.breakfast()': checker });
@@ -126,9 +157,17 @@ export default function () {
     check(faker.food.snack(), { 'food.snack()': checker });
     check(faker.food.vegetable(), { 'food.vegetable()': checker });
   });
+  group('fuzz', ()=> {
+    check(faker.fuzz.boundaryNumbers("all"), { 'fuzz.boundaryNumbers("all")': checker });
+    check(faker.fuzz.injectionStrings("all"), { 'fuzz.injectionStrings("all")': checker });
+  });
   group('game', ()=> {
     check(faker.game.dice(1,[5,4,13]), { 'game.dice(1,[5,4,13])': checker });
     check(faker.game.gamertag(), { 'game.gamertag()': checker });
+    check(faker.game.leaderboardEntry(), { 'game.leaderboardEntry()': checker });
+    check(faker.game.lobbyName(), { 'game.lobbyName()': checker });
+    check(faker.game.matchResult(10), { 'game.matchResult(10)': checker });
+    check(faker.game.playerTelemetryBatch(10), { 'game.playerTelemetryBatch(10)': checker });
   });
   group('hacker', ()=> {
     check(faker.hacker.hackerAbbreviation(), { 'hacker.hackerAbbreviation()': checker });
@@ -138,15 +177,31 @@ export default function () {
     check(faker.hacker.hackerVerb(), { 'hacker.hackerVerb()': checker });
     check(faker.hacker.hackeringVerb(), { 'hacker.hackeringVerb()': checker });
   });
+  group('health', ()=> {
+    check(faker.health.allergy(), { 'health.allergy()': checker });
+    check(faker.health.bloodType(), { 'health.bloodType()': checker });
+    check(faker.health.fhirPatient(), { 'health.fhirPatient()': checker });
+    check(faker.health.icd10Code(), { 'health.icd10Code()': checker });
+    check(faker.health.npi(), { 'health.npi()': checker });
+    check(faker.health.patient(), { 'health.patient()': checker });
+  });
   group('hipster', ()=> {
     check(faker.hipster.hipsterParagraph(2,2,5,"\u003cbr /\u003e"), { 'hipster.hipsterParagraph(2,2,5,"\u003cbr /\u003e")': checker });
     check(faker.hipster.hipsterSentence(5), { 'hipster.hipsterSentence(5)': checker });
     check(faker.hipster.hipsterWord(), { 'hipster.hipsterWord()': checker });
   });
   group('internet', ()=> {
+    check(faker.internet.asn(), { 'internet.asn()': checker });
     check(faker.internet.chromeUserAgent(), { 'internet.chromeUserAgent()': checker });
+    check(faker.internet.cidr("v4",0), { 'internet.cidr("v4",0)': checker });
+    check(faker.internet.cookie("any","any",["secure"]), { 'internet.cookie("any","any",["secure"])': checker });
+    check(faker.internet.cookieHeader(3), { 'internet.cookieHeader(3)': checker });
+    check(faker.internet.disposableEmail(), { 'internet.disposableEmail()': checker });
+    check(faker.internet.dnsRecord("any"), { 'internet.dnsRecord("any")': checker });
     check(faker.internet.domainName(), { 'internet.domainName()': checker });
     check(faker.internet.domainSuffix(), { 'internet.domainSuffix()': checker });
+    check(faker.internet.emailPlusTag("qa@example.com"), { 'internet.emailPlusTag("qa@example.com")': checker });
+    check(faker.internet.emailWithDomain("example.com"), { 'internet.emailWithDomain("example.com")': checker });
     check(faker.internet.firefoxUserAgent(), { 'internet.firefoxUserAgent()': checker });
     check(faker.internet.httpMethod(), { 'internet.httpMethod()': checker });
     check(faker.internet.httpStatusCode(), { 'internet.httpStatusCode()': checker });
@@ -154,16 +209,39 @@ export default function () {
     check(faker.internet.httpVersion(), { 'internet.httpVersion()': checker });
     check(faker.internet.imageUrl(500,500), { 'internet.imageUrl(500,500)': checker });
     check(faker.internet.inputName(), { 'internet.inputName()': checker });
+    check(faker.internet.ipInCidr("10.0.0.0/8"), { 'internet.ipInCidr("10.0.0.0/8")': checker });
     check(faker.internet.ipv4Address(), { 'internet.ipv4Address()': checker });
     check(faker.internet.ipv6Address(), { 'internet.ipv6Address()': checker });
     check(faker.internet.logLevel(), { 'internet.logLevel()': checker });
     check(faker.internet.macAddress(), { 'internet.macAddress()': checker });
+    check(faker.internet.macAddressVendor("any"), { 'internet.macAddressVendor("any")': checker });
+    check(faker.internet.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600), { 'internet.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600)': checker });
     check(faker.internet.operaUserAgent(), { 'internet.operaUserAgent()': checker });
     check(faker.internet.password(true,false,true,true,false,12), { 'internet.password(true,false,true,true,false,12)': checker });
+    check(faker.internet.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true), { 'internet.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true)': checker });
+    check(faker.internet.portEphemeral(), { 'internet.portEphemeral()': checker });
+    check(faker.internet.portWellKnown(), { 'internet.portWellKnown()': checker });
+    check(faker.internet.qrContent("any"), { 'internet.qrContent("any")': checker });
     check(faker.internet.safariUserAgent(), { 'internet.safariUserAgent()': checker });
+    check(faker.internet.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa"), { 'internet.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa")': checker });
+    check(faker.internet.sshKeyPair("ed25519"), { 'internet.sshKeyPair("ed25519")': checker });
     check(faker.internet.url(), { 'internet.url()': checker });
+    check(faker.internet.urlWithin("https://test.example.com",3,"none",2), { 'internet.urlWithin("https://test.example.com",3,"none",2)': checker });
     check(faker.internet.userAgent(), { 'internet.userAgent()': checker });
     check(faker.internet.username(), { 'internet.username()': checker });
+    check(faker.internet.usernamePolicy("a-z0-9._",3,16), { 'internet.usernamePolicy("a-z0-9._",3,16)': checker });
+    check(faker.internet.zoneFile(10), { 'internet.zoneFile(10)': checker });
+  });
+  group('iot', ()=> {
+    check(faker.iot.deviceId("uuid"), { 'iot.deviceId("uuid")': checker });
+    check(faker.iot.mqttTopic(5,"ourselves"), { 'iot.mqttTopic(5,"ourselves")': checker });
+    check(faker.iot.telemetryBatch(10,"temperature","meanReverting"), { 'iot.telemetryBatch(10,"temperature","meanReverting")': checker });
+  });
+  group('k8s', ()=> {
+    check(faker.k8s.labelSet(3), { 'k8s.labelSet(3)': checker });
+    check(faker.k8s.namespace(), { 'k8s.namespace()': checker });
+    check(faker.k8s.podManifest(1), { 'k8s.podManifest(1)': checker });
+    check(faker.k8s.resourceName(), { 'k8s.resourceName()': checker });
   });
   group('language', ()=> {
     check(faker.language.language(), { 'language.language()': checker });
@@ -171,7 +249,14 @@ export default function () {
     check(faker.language.languageBcp(), { 'language.languageBcp()': checker });
     check(faker.language.programmingLanguage(), { 'language.programmingLanguage()': checker });
   });
+  group('messaging', ()=> {
+    check(faker.messaging.amqpMessage("any"), { 'messaging.amqpMessage("any")': checker });
+    check(faker.messaging.cloudEvent("any","any","any"), { 'messaging.cloudEvent("any","any","any")': checker });
+    check(faker.messaging.kafkaRecord("uuid","any"), { 'messaging.kafkaRecord("uuid","any")': checker });
+  });
   group('minecraft', ()=> {
+    check(faker.minecraft.chunkCoordinates(), { 'minecraft.chunkCoordinates()': checker });
+    check(faker.minecraft.inventory(36), { 'minecraft.inventory(36)': checker });
     check(faker.minecraft.minecraftAnimal(), { 'minecraft.minecraftAnimal()': checker });
     check(faker.minecraft.minecraftArmorPart(), { 'minecraft.minecraftArmorPart()': checker });
     check(faker.minecraft.minecraftArmorTier(), { 'minecraft.minecraftArmorTier()': checker });
@@ -190,6 +275,7 @@ export default function () {
     check(faker.minecraft.minecraftWeapon(), { 'minecraft.minecraftWeapon()': checker });
     check(faker.minecraft.minecraftWeather(), { 'minecraft.minecraftWeather()': checker });
     check(faker.minecraft.minecraftWood(), { 'minecraft.minecraftWood()': checker });
+    check(faker.minecraft.serverStatusJson(), { 'minecraft.serverStatusJson()': checker });
   });
   group('movie', ()=> {
     check(faker.movie.movie(), { 'movie.movie()': checker });
@@ -197,11 +283,12 @@ export default function () {
     check(faker.movie.movieName(), { 'movie.movieName()': checker });
   });
   group('numbers', ()=> {
+    check(faker.numbers.bigInt(64), { 'numbers.bigInt(64)': checker });
     check(faker.numbers.boolean(), { 'numbers.boolean()': checker });
     check(faker.numbers.float32(), { 'numbers.float32()': checker });
     check(faker.numbers.float32Range(3,5), { 'numbers.float32Range(3,5)': checker });
     check(faker.numbers.float64(), { 'numbers.float64()': checker });
-    check(faker.numbers.float64Range(3,5), { 'numbers.float64Range(3,5)': checker });
+    check(faker.numbers.float64Range(3,5,-1), { 'numbers.float64Range(3,5,-1)': checker });
     check(faker.numbers.hexUint128(), { 'numbers.hexUint128()': checker });
     check(faker.numbers.hexUint16(), { 'numbers.hexUint16()': checker });
     check(faker.numbers.hexUint256(), { 'numbers.hexUint256()': checker });
@@ -210,6 +297,7 @@ export default function () {
     check(faker.numbers.hexUint8(), { 'numbers.hexUint8()': checker });
     check(faker.numbers.int16(), { 'numbers.int16()': checker });
     check(faker.numbers.int32(), { 'numbers.int32()': checker });
+    check(faker.numbers.int53(), { 'numbers.int53()': checker });
     check(faker.numbers.int64(), { 'numbers.int64()': checker });
     check(faker.numbers.int8(), { 'numbers.int8()': checker });
     check(faker.numbers.intRange(3,5), { 'numbers.intRange(3,5)': checker });
@@ -230,6 +318,7 @@ export default function () {
     check(faker.payment.bitcoinPrivateKey(), { 'payment.bitcoinPrivateKey()': checker });
     check(faker.payment.creditCard(), { 'payment.creditCard()': checker });
     check(faker.payment.creditCardCVV(), { 'payment.creditCardCVV()': checker });
+    check(faker.payment.creditCardCvv(), { 'payment.creditCardCvv()': checker });
     check(faker.payment.creditCardExp(), { 'payment.creditCardExp()': checker });
     check(faker.payment.creditCardExpMonth(), { 'payment.creditCardExpMonth()': checker });
     check(faker.payment.creditCardExpYear(), { 'payment.creditCardExpYear()': checker });
@@ -241,15 +330,24 @@ export default function () {
     check(faker.payment.price(0,1000), { 'payment.price(0,1000)': checker });
   });
   group('person', ()=> {
+    check(faker.person.birthdate(18,80), { 'person.birthdate(18,80)': checker });
+    check(faker.person.demographicAge("US"), { 'person.demographicAge("US")': checker });
+    check(faker.person.demographicFirstName("US","any"), { 'person.demographicFirstName("US","any")': checker });
+    check(faker.person.demographicLastName("US"), { 'person.demographicLastName("US")': checker });
+    check(faker.person.demographicPerson("US"), { 'person.demographicPerson("US")': checker });
     check(faker.person.email(), { 'person.email()': checker });
-    check(faker.person.firstName(), { 'person.firstName()': checker });
+    check(faker.person.firstName("any","any"), { 'person.firstName("any","any")': checker });
+    check(faker.person.fullName("{firstName} {lastName}","any","any"), { 'person.fullName("{firstName} {lastName}","any","any")': checker });
     check(faker.person.gender(), { 'person.gender()': checker });
+    check(faker.person.genderPronouns("any"), { 'person.genderPronouns("any")': checker });
     check(faker.person.hobby(), { 'person.hobby()': checker });
+    check(faker.person.honorific("any","any"), { 'person.honorific("any","any")': checker });
     check(faker.person.lastName(), { 'person.lastName()': checker });
     check(faker.person.middleName(), { 'person.middleName()': checker });
     check(faker.person.name(), { 'person.name()': checker });
     check(faker.person.namePrefix(), { 'person.namePrefix()': checker });
     check(faker.person.nameSuffix(), { 'person.nameSuffix()': checker });
+    check(faker.person.nationalId("US",true,true), { 'person.nationalId("US",true,true)': checker });
     check(faker.person.person(), { 'person.person()': checker });
     check(faker.person.phone(), { 'person.phone()': checker });
     check(faker.person.phoneFormatted(), { 'person.phoneFormatted()': checker });
@@ -258,6 +356,8 @@ export default function () {
     check(faker.person.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"]), { 'person.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"])': checker });
   });
   group('product', ()=> {
+    check(faker.product.ean13(), { 'product.ean13()': checker });
+    check(faker.product.gs1128(["00"]), { 'product.gs1128(["00"])': checker });
     check(faker.product.product(), { 'product.product()': checker });
     check(faker.product.productCategory(), { 'product.productCategory()': checker });
     check(faker.product.productDescription(), { 'product.productDescription()': checker });
@@ -265,38 +365,70 @@ export default function () {
     check(faker.product.productMaterial(), { 'product.productMaterial()': checker });
     check(faker.product.productName(), { 'product.productName()': checker });
     check(faker.product.productUpc(), { 'product.productUpc()': checker });
+    check(faker.product.review("any",0,"en"), { 'product.review("any",0,"en")': checker });
+    check(faker.product.upcA(), { 'product.upcA()': checker });
   });
   group('strings', ()=> {
+    check(faker.strings.adversarial("any"), { 'strings.adversarial("any")': checker });
+    check(faker.strings.camelCase(3), { 'strings.camelCase(3)': checker });
+    check(faker.strings.crc32("none"), { 'strings.crc32("none")': checker });
     check(faker.strings.digit(), { 'strings.digit()': checker });
     check(faker.strings.digitN(3), { 'strings.digitN(3)': checker });
+    check(faker.strings.identifier("none",12,"alphanumeric"), { 'strings.identifier("none",12,"alphanumeric")': checker });
+    check(faker.strings.kebabCase(3), { 'strings.kebabCase(3)': checker });
+    check(faker.strings.ksuid(), { 'strings.ksuid()': checker });
     check(faker.strings.letter(), { 'strings.letter()': checker });
     check(faker.strings.letterN(3), { 'strings.letterN(3)': checker });
     check(faker.strings.lexify("none"), { 'strings.lexify("none")': checker });
+    check(faker.strings.md5("none"), { 'strings.md5("none")': checker });
+    check(faker.strings.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"), { 'strings.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")': checker });
     check(faker.strings.numerify("none"), { 'strings.numerify("none")': checker });
     check(faker.strings.randomString(["none","how","these","keep","trip","congolese","choir","computer","still","far"]), { 'strings.randomString(["none","how","these","keep","trip","congolese","choir","computer","still","far"])': checker });
+    check(faker.strings.sha1("none"), { 'strings.sha1("none")': checker });
+    check(faker.strings.sha256("none"), { 'strings.sha256("none")': checker });
     check(faker.strings.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"]), { 'strings.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"])': checker });
+    check(faker.strings.slug(3), { 'strings.slug(3)': checker });
+    check(faker.strings.snakeCase(3), { 'strings.snakeCase(3)': checker });
+    check(faker.strings.ulid(), { 'strings.ulid()': checker });
+    check(faker.strings.unicode("mixed",10), { 'strings.unicode("mixed",10)': checker });
     check(faker.strings.uuid(), { 'strings.uuid()': checker });
+    check(faker.strings.uuidV7(), { 'strings.uuidV7()': checker });
   });
   group('time', ()=> {
+    check(faker.time.businessDay("US"), { 'time.businessDay("US")': checker });
+    check(faker.time.cron(), { 'time.cron()': checker });
     check(faker.time.date("RFC3339"), { 'time.date("RFC3339")': checker });
     check(faker.time.dateRange("1970-01-01","2024-03-13","yyyy-MM-dd"), { 'time.dateRange("1970-01-01","2024-03-13","yyyy-MM-dd")': checker });
     check(faker.time.day(), { 'time.day()': checker });
+    check(faker.time.duration("1s","1h","s"), { 'time.duration("1s","1h","s")': checker });
     check(faker.time.futureTime(), { 'time.futureTime()': checker });
     check(faker.time.hour(), { 'time.hour()': checker });
+    check(faker.time.interarrival("poisson",1), { 'time.interarrival("poisson",1)': checker });
     check(faker.time.minute(), { 'time.minute()': checker });
     check(faker.time.month(), { 'time.month()': checker });
     check(faker.time.monthString(), { 'time.monthString()': checker });
     check(faker.time.nanosecond(), { 'time.nanosecond()': checker });
     check(faker.time.pastTime(), { 'time.pastTime()': checker });
+    check(faker.time.recentTimestamp("24h"), { 'time.recentTimestamp("24h")': checker });
     check(faker.time.second(), { 'time.second()': checker });
+    check(faker.time.soonTimestamp("24h"), { 'time.soonTimestamp("24h")': checker });
+    check(faker.time.timeRange("now","30d","1h","7d"), { 'time.timeRange("now","30d","1h","7d")': checker });
     check(faker.time.timezone(), { 'time.timezone()': checker });
     check(faker.time.timezoneAbbreviation(), { 'time.timezoneAbbreviation()': checker });
     check(faker.time.timezoneFull(), { 'time.timezoneFull()': checker });
     check(faker.time.timezoneOffset(), { 'time.timezoneOffset()': checker });
     check(faker.time.timezoneRegion(), { 'time.timezoneRegion()': checker });
     check(faker.time.weekday(), { 'time.weekday()': checker });
+    check(faker.time.withinBusinessHours("UTC"), { 'time.withinBusinessHours("UTC")': checker });
     check(faker.time.year(), { 'time.year()': checker });
   });
+  group('travel', ()=> {
+    check(faker.travel.bookingItinerary(2), { 'travel.bookingItinerary(2)': checker });
+    check(faker.travel.flightNumber(), { 'travel.flightNumber()': checker });
+    check(faker.travel.iataAirport(), { 'travel.iataAirport()': checker });
+    check(faker.travel.passportNumber("US"), { 'travel.passportNumber("US")': checker });
+    check(faker.travel.pnr(), { 'travel.pnr()': checker });
+  });
   group('word', ()=> {
     check(faker.word.actionVerb(), { 'word.actionVerb()': checker });
     check(faker.word.adjective(), { 'word.adjective()': checker });
@@ -340,7 +472,7 @@ export default function () {
     check(faker.word.nounPhrase(), { 'word.nounPhrase()': checker });
     check(faker.word.nounProper(), { 'word.nounProper()': checker });
     check(faker.word.nounUncountable(), { 'word.nounUncountable()': checker });
-    check(faker.word.paragraph(2,2,5,"\u003cbr /\u003e"), { 'word.paragraph(2,2,5,"\u003cbr /\u003e")': checker });
+    check(faker.word.paragraph(2,2,5,"\u003cbr /\u003e",0), { 'word.paragraph(2,2,5,"\u003cbr /\u003e",0)': checker });
     check(faker.word.phrase(), { 'word.phrase()': checker });
     check(faker.word.possessiveAdjective(), { 'word.possessiveAdjective()': checker });
     check(faker.word.preposition(), { 'word.preposition()': checker });
@@ -361,8 +493,9 @@ export default function () {
     check(faker.word.quantitativeAdjective(), { 'word.quantitativeAdjective()': checker });
     check(faker.word.question(), { 'word.question()': checker });
     check(faker.word.quote(), { 'word.quote()': checker });
-    check(faker.word.sentence(5), { 'word.sentence(5)': checker });
+    check(faker.word.sentence(5,0), { 'word.sentence(5,0)': checker });
     check(faker.word.simpleSentence(), { 'word.simpleSentence()': checker });
+    check(faker.word.textOfLength(100), { 'word.textOfLength(100)': checker });
     check(faker.word.transitiveVerb(), { 'word.transitiveVerb()': checker });
     check(faker.word.verb(), { 'word.verb()': checker });
     check(faker.word.verbPhrase(), { 'word.verbPhrase()': checker });
@@ -397,6 +530,14 @@ export default function () {
     check(faker.call("adverbTimeDefinite"), { 'call("adverbTimeDefinite")': checker });
     check(faker.zen.adverbTimeIndefinite(), { 'zen.adverbTimeIndefinite()': checker });
     check(faker.call("adverbTimeIndefinite"), { 'call("adverbTimeIndefinite")': checker });
+    check(faker.zen.adversarial("any"), { 'zen.adversarial("any")': checker });
+    check(faker.call("adversarial","any"), { 'call("adversarial","any")': checker });
+    check(faker.zen.allergy(), { 'zen.allergy()': checker });
+    check(faker.call("allergy"), { 'call("allergy")': checker });
+    check(faker.zen.amount(0,1000), { 'zen.amount(0,1000)': checker });
+    check(faker.call("amount",0,1000), { 'call("amount",0,1000)': checker });
+    check(faker.zen.amqpMessage("any"), { 'zen.amqpMessage("any")': checker });
+    check(faker.call("amqpMessage","any"), { 'call("amqpMessage","any")': checker });
     check(faker.zen.animal(), { 'zen.animal()': checker });
     check(faker.call("animal"), { 'call("animal")': checker });
     check(faker.zen.animalType(), { 'zen.animalType()': checker });
@@ -407,6 +548,12 @@ export default function () {
     check(faker.call("appName"), { 'call("appName")': checker });
     check(faker.zen.appVersion(), { 'zen.appVersion()': checker });
     check(faker.call("appVersion"), { 'call("appVersion")': checker });
+    check(faker.zen.asn(), { 'zen.asn()': checker });
+    check(faker.call("asn"), { 'call("asn")': checker });
+    check(faker.zen.awsArn("any"), { 'zen.awsArn("any")': checker });
+    check(faker.call("awsArn","any"), { 'call("awsArn","any")': checker });
+    check(faker.zen.azureResourceId("any"), { 'zen.azureResourceId("any")': checker });
+    check(faker.call("azureResourceId","any"), { 'call("azureResourceId","any")': checker });
     check(faker.zen.beerAlcohol(), { 'zen.beerAlcohol()': checker });
     check(faker.call("beerAlcohol"), { 'call("beerAlcohol")': checker });
     check(faker.zen.beerBlg(), { 'zen.beerBlg()': checker });
@@ -423,12 +570,18 @@ export default function () {
     check(faker.call("beerStyle"), { 'call("beerStyle")': checker });
     check(faker.zen.beerYeast(), { 'zen.beerYeast()': checker });
     check(faker.call("beerYeast"), { 'call("beerYeast")': checker });
+    check(faker.zen.bigInt(64), { 'zen.bigInt(64)': checker });
+    check(faker.call("bigInt",64), { 'call("bigInt",64)': checker });
     check(faker.zen.bird(), { 'zen.bird()': checker });
     check(faker.call("bird"), { 'call("bird")': checker });
+    check(faker.zen.birthdate(18,80), { 'zen.birthdate(18,80)': checker });
+    check(faker.call("birthdate",18,80), { 'call("birthdate",18,80)': checker });
     check(faker.zen.bitcoinAddress(), { 'zen.bitcoinAddress()': checker });
     check(faker.call("bitcoinAddress"), { 'call("bitcoinAddress")': checker });
     check(faker.zen.bitcoinPrivateKey(), { 'zen.bitcoinPrivateKey()': checker });
     check(faker.call("bitcoinPrivateKey"), { 'call("bitcoinPrivateKey")': checker });
+    check(faker.zen.bloodType(), { 'zen.bloodType()': checker });
+    check(faker.call("bloodType"), { 'call("bloodType")': checker });
     check(faker.zen.blurb(), { 'zen.blurb()': checker });
     check(faker.call("blurb"), { 'call("blurb")': checker });
     check(faker.zen.book(), { 'zen.book()': checker });
@@ -439,14 +592,22 @@ export default function () {
     check(faker.call("bookGenre"), { 'call("bookGenre")': checker });
     check(faker.zen.bookTitle(), { 'zen.bookTitle()': checker });
     check(faker.call("bookTitle"), { 'call("bookTitle")': checker });
+    check(faker.zen.bookingItinerary(2), { 'zen.bookingItinerary(2)': checker });
+    check(faker.call("bookingItinerary",2), { 'call("bookingItinerary",2)': checker });
     check(faker.zen.boolean(), { 'zen.boolean()': checker });
     check(faker.call("boolean"), { 'call("boolean")': checker });
+    check(faker.zen.boundaryNumbers("all"), { 'zen.boundaryNumbers("all")': checker });
+    check(faker.call("boundaryNumbers","all"), { 'call("boundaryNumbers","all")': checker });
     check(faker.zen.breakfast(), { 'zen.breakfast()': checker });
     check(faker.call("breakfast"), { 'call("breakfast")': checker });
     check(faker.zen.bs(), { 'zen.bs()': checker });
     check(faker.call("bs"), { 'call("bs")': checker });
+    check(faker.zen.businessDay("US"), { 'zen.businessDay("US")': checker });
+    check(faker.call("businessDay","US"), { 'call("businessDay","US")': checker });
     check(faker.zen.buzzword(), { 'zen.buzzword()': checker });
     check(faker.call("buzzword"), { 'call("buzzword")': checker });
+    check(faker.zen.camelCase(3), { 'zen.camelCase(3)': checker });
+    check(faker.call("camelCase",3), { 'call("camelCase",3)': checker });
     check(faker.zen.car(), { 'zen.car()': checker });
     check(faker.call("car"), { 'call("car")': checker });
     check(faker.zen.carFuelType(), { 'zen.carFuelType()': checker });
@@ -459,6 +620,8 @@ export default function () {
     check(faker.call("carTransmissionType"), { 'call("carTransmissionType")': checker });
     check(faker.zen.carType(), { 'zen.carType()': checker });
     check(faker.call("carType"), { 'call("carType")': checker });
+    check(faker.zen.cartItems(3), { 'zen.cartItems(3)': checker });
+    check(faker.call("cartItems",3), { 'call("cartItems",3)': checker });
     check(faker.zen.cat(), { 'zen.cat()': checker });
     check(faker.call("cat"), { 'call("cat")': checker });
     check(faker.zen.celebrityActor(), { 'zen.celebrityActor()': checker });
@@ -469,8 +632,14 @@ export default function () {
     check(faker.call("celebritySport"), { 'call("celebritySport")': checker });
     check(faker.zen.chromeUserAgent(), { 'zen.chromeUserAgent()': checker });
     check(faker.call("chromeUserAgent"), { 'call("chromeUserAgent")': checker });
+    check(faker.zen.chunkCoordinates(), { 'zen.chunkCoordinates()': checker });
+    check(faker.call("chunkCoordinates"), { 'call("chunkCoordinates")': checker });
+    check(faker.zen.cidr("v4",0), { 'zen.cidr("v4",0)': checker });
+    check(faker.call("cidr","v4",0), { 'call("cidr","v4",0)': checker });
     check(faker.zen.city(), { 'zen.city()': checker });
     check(faker.call("city"), { 'call("city")': checker });
+    check(faker.zen.cloudEvent("any","any","any"), { 'zen.cloudEvent("any","any","any")': checker });
+    check(faker.call("cloudEvent","any","any","any"), { 'call("cloudEvent","any","any","any")': checker });
     check(faker.zen.color(), { 'zen.color()': checker });
     check(faker.call("color"), { 'call("color")': checker });
     check(faker.zen.comment(), { 'zen.comment()': checker });
@@ -493,14 +662,22 @@ export default function () {
     check(faker.call("connectiveListing"), { 'call("connectiveListing")': checker });
     check(faker.zen.connectiveTime(), { 'zen.connectiveTime()': checker });
     check(faker.call("connectiveTime"), { 'call("connectiveTime")': checker });
+    check(faker.zen.cookie("any","any",["secure"]), { 'zen.cookie("any","any",["secure"])': checker });
+    check(faker.call("cookie","any","any",["secure"]), { 'call("cookie","any","any",["secure"])': checker });
+    check(faker.zen.cookieHeader(3), { 'zen.cookieHeader(3)': checker });
+    check(faker.call("cookieHeader",3), { 'call("cookieHeader",3)': checker });
     check(faker.zen.country(), { 'zen.country()': checker });
     check(faker.call("country"), { 'call("country")': checker });
     check(faker.zen.countryAbbreviation(), { 'zen.countryAbbreviation()': checker });
     check(faker.call("countryAbbreviation"), { 'call("countryAbbreviation")': checker });
+    check(faker.zen.crc32("none"), { 'zen.crc32("none")': checker });
+    check(faker.call("crc32","none"), { 'call("crc32","none")': checker });
     check(faker.zen.creditCard(), { 'zen.creditCard()': checker });
     check(faker.call("creditCard"), { 'call("creditCard")': checker });
     check(faker.zen.creditCardCVV(), { 'zen.creditCardCVV()': checker });
     check(faker.call("creditCardCVV"), { 'call("creditCardCVV")': checker });
+    check(faker.zen.creditCardCvv(), { 'zen.creditCardCvv()': checker });
+    check(faker.call("creditCardCvv"), { 'call("creditCardCvv")': checker });
     check(faker.zen.creditCardExp(), { 'zen.creditCardExp()': checker });
     check(faker.call("creditCardExp"), { 'call("creditCardExp")': checker });
     check(faker.zen.creditCardExpMonth(), { 'zen.creditCardExpMonth()': checker });
@@ -511,6 +688,8 @@ export default function () {
     check(faker.call("creditCardNumberFormatted"), { 'call("creditCardNumberFormatted")': checker });
     check(faker.zen.creditCardType(), { 'zen.creditCardType()': checker });
     check(faker.call("creditCardType"), { 'call("creditCardType")': checker });
+    check(faker.zen.cron(), { 'zen.cron()': checker });
+    check(faker.call("cron"), { 'call("cron")': checker });
     check(faker.zen.currency(), { 'zen.currency()': checker });
     check(faker.call("currency"), { 'call("currency")': checker });
     check(faker.zen.currencyLong(), { 'zen.currencyLong()': checker });
@@ -527,12 +706,22 @@ export default function () {
     check(faker.call("dateRange","1970-01-01","2024-03-13","yyyy-MM-dd"), { 'call("dateRange","1970-01-01","2024-03-13","yyyy-MM-dd")': checker });
     check(faker.zen.day(), { 'zen.day()': checker });
     check(faker.call("day"), { 'call("day")': checker });
+    check(faker.zen.demographicAge("US"), { 'zen.demographicAge("US")': checker });
+    check(faker.call("demographicAge","US"), { 'call("demographicAge","US")': checker });
+    check(faker.zen.demographicFirstName("US","any"), { 'zen.demographicFirstName("US","any")': checker });
+    check(faker.call("demographicFirstName","US","any"), { 'call("demographicFirstName","US","any")': checker });
+    check(faker.zen.demographicLastName("US"), { 'zen.demographicLastName("US")': checker });
+    check(faker.call("demographicLastName","US"), { 'call("demographicLastName","US")': checker });
+    check(faker.zen.demographicPerson("US"), { 'zen.demographicPerson("US")': checker });
+    check(faker.call("demographicPerson","US"), { 'call("demographicPerson","US")': checker });
     check(faker.zen.demonstrativeAdjective(), { 'zen.demonstrativeAdjective()': checker });
     check(faker.call("demonstrativeAdjective"), { 'call("demonstrativeAdjective")': checker });
     check(faker.zen.descriptiveAdjective(), { 'zen.descriptiveAdjective()': checker });
     check(faker.call("descriptiveAdjective"), { 'call("descriptiveAdjective")': checker });
     check(faker.zen.dessert(), { 'zen.dessert()': checker });
     check(faker.call("dessert"), { 'call("dessert")': checker });
+    check(faker.zen.deviceId("uuid"), { 'zen.deviceId("uuid")': checker });
+    check(faker.call("deviceId","uuid"), { 'call("deviceId","uuid")': checker });
     check(faker.zen.dice(1,[5,4,13]), { 'zen.dice(1,[5,4,13])': checker });
     check(faker.call("dice",1,[5,4,13]), { 'call("dice",1,[5,4,13])': checker });
     check(faker.zen.digit(), { 'zen.digit()': checker });
@@ -541,16 +730,34 @@ export default function () {
     check(faker.call("digitN",3), { 'call("digitN",3)': checker });
     check(faker.zen.dinner(), { 'zen.dinner()': checker });
     check(faker.call("dinner"), { 'call("dinner")': checker });
+    check(faker.zen.discountCode(), { 'zen.discountCode()': checker });
+    check(faker.call("discountCode"), { 'call("discountCode")': checker });
+    check(faker.zen.disposableEmail(), { 'zen.disposableEmail()': checker });
+    check(faker.call("disposableEmail"), { 'call("disposableEmail")': checker });
+    check(faker.zen.dnsRecord("any"), { 'zen.dnsRecord("any")': checker });
+    check(faker.call("dnsRecord","any"), { 'call("dnsRecord","any")': checker });
     check(faker.zen.dog(), { 'zen.dog()': checker });
     check(faker.call("dog"), { 'call("dog")': checker });
+    check(faker.zen.doi(), { 'zen.doi()': checker });
+    check(faker.call("doi"), { 'call("doi")': checker });
     check(faker.zen.domainName(), { 'zen.domainName()': checker });
     check(faker.call("domainName"), { 'call("domainName")': checker });
     check(faker.zen.domainSuffix(), { 'zen.domainSuffix()': checker });
     check(faker.call("domainSuffix"), { 'call("domainSuffix")': checker });
     check(faker.zen.drink(), { 'zen.drink()': checker });
     check(faker.call("drink"), { 'call("drink")': checker });
+    check(faker.zen.duration("1s","1h","s"), { 'zen.duration("1s","1h","s")': checker });
+    check(faker.call("duration","1s","1h","s"), { 'call("duration","1s","1h","s")': checker });
+    check(faker.zen.ean13(), { 'zen.ean13()': checker });
+    check(faker.call("ean13"), { 'call("ean13")': checker });
+    check(faker.zen.ein(), { 'zen.ein()': checker });
+    check(faker.call("ein"), { 'call("ein")': checker });
     check(faker.zen.email(), { 'zen.email()': checker });
     check(faker.call("email"), { 'call("email")': checker });
+    check(faker.zen.emailPlusTag("qa@example.com"), { 'zen.emailPlusTag("qa@example.com")': checker });
+    check(faker.call("emailPlusTag","qa@example.com"), { 'call("emailPlusTag","qa@example.com")': checker });
+    check(faker.zen.emailWithDomain("example.com"), { 'zen.emailWithDomain("example.com")': checker });
+    check(faker.call("emailWithDomain","example.com"), { 'call("emailWithDomain","example.com")': checker });
     check(faker.zen.emoji(), { 'zen.emoji()': checker });
     check(faker.call("emoji"), { 'call("emoji")': checker });
     check(faker.zen.emojiAlias(), { 'zen.emojiAlias()': checker });
@@ -567,32 +774,46 @@ export default function () {
     check(faker.call("errorObjectWord"), { 'call("errorObjectWord")': checker });
     check(faker.zen.farmAnimal(), { 'zen.farmAnimal()': checker });
     check(faker.call("farmAnimal"), { 'call("farmAnimal")': checker });
+    check(faker.zen.fhirPatient(), { 'zen.fhirPatient()': checker });
+    check(faker.call("fhirPatient"), { 'call("fhirPatient")': checker });
     check(faker.zen.fileExtension(), { 'zen.fileExtension()': checker });
     check(faker.call("fileExtension"), { 'call("fileExtension")': checker });
     check(faker.zen.fileMimeType(), { 'zen.fileMimeType()': checker });
     check(faker.call("fileMimeType"), { 'call("fileMimeType")': checker });
     check(faker.zen.firefoxUserAgent(), { 'zen.firefoxUserAgent()': checker });
     check(faker.call("firefoxUserAgent"), { 'call("firefoxUserAgent")': checker });
-    check(faker.zen.firstName(), { 'zen.firstName()': checker });
-    check(faker.call("firstName"), { 'call("firstName")': checker });
+    check(faker.zen.firstName("any","any"), { 'zen.firstName("any","any")': checker });
+    check(faker.call("firstName","any","any"), { 'call("firstName","any","any")': checker });
+    check(faker.zen.flightNumber(), { 'zen.flightNumber()': checker });
+    check(faker.call("flightNumber"), { 'call("flightNumber")': checker });
     check(faker.zen.float32(), { 'zen.float32()': checker });
     check(faker.call("float32"), { 'call("float32")': checker });
     check(faker.zen.float32Range(3,5), { 'zen.float32Range(3,5)': checker });
     check(faker.call("float32Range",3,5), { 'call("float32Range",3,5)': checker });
     check(faker.zen.float64(), { 'zen.float64()': checker });
     check(faker.call("float64"), { 'call("float64")': checker });
-    check(faker.zen.float64Range(3,5), { 'zen.float64Range(3,5)': checker });
-    check(faker.call("float64Range",3,5), { 'call("float64Range",3,5)': checker });
+    check(faker.zen.float64Range(3,5,-1), { 'zen.float64Range(3,5,-1)': checker });
+    check(faker.call("float64Range",3,5,-1), { 'call("float64Range",3,5,-1)': checker });
     check(faker.zen.fruit(), { 'zen.fruit()': checker });
     check(faker.call("fruit"), { 'call("fruit")': checker });
+    check(faker.zen.fullName("{firstName} {lastName}","any","any"), { 'zen.fullName("{firstName} {lastName}","any","any")': checker });
+    check(faker.call("fullName","{firstName} {lastName}","any","any"), { 'call("fullName","{firstName} {lastName}","any","any")': checker });
     check(faker.zen.futureTime(), { 'zen.futureTime()': checker });
     check(faker.call("futureTime"), { 'call("futureTime")': checker });
     check(faker.zen.gRPCError(), { 'zen.gRPCError()': checker });
     check(faker.call("gRPCError"), { 'call("gRPCError")': checker });
     check(faker.zen.gamertag(), { 'zen.gamertag()': checker });
     check(faker.call("gamertag"), { 'call("gamertag")': checker });
+    check(faker.zen.gcpResourceName("any"), { 'zen.gcpResourceName("any")': checker });
+    check(faker.call("gcpResourceName","any"), { 'call("gcpResourceName","any")': checker });
     check(faker.zen.gender(), { 'zen.gender()': checker });
     check(faker.call("gender"), { 'call("gender")': checker });
+    check(faker.zen.genderPronouns("any"), { 'zen.genderPronouns("any")': checker });
+    check(faker.call("genderPronouns","any"), { 'call("genderPronouns","any")': checker });
+    check(faker.zen.gpsTrace([],[],20,50,5), { 'zen.gpsTrace([],[],20,50,5)': checker });
+    check(faker.call("gpsTrace",[],[],20,50,5), { 'call("gpsTrace",[],[],20,50,5)': checker });
+    check(faker.zen.gs1128(["00"]), { 'zen.gs1128(["00"])': checker });
+    check(faker.call("gs1128",["00"]), { 'call("gs1128",["00"])': checker });
     check(faker.zen.hackerAbbreviation(), { 'zen.hackerAbbreviation()': checker });
     check(faker.call("hackerAbbreviation"), { 'call("hackerAbbreviation")': checker });
     check(faker.zen.hackerAdjective(), { 'zen.hackerAdjective()': checker });
@@ -629,6 +850,8 @@ export default function () {
     check(faker.call("hipsterWord"), { 'call("hipsterWord")': checker });
     check(faker.zen.hobby(), { 'zen.hobby()': checker });
     check(faker.call("hobby"), { 'call("hobby")': checker });
+    check(faker.zen.honorific("any","any"), { 'zen.honorific("any","any")': checker });
+    check(faker.call("honorific","any","any"), { 'call("honorific","any","any")': checker });
     check(faker.zen.hour(), { 'zen.hour()': checker });
     check(faker.call("hour"), { 'call("hour")': checker });
     check(faker.zen.httpClientError(), { 'zen.httpClientError()': checker });
@@ -645,34 +868,56 @@ export default function () {
     check(faker.call("httpStatusCodeSimple"), { 'call("httpStatusCodeSimple")': checker });
     check(faker.zen.httpVersion(), { 'zen.httpVersion()': checker });
     check(faker.call("httpVersion"), { 'call("httpVersion")': checker });
+    check(faker.zen.iataAirport(), { 'zen.iataAirport()': checker });
+    check(faker.call("iataAirport"), { 'call("iataAirport")': checker });
+    check(faker.zen.icd10Code(), { 'zen.icd10Code()': checker });
+    check(faker.call("icd10Code"), { 'call("icd10Code")': checker });
+    check(faker.zen.identifier("none",12,"alphanumeric"), { 'zen.identifier("none",12,"alphanumeric")': checker });
+    check(faker.call("identifier","none",12,"alphanumeric"), { 'call("identifier","none",12,"alphanumeric")': checker });
     check(faker.zen.imageUrl(500,500), { 'zen.imageUrl(500,500)': checker });
     check(faker.call("imageUrl",500,500), { 'call("imageUrl",500,500)': checker });
     check(faker.zen.indefiniteAdjective(), { 'zen.indefiniteAdjective()': checker });
     check(faker.call("indefiniteAdjective"), { 'call("indefiniteAdjective")': checker });
+    check(faker.zen.injectionStrings("all"), { 'zen.injectionStrings("all")': checker });
+    check(faker.call("injectionStrings","all"), { 'call("injectionStrings","all")': checker });
     check(faker.zen.inputName(), { 'zen.inputName()': checker });
     check(faker.call("inputName"), { 'call("inputName")': checker });
     check(faker.zen.int16(), { 'zen.int16()': checker });
     check(faker.call("int16"), { 'call("int16")': checker });
     check(faker.zen.int32(), { 'zen.int32()': checker });
     check(faker.call("int32"), { 'call("int32")': checker });
+    check(faker.zen.int53(), { 'zen.int53()': checker });
+    check(faker.call("int53"), { 'call("int53")': checker });
     check(faker.zen.int64(), { 'zen.int64()': checker });
     check(faker.call("int64"), { 'call("int64")': checker });
     check(faker.zen.int8(), { 'zen.int8()': checker });
     check(faker.call("int8"), { 'call("int8")': checker });
     check(faker.zen.intRange(3,5), { 'zen.intRange(3,5)': checker });
     check(faker.call("intRange",3,5), { 'call("intRange",3,5)': checker });
+    check(faker.zen.interarrival("poisson",1), { 'zen.interarrival("poisson",1)': checker });
+    check(faker.call("interarrival","poisson",1), { 'call("interarrival","poisson",1)': checker });
     check(faker.zen.interjection(), { 'zen.interjection()': checker });
     check(faker.call("interjection"), { 'call("interjection")': checker });
     check(faker.zen.interrogativeAdjective(), { 'zen.interrogativeAdjective()': checker });
     check(faker.call("interrogativeAdjective"), { 'call("interrogativeAdjective")': checker });
     check(faker.zen.intransitiveVerb(), { 'zen.intransitiveVerb()': checker });
     check(faker.call("intransitiveVerb"), { 'call("intransitiveVerb")': checker });
+    check(faker.zen.inventory(36), { 'zen.inventory(36)': checker });
+    check(faker.call("inventory",36), { 'call("inventory",36)': checker });
+    check(faker.zen.ipInCidr("10.0.0.0/8"), { 'zen.ipInCidr("10.0.0.0/8")': checker });
+    check(faker.call("ipInCidr","10.0.0.0/8"), { 'call("ipInCidr","10.0.0.0/8")': checker });
     check(faker.zen.ipv4Address(), { 'zen.ipv4Address()': checker });
     check(faker.call("ipv4Address"), { 'call("ipv4Address")': checker });
     check(faker.zen.ipv6Address(), { 'zen.ipv6Address()': checker });
     check(faker.call("ipv6Address"), { 'call("ipv6Address")': checker });
+    check(faker.zen.isbn10(), { 'zen.isbn10()': checker });
+    check(faker.call("isbn10"), { 'call("isbn10")': checker });
+    check(faker.zen.isbn13(), { 'zen.isbn13()': checker });
+    check(faker.call("isbn13"), { 'call("isbn13")': checker });
     check(faker.zen.isin(), { 'zen.isin()': checker });
     check(faker.call("isin"), { 'call("isin")': checker });
+    check(faker.zen.issn(), { 'zen.issn()': checker });
+    check(faker.call("issn"), { 'call("issn")': checker });
     check(faker.zen.job(), { 'zen.job()': checker });
     check(faker.call("job"), { 'call("job")': checker });
     check(faker.zen.jobDescriptor(), { 'zen.jobDescriptor()': checker });
@@ -681,6 +926,14 @@ export default function () {
     check(faker.call("jobLevel"), { 'call("jobLevel")': checker });
     check(faker.zen.jobTitle(), { 'zen.jobTitle()': checker });
     check(faker.call("jobTitle"), { 'call("jobTitle")': checker });
+    check(faker.zen.kafkaRecord("uuid","any"), { 'zen.kafkaRecord("uuid","any")': checker });
+    check(faker.call("kafkaRecord","uuid","any"), { 'call("kafkaRecord","uuid","any")': checker });
+    check(faker.zen.kebabCase(3), { 'zen.kebabCase(3)': checker });
+    check(faker.call("kebabCase",3), { 'call("kebabCase",3)': checker });
+    check(faker.zen.ksuid(), { 'zen.ksuid()': checker });
+    check(faker.call("ksuid"), { 'call("ksuid")': checker });
+    check(faker.zen.labelSet(3), { 'zen.labelSet(3)': checker });
+    check(faker.call("labelSet",3), { 'call("labelSet",3)': checker });
     check(faker.zen.language(), { 'zen.language()': checker });
     check(faker.call("language"), { 'call("language")': checker });
     check(faker.zen.languageAbbreviation(), { 'zen.languageAbbreviation()': checker });
@@ -693,14 +946,20 @@ export default function () {
     check(faker.call("latitude"), { 'call("latitude")': checker });
     check(faker.zen.latitudeRange(0,90), { 'zen.latitudeRange(0,90)': checker });
     check(faker.call("latitudeRange",0,90), { 'call("latitudeRange",0,90)': checker });
+    check(faker.zen.leaderboardEntry(), { 'zen.leaderboardEntry()': checker });
+    check(faker.call("leaderboardEntry"), { 'call("leaderboardEntry")': checker });
     check(faker.zen.letter(), { 'zen.letter()': checker });
     check(faker.call("letter"), { 'call("letter")': checker });
     check(faker.zen.letterN(3), { 'zen.letterN(3)': checker });
     check(faker.call("letterN",3), { 'call("letterN",3)': checker });
     check(faker.zen.lexify("none"), { 'zen.lexify("none")': checker });
     check(faker.call("lexify","none"), { 'call("lexify","none")': checker });
+    check(faker.zen.licensePlate("US"), { 'zen.licensePlate("US")': checker });
+    check(faker.call("licensePlate","US"), { 'call("licensePlate","US")': checker });
     check(faker.zen.linkingVerb(), { 'zen.linkingVerb()': checker });
     check(faker.call("linkingVerb"), { 'call("linkingVerb")': checker });
+    check(faker.zen.lobbyName(), { 'zen.lobbyName()': checker });
+    check(faker.call("lobbyName"), { 'call("lobbyName")': checker });
     check(faker.zen.logLevel(), { 'zen.logLevel()': checker });
     check(faker.call("logLevel"), { 'call("logLevel")': checker });
     check(faker.zen.longitude(), { 'zen.longitude()': checker });
@@ -717,6 +976,12 @@ export default function () {
     check(faker.call("lunch"), { 'call("lunch")': checker });
     check(faker.zen.macAddress(), { 'zen.macAddress()': checker });
     check(faker.call("macAddress"), { 'call("macAddress")': checker });
+    check(faker.zen.macAddressVendor("any"), { 'zen.macAddressVendor("any")': checker });
+    check(faker.call("macAddressVendor","any"), { 'call("macAddressVendor","any")': checker });
+    check(faker.zen.matchResult(10), { 'zen.matchResult(10)': checker });
+    check(faker.call("matchResult",10), { 'call("matchResult",10)': checker });
+    check(faker.zen.md5("none"), { 'zen.md5("none")': checker });
+    check(faker.call("md5","none"), { 'call("md5","none")': checker });
     check(faker.zen.middleName(), { 'zen.middleName()': checker });
     check(faker.call("middleName"), { 'call("middleName")': checker });
     check(faker.zen.minecraftAnimal(), { 'zen.minecraftAnimal()': checker });
@@ -767,14 +1032,22 @@ export default function () {
     check(faker.call("movieGenre"), { 'call("movieGenre")': checker });
     check(faker.zen.movieName(), { 'zen.movieName()': checker });
     check(faker.call("movieName"), { 'call("movieName")': checker });
+    check(faker.zen.mqttTopic(5,"ourselves"), { 'zen.mqttTopic(5,"ourselves")': checker });
+    check(faker.call("mqttTopic",5,"ourselves"), { 'call("mqttTopic",5,"ourselves")': checker });
     check(faker.zen.name(), { 'zen.name()': checker });
     check(faker.call("name"), { 'call("name")': checker });
     check(faker.zen.namePrefix(), { 'zen.namePrefix()': checker });
     check(faker.call("namePrefix"), { 'call("namePrefix")': checker });
     check(faker.zen.nameSuffix(), { 'zen.nameSuffix()': checker });
     check(faker.call("nameSuffix"), { 'call("nameSuffix")': checker });
+    check(faker.zen.namespace(), { 'zen.namespace()': checker });
+    check(faker.call("namespace"), { 'call("namespace")': checker });
+    check(faker.zen.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"), { 'zen.nanoid(21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")': checker });
+    check(faker.call("nanoid",21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"), { 'call("nanoid",21,"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")': checker });
     check(faker.zen.nanosecond(), { 'zen.nanosecond()': checker });
     check(faker.call("nanosecond"), { 'call("nanosecond")': checker });
+    check(faker.zen.nationalId("US",true,true), { 'zen.nationalId("US",true,true)': checker });
+    check(faker.call("nationalId","US",true,true), { 'call("nationalId","US",true,true)': checker });
     check(faker.zen.niceColors(), { 'zen.niceColors()': checker });
     check(faker.call("niceColors"), { 'call("niceColors")': checker });
     check(faker.zen.noun(), { 'zen.noun()': checker });
@@ -801,18 +1074,38 @@ export default function () {
     check(faker.call("nounProper"), { 'call("nounProper")': checker });
     check(faker.zen.nounUncountable(), { 'zen.nounUncountable()': checker });
     check(faker.call("nounUncountable"), { 'call("nounUncountable")': checker });
+    check(faker.zen.npi(), { 'zen.npi()': checker });
+    check(faker.call("npi"), { 'call("npi")': checker });
     check(faker.zen.number(-2147483648,2147483647), { 'zen.number(-2147483648,2147483647)': checker });
     check(faker.call("number",-2147483648,2147483647), { 'call("number",-2147483648,2147483647)': checker });
     check(faker.zen.numerify("none"), { 'zen.numerify("none")': checker });
     check(faker.call("numerify","none"), { 'call("numerify","none")': checker });
+    check(faker.zen.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600), { 'zen.oauthTokenResponse(["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600)': checker });
+    check(faker.call("oauthTokenResponse",["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600), { 'call("oauthTokenResponse",["none","how","these","keep","trip","congolese","choir","computer","still","far"],3600)': checker });
+    check(faker.zen.ohlcCandle(100,0.01), { 'zen.ohlcCandle(100,0.01)': checker });
+    check(faker.call("ohlcCandle",100,0.01), { 'call("ohlcCandle",100,0.01)': checker });
     check(faker.zen.operaUserAgent(), { 'zen.operaUserAgent()': checker });
     check(faker.call("operaUserAgent"), { 'call("operaUserAgent")': checker });
-    check(faker.zen.paragraph(2,2,5,"\u003cbr /\u003e"), { 'zen.paragraph(2,2,5,"\u003cbr /\u003e")': checker });
-    check(faker.call("paragraph",2,2,5,"\u003cbr /\u003e"), { 'call("paragraph",2,2,5,"\u003cbr /\u003e")': checker });
+    check(faker.zen.order(), { 'zen.order()': checker });
+    check(faker.call("order"), { 'call("order")': checker });
+    check(faker.zen.orderBookSnapshot(5), { 'zen.orderBookSnapshot(5)': checker });
+    check(faker.call("orderBookSnapshot",5), { 'call("orderBookSnapshot",5)': checker });
+    check(faker.zen.organization(), { 'zen.organization()': checker });
+    check(faker.call("organization"), { 'call("organization")': checker });
+    check(faker.zen.paragraph(2,2,5,"\u003cbr /\u003e",0), { 'zen.paragraph(2,2,5,"\u003cbr /\u003e",0)': checker });
+    check(faker.call("paragraph",2,2,5,"\u003cbr /\u003e",0), { 'call("paragraph",2,2,5,"\u003cbr /\u003e",0)': checker });
+    check(faker.zen.passportNumber("US"), { 'zen.passportNumber("US")': checker });
+    check(faker.call("passportNumber","US"), { 'call("passportNumber","US")': checker });
     check(faker.zen.password(true,false,true,true,false,12), { 'zen.password(true,false,true,true,false,12)': checker });
     check(faker.call("password",true,false,true,true,false,12), { 'call("password",true,false,true,true,false,12)': checker });
+    check(faker.zen.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true), { 'zen.passwordPolicy(12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true)': checker });
+    check(faker.call("passwordPolicy",12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true), { 'call("passwordPolicy",12,0,1,1,1,1,"!@#$%^\u0026*-_=+?",true)': checker });
     check(faker.zen.pastTime(), { 'zen.pastTime()': checker });
     check(faker.call("pastTime"), { 'call("pastTime")': checker });
+    check(faker.zen.pathSegment(0.1), { 'zen.pathSegment(0.1)': checker });
+    check(faker.call("pathSegment",0.1), { 'call("pathSegment",0.1)': checker });
+    check(faker.zen.patient(), { 'zen.patient()': checker });
+    check(faker.call("patient"), { 'call("patient")': checker });
     check(faker.zen.person(), { 'zen.person()': checker });
     check(faker.call("person"), { 'call("person")': checker });
     check(faker.zen.petName(), { 'zen.petName()': checker });
@@ -823,6 +1116,16 @@ export default function () {
     check(faker.call("phoneFormatted"), { 'call("phoneFormatted")': checker });
     check(faker.zen.phrase(), { 'zen.phrase()': checker });
     check(faker.call("phrase"), { 'call("phrase")': checker });
+    check(faker.zen.playerTelemetryBatch(10), { 'zen.playerTelemetryBatch(10)': checker });
+    check(faker.call("playerTelemetryBatch",10), { 'call("playerTelemetryBatch",10)': checker });
+    check(faker.zen.pnr(), { 'zen.pnr()': checker });
+    check(faker.call("pnr"), { 'call("pnr")': checker });
+    check(faker.zen.podManifest(1), { 'zen.podManifest(1)': checker });
+    check(faker.call("podManifest",1), { 'call("podManifest",1)': checker });
+    check(faker.zen.portEphemeral(), { 'zen.portEphemeral()': checker });
+    check(faker.call("portEphemeral"), { 'call("portEphemeral")': checker });
+    check(faker.zen.portWellKnown(), { 'zen.portWellKnown()': checker });
+    check(faker.call("portWellKnown"), { 'call("portWellKnown")': checker });
     check(faker.zen.possessiveAdjective(), { 'zen.possessiveAdjective()': checker });
     check(faker.call("possessiveAdjective"), { 'call("possessiveAdjective")': checker });
     check(faker.zen.preposition(), { 'zen.preposition()': checker });
@@ -873,8 +1176,12 @@ export default function () {
     check(faker.call("pronounRelative"), { 'call("pronounRelative")': checker });
     check(faker.zen.properAdjective(), { 'zen.properAdjective()': checker });
     check(faker.call("properAdjective"), { 'call("properAdjective")': checker });
+    check(faker.zen.qrContent("any"), { 'zen.qrContent("any")': checker });
+    check(faker.call("qrContent","any"), { 'call("qrContent","any")': checker });
     check(faker.zen.quantitativeAdjective(), { 'zen.quantitativeAdjective()': checker });
     check(faker.call("quantitativeAdjective"), { 'call("quantitativeAdjective")': checker });
+    check(faker.zen.queryString(0.1), { 'zen.queryString(0.1)': checker });
+    check(faker.call("queryString",0.1), { 'call("queryString",0.1)': checker });
     check(faker.zen.question(), { 'zen.question()': checker });
     check(faker.call("question"), { 'call("question")': checker });
     check(faker.zen.quote(), { 'zen.quote()': checker });
@@ -885,6 +1192,12 @@ export default function () {
     check(faker.call("randomString",["none","how","these","keep","trip","congolese","choir","computer","still","far"]), { 'call("randomString",["none","how","these","keep","trip","congolese","choir","computer","still","far"])': checker });
     check(faker.zen.randomUint([14,8,13]), { 'zen.randomUint([14,8,13])': checker });
     check(faker.call("randomUint",[14,8,13]), { 'call("randomUint",[14,8,13])': checker });
+    check(faker.zen.recentTimestamp("24h"), { 'zen.recentTimestamp("24h")': checker });
+    check(faker.call("recentTimestamp","24h"), { 'call("recentTimestamp","24h")': checker });
+    check(faker.zen.resourceName(), { 'zen.resourceName()': checker });
+    check(faker.call("resourceName"), { 'call("resourceName")': checker });
+    check(faker.zen.review("any",0,"en"), { 'zen.review("any",0,"en")': checker });
+    check(faker.call("review","any",0,"en"), { 'call("review","any",0,"en")': checker });
     check(faker.zen.rgbColor(), { 'zen.rgbColor()': checker });
     check(faker.call("rgbColor"), { 'call("rgbColor")': checker });
     check(faker.zen.runtimeError(), { 'zen.runtimeError()': checker });
@@ -897,18 +1210,38 @@ export default function () {
     check(faker.call("school"), { 'call("school")': checker });
     check(faker.zen.second(), { 'zen.second()': checker });
     check(faker.call("second"), { 'call("second")': checker });
-    check(faker.zen.sentence(5), { 'zen.sentence(5)': checker });
-    check(faker.call("sentence",5), { 'call("sentence",5)': checker });
+    check(faker.zen.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa"), { 'zen.selfSignedCert("any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa")': checker });
+    check(faker.call("selfSignedCert","any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa"), { 'call("selfSignedCert","any",["how","these","keep","trip","congolese","choir","computer","still","far","unless"],"365d","ecdsa")': checker });
+    check(faker.zen.sentence(5,0), { 'zen.sentence(5,0)': checker });
+    check(faker.call("sentence",5,0), { 'call("sentence",5,0)': checker });
+    check(faker.zen.serverStatusJson(), { 'zen.serverStatusJson()': checker });
+    check(faker.call("serverStatusJson"), { 'call("serverStatusJson")': checker });
+    check(faker.zen.sha1("none"), { 'zen.sha1("none")': checker });
+    check(faker.call("sha1","none"), { 'call("sha1","none")': checker });
+    check(faker.zen.sha256("none"), { 'zen.sha256("none")': checker });
+    check(faker.call("sha256","none"), { 'call("sha256","none")': checker });
+    check(faker.zen.shipment("any"), { 'zen.shipment("any")': checker });
+    check(faker.call("shipment","any"), { 'call("shipment","any")': checker });
     check(faker.zen.shuffleInts([14,8,13]), { 'zen.shuffleInts([14,8,13])': checker });
     check(faker.call("shuffleInts",[14,8,13]), { 'call("shuffleInts",[14,8,13])': checker });
     check(faker.zen.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"]), { 'zen.shuffleStrings(["none","how","these","keep","trip","congolese","choir","computer","still","far"])': checker });
     check(faker.call("shuffleStrings",["none","how","these","keep","trip","congolese","choir","computer","still","far"]), { 'call("shuffleStrings",["none","how","these","keep","trip","congolese","choir","computer","still","far"])': checker });
     check(faker.zen.simpleSentence(), { 'zen.simpleSentence()': checker });
     check(faker.call("simpleSentence"), { 'call("simpleSentence")': checker });
+    check(faker.zen.sku("???-#####"), { 'zen.sku("???-#####")': checker });
+    check(faker.call("sku","???-#####"), { 'call("sku","???-#####")': checker });
     check(faker.zen.slogan(), { 'zen.slogan()': checker });
     check(faker.call("slogan"), { 'call("slogan")': checker });
+    check(faker.zen.slug(3), { 'zen.slug(3)': checker });
+    check(faker.call("slug",3), { 'call("slug",3)': checker });
     check(faker.zen.snack(), { 'zen.snack()': checker });
     check(faker.call("snack"), { 'call("snack")': checker });
+    check(faker.zen.snakeCase(3), { 'zen.snakeCase(3)': checker });
+    check(faker.call("snakeCase",3), { 'call("snakeCase",3)': checker });
+    check(faker.zen.soonTimestamp("24h"), { 'zen.soonTimestamp("24h")': checker });
+    check(faker.call("soonTimestamp","24h"), { 'call("soonTimestamp","24h")': checker });
+    check(faker.zen.sshKeyPair("ed25519"), { 'zen.sshKeyPair("ed25519")': checker });
+    check(faker.call("sshKeyPair","ed25519"), { 'call("sshKeyPair","ed25519")': checker });
     check(faker.zen.ssn(), { 'zen.ssn()': checker });
     check(faker.call("ssn"), { 'call("ssn")': checker });
     check(faker.zen.state(), { 'zen.state()': checker });
@@ -927,6 +1260,14 @@ export default function () {
     check(faker.call("streetSuffix"), { 'call("streetSuffix")': checker });
     check(faker.zen.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"]), { 'zen.teams(["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"])': checker });
     check(faker.call("teams",["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"]), { 'call("teams",["none","how","these","keep","trip","congolese","choir","computer","still","far"],["unless","army","party","riches","theirs","instead","here","mine","whichever","that"])': checker });
+    check(faker.zen.telemetryBatch(10,"temperature","meanReverting"), { 'zen.telemetryBatch(10,"temperature","meanReverting")': checker });
+    check(faker.call("telemetryBatch",10,"temperature","meanReverting"), { 'call("telemetryBatch",10,"temperature","meanReverting")': checker });
+    check(faker.zen.textOfLength(100), { 'zen.textOfLength(100)': checker });
+    check(faker.call("textOfLength",100), { 'call("textOfLength",100)': checker });
+    check(faker.zen.tickerQuote("any"), { 'zen.tickerQuote("any")': checker });
+    check(faker.call("tickerQuote","any"), { 'call("tickerQuote","any")': checker });
+    check(faker.zen.timeRange("now","30d","1h","7d"), { 'zen.timeRange("now","30d","1h","7d")': checker });
+    check(faker.call("timeRange","now","30d","1h","7d"), { 'call("timeRange","now","30d","1h","7d")': checker });
     check(faker.zen.timezone(), { 'zen.timezone()': checker });
     check(faker.call("timezone"), { 'call("timezone")': checker });
     check(faker.zen.timezoneAbbreviation(), { 'zen.timezoneAbbreviation()': checker });
@@ -937,6 +1278,8 @@ export default function () {
     check(faker.call("timezoneOffset"), { 'call("timezoneOffset")': checker });
     check(faker.zen.timezoneRegion(), { 'zen.timezoneRegion()': checker });
     check(faker.call("timezoneRegion"), { 'call("timezoneRegion")': checker });
+    check(faker.zen.trackingNumber("any"), { 'zen.trackingNumber("any")': checker });
+    check(faker.call("trackingNumber","any"), { 'call("trackingNumber","any")': checker });
     check(faker.zen.transitiveVerb(), { 'zen.transitiveVerb()': checker });
     check(faker.call("transitiveVerb"), { 'call("transitiveVerb")': checker });
     check(faker.zen.uint16(), { 'zen.uint16()': checker });
@@ -949,29 +1292,49 @@ export default function () {
     check(faker.call("uint8"), { 'call("uint8")': checker });
     check(faker.zen.uintRange(0,4294967295), { 'zen.uintRange(0,4294967295)': checker });
     check(faker.call("uintRange",0,4294967295), { 'call("uintRange",0,4294967295)': checker });
+    check(faker.zen.ulid(), { 'zen.ulid()': checker });
+    check(faker.call("ulid"), { 'call("ulid")': checker });
+    check(faker.zen.unicode("mixed",10), { 'zen.unicode("mixed",10)': checker });
+    check(faker.call("unicode","mixed",10), { 'call("unicode","mixed",10)': checker });
+    check(faker.zen.upcA(), { 'zen.upcA()': checker });
+    check(faker.call("upcA"), { 'call("upcA")': checker });
     check(faker.zen.url(), { 'zen.url()': checker });
     check(faker.call("url"), { 'call("url")': checker });
+    check(faker.zen.urlWithin("https://test.example.com",3,"none",2), { 'zen.urlWithin("https://test.example.com",3,"none",2)': checker });
+    check(faker.call("urlWithin","https://test.example.com",3,"none",2), { 'call("urlWithin","https://test.example.com",3,"none",2)': checker });
     check(faker.zen.userAgent(), { 'zen.userAgent()': checker });
     check(faker.call("userAgent"), { 'call("userAgent")': checker });
     check(faker.zen.username(), { 'zen.username()': checker });
     check(faker.call("username"), { 'call("username")': checker });
+    check(faker.zen.usernamePolicy("a-z0-9._",3,16), { 'zen.usernamePolicy("a-z0-9._",3,16)': checker });
+    check(faker.call("usernamePolicy","a-z0-9._",3,16), { 'call("usernamePolicy","a-z0-9._",3,16)': checker });
     check(faker.zen.uuid(), { 'zen.uuid()': checker });
     check(faker.call("uuid"), { 'call("uuid")': checker });
+    check(faker.zen.uuidV7(), { 'zen.uuidV7()': checker });
+    check(faker.call("uuidV7"), { 'call("uuidV7")': checker });
     check(faker.zen.validationError(), { 'zen.validationError()': checker });
     check(faker.call("validationError"), { 'call("validationError")': checker });
+    check(faker.zen.vatNumber("any"), { 'zen.vatNumber("any")': checker });
+    check(faker.call("vatNumber","any"), { 'call("vatNumber","any")': checker });
     check(faker.zen.vegetable(), { 'zen.vegetable()': checker });
     check(faker.call("vegetable"), { 'call("vegetable")': checker });
     check(faker.zen.verb(), { 'zen.verb()': checker });
     check(faker.call("verb"), { 'call("verb")': checker });
     check(faker.zen.verbPhrase(), { 'zen.verbPhrase()': checker });
     check(faker.call("verbPhrase"), { 'call("verbPhrase")': checker });
+    check(faker.zen.vin(), { 'zen.vin()': checker });
+    check(faker.call("vin"), { 'call("vin")': checker });
     check(faker.zen.weekday(), { 'zen.weekday()': checker });
     check(faker.call("weekday"), { 'call("weekday")': checker });
+    check(faker.zen.withinBusinessHours("UTC"), { 'zen.withinBusinessHours("UTC")': checker });
+    check(faker.call("withinBusinessHours","UTC"), { 'call("withinBusinessHours","UTC")': checker });
     check(faker.zen.word(), { 'zen.word()': checker });
     check(faker.call("word"), { 'call("word")': checker });
     check(faker.zen.year(), { 'zen.year()': checker });
     check(faker.call("year"), { 'call("year")': checker });
     check(faker.zen.zip(), { 'zen.zip()': checker });
     check(faker.call("zip"), { 'call("zip")': checker });
+    check(faker.zen.zoneFile(10), { 'zen.zoneFile(10)': checker });
+    check(faker.call("zoneFile",10), { 'call("zoneFile",10)': checker });
   });
 };
@@ -5,12 +5,29 @@ package main
 import (
 	"encoding/json"
 	"io"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/grafana/xk6-faker/faker"
 )
 
+// jsonInfo is the lookup metadata of a function extended with the personally identifiable information class.
+type jsonInfo struct {
+	*gofakeit.Info
+
+	PII string `json:"pii,omitempty"`
+}
+
 func jsonGen(out io.Writer) error {
 	encoder := json.NewEncoder(out)
 
 	encoder.SetIndent("", "  ")
 
-	return encoder.Encode(getFuncLookups())
+	lookups := getFuncLookups()
+	all := make(map[string]*jsonInfo, len(lookups))
+
+	for name, info := range lookups {
+		all[name] = &jsonInfo{Info: info, PII: faker.GetPIIClasses()[name]}
+	}
+
+	return encoder.Encode(all)
 }
@@ -63,12 +63,20 @@ func genParams(info *gofakeit.Info) (string, error) {
 			val = nil
 		}
 
+		if param.Type == "string[]" && len(param.Options) != 0 {
+			val = param.Options[:1]
+		}
+
+		if param.Type == "number[]" && param.Optional { // e.g. coordinates, which are random if missing
+			val = []any{}
+		}
+
 		if param.Type == "string" && len(param.Default) != 0 {
 			val = param.Default
 		}
 
 		if param.Type == "number" && len(param.Default) != 0 {
-			if v, e := strconv.Atoi(param.Default); e == nil {
+			if v, e := strconv.ParseFloat(param.Default, 64); e == nil {
 				val = v
 			}
 		}
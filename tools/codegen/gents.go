@@ -7,6 +7,9 @@ import (
 	_ "embed"
 	"fmt"
 	"io"
+	"math/big"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -26,6 +29,862 @@ declare const faker: Faker;
 /** Default Faker instance */
 export default faker;
 
+/**
+ * Options of the Faker instance.
+ */
+export declare interface FakerOptions {
+  /**
+   * Random seed value, used only if the options object is the first constructor parameter.
+   */
+  seed?: number;
+  /**
+   * Scope of the random seed.
+   *
+   * - "instance": the same random sequence is used for the whole lifetime of the instance (default)
+   * - "vu": the VU ID is mixed into the seed
+   * - "iteration": the scenario name, the VU ID and the iteration number are mixed into the seed
+   *
+   * Outside of the VU context (e.g. in the init context) the seed of the instance is used.
+   */
+  seedScope?: "instance" | "vu" | "iteration";
+  /**
+   * Random source of the instance.
+   *
+   * - "frand": fast ChaCha12 based source (default)
+   * - "pcg64": PCG-DXSM generator with 128 bits of state, initialized like NewPCG(0, seed) of Go math/rand/v2,
+   *   so the sequence can be reproduced by other PCG-DXSM implementations
+   * - "xoshiro256": xoshiro256** generator, the state is initialized by splitmix64 seeded with the seed
+   * - "crypto": cryptographically secure source of the operating system, the seed and the state are ignored,
+   *   so the generated data is unpredictable and not reproducible
+   */
+  rng?: "frand" | "pcg64" | "xoshiro256" | "crypto";
+  /**
+   * Return the time values of the generator functions (e.g. pastTime, futureTime)
+   * as RFC 3339 strings in UTC instead of Go time values.
+   */
+  rfc3339?: boolean;
+  /**
+   * Default values of the generator function parameters, used when the parameter is not passed.
+   *
+   * The keys are parameter names (e.g. length), optionally prefixed with the function name
+   * (e.g. "nationalId.country"). The function specific value takes precedence over the generic one,
+   * and both take precedence over the built-in default of the parameter.
+   */
+  defaults?: Record<string, unknown>;
+  /**
+   * Emit the faker_calls (counter) and faker_generation_time (trend) k6 metrics,
+   * tagged by the name of the generator function (function tag).
+   *
+   * The metrics show when data generation dominates the iteration time and which generators are hot.
+   * The option can only be used in k6 scripts.
+   */
+  metrics?: boolean;
+  /**
+   * Generate only reserved or documentation identifiers, which never belong to real people or systems.
+   *
+   * In safe mode email, disposableEmail, domainName, domainSuffix and url use RFC 2606 domains
+   * (example.com, .test, .example), ipv4Address and ipv6Address use 192.0.2.0/24 and 2001:db8::/32,
+   * phone and phoneFormatted use the 555-0100 - 555-0199 range, creditCard, creditCardNumber and
+   * creditCardNumberFormatted use well-known test card numbers. ssn and nationalId use the never issued fake
+   * numbers (see the fake parameter of nationalId), ein uses never assigned prefixes and vatNumber wrong
+   * check digits. The contact, image, SSN and credit card of person are replaced the same way, and so are
+   * the email addresses, domain names, URLs, phone numbers, EINs and IP addresses of the composite generators
+   * (e.g. organization, order, cookie, dnsRecord) and of the responses of serve. The networks of cidr and
+   * ipInCidr are restricted to the documentation ranges.
+   */
+  safe?: boolean;
+  /**
+   * Pinned version of the deterministic sequence algorithm (see {@link Faker.sequenceHash}).
+   *
+   * The constructor throws a TypeError if this release of the extension can't reproduce the sequence
+   * of the version, for example because the embedded generators consume random numbers in a different order,
+   * so seeded data doesn't change silently after an upgrade. The current version is 1.
+   *
+   * The option is an assertion, it doesn't select the algorithm: a release implements only the current version,
+   * so pinning it doesn't change the generated data.
+   */
+  sequenceVersion?: number;
+  /**
+   * Budget of a bulk generation call (e.g. many, collections, cardinality, scenarioData) in bytes.
+   *
+   * The size of the generated values is measured by their JSON representation. The call throws
+   * a FakerArgumentError as soon as the values exceed the budget, or when the estimated size of all values
+   * exceeds it after generating the first ones, so too large data sets don't exhaust the memory of the load generator.
+   */
+  maxTotalBytes?: number;
+  /**
+   * Number of worker goroutines of the many bulk generation calls, true for one worker per CPU (GOMAXPROCS).
+   *
+   * The values are generated in fixed size chunks, each chunk seeded from the instance seed and its index,
+   * and returned in order, so the result is reproducible regardless of the number of workers
+   * (but differs from the sequentially generated values). Sequential generation is the default.
+   */
+  parallel?: boolean | number;
+}
+
+/**
+ * Field specification of a schema.
+ *
+ * It is the name of the generator function, an array containing the name of the generator function
+ * and its parameters, an object with func and args properties, an object with ref property referencing
+ * a stored entity (with optional fallback generator function), an object with oneOf property listing
+ * alternative field specifications (picked proportionally to the optional weights), or a nested schema.
+ * The objects with func, ref or oneOf property accept the modifiers of {@link FieldModifiers}.
+ */
+export declare type FieldSpec =
+  | string
+  | [string, ...unknown[]]
+  | ({ func: string; args?: unknown[] } & FieldModifiers)
+  | ({ ref: string; func?: string; args?: unknown[] } & FieldModifiers)
+  | ({ oneOf: FieldSpec[]; weights?: number[] } & FieldModifiers)
+  | Schema;
+
+/**
+ * Modifiers of a field specification, so the generated records include realistic null and absent fields.
+ */
+export declare interface FieldModifiers {
+  /** Probability of a null value, between 0 and 1 (0 by default). */
+  nullable?: number;
+  /** Probability of an absent field, between 0 and 1 (0 by default). */
+  missing?: number;
+}
+
+/**
+ * Data generation schema, the property values are field specifications.
+ */
+export declare interface Schema {
+  [field: string]: FieldSpec;
+}
+
+/**
+ * Validation rule specification.
+ *
+ * It is the name of a built-in rule, an object with rule property and options,
+ * a custom rule function or an array of rule specifications.
+ */
+export declare type RuleSpec =
+  | string
+  | { rule: string; min?: string | Date; max?: string | Date }
+  | ((value: unknown) => boolean | string)
+  | RuleSpec[];
+
+/**
+ * Validation rules, a rule specification or an object whose property values are rules of the properties.
+ */
+export declare type Rules = RuleSpec | { [field: string]: Rules };
+
+/**
+ * Value which does not satisfy a validation rule.
+ */
+export declare interface Violation {
+  /** The path of the value (e.g. "[3].email"), empty for the validated value itself. */
+  readonly path: string;
+  /** The name of the violated rule. */
+  readonly rule: string;
+  /** The invalid value. */
+  readonly value: unknown;
+  /** The description of the violation. */
+  readonly message: string;
+}
+
+/**
+ * Person returned by person().
+ */
+export declare interface PersonInfo {
+  firstName: string;
+  lastName: string;
+  gender: string;
+  ssn: string;
+  image: string;
+  hobby: string;
+  job: JobInfo;
+  address: AddressInfo;
+  contact: ContactInfo;
+  creditCard: CreditCardInfo;
+}
+
+/**
+ * Address returned by address().
+ */
+export declare interface AddressInfo {
+  address: string;
+  street: string;
+  city: string;
+  state: string;
+  zip: string;
+  country: string;
+  latitude: number;
+  longitude: number;
+}
+
+/**
+ * Contact details of a person.
+ */
+export declare interface ContactInfo {
+  phone: string;
+  email: string;
+}
+
+/**
+ * Credit card returned by creditCard().
+ */
+export declare interface CreditCardInfo {
+  type: string;
+  number: string;
+  exp: string;
+  cvv: string;
+}
+
+/**
+ * Job returned by job().
+ */
+export declare interface JobInfo {
+  company: string;
+  title: string;
+  descriptor: string;
+  level: string;
+}
+
+/**
+ * Movie returned by movie().
+ */
+export declare interface MovieInfo {
+  name: string;
+  genre: string;
+}
+
+/**
+ * Book returned by book().
+ */
+export declare interface BookInfo {
+  title: string;
+  author: string;
+  genre: string;
+}
+
+/**
+ * File part of a multipart form.
+ */
+export declare interface MultipartFile {
+  /** The filename, the field name followed by .bin by default. */
+  filename?: string;
+  /** The content type, application/octet-stream by default. */
+  contentType?: string;
+  /** The size of the random content in bytes, 1024 by default. */
+  size?: number;
+  /** The content of the file, random bytes if missing. */
+  content?: string | ArrayBuffer;
+}
+
+/**
+ * Generated multipart/form-data body.
+ */
+export declare interface MultipartForm {
+  /** The encoded body. */
+  readonly body: ArrayBuffer;
+  /** The boundary of the parts. */
+  readonly boundary: string;
+  /** The Content-Type header value containing the boundary. */
+  readonly contentType: string;
+}
+
+/**
+ * Pre-generated data rows of a scenario.
+ */
+export declare interface ScenarioData {
+  /** The number of rows. */
+  readonly length: number;
+  /** Returns the row of the current scenario iteration. */
+  next(): Record<string, unknown>;
+}
+
+/**
+ * Options of the Markov chain text generator. Only one of builtin, corpus and corpusPath can be set.
+ */
+export declare interface MarkovOptions {
+  /** Built-in corpus, news by default. */
+  builtin?: "news" | "reviews";
+  /** Text of the corpus. */
+  corpus?: string;
+  /** Path of the corpus file, relative to the script. */
+  corpusPath?: string;
+  /** Number of words, 50 by default. */
+  words?: number;
+  /** Number of words the next word depends on (1-3), 2 by default. */
+  order?: number;
+}
+
+/**
+ * Options of the user journey sampler.
+ */
+export declare interface JourneyOptions {
+  /** The states, by default the states appearing in the transitions. */
+  states?: string[];
+  /** The weights of the target states by state. */
+  transitions: Record<string, Record<string, number>>;
+  /** The initial state, by default the first state. */
+  start?: string;
+}
+
+/**
+ * Sampler walking the weighted state machine of a user journey.
+ */
+export declare interface Journey {
+  /** The states of the state machine. */
+  readonly states: string[];
+  /** Returns the next state, restarting at the start state after a terminal state. */
+  next(): string;
+  /** Returns a journey from the start state to a terminal state, at most maxSteps (default 100) states long. */
+  walk(maxSteps?: number): string[];
+  /** Restarts the walk of next() at the start state. */
+  reset(): void;
+}
+
+/**
+ * Options of the cardinality controlled generator.
+ */
+export declare interface CardinalityOptions {
+  /** Order of picking the values, round-robin by default. */
+  mode?: "round-robin" | "random";
+  /** Parameters passed to the generator function. */
+  args?: unknown[];
+}
+
+/**
+ * Generator picking among a fixed set of distinct pre-generated values.
+ */
+export declare interface Cardinality<T = unknown> {
+  /** The number of distinct values. */
+  readonly length: number;
+  /** The pre-generated values. */
+  readonly values: T[];
+  /** Returns the next value. */
+  next(): T;
+}
+
+/**
+ * Custom datasets, the values are arrays of strings or JSON array texts.
+ */
+export declare interface Datasets {
+  /** First names used by firstName, name and person. */
+  firstNames?: string[] | string;
+  /** Middle names used by middleName. */
+  middleNames?: string[] | string;
+  /** Last names used by lastName, name and person. */
+  lastNames?: string[] | string;
+  /** Hobbies used by hobby and person. */
+  hobbies?: string[] | string;
+  /** Cities used by city, address and person. */
+  cities?: string[] | string;
+  /** States used by state, address and person. */
+  states?: string[] | string;
+  /** Countries used by country, address and person. */
+  countries?: string[] | string;
+  /** Company names used by company, job and person. */
+  companies?: string[] | string;
+  /** Job titles used by jobTitle, job and person. */
+  jobTitles?: string[] | string;
+  /** Product names used by productName and product. */
+  products?: string[] | string;
+  /** Product categories used by productCategory and product. */
+  productCategories?: string[] | string;
+}
+
+/**
+ * Options of loading custom datasets.
+ */
+export declare interface LoadDataOptions {
+  /** Replace the embedded values (default) or extend them with the custom values. */
+  mode?: "replace" | "extend";
+}
+
+/**
+ * Options of the dataset file sampling generator.
+ */
+export declare interface FromFileOptions {
+  /** Name of the column (or property) to be returned instead of the whole row. */
+  column?: string;
+  /** Name of the column (or property) containing the non-negative weight of the row. */
+  weighted?: string;
+}
+
+/**
+ * Options of the payload mutation.
+ */
+export declare interface MutateOptions {
+  /** Applicable mutation operators (default all). */
+  operators?: Array<"bitFlip" | "truncate" | "overlong" | "null" | "boundary" | "injection">;
+}
+
+/**
+ * Mutated copy of a seed payload.
+ */
+export declare interface Mutation {
+  /** The mutated payload. */
+  data: unknown;
+  /** The applied mutation operator. */
+  operator: string;
+  /** Dotted path of the mutated value, empty if the payload itself was mutated. */
+  path: string;
+}
+
+/**
+ * Options of the sensor reading.
+ */
+export declare interface SensorReadingOptions {
+  /** Type of the sensor (default temperature). */
+  type?: "co2" | "humidity" | "light" | "pressure" | "temperature" | "voltage";
+  /** Drift model of the successive readings (default meanReverting). */
+  driftModel?: "meanReverting" | "randomWalk";
+  /** Identifier of the sensor, sensors of the same type with different identifiers drift independently. */
+  id?: string;
+}
+
+/**
+ * Reading of a sensor.
+ */
+export declare interface SensorReading {
+  /** Identifier of the sensor, present if given in the options. */
+  id?: string;
+  /** Type of the sensor. */
+  type: string;
+  /** Value of the reading. */
+  value: number;
+  /** SenML unit of the value. */
+  unit: string;
+  /** Time of the reading in RFC 3339 format. */
+  timestamp: string;
+}
+
+/**
+ * Options of the message stream.
+ */
+export declare interface StreamOptions {
+  /** Number of messages per second (default 1). */
+  ratePerSecond?: number;
+  /** Randomization of the delays as the fraction of the interval, between 0 and 1 (default 0). */
+  jitter?: number;
+}
+
+/**
+ * Message of the message stream and its delay.
+ */
+export declare interface StreamItem {
+  /** Delay after the previous message in milliseconds. */
+  delay: number;
+  /** The generated message. */
+  message: Record<string, unknown>;
+}
+
+/**
+ * Source of timed fake messages.
+ */
+export declare interface MessageStream extends IterableIterator<StreamItem> {
+  /** Calls the callback with the messages at the generated delays (using setTimeout) until stopped. */
+  start(callback: (message: Record<string, unknown>) => void): void;
+  /** Stops the scheduling of the messages. */
+  stop(): void;
+}
+
+/**
+ * Options of the change events.
+ */
+export declare interface ChangesOptions {
+  /** Number of insert events (default 0). */
+  inserts?: number;
+  /** Number of update events (default 0). */
+  updates?: number;
+  /** Number of delete events (default 0). */
+  deletes?: number;
+  /** Name of the key field of the records (default "id"). */
+  key?: string;
+  /** Schema of the inserted records and the updated fields, by default they are taken from the dataset. */
+  schema?: Schema;
+}
+
+/**
+ * CRUD mutation event of a dataset.
+ */
+export declare interface ChangeEvent {
+  /** Sequence number of the event, starting from 1. */
+  sequence: number;
+  /** The operation of the event. */
+  op: "insert" | "update" | "delete";
+  /** Key of the changed record. */
+  key: unknown;
+  /** The record before the event, null for inserts. */
+  before: Record<string, unknown> | null;
+  /** The record after the event, null for deletes. */
+  after: Record<string, unknown> | null;
+  /** Names of the changed fields of updates. */
+  changed?: string[];
+}
+
+/**
+ * Source of the CRUD mutation events of a dataset.
+ */
+export declare interface ChangeStream extends IterableIterator<ChangeEvent> {
+  /** Total number of events. */
+  readonly length: number;
+  /** Returns the records after the events so far. */
+  state(): Record<string, unknown>[];
+}
+
+/**
+ * Request body generator of an OpenAPI 3 specification.
+ */
+export declare interface OpenAPI {
+  /** The operations of the specification (e.g. "POST /users"). */
+  readonly operations: string[];
+  /**
+   * Generates a JSON request body of the operation, valid according to its schema.
+   *
+   * @param operation the method and the path of the operation as listed in the specification (e.g. "POST /users")
+   * @returns the request body
+   */
+  requestBody<T = unknown>(operation: string): T;
+}
+
+/**
+ * Route of the embedded mock server.
+ */
+export declare interface MockRoute {
+  /** Schema of the response objects. */
+  schema: Schema;
+  /** HTTP status code of the response (default 200). */
+  status?: number;
+  /** The response is an array of count objects instead of a single object. */
+  count?: number;
+}
+
+/**
+ * Options of the {@link Faker.fromSchema} method.
+ */
+export declare interface FromSchemaOptions {
+  /** The kind of the intentional violation. */
+  invalid: "missingRequired" | "wrongType" | "outOfRange";
+}
+
+/**
+ * Description of the intentional violation of a generated object.
+ */
+export declare interface Violation {
+  /** The broken rule (missingRequired, wrongType or outOfRange). */
+  rule: "missingRequired" | "wrongType" | "outOfRange";
+  /** The dotted path of the field (e.g. address.zip). */
+  field: string;
+  /** The expected value: present, the JavaScript type or the range (e.g. 1..10). */
+  expected: string;
+  /** The actual value: missing, the JavaScript type or the out of range value. */
+  actual: string | number;
+}
+
+/**
+ * Object generated with an intentional violation.
+ */
+export declare interface InvalidRecord {
+  /** The generated object. */
+  data: Record<string, unknown>;
+  /** The description of the violation. */
+  violation: Violation;
+}
+
+/**
+ * Precompiled schema returned by {@link Faker.compile}.
+ */
+export declare interface CompiledSchema {
+  /**
+   * Generate an object based on the schema (like {@link Faker.fromSchema}).
+   *
+   * @returns the generated object
+   */
+  one(): Record<string, unknown>;
+  /**
+   * Generate multiple objects based on the schema.
+   *
+   * @param count the number of objects
+   * @returns the generated objects
+   */
+  many(count: number): Record<string, unknown>[];
+}
+
+/**
+ * Deprecated function or category name returned by {@link Faker.aliases}.
+ */
+export declare interface Alias {
+  /** The name to be used instead. */
+  replacement: string;
+  /** The release in which the name was deprecated. */
+  since: string;
+  /** The release in which the name will be removed. */
+  removal: string;
+}
+
+/**
+ * Deprecated names returned by {@link Faker.aliases}.
+ */
+export declare interface Aliases {
+  /** The deprecated function names. */
+  functions: Record<string, Alias>;
+  /** The deprecated category names. */
+  categories: Record<string, Alias>;
+}
+
+/**
+ * Embedded mock server started by {@link Faker.serve}.
+ */
+export declare interface MockServer {
+  /** Base URL of the server (e.g. http://127.0.0.1:8080). */
+  readonly url: string;
+  /** Stops the server before the end of the test run. */
+  close(): void;
+}
+
+/**
+ * Generator sampling the rows of a dataset file.
+ */
+export declare interface Sample<T = unknown> {
+  /** The number of rows. */
+  readonly length: number;
+  /** Returns a random row. */
+  next(): T;
+}
+
+/**
+ * Parameter of a generator function.
+ */
+export declare interface ParameterDescription {
+  /** Name of the parameter. */
+  name: string;
+  /** Type of the parameter. */
+  type: string;
+  /** The parameter can be omitted. */
+  optional: boolean;
+  /** Description of the parameter. */
+  description: string;
+  /** Default value of the parameter. */
+  default?: string;
+  /** Accepted values of the parameter. */
+  options?: string[];
+}
+
+/**
+ * Description of a generator function.
+ */
+export declare interface FunctionDescription {
+  /** Name of the function. */
+  name: string;
+  /** Category of the function. */
+  category: string;
+  /** Description of the function. */
+  description: string;
+  /** Example output of the function. */
+  example: string;
+  /** Output type of the function. */
+  output: string;
+  /** Parameters of the function. */
+  params: ParameterDescription[];
+  /** Personally identifiable information class of the generated values (e.g. name, email, ssn). */
+  pii: string | null;
+}
+
+/**
+ * Options of the binary encoders.
+ */
+export declare interface EncodeOptions {
+  /** Schema registry ID, the data is prefixed with the wire format header if set. */
+  schemaId?: number;
+}
+
+/**
+ * Options of the barcode image.
+ */
+export declare interface BarcodeOptions {
+  /** Symbology of the barcode, detected from the code by default. */
+  symbology?: "auto" | "ean13" | "upca" | "code128" | "gs1128";
+  /** Width of the narrowest bar in pixels (default 2). */
+  moduleWidth?: number;
+  /** Height of the image in pixels (default 80). */
+  height?: number;
+}
+
+/**
+ * Options of the SenML encoder.
+ */
+export declare interface SenMLOptions {
+  /** Format of the pack, cbor (application/senml+cbor, the default) or json (application/senml+json). */
+  format?: "cbor" | "json";
+  /** Base name of the records, added to the first record. */
+  baseName?: string;
+}
+
+/**
+ * Binary encoders of records.
+ */
+export declare interface Encode {
+  /**
+   * Encode a record in Avro binary format.
+   *
+   * @param schema the Avro schema (JSON string or object)
+   * @param record the record to be encoded, a random record of the schema if missing
+   * @param options encoding options
+   * @returns the encoded data
+   */
+  avro(
+    schema: string | Record<string, unknown>,
+    record?: Record<string, unknown> | null,
+    options?: EncodeOptions
+  ): ArrayBuffer;
+
+  /**
+   * Encode a record in protobuf binary format.
+   *
+   * @param descriptor the .proto definition of the message
+   * @param record the record to be encoded
+   * @param options encoding options, message is the name of the message type (the first one by default)
+   * @returns the encoded data
+   */
+  protobuf(
+    descriptor: string,
+    record: Record<string, unknown>,
+    options?: EncodeOptions & { message?: string }
+  ): ArrayBuffer;
+
+  /**
+   * Encode a value in CBOR format (RFC 8949), the object keys are sorted in deterministic order.
+   *
+   * @param value the value to be encoded
+   * @returns the encoded data
+   */
+  cbor(value: unknown): ArrayBuffer;
+
+  /**
+   * Encode records as SenML pack (RFC 8428), the payload format of many MQTT and LwM2M device platforms.
+   * Besides the SenML fields (n, u, v, vs, vb, vd, t, ...) the properties of sensor readings are accepted:
+   * type, unit, value and timestamp, the id or deviceId property is prepended to the name.
+   *
+   * @param records the records (or a single record), e.g. readings of iot.sensorReading or iot.telemetryBatch
+   * @param options encoding options
+   * @returns the encoded data
+   */
+  senml(records: Record<string, unknown> | Record<string, unknown>[], options?: SenMLOptions): ArrayBuffer;
+}
+
+/**
+ * Helpers filling the forms of k6/browser pages.
+ */
+export declare interface Forms {
+  /**
+   * Fills the form of the page with coherent fake data: the name, the email, the address
+   * and the other values belong to the same person.
+   *
+   * The mapping is a schema keyed by locator selector, the string field specifications naming a
+   * person field (firstName, lastName, name, email, username, password, phone, street, city, state,
+   * zip, country, company, jobTitle or birthdate) use the coherent value. Without mapping the purpose
+   * of the input and textarea fields is detected by their autocomplete, type, name and id attributes,
+   * the fields of unknown purpose are left empty.
+   *
+   * @param page the k6/browser page
+   * @param mapping the field specifications by locator selector
+   * @returns a promise of the filled values by selector (or by field name if detected)
+   */
+  fill(page: object, mapping?: Schema): Promise<Record<string, unknown>>;
+}
+
+/**
+ * Helpers operating on user provided data using the random source of the Faker instance.
+ */
+export declare interface Helpers {
+  /**
+   * Returns a random element of the array.
+   *
+   * @param array the non-empty array
+   * @returns the random element
+   */
+  arrayElement<T>(array: T[]): T;
+
+  /**
+   * Returns distinct random elements of the array in random order.
+   *
+   * @param array the array
+   * @param count the number of elements, a random number (at least one) if missing
+   * @returns the random elements
+   */
+  arrayElements<T>(array: T[], count?: number): T[];
+
+  /**
+   * Returns a new array containing the elements of the array in random order.
+   *
+   * @param array the array
+   * @returns the shuffled array
+   */
+  shuffle<T>(array: T[]): T[];
+
+  /**
+   * Returns a random key of the object.
+   *
+   * @param object the non-empty object
+   * @returns the random key
+   */
+  objectKey<T extends Record<string, unknown>>(object: T): keyof T;
+
+  /**
+   * Returns the value of a random key of the object.
+   *
+   * @param object the non-empty object
+   * @returns the random value
+   */
+  objectValue<T extends Record<string, unknown>>(object: T): T[keyof T];
+
+  /**
+   * Calls the function with the given probability.
+   *
+   * @param fn the function to be called
+   * @param probability the probability between 0 and 1, 0.5 by default
+   * @returns the result of the function or undefined if it was not called
+   */
+  maybe<T>(fn: () => T, probability?: number): T | undefined;
+
+  /**
+   * Calls the function count times and returns the results.
+   *
+   * @param fn the function to be called, it receives the index as second argument
+   * @param options the number of calls (count) or its range (count: {min, max}), 3 by default
+   * @returns the results of the function
+   */
+  multiple<T>(fn: (value: undefined, index: number) => T, options?: { count?: number | { min: number; max: number } }): T[];
+}
+
+/**
+ * Error thrown when a generator function is called with invalid arguments.
+ *
+ * The error is a TypeError whose name is "FakerArgumentError".
+ */
+export declare interface FakerArgumentError extends TypeError {
+  readonly name: "FakerArgumentError";
+  /** The name of the generator function. */
+  readonly function: string;
+  /** The name of the invalid parameter, empty if the error is not parameter specific. */
+  readonly parameter: string;
+  /** The expected type of the parameter. */
+  readonly expected: string;
+  /** Link to the API documentation of the generator function. */
+  readonly docs: string;
+}
+
+/**
+ * Error thrown when a generator function cannot be found by name.
+ *
+ * The error is a TypeError whose name is "FakerLookupError".
+ */
+export declare interface FakerLookupError extends TypeError {
+  readonly name: "FakerLookupError";
+  /** The name of the requested generator function. */
+  readonly function: string;
+  /** Link to the API documentation. */
+  readonly docs: string;
+}
+
 `
 
 func tsGen(out io.Writer) error {
@@ -65,10 +924,16 @@ func tsGen(out io.Writer) error {
 			fmt.Fprintf(out, "  /**\n   * %s.\n", info.Description)
 
 			for _, param := range info.Params {
-				fmt.Fprintf(out, "   * @param %s - %s\n", param.Field, param.Display)
+				fmt.Fprintf(out, "   * @param %s - %s%s\n", param.Field, param.Display, buildDefault(param))
 			}
 
 			fmt.Fprintf(out, "   * @returns a random %s\n", strings.ToLower(info.Display))
+
+			if deprecation, found := faker.GetDeprecations()[fname]; found {
+				fmt.Fprintf(out, "   * @deprecated since %s, use {@link %s.%s} instead, it will be removed in %s\n",
+					deprecation.Since, strcase.ToCamel(cname), deprecation.Replacement, deprecation.Removal)
+			}
+
 			fmt.Fprintf(out, "   * @example\n")
 			fmt.Fprintf(out, "   * ```ts\n")
 
@@ -89,28 +954,111 @@ func tsGen(out io.Writer) error {
 			fmt.Fprintf(out, "   * ```\n")
 			fmt.Fprintf(out, "   */\n")
 			fmt.Fprintf(out, "  %s(%s): %s;\n", fname, buildParamList(info), info.Output)
+
+			if options := buildOptionsType(info); len(options) != 0 {
+				fmt.Fprintf(out, "  %s(options: %s): %s;\n", fname, options, info.Output)
+			}
 		}
 
+		fmt.Fprint(out, catmethods[cname])
 		fmt.Fprintln(out, "}")
 	}
 
 	return nil
 }
 
+// buildParamList returns the positional parameter list of the generator function.
+// The parameters with default value are optional if all the following parameters are optional too.
 func buildParamList(info *gofakeit.Info) string {
 	out := new(bytes.Buffer)
+	optional := len(info.Params)
+
+	for optional > 0 && isOptionalParam(info.Params[optional-1]) {
+		optional--
+	}
 
 	for idx, param := range info.Params {
 		if idx != 0 {
 			fmt.Fprint(out, ", ")
 		}
 
-		fmt.Fprintf(out, "%s: %s", param.Field, param.Type)
+		mark := ""
+		if idx >= optional {
+			mark = "?"
+		}
+
+		fmt.Fprintf(out, "%s%s: %s", param.Field, mark, buildParamType(param))
 	}
 
 	return out.String()
 }
 
+// buildOptionsType returns the type of the options object accepted instead of the positional parameters,
+// or empty string if the generator function has no parameters or its first parameter is an object.
+func buildOptionsType(info *gofakeit.Info) string {
+	if len(info.Params) == 0 || strings.HasPrefix(info.Params[0].Type, "Record<") {
+		return ""
+	}
+
+	fields := make([]string, len(info.Params))
+
+	for idx, param := range info.Params {
+		mark := ""
+		if isOptionalParam(param) {
+			mark = "?"
+		}
+
+		fields[idx] = fmt.Sprintf("%s%s: %s", param.Field, mark, buildParamType(param))
+	}
+
+	return "{ " + strings.Join(fields, "; ") + " }"
+}
+
+// buildParamType returns the TypeScript type of the parameter.
+// String parameters with enumerated values are union types of the values (including the default value).
+func buildParamType(param gofakeit.Param) string {
+	if len(param.Options) == 0 || (param.Type != "string" && param.Type != "string[]") {
+		return param.Type
+	}
+
+	values := param.Options
+
+	if param.Type == "string" && len(param.Default) != 0 && !slices.Contains(values, param.Default) {
+		values = append(slices.Clone(values), param.Default)
+	}
+
+	literals := make([]string, len(values))
+
+	for idx, value := range values {
+		literals[idx] = strconv.Quote(value)
+	}
+
+	union := strings.Join(literals, " | ")
+
+	if param.Type == "string[]" {
+		return "(" + union + ")[]"
+	}
+
+	return union
+}
+
+// buildDefault returns the description of the default value of the parameter, empty if it has no default.
+func buildDefault(param gofakeit.Param) string {
+	if len(param.Default) == 0 {
+		return ""
+	}
+
+	if param.Type == "string" || (len(param.Options) != 0 && param.Type != "string[]") {
+		return fmt.Sprintf(" (default: %s)", strconv.Quote(param.Default))
+	}
+
+	return fmt.Sprintf(" (default: %s)", param.Default)
+}
+
+func isOptionalParam(param gofakeit.Param) bool {
+	return param.Optional || len(param.Default) != 0
+}
+
 func buildExample(name string, category string, info *gofakeit.Info) (string, string, error) {
 	params, err := genParams(info)
 	if err != nil {
@@ -132,7 +1080,10 @@ func buildExample(name string, category string, info *gofakeit.Info) (string, st
 
 	var output string
 
-	if obj := value.ToObject(runtime); obj != nil {
+	// BigInt values can't be marshaled, their decimal form is a valid JSON number
+	if _, isBigInt := value.Export().(*big.Int); isBigInt {
+		output = value.String()
+	} else if obj := value.ToObject(runtime); obj != nil {
 		b, err := obj.MarshalJSON()
 		if err != nil {
 			return "", "", err
@@ -157,6 +1108,159 @@ export default function () {
 	return out.String(), output, nil
 }
 
+// catmethods contains the declarations of the category methods which are not generator functions.
+var catmethods = map[string]string{ //nolint:gochecknoglobals
+	"internet": `
+  /**
+   * Query string (without leading question mark) of an object generated based on the schema.
+   * Nested objects are flattened using bracket notation (e.g. address[city]), spaces are encoded as %20.
+   * @param schema - Schema of the parameters
+   * @returns the escaped query string
+   */
+  queryString(schema: Schema): string;
+
+  /**
+   * application/x-www-form-urlencoded body of an object generated based on the schema.
+   * Nested objects are flattened using bracket notation (e.g. address[city]), spaces are encoded as +.
+   * @param schema - Schema of the form fields
+   * @returns the escaped form body
+   */
+  formUrlEncoded(schema: Schema): string;
+
+  /**
+   * multipart/form-data body of an object generated based on the schema, with optional file parts.
+   * @param schema - Schema of the form fields
+   * @param files - File parts by field name, random content is used if the content is missing
+   * @returns the body, the boundary and the Content-Type header value
+   * @example
+   * ` + "```ts" + `
+   * const form = faker.internet.multipartForm({ name: "firstName" }, { avatar: { filename: "me.png", size: 2048 } })
+   *
+   * http.post(url, form.body, { headers: { "Content-Type": form.contentType } })
+   * ` + "```" + `
+   */
+  multipartForm(schema: Schema, files?: Record<string, MultipartFile>): MultipartForm;
+
+  /**
+   * PNG image of the QR code of the content, e.g. for testing QR code ingestion endpoints.
+   * The code is encoded in byte mode with error correction level M, the content is at most 412 bytes long.
+   * If the content is missing, a random URL, Wi-Fi network configuration or vCard is encoded (see qrContent).
+   * @param content - The content to be encoded
+   * @param size - The width and height of the image in pixels (256 by default)
+   * @returns the PNG image
+   * @example
+   * ` + "```ts" + `
+   * const image = faker.internet.qrPng(faker.internet.qrContent("vcard"), 512)
+   * ` + "```" + `
+   */
+  qrPng(content?: string | null, size?: number): ArrayBuffer;
+`,
+	"fuzz": `
+  /**
+   * Copy of the seed payload with a single structured mutation at a random place, e.g. for robustness tests under load.
+   * The operators are bit flips, truncation, overlong strings and arrays, nulls, boundary numbers and injection strings.
+   * @param input - The seed payload
+   * @param options - The applicable mutation operators
+   * @returns the mutated payload, the applied operator and the path of the mutated value
+   * @example
+   * ` + "```ts" + `
+   * const { data, operator, path } = faker.fuzz.mutate({ name: "Jane", age: 42 }, { operators: ["null", "boundary"] })
+   * ` + "```" + `
+   */
+  mutate(input: unknown, options?: MutateOptions): Mutation;
+`,
+	"iot": `
+  /**
+   * Next reading of a sensor simulated by the faker instance. The successive readings of a sensor drift slowly
+   * instead of being white noise: meanReverting drifts around the typical value of the sensor type,
+   * randomWalk drifts freely within the range of the sensor type.
+   * @param options - The sensor type, the drift model and the sensor identifier
+   * @returns the reading of the sensor
+   * @example
+   * ` + "```ts" + `
+   * const reading = faker.iot.sensorReading({ type: "humidity", driftModel: "randomWalk", id: "kitchen" })
+   * ` + "```" + `
+   */
+  sensorReading(options?: SensorReadingOptions): SensorReading;
+`,
+	"messaging": `
+  /**
+   * Source of timed fake messages generated from the schema, e.g. for simulating chat or telemetry clients
+   * of a WebSocket or SSE service. The messages follow each other at ratePerSecond (1 by default),
+   * the delays are randomized by the jitter fraction of the interval (0 by default, at most 1).
+   * @param schema - Schema of the messages
+   * @param options - The rate and the jitter of the messages
+   * @returns the message stream, an iterator of messages and their delays
+   * @example
+   * ` + "```ts" + `
+   * const stream = faker.messaging.stream({ user: "username", text: "sentence" }, { ratePerSecond: 2, jitter: 0.3 })
+   *
+   * ws.onopen = () => stream.start((message) => ws.send(JSON.stringify(message)))
+   * ws.onclose = () => stream.stop()
+   * ` + "```" + `
+   */
+  stream(schema: Schema, options?: StreamOptions): MessageStream;
+`,
+	"numbers": `
+  /**
+   * Float32Array of random numbers, backed by a buffer filled without creating JavaScript numbers,
+   * e.g. for posting large ML feature vectors or telemetry batches.
+   * @param count - The number of elements
+   * @param min - The minimum value (inclusive), 0 by default
+   * @param max - The maximum value (exclusive), 1 by default
+   * @returns the typed array of the numbers
+   * @example
+   * ` + "```ts" + `
+   * http.post(url, faker.numbers.float32Array(1024, -1, 1).buffer)
+   * ` + "```" + `
+   */
+  float32Array(count: number, min?: number, max?: number): Float32Array;
+
+  /**
+   * Int32Array of random integers, backed by a buffer filled without creating JavaScript numbers.
+   * @param count - The number of elements
+   * @param min - The minimum value (inclusive), -2147483648 by default
+   * @param max - The maximum value (inclusive), 2147483647 by default
+   * @returns the typed array of the integers
+   * @example
+   * ` + "```ts" + `
+   * const ids = faker.numbers.intArray(10000, 1, 1000000)
+   * ` + "```" + `
+   */
+  intArray(count: number, min?: number, max?: number): Int32Array;
+`,
+	"product": `
+  /**
+   * PNG image of the barcode of the code, e.g. for inventory scanning API tests. The symbology is detected
+   * from the code unless set: GS1-128 for element strings in human readable form (e.g. of product.gs1128),
+   * EAN-13 for 13 digits, UPC-A for 12 digits and Code 128 for the other codes. The check digits are not validated,
+   * so images of invalid codes can be generated too.
+   * @param code - The code to be encoded
+   * @param options - The symbology and the size of the image
+   * @returns the PNG image
+   * @example
+   * ` + "```ts" + `
+   * const image = faker.product.barcodePng(faker.product.ean13(), { moduleWidth: 3 })
+   * ` + "```" + `
+   */
+  barcodePng(code: string, options?: BarcodeOptions): ArrayBuffer;
+`,
+	"word": `
+  /**
+   * Text statistically similar to a corpus, generated by a word level Markov chain.
+   * Unlike lorem ipsum, the text compresses like real user text, e.g. for CDN and compression benchmarks.
+   * The corpus file is loaded in the init context, the chain trained from it is shared between the VUs.
+   * @param options - The corpus (news by default), the number of words (50 by default) and the order (2 by default)
+   * @returns the generated text
+   * @example
+   * ` + "```ts" + `
+   * faker.word.markov({ corpusPath: "./reviews.txt", words: 120 })
+   * ` + "```" + `
+   */
+  markov(options?: MarkovOptions): string;
+`,
+}
+
 var catdesc = map[string]string{ //nolint:gochecknoglobals
 	"address":   "Generator to generate addresses and locations.",
 	"animal":    "Generator to generate animals.",
@@ -165,17 +1269,25 @@ var catdesc = map[string]string{ //nolint:gochecknoglobals
 	"book":      "Generator to generate book related entries.",
 	"car":       "Generator to generate car related entries.",
 	"celebrity": "Generator to generate celebrities.",
+	"chaos":     "Generator to generate edge case values for resilience testing.",
+	"cloud":     "Generator to generate cloud provider resource identifiers.",
 	"color":     "Generator to generate colors.",
+	"commerce":  "Generator to generate e-commerce order related entries.",
 	"company":   "Generator to generate company related entries.",
 	"emoji":     "Generator to generate emoji related entries.",
 	"error":     "Generator to generate various error codes and messages.",
 	"file":      "Generator to generate file related entries.",
 	"finance":   "Generator to generate finance related entries.",
 	"food":      "Generator to generate food related entries.",
+	"fuzz":      "Generator to generate fuzzing inputs for robustness testing.",
 	"game":      "Generator to generate game related entries.",
 	"hacker":    "Generator to generate hacker/IT words and phrases.",
+	"health":    "Generator to generate healthcare related entries.",
 	"hipster":   "Generator to generate hipster words, phrases and paragraphs.",
 	"internet":  "Generator to generate internet related entries.",
+	"iot":       "Generator to generate IoT device identifiers and sensor telemetry.",
+	"k8s":       "Generator to generate Kubernetes resource names, labels and manifests.",
+	"messaging": "Generator to generate message queue records and event envelopes.",
 	"language":  "Generator to generate language related entries.",
 	"minecraft": "Generator to generate minecraft related entries.",
 	"movie":     "Generator to generate movie related entries.",
@@ -185,6 +1297,7 @@ var catdesc = map[string]string{ //nolint:gochecknoglobals
 	"product":   "Generator to generate product related entries.",
 	"strings":   "Generator to generate strings.",
 	"time":      "Generator to generate time and date.",
+	"travel":    "Generator to generate air travel related entries.",
 	"word":      "Generator to generate words and sentences.",
 	"zen":       "Generator with all generator functions for convenient use.",
 }
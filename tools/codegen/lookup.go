@@ -3,6 +3,7 @@
 package main
 
 import (
+	"log"
 	"strings"
 
 	"github.com/grafana/xk6-faker/faker"
@@ -25,11 +26,12 @@ func typemap(src string) string {
 	case "byte", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
 		src = "number"
 	case "map[string]any":
-		src = "Record<string,unknown>"
+		src = "Record<string, unknown>"
 	case "map[string]string":
-		src = "Record<string,string>"
+		src = "Record<string, string>"
 	case "any":
 		src = "unknown"
+	case "bigint":
 	case "map[string][]string":
 		src = "Record<string, Array<string>>"
 	default:
@@ -43,18 +45,39 @@ func typemap(src string) string {
 	return src
 }
 
-func convertLookup(src *gofakeit.Info) (*gofakeit.Info, bool) {
-	output := typemap(src.Output)
-	if len(output) == 0 {
-		return src, false
+// convertType returns the TypeScript type of the gofakeit type.
+// Unknown types are converted to unknown with a warning, so the function is still part of the API.
+func convertType(src string, what string) string {
+	if typ := typemap(src); len(typ) != 0 {
+		return typ
 	}
 
+	log.Printf("warning: %s: unsupported type %s, using unknown", what, src)
+
+	return "unknown"
+}
+
+// structOutputs contains the TypeScript interfaces of the generator functions returning structs.
+var structOutputs = map[string]string{ //nolint:gochecknoglobals
+	"person":     "PersonInfo",
+	"address":    "AddressInfo",
+	"creditCard": "CreditCardInfo",
+	"job":        "JobInfo",
+	"movie":      "MovieInfo",
+	"book":       "BookInfo",
+}
+
+func convertLookup(name string, src *gofakeit.Info) *gofakeit.Info {
 	info := *src
 
-	info.Output = output
+	info.Output = convertType(src.Output, src.Display+" output")
+
+	if output, found := structOutputs[name]; found {
+		info.Output = output
+	}
 
 	if len(src.Params) == 0 {
-		return &info, true
+		return &info
 	}
 
 	info.Params = make([]gofakeit.Param, len(src.Params))
@@ -65,22 +88,27 @@ func convertLookup(src *gofakeit.Info) (*gofakeit.Info, bool) {
 		}
 
 		param := from
-		param.Type = typemap(param.Type)
+		param.Type = convertType(param.Type, src.Display+" parameter "+param.Field)
 
 		info.Params[idx] = param
 	}
 
 	info.Category = convertCategory(src.Category)
 
-	return &info, true
+	return &info
 }
 
 func getFuncLookups() map[string]*gofakeit.Info {
 	all := make(map[string]*gofakeit.Info)
 
 	for key, value := range faker.GetFuncLookups() {
-		if info, ok := convertLookup(value); ok {
-			all[key] = info
+		all[key] = convertLookup(key, value)
+	}
+
+	// the deprecated names are available until the removal release
+	for key, deprecation := range faker.GetDeprecations() {
+		if value, found := faker.GetFuncLookups()[deprecation.Replacement]; found {
+			all[key] = convertLookup(key, value)
 		}
 	}
 
@@ -94,8 +122,12 @@ func getCategoryFuncs() map[string]map[string]*gofakeit.Info {
 		category := make(map[string]*gofakeit.Info, len(funcs))
 
 		for fun, info := range funcs {
-			if converted, ok := convertLookup(info); ok {
-				category[fun] = converted
+			category[fun] = convertLookup(fun, info)
+		}
+
+		for fun, deprecation := range faker.GetDeprecations() {
+			if info, found := funcs[deprecation.Replacement]; found {
+				category[fun] = convertLookup(fun, info)
 			}
 		}
 
@@ -95,6 +95,7 @@ export declare class Faker {
    * Setting seed to 0 (or omitting it) will use seed derived from system entropy.
    *
    * @param seed random seed value for deterministic generator
+   * @param options additional options of the Faker instance
    *
    * @example
    * ```ts
@@ -102,7 +103,63 @@ export declare class Faker {
    * const semiRandomFaker = new Faker()
    * ```
    */
-  constructor(seed?: number);
+  constructor(seed?: number, options?: FakerOptions);
+
+  /**
+   * Creates a new instance of Faker using an options object.
+   *
+   * The random seed can be set as the seed option.
+   * Using the seedScope option, the scenario name, the VU ID and the iteration number
+   * can be mixed into the seed automatically, which gives reproducible but distinct
+   * data per VU or per iteration.
+   *
+   * @param options options of the Faker instance
+   *
+   * @example
+   * ```ts
+   * const faker = new Faker({ seed: 11, seedScope: "iteration" })
+   * ```
+   */
+  constructor(options: FakerOptions);
+
+  /**
+   * Create a new instance of Faker from a state returned by the {@link Faker.state} method.
+   *
+   * The restored instance continues the deterministic sequence exactly where the
+   * original instance was at the time of the state export.
+   * The internal state of the random source is restored, so restoring is fast
+   * regardless of the number of values generated by the original instance.
+   *
+   * @param state the exported state
+   *
+   * @example
+   * ```ts
+   * const faker = Faker.fromState(__ENV.FAKER_STATE)
+   * ```
+   */
+  static fromState(state: string): Faker;
+
+  /**
+   * Create a new instance of Faker from a snapshot returned by the {@link Faker.snapshot} method.
+   *
+   * Besides the random source position, the options and the stored entities (see {@link Faker.adapt})
+   * of the original instance are restored, so the configuration done once in the setup function
+   * is available in every VU.
+   *
+   * @param snapshot the exported snapshot
+   *
+   * @example
+   * ```ts
+   * export function setup() {
+   *   return { faker: faker.snapshot() }
+   * }
+   *
+   * export default function (data) {
+   *   const faker = Faker.restore(data.faker)
+   * }
+   * ```
+   */
+  static restore(snapshot: string): Faker;
 
   /**
    * Call fake data generator function based on function name.
@@ -111,4 +168,719 @@ export declare class Faker {
    * @param args parameters for the generator function to be called
    */
   call(func: string, ...args: unknown[]): unknown;
+
+  /**
+   * Call fake data generator function multiple times and return the generated values as an array.
+   *
+   * The values are generated entirely in Go, so it is much faster than calling
+   * the generator function in a loop. The same is available on every generator function
+   * as a `many` method (e.g. `faker.internet.email.many(1000)`).
+   *
+   * @param func the name of the generator function to be called
+   * @param count the number of values to generate (an integer, at most 10000000)
+   * @param args parameters for the generator function to be called
+   *
+   * @example
+   * ```ts
+   * const emails = faker.many("email", 1000)
+   * ```
+   */
+  many(func: string, count: number, ...args: unknown[]): unknown[];
+
+  /**
+   * Return an iterator of the successive values of the seeded stream, so the values can be consumed
+   * by `for...of` loops and iterator utilities without materializing arrays.
+   *
+   * The source of the values is the name of a generator function (the rest of parameters passed to the function),
+   * a function called with the index of the value, or a schema (see {@link Faker.fromSchema}).
+   * The iterator never ends on its own: break the loop or call the `return` method to stop it.
+   *
+   * @param source the generator function name, the function or the schema of the values
+   * @param args parameters for the generator function
+   *
+   * @example
+   * ```ts
+   * for (const email of faker.iter("email")) {
+   *   if (!register(email)) break
+   * }
+   * ```
+   */
+  iter(source: string, ...args: unknown[]): IterableIterator<unknown>;
+  iter<T>(source: (index: number) => T): IterableIterator<T>;
+  iter(source: Schema): IterableIterator<Record<string, unknown>>;
+
+  /**
+   * Generate an object based on a schema.
+   *
+   * The schema property values are field specifications: the name of the generator function
+   * (optionally qualified by the category name), an array containing the name of the generator function
+   * and its parameters, an object with func and args properties, an object with ref property referencing
+   * a stored entity (see {@link Faker.adapt}), or a nested schema.
+   *
+   * @param schema the schema of the object to generate
+   * @returns the generated object
+   *
+   * @example
+   * ```ts
+   * const user = faker.fromSchema({
+   *   name: "person.name",
+   *   age: ["intRange", 18, 99],
+   *   address: { city: "city", zip: "zip" },
+   * })
+   * ```
+   */
+  fromSchema(schema: Schema): Record<string, unknown>;
+
+  /**
+   * Generate an object based on a schema with exactly one intentional violation, e.g. for testing
+   * the validation of an API. All fields of the schema are considered required.
+   *
+   * The invalid option selects the kind of the violation: missingRequired removes a field, wrongType
+   * replaces a field value with a value of another type, outOfRange replaces the value of a field
+   * generated with min and max parameters (e.g. ["intRange", 1, 10]) with a value outside the range.
+   *
+   * @param schema the schema of the object to generate
+   * @param options the kind of the violation
+   * @returns the generated object and the description of the violation
+   *
+   * @example
+   * ```ts
+   * const { data, violation } = faker.fromSchema({ name: "name", age: ["intRange", 18, 99] }, { invalid: "outOfRange" })
+   *
+   * check(http.post(url, JSON.stringify(data)), { [`rejects ${violation.field}`]: (r) => r.status === 400 })
+   * ```
+   */
+  fromSchema(schema: Schema, options: FromSchemaOptions): InvalidRecord;
+
+  /**
+   * Return an object with the fields of the schema (see {@link Faker.fromSchema}), whose values are
+   * generated on first access and then cached, so large templates only pay for the fields actually used.
+   *
+   * Nested schemas are lazy objects too. The values are generated in the order of access,
+   * so the same seed produces the same values only if the fields are accessed in the same order.
+   *
+   * @param schema the schema of the object
+   * @returns the lazy object
+   *
+   * @example
+   * ```ts
+   * const user = faker.lazy({ id: "uuid", name: "person.name", bio: ["paragraph", 3, 5, 20] })
+   *
+   * http.get(`${url}/users/${user.id}`) // only the id is generated
+   * ```
+   */
+  lazy(schema: Schema): Record<string, unknown>;
+
+  /**
+   * Compile a schema (see {@link Faker.fromSchema}) once, so the generator functions are looked up
+   * and their parameters are validated only at compile time, not on every generated object.
+   *
+   * @param schema the schema of the objects to generate
+   * @returns the compiled schema generating the objects
+   *
+   * @example
+   * ```ts
+   * const users = faker.compile({ id: "uuid", name: "person.name", age: ["intRange", 18, 99] })
+   *
+   * export default function () {
+   *   http.post(url, JSON.stringify(users.one()))
+   * }
+   * ```
+   */
+  compile(schema: Schema): CompiledSchema;
+
+  /**
+   * Return the deprecated function and category names with their replacements.
+   *
+   * The deprecated names keep working until the removal release, but their first use logs a warning.
+   *
+   * @returns the deprecated names
+   *
+   * @example
+   * ```ts
+   * console.log(faker.aliases().functions.creditCardCvv.replacement) // creditCardCVV
+   * ```
+   */
+  aliases(): Aliases;
+
+  /**
+   * Estimate the size of a data set before generating it, e.g. for checking the memory need of the load generator.
+   *
+   * The estimate is extrapolated from a sample of values generated by a Faker derived from the seed
+   * of the instance, so the random sequence of the instance is not affected.
+   *
+   * @param schema the schema (see {@link Faker.fromSchema}) or the name of the generator function
+   * @param count the number of values
+   * @returns the approximate size of the values serialized as a JSON array in bytes
+   *
+   * @example
+   * ```ts
+   * const bytes = faker.estimateBytes({ id: "uuid", name: "person.name", email: "email" }, 1000000)
+   * ```
+   */
+  estimateBytes(schema: Schema | string, count: number): number;
+
+  /**
+   * Generate multiple related collections of records, e.g. for seeding a database with realistic joins.
+   *
+   * The property names of the specification are the collection names, the values are schemas
+   * (see {@link Faker.fromSchema}) or objects with schema and count (10 by default) properties.
+   * The ref fields in `collection.field` format reference a random generated record of the collection,
+   * so the foreign keys point to actually generated parent records. The field path can be nested,
+   * the collection name alone references the whole record. The collections are generated in the order
+   * of their dependencies, the references to the same collection pick one of the previous records.
+   *
+   * @param spec the collection specifications by collection name
+   * @returns the generated records by collection name
+   *
+   * @example
+   * ```ts
+   * const { users, orders } = faker.collections({
+   *   users: { id: "uuid", name: "person.name" },
+   *   orders: { schema: { id: "uuid", userId: { ref: "users.id" }, amount: ["price", 1, 100] }, count: 100 },
+   * })
+   * ```
+   */
+  collections(spec: Record<string, Schema | { schema: Schema; count?: number }>): Record<string, Record<string, unknown>[]>;
+
+  /**
+   * Generate a stream of CRUD mutation events against a previously generated dataset,
+   * e.g. for load testing sync and CDC endpoints.
+   *
+   * The events are applied to a copy of the dataset in random order, so their before and after states
+   * are consistent: updates and deletes refer to the current records. The inserted records and the updated fields
+   * are generated from the schema option, without schema they are taken from the values of the dataset.
+   *
+   * @param dataset the records to change
+   * @param options the number of events of each operation, the key field and the schema
+   * @returns iterator of the change events
+   *
+   * @example
+   * ```ts
+   * const { users } = faker.collections({ users: { id: "uuid", name: "person.name", email: "email" } })
+   *
+   * for (const event of faker.changes(users, { inserts: 10, updates: 50, deletes: 5 })) {
+   *   http.post(url, JSON.stringify(event))
+   * }
+   * ```
+   */
+  changes(dataset: Record<string, unknown>[], options?: ChangesOptions): ChangeStream;
+
+  /**
+   * Generate an object based on a compact recipe string.
+   *
+   * The recipe is a comma separated list of field definitions in "name:function(args)" format.
+   * The parameter list is optional, the arguments are numbers, booleans, arrays in square brackets
+   * or strings (quoted, or unquoted if they contain no special characters).
+   * If the name is omitted, the name of the generator function is used as the field name.
+   *
+   * @param recipe the recipe of the object to generate
+   * @returns the generated object
+   *
+   * @example
+   * ```ts
+   * const payment = faker.recipe("name:person.name, email:person.email, amount:finance.amount(10,500)")
+   * ```
+   */
+  recipe(recipe: string): Record<string, unknown>;
+
+  /**
+   * Write the dataset into a Parquet file.
+   *
+   * The properties of the objects are the columns of the file, in the order of their first occurrence.
+   * Boolean, integer and floating point values are stored as such, strings as UTF-8 strings,
+   * dates as RFC 3339 strings and other values (e.g. nested objects) as JSON strings.
+   * Missing and null values are stored as nulls. The columns are stored uncompressed.
+   *
+   * The path is relative to the working directory of k6, the file is created or truncated.
+   *
+   * @param path the path of the Parquet file
+   * @param dataset the array of objects to write
+   *
+   * @example
+   * ```ts
+   * const faker = new Faker(11)
+   *
+   * const users = Array.from({ length: 100000 }, () => faker.fromSchema({ id: "uuid", name: "firstName", age: "int8" }))
+   *
+   * faker.writeParquet("users.parquet", users)
+   * ```
+   */
+  writeParquet(path: string, dataset: Record<string, unknown>[]): void;
+
+  /**
+   * Write the dataset into an Arrow IPC file (also known as Feather V2).
+   *
+   * The columns are created like by {@link Faker.writeParquet}.
+   *
+   * @param path the path of the Arrow IPC file
+   * @param dataset the array of objects to write
+   */
+  writeArrow(path: string, dataset: Record<string, unknown>[]): void;
+
+  /**
+   * Create a check function that validates a response body against a schema.
+   *
+   * The same schema can be used for generating the request and for validating the response.
+   * The check function accepts a k6 HTTP response, a JSON string or an object and
+   * returns true if every field of the schema is present with the expected type and satisfies
+   * the constraints derived from the arguments of the generator function: the range of min and max
+   * (e.g. ["intRange", 18, 99]), the length (e.g. ["digitN", 6] or ["passwordPolicy", 10, 14])
+   * and the format (e.g. ["date", "RFC1123"], sku or ["numerify", "###-####"]).
+   *
+   * @param schema the schema to validate against
+   * @returns the check function
+   *
+   * @example
+   * ```ts
+   * const res = http.post(url, JSON.stringify(faker.fromSchema(schema)))
+   *
+   * check(res, { "valid user": faker.expectations(schema) })
+   * ```
+   */
+  expectations(schema: Schema): (value: unknown) => boolean;
+
+  /**
+   * Validate a value or a dataset against data quality rules.
+   *
+   * The built-in rules are "required", "email", "e164" (or "phone"), "luhn" and "date"
+   * (with optional min and max options). A function can be used as a custom rule,
+   * it returns true if the value is valid, false or an error message otherwise.
+   * If the value is an array and the rules describe object properties,
+   * every item of the array is validated.
+   *
+   * @param data the value or the dataset to validate
+   * @param rules the rules to validate against
+   * @returns the list of violations, empty if the data is valid
+   *
+   * @example
+   * ```ts
+   * export function setup() {
+   *   const users = faker.many("person", 100).map(makeUser)
+   *   const violations = faker.validate(users, {
+   *     email: "email",
+   *     phone: "e164",
+   *     card: ["required", "luhn"],
+   *     born: { rule: "date", min: "1900-01-01", max: new Date() },
+   *   })
+   *
+   *   if (violations.length != 0) {
+   *     throw new Error(JSON.stringify(violations))
+   *   }
+   * }
+   * ```
+   */
+  validate(data: unknown, rules: Rules): Violation[];
+
+  /**
+   * Create a function that feeds backend responses into the entity store of the instance.
+   *
+   * The returned function calls fn with its arguments (e.g. a k6 HTTP response) and stores
+   * the entities returned by fn. The fn function returns an object, the property names are the entity kinds,
+   * the property values are the entities (e.g. server-assigned IDs) or arrays of entities.
+   * The stored entities can be referenced by the {@link Faker.ref} method or
+   * by a field specification with ref property in schemas.
+   * At most 10000 entities are stored per kind, the oldest ones are overwritten.
+   *
+   * @param fn function extracting the entities
+   * @returns the function storing the entities
+   *
+   * @example
+   * ```ts
+   * const created = faker.adapt((res) => ({ users: res.json("id") }))
+   *
+   * export default function() {
+   *   created(http.post(url + "/users", JSON.stringify(faker.fromSchema({ name: "name" }))))
+   *
+   *   const order = faker.fromSchema({ userId: { ref: "users" }, amount: ["price", 1, 100] })
+   * }
+   * ```
+   */
+  adapt(fn: (...args: any[]) => Record<string, unknown> | undefined): (...args: any[]) => Record<string, unknown> | undefined;
+
+  /**
+   * Get a random stored entity of the given kind (see {@link Faker.adapt}).
+   *
+   * @param kind the kind of the entity
+   * @returns a random stored entity, undefined if there is no stored entity of the kind
+   */
+  ref(kind: string): unknown;
+
+  /**
+   * Pre-generate data rows for a scenario.
+   *
+   * The rows are generated deterministically from the seed and the scenario name,
+   * so each scenario gets its own isolated, reproducible data set.
+   * Inside the iterations of the scenario, the `next()` method returns the row belonging to
+   * the current scenario iteration, so VUs of the scenario get distinct rows.
+   * Outside of the scenario, `next()` cycles through the rows.
+   *
+   * @param scenario the name of the scenario
+   * @param options the number of rows and the schema of the rows
+   *
+   * @example
+   * ```ts
+   * const users = faker.scenarioData("signup", { rows: 1000, fields: { name: "name", email: "email" } })
+   *
+   * export function signup() {
+   *   const user = users.next()
+   * }
+   * ```
+   */
+  scenarioData(scenario: string, options: { rows: number; fields: Schema }): ScenarioData;
+
+  /**
+   * Create a sampler walking a weighted state machine, e.g. for realistic multi-step user behavior.
+   *
+   * The transitions map each state to the weights of its target states, states without transitions
+   * are terminal states. The `next()` method returns the next state of the walk, starting with the
+   * start state and restarting there after a terminal state. The `walk()` method returns a complete
+   * journey from the start state to a terminal state. The walks are reproducible from the seed.
+   *
+   * @param options the states, the weighted transitions and the start state
+   *
+   * @example
+   * ```ts
+   * const visitor = faker.journey({
+   *   transitions: {
+   *     browse: { browse: 2, search: 3, exit: 1 },
+   *     search: { browse: 1, addToCart: 2, exit: 1 },
+   *     addToCart: { search: 1, checkout: 2 },
+   *   },
+   *   start: "browse",
+   * })
+   *
+   * export default function () {
+   *   for (const step of visitor.walk()) {
+   *     actions[step]()
+   *   }
+   * }
+   * ```
+   */
+  journey(options: JourneyOptions): Journey;
+
+  /**
+   * Create a generator cycling among exactly count distinct pre-generated values.
+   *
+   * Database and cache load tests often need controlled key cardinality, which pure random generation
+   * can't provide. The values are generated by the generator function (name of a generator function
+   * or a JavaScript function), the next() method picks them in round-robin order or randomly.
+   *
+   * @param func the name of the generator function or a function returning a value
+   * @param count the number of distinct values
+   * @param options the picking mode and the parameters of the generator function
+   *
+   * @example
+   * ```ts
+   * const keys = faker.cardinality("uuid", 100, { mode: "random" })
+   *
+   * export default function () {
+   *   http.get(`https://test.example.com/cache/${keys.next()}`)
+   * }
+   * ```
+   */
+  cardinality<T = unknown>(func: string | (() => T), count: number, options?: CardinalityOptions): Cardinality<T>;
+
+  /**
+   * Describe a generator function.
+   *
+   * Besides the documentation of the function and its parameters, the description contains the
+   * personally identifiable information class (e.g. name, email, ssn) of the generated values.
+   *
+   * @param func the name of the generator function, optionally qualified by the category name
+   *
+   * @example
+   * ```ts
+   * if (faker.describe("person.email").pii) {
+   *   console.log("email addresses are personally identifiable information")
+   * }
+   * ```
+   */
+  describe(func: string): FunctionDescription;
+
+  /**
+   * Create a generator function producing values with embedded redaction markers.
+   *
+   * The values of functions with personally identifiable information class are wrapped in
+   * `[[pii:class]]` and `[[/pii]]` markers (e.g. `[[pii:email]]linda.garcia@example.com[[/pii]]`),
+   * so downstream tooling can scrub them from logs and result exports. The items of object and array
+   * values are marked one by one, numbers are converted to marked strings.
+   * Values of other functions are returned as is.
+   *
+   * @param func the name of the generator function, optionally qualified by the category name
+   *
+   * @example
+   * ```ts
+   * const email = faker.redactable("person.email")
+   *
+   * export default function () {
+   *   console.log(`signing up ${email()}`)
+   * }
+   * ```
+   */
+  redactable(func: string): (...args: unknown[]) => unknown;
+
+  /**
+   * Load custom datasets (domain-specific vocabularies) into the instance.
+   *
+   * The generator functions of the instance, including the composite ones (e.g. person, address, product),
+   * use the custom values instead of the embedded ones, or besides them in extend mode.
+   * The values of a dataset are an array of strings or a JSON array text, e.g. the content of a file.
+   *
+   * @param datasets the values by dataset name
+   * @param options the loading mode, replace by default
+   *
+   * @example
+   * ```ts
+   * const faker = new Faker(11)
+   *
+   * faker.loadData({ products: open("drugs.json"), cities: ["Springfield", "Shelbyville"] })
+   *
+   * export default function () {
+   *   console.log(faker.product.productName())
+   * }
+   * ```
+   */
+  loadData(datasets: Datasets, options?: LoadDataOptions): void;
+
+  /**
+   * Create a generator sampling the rows of a CSV or JSON dataset file.
+   *
+   * The file can only be loaded in the init context, the path is relative to the script.
+   * CSV files must have a header row, the rows are returned as objects by column name.
+   * JSON files must contain an array, its items are returned.
+   * The rows are picked using the random source of the instance, so the sampling is reproducible.
+   *
+   * @param path the path of the .csv or .json file
+   * @param options the column to be returned instead of the whole row and the column of the weights
+   *
+   * @example
+   * ```ts
+   * const drugs = faker.fromFile("drugs.csv", { column: "name", weighted: "prescriptions" })
+   *
+   * export default function () {
+   *   http.post("https://test.example.com/orders", JSON.stringify({ drug: drugs.next() }))
+   * }
+   * ```
+   */
+  fromFile<T = unknown>(path: string, options?: FromFileOptions): Sample<T>;
+
+  /**
+   * Load an OpenAPI 3 specification (JSON or YAML) to generate valid request bodies of its operations.
+   * The files with .json extension are parsed as JSON, the others as YAML.
+   *
+   * The generated bodies honor the formats, enums, patterns, required and read-only properties,
+   * the numeric bounds and the length constraints of the schemas. Local references are resolved,
+   * string properties without format are generated by the generator function named after the property
+   * (e.g. firstName) if there is such.
+   * Recursive schemas are generated up to 6 levels deep, arrays have at most 1000 items.
+   *
+   * The file can only be loaded in the init context, the path is relative to the script.
+   *
+   * @param path the path of the specification
+   * @returns the request body generator of the specification
+   *
+   * @example
+   * ```ts
+   * const api = faker.openapi("openapi.yaml")
+   *
+   * export default function () {
+   *   http.post("https://test.example.com/users", JSON.stringify(api.requestBody("POST /users")))
+   * }
+   * ```
+   */
+  openapi(path: string): OpenAPI;
+
+  /**
+   * Start an embedded HTTP server serving fake JSON responses generated from schemas,
+   * so the same k6 binary can stub the dependencies of the system under test and drive the load.
+   *
+   * The route keys are Go ServeMux patterns (e.g. "GET /users/{id}"), the values are schemas
+   * (see {@link Faker.fromSchema}) or route objects. The responses are generated by the server using a random
+   * source seeded from the instance, ref fields and loaded datasets are not supported.
+   * The servers are not shared between the VUs, so a server started in the init context runs in every VU.
+   * Listening on port 0 picks a free port for each of them, the url property contains the actual address.
+   * If a server of the VU is already running on the address, it is returned.
+   * The server is stopped at the end of the test run, unless it is closed before.
+   *
+   * @param addr the listen address (e.g. "127.0.0.1:0")
+   * @param routes the routes by pattern
+   * @returns the running server
+   *
+   * @example
+   * ```ts
+   * const mock = faker.serve("127.0.0.1:0", {
+   *   "GET /users/{id}": { id: "uuid", name: "person.firstName" },
+   *   "GET /orders": { schema: { id: "uuid", total: "price" }, count: 10 },
+   * })
+   *
+   * export default function () {
+   *   http.get(`${mock.url}/users/1`)
+   * }
+   * ```
+   */
+  serve(addr: string, routes: Record<string, Schema | MockRoute>): MockServer;
+
+  /**
+   * Replay the values recorded in a JSON Lines file instead of generating new ones.
+   *
+   * If the XK6_FAKER_RECORD environment variable contains a file path, every value generated
+   * by the generator functions is written to the file as a line containing the function name,
+   * the arguments, the output, the global VU ID and the iteration number (0 and -1 in the init context).
+   * The file is written through a buffer and completed when the test run ends.
+   * The recorded values are replayed per VU and function in the recorded order, so the same script
+   * receives exactly the same data, for example while debugging a flaky assertion.
+   * An error is thrown if there are no more recorded values of a function.
+   *
+   * The file can only be loaded in the init context, the path is relative to the script.
+   *
+   * @param path the path of the record file
+   *
+   * @example
+   * ```ts
+   * // k6 run --env XK6_FAKER_RECORD=record.jsonl script.js
+   * const faker = new Faker()
+   *
+   * if (__ENV.REPLAY) {
+   *   faker.replayFrom("record.jsonl")
+   * }
+   * ```
+   */
+  replayFrom(path: string): void;
+
+  /**
+   * Returns the hash of the deterministic sequence generated from the seed of the instance.
+   *
+   * The hash covers the primitive random values of the random source and the values of a fixed set
+   * of generator functions, so it changes if an upgrade changes the seeded data. It can be recorded
+   * next to the assertions based on the seeded data and checked before running them.
+   * The random source of the instance is not used, so the generated data is not affected.
+   *
+   * @param count the number of primitive random values included in the hash (default 100)
+   * @returns the versioned hash, for example "v1:8f2852..."
+   *
+   * @example
+   * ```ts
+   * const faker = new Faker(11)
+   *
+   * if (faker.sequenceHash() !== __ENV.FAKER_SEQUENCE) {
+   *   throw new Error("the seeded data has changed")
+   * }
+   * ```
+   */
+  sequenceHash(count?: number): string;
+
+  /**
+   * Export the state of the random source as an opaque string.
+   *
+   * The state can be used to create a new Faker instance continuing the
+   * deterministic sequence (see {@link Faker.fromState}), for example after restarting a long soak test.
+   * The state of the "crypto" random source (see {@link FakerOptions.rng}) can not be exported.
+   *
+   * @returns the opaque state string
+   */
+  state(): string;
+
+  /**
+   * Export the options, the state of the random source, the stored entities, the datasets loaded by
+   * {@link Faker.loadData}, the used recipes, Avro and protobuf schemas, markov corpus files and the
+   * values of the simulated sensors as an opaque string.
+   *
+   * The instances replaying recorded values (see {@link Faker.replayFrom}) can not be exported.
+   *
+   * The snapshot can be passed to the VUs (e.g. as setup data) and restored using {@link Faker.restore}.
+   * The instance is also serialized as its snapshot by `JSON.stringify()`.
+   *
+   * @returns the opaque snapshot string
+   */
+  snapshot(): string;
+
+  /**
+   * Returns the snapshot of the instance (see {@link Faker.snapshot}), used by `JSON.stringify()`.
+   */
+  toJSON(): string;
+
+  /**
+   * Generate a value using a generator function and set it as a tag of the VU.
+   *
+   * The tag is attached to all subsequent metrics emitted by the VU (e.g. HTTP requests),
+   * so backend traces and k6 metrics can be joined on the generated identity.
+   * It can only be used in the VU context.
+   *
+   * @param name the name of the tag
+   * @param func the name of the generator function to be called
+   * @param args parameters for the generator function to be called
+   * @returns the generated value
+   *
+   * @example
+   * ```ts
+   * export default function() {
+   *   faker.tag("tenant", "company")
+   *   http.get("https://test.k6.io") // tagged with tenant
+   * }
+   * ```
+   */
+  tag(name: string, func: string, ...args: unknown[]): unknown;
+
+  /**
+   * Generate a value using a generator function and set it as a metadata of the VU.
+   *
+   * Unlike tags, metadata is not indexed, so it is suitable for high-cardinality correlation IDs.
+   * It can only be used in the VU context.
+   *
+   * @param name the name of the metadata
+   * @param func the name of the generator function to be called
+   * @param args parameters for the generator function to be called
+   * @returns the generated value
+   */
+  correlate(name: string, func: string, ...args: unknown[]): unknown;
+
+  /**
+   * Binary encoders of fake records.
+   *
+   * The records can be serialized to Avro or protobuf wire format, optionally prefixed with
+   * the schema registry header, so they can be produced to Kafka as is, or to CBOR and SenML
+   * for IoT device platforms.
+   *
+   * @example
+   * ```ts
+   * const schema = { type: "record", name: "User", fields: [{ name: "name", type: "string" }] }
+   *
+   * export default function() {
+   *   const value = faker.encode.avro(schema, null, { schemaId: 7 })
+   * }
+   * ```
+   */
+  readonly encode: Encode;
+
+  /**
+   * Helpers operating on user provided data.
+   *
+   * The helpers use the random source of the instance, so the results are reproducible.
+   *
+   * @example
+   * ```ts
+   * export default function() {
+   *   const plan = faker.helpers.arrayElement(["free", "pro", "enterprise"])
+   *   const coupon = faker.helpers.maybe(() => faker.strings.lexify("????-????"), 0.2)
+   * }
+   * ```
+   */
+  readonly helpers: Helpers;
+
+  /**
+   * Helpers filling the forms of k6/browser pages with coherent fake data.
+   *
+   * @example
+   * ```ts
+   * export default async function() {
+   *   const page = await browser.newPage()
+   *
+   *   await page.goto("https://test.example.com/signup")
+   *   await faker.forms.fill(page)
+   *   await faker.forms.fill(page, { "#company": "company", "#seats": ["intRange", 1, 50] })
+   * }
+   * ```
+   */
+  readonly forms: Forms;
 }